@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/adapter/gateway/postgres"
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/adapter/gateway/uuid"
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/domain/service"
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/driver/config"
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/driver/datastore"
+	pubsubdriver "github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/driver/pubsub"
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/usecase"
+)
+
+func main() {
+	// Load configuration
+	cfg := config.Load()
+
+	// Setup signal handling
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sigCh
+		log.Println("Shutting down...")
+		cancel()
+	}()
+
+	// Initialize database connection
+	db, err := datastore.OpenPostgres(cfg.DatabaseURL)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	// Initialize repositories
+	pgRepo := postgres.NewRepository(db)
+	videoRepo := postgres.NewVideoRepository(pgRepo)
+	snapshotRepo := postgres.NewVideoSnapshotRepository(pgRepo)
+	metricsRepo := postgres.NewVideoMetricsRepository(pgRepo)
+	processedEventRepo := postgres.NewProcessedEventRepository(pgRepo)
+	poisonMessageRepo := postgres.NewPoisonMessageRepository(pgRepo)
+	txManager := postgres.NewTransactionManager(db)
+
+	// Initialize use case
+	metricsUseCase := usecase.NewMetricsUseCase(
+		videoRepo,
+		snapshotRepo,
+		metricsRepo,
+		processedEventRepo,
+		poisonMessageRepo,
+		txManager,
+		uuid.NewGenerator(),
+		service.NewMetricsCalculator(),
+	)
+
+	// Initialize Pub/Sub client
+	client, err := pubsub.NewClient(ctx, cfg.PubSubProjectID)
+	if err != nil {
+		log.Fatalf("Failed to create pubsub client: %v", err)
+	}
+	defer client.Close()
+
+	subscriber := pubsubdriver.NewSnapshotAddedSubscriber(
+		client,
+		cfg.SnapshotAddedSubscription,
+		cfg.PubSubMaxOutstandingMessages,
+		cfg.PubSubMaxDeliveryAttempts,
+		metricsUseCase,
+	)
+
+	if err := subscriber.Run(ctx); err != nil {
+		log.Fatalf("Subscriber stopped: %v", err)
+	}
+}
@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/adapter/gateway/postgres/sqlcgen"
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/port/output/gateway"
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+)
+
+// poisonMessageRepository implements gateway.PoisonMessageRepository interface
+type poisonMessageRepository struct {
+	*Repository
+}
+
+// NewPoisonMessageRepository creates a new poison message repository
+func NewPoisonMessageRepository(repo *Repository) gateway.PoisonMessageRepository {
+	return &poisonMessageRepository{Repository: repo}
+}
+
+// Save stores a poison message
+func (r *poisonMessageRepository) Save(ctx context.Context, message *domain.PoisonMessage) error {
+	id, err := uuid.Parse(string(message.ID))
+	if err != nil {
+		return err
+	}
+
+	var attributes pqtype.NullRawMessage
+	if len(message.Attributes) > 0 {
+		data, err := json.Marshal(message.Attributes)
+		if err != nil {
+			return err
+		}
+		attributes = pqtype.NullRawMessage{RawMessage: data, Valid: true}
+	}
+
+	payload := message.Payload
+	if payload == nil {
+		payload = []byte{}
+	}
+
+	return r.querier(ctx).CreatePoisonMessage(ctx, sqlcgen.CreatePoisonMessageParams{
+		ID:              id,
+		MessageID:       message.MessageID,
+		EventID:         sql.NullString{String: message.EventID, Valid: message.EventID != ""},
+		Subscription:    message.Subscription,
+		Payload:         payload,
+		Attributes:      attributes,
+		ErrorMessage:    message.ErrorMessage,
+		DeliveryAttempt: int32(message.DeliveryAttempt),
+		ReceivedAt:      message.ReceivedAt,
+		CreatedAt:       message.CreatedAt,
+	})
+}
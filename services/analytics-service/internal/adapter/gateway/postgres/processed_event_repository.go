@@ -0,0 +1,35 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/adapter/gateway/postgres/sqlcgen"
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/port/output/gateway"
+)
+
+// processedEventRepository implements gateway.ProcessedEventRepository interface
+type processedEventRepository struct {
+	*Repository
+}
+
+// NewProcessedEventRepository creates a new processed event repository
+func NewProcessedEventRepository(repo *Repository) gateway.ProcessedEventRepository {
+	return &processedEventRepository{Repository: repo}
+}
+
+// MarkProcessed records the event and returns false if it was already recorded.
+// Inside a transaction, a concurrent duplicate blocks on the primary key until
+// the first delivery commits or rolls back.
+func (r *processedEventRepository) MarkProcessed(ctx context.Context, eventID, eventType string) (bool, error) {
+	n, err := r.querier(ctx).InsertProcessedEvent(ctx, sqlcgen.InsertProcessedEventParams{
+		EventID:     eventID,
+		EventType:   eventType,
+		ProcessedAt: time.Now(),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
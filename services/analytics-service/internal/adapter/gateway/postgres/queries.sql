@@ -0,0 +1,86 @@
+-- Video queries
+-- name: UpsertVideo :exec
+INSERT INTO analytics.videos (id, published_at, updated_at)
+VALUES ($1, $2, now())
+ON CONFLICT (id) DO UPDATE SET
+    published_at = EXCLUDED.published_at,
+    updated_at = now();
+
+-- Video snapshot queries
+-- name: ListVideoSnapshotsByVideoID :many
+SELECT video_id, checkpoint_hour, measured_at, view_count, like_count, subscription_count, comment_count, drift_seconds
+FROM analytics.video_snapshots
+WHERE video_id = $1
+ORDER BY checkpoint_hour;
+
+-- name: UpsertVideoSnapshot :exec
+-- Keeps the latest measurement of the checkpoint, whatever order events arrive in
+INSERT INTO analytics.video_snapshots (
+    video_id, checkpoint_hour, measured_at, view_count, like_count,
+    subscription_count, comment_count, drift_seconds, updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, now()
+)
+ON CONFLICT (video_id, checkpoint_hour) DO UPDATE SET
+    measured_at = EXCLUDED.measured_at,
+    view_count = EXCLUDED.view_count,
+    like_count = EXCLUDED.like_count,
+    subscription_count = EXCLUDED.subscription_count,
+    comment_count = EXCLUDED.comment_count,
+    drift_seconds = EXCLUDED.drift_seconds,
+    updated_at = now()
+WHERE analytics.video_snapshots.measured_at <= EXCLUDED.measured_at;
+
+-- Video metrics checkpoint queries
+-- name: UpsertVideoMetricsCheckpoint :exec
+INSERT INTO analytics.video_metrics_checkpoint (
+    video_id, checkpoint_hour, published_at,
+    views_count, likes_count, subscription_count,
+    views_baseline_count, likes_baseline_count, subscription_baseline_count,
+    view_growth_rate_per_hour, like_growth_rate_per_hour, like_growth_rate_per_subscription_per_hour,
+    views_per_subscription_rate, like_rate_at_checkpoint, wilson_like_rate_lower_bound,
+    likes_per_subscription_shrunk_rate, comments_count, comments_baseline_count,
+    comment_growth_rate_per_hour, drift_seconds, interpolated, low_confidence,
+    exclude_from_ranking, computed_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21,
+    $22, $23, $24
+)
+ON CONFLICT (video_id, checkpoint_hour) DO UPDATE SET
+    published_at = EXCLUDED.published_at,
+    views_count = EXCLUDED.views_count,
+    likes_count = EXCLUDED.likes_count,
+    subscription_count = EXCLUDED.subscription_count,
+    views_baseline_count = EXCLUDED.views_baseline_count,
+    likes_baseline_count = EXCLUDED.likes_baseline_count,
+    subscription_baseline_count = EXCLUDED.subscription_baseline_count,
+    view_growth_rate_per_hour = EXCLUDED.view_growth_rate_per_hour,
+    like_growth_rate_per_hour = EXCLUDED.like_growth_rate_per_hour,
+    like_growth_rate_per_subscription_per_hour = EXCLUDED.like_growth_rate_per_subscription_per_hour,
+    views_per_subscription_rate = EXCLUDED.views_per_subscription_rate,
+    like_rate_at_checkpoint = EXCLUDED.like_rate_at_checkpoint,
+    wilson_like_rate_lower_bound = EXCLUDED.wilson_like_rate_lower_bound,
+    likes_per_subscription_shrunk_rate = EXCLUDED.likes_per_subscription_shrunk_rate,
+    comments_count = EXCLUDED.comments_count,
+    comments_baseline_count = EXCLUDED.comments_baseline_count,
+    comment_growth_rate_per_hour = EXCLUDED.comment_growth_rate_per_hour,
+    drift_seconds = EXCLUDED.drift_seconds,
+    interpolated = EXCLUDED.interpolated,
+    low_confidence = EXCLUDED.low_confidence,
+    exclude_from_ranking = EXCLUDED.exclude_from_ranking,
+    computed_at = EXCLUDED.computed_at;
+
+-- Processed event queries
+-- name: InsertProcessedEvent :execrows
+INSERT INTO analytics.processed_events (event_id, event_type, processed_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (event_id) DO NOTHING;
+
+-- Poison message queries
+-- name: CreatePoisonMessage :exec
+INSERT INTO analytics.poison_messages (
+    id, message_id, event_id, subscription, payload, attributes,
+    error_message, delivery_attempt, received_at, created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+);
@@ -0,0 +1,35 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/adapter/gateway/postgres/sqlcgen"
+)
+
+// Repository holds the database connection and sqlc querier
+type Repository struct {
+	db *sql.DB
+	q  sqlcgen.Querier
+}
+
+// NewRepository creates a new repository instance
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{
+		db: db,
+		q:  sqlcgen.New(db),
+	}
+}
+
+// DB returns the database connection
+func (r *Repository) DB() *sql.DB {
+	return r.db
+}
+
+// querier returns a querier bound to the transaction in ctx, if any
+func (r *Repository) querier(ctx context.Context) sqlcgen.Querier {
+	if tx, ok := GetTx(ctx); ok {
+		return sqlcgen.New(tx)
+	}
+	return r.q
+}
@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/adapter/gateway/postgres/sqlcgen"
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/port/output/gateway"
+	"github.com/google/uuid"
+)
+
+// videoMetricsRepository implements gateway.VideoMetricsRepository interface
+type videoMetricsRepository struct {
+	*Repository
+}
+
+// NewVideoMetricsRepository creates a new video metrics repository
+func NewVideoMetricsRepository(repo *Repository) gateway.VideoMetricsRepository {
+	return &videoMetricsRepository{Repository: repo}
+}
+
+// Upsert creates or replaces the metrics of a video at a checkpoint
+func (r *videoMetricsRepository) Upsert(ctx context.Context, m *domain.VideoMetricsCheckpoint) error {
+	videoID, err := uuid.Parse(string(m.VideoID))
+	if err != nil {
+		return err
+	}
+
+	return r.querier(ctx).UpsertVideoMetricsCheckpoint(ctx, sqlcgen.UpsertVideoMetricsCheckpointParams{
+		VideoID:                              videoID,
+		CheckpointHour:                       int32(m.CheckpointHour),
+		PublishedAt:                          m.PublishedAt,
+		ViewsCount:                           m.ViewsCount,
+		LikesCount:                           m.LikesCount,
+		SubscriptionCount:                    m.SubscriptionCount,
+		ViewsBaselineCount:                   m.ViewsBaselineCount,
+		LikesBaselineCount:                   m.LikesBaselineCount,
+		SubscriptionBaselineCount:            m.SubscriptionBaselineCount,
+		ViewGrowthRatePerHour:                m.ViewGrowthRatePerHour,
+		LikeGrowthRatePerHour:                m.LikeGrowthRatePerHour,
+		LikeGrowthRatePerSubscriptionPerHour: toNullFloat64(m.LikeGrowthRatePerSubscriptionPerHour),
+		ViewsPerSubscriptionRate:             m.ViewsPerSubscriptionRate,
+		LikeRateAtCheckpoint:                 toNullFloat64(m.LikeRateAtCheckpoint),
+		WilsonLikeRateLowerBound:             toNullFloat64(m.WilsonLikeRateLowerBound),
+		LikesPerSubscriptionShrunkRate:       toNullFloat64(m.LikesPerSubscriptionShrunkRate),
+		CommentsCount:                        toNullInt64(m.CommentsCount),
+		CommentsBaselineCount:                toNullInt64(m.CommentsBaselineCount),
+		CommentGrowthRatePerHour:             toNullFloat64(m.CommentGrowthRatePerHour),
+		DriftSeconds:                         int32(m.Drift / time.Second),
+		Interpolated:                         m.Interpolated,
+		LowConfidence:                        m.LowConfidence,
+		ExcludeFromRanking:                   m.ExcludeFromRanking,
+		ComputedAt:                           m.ComputedAt,
+	})
+}
+
+// Helper functions
+func toNullFloat64(f *float64) sql.NullFloat64 {
+	if f == nil {
+		return sql.NullFloat64{Valid: false}
+	}
+	return sql.NullFloat64{Float64: *f, Valid: true}
+}
+
+func toNullInt64(n *int64) sql.NullInt64 {
+	if n == nil {
+		return sql.NullInt64{Valid: false}
+	}
+	return sql.NullInt64{Int64: *n, Valid: true}
+}
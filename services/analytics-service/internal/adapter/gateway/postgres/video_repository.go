@@ -0,0 +1,33 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/adapter/gateway/postgres/sqlcgen"
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/port/output/gateway"
+	"github.com/google/uuid"
+)
+
+// videoRepository implements gateway.VideoRepository interface
+type videoRepository struct {
+	*Repository
+}
+
+// NewVideoRepository creates a new video repository
+func NewVideoRepository(repo *Repository) gateway.VideoRepository {
+	return &videoRepository{Repository: repo}
+}
+
+// Save stores the video, replacing the stored copy
+func (r *videoRepository) Save(ctx context.Context, video *domain.Video) error {
+	uid, err := uuid.Parse(string(video.ID))
+	if err != nil {
+		return domain.ErrInvalidEvent
+	}
+
+	return r.querier(ctx).UpsertVideo(ctx, sqlcgen.UpsertVideoParams{
+		ID:          uid,
+		PublishedAt: video.PublishedAt,
+	})
+}
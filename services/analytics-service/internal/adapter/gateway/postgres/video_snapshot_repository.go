@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/adapter/gateway/postgres/sqlcgen"
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/domain/valueobject"
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/port/output/gateway"
+	"github.com/google/uuid"
+)
+
+// videoSnapshotRepository implements gateway.VideoSnapshotRepository interface
+type videoSnapshotRepository struct {
+	*Repository
+}
+
+// NewVideoSnapshotRepository creates a new video snapshot repository
+func NewVideoSnapshotRepository(repo *Repository) gateway.VideoSnapshotRepository {
+	return &videoSnapshotRepository{Repository: repo}
+}
+
+// ListByVideo lists all snapshots of a video ordered by checkpoint
+func (r *videoSnapshotRepository) ListByVideo(ctx context.Context, videoID valueobject.UUID) ([]*domain.VideoSnapshot, error) {
+	uid, err := uuid.Parse(string(videoID))
+	if err != nil {
+		return nil, domain.ErrVideoNotFound
+	}
+
+	rows, err := r.querier(ctx).ListVideoSnapshotsByVideoID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]*domain.VideoSnapshot, len(rows))
+	for i, row := range rows {
+		snapshots[i] = toDomainVideoSnapshot(row)
+	}
+
+	return snapshots, nil
+}
+
+// Save stores the snapshot unless a later measurement of its checkpoint is stored
+func (r *videoSnapshotRepository) Save(ctx context.Context, snapshot *domain.VideoSnapshot) error {
+	uid, err := uuid.Parse(string(snapshot.VideoID))
+	if err != nil {
+		return domain.ErrInvalidEvent
+	}
+
+	params := sqlcgen.UpsertVideoSnapshotParams{
+		VideoID:           uid,
+		CheckpointHour:    int32(snapshot.CheckpointHour),
+		MeasuredAt:        snapshot.MeasuredAt,
+		ViewCount:         snapshot.ViewsCount,
+		LikeCount:         snapshot.LikesCount,
+		SubscriptionCount: snapshot.SubscriptionCount,
+		DriftSeconds:      int32(snapshot.Drift / time.Second),
+	}
+	if snapshot.CommentsCount != nil {
+		params.CommentCount = sql.NullInt64{Int64: *snapshot.CommentsCount, Valid: true}
+	}
+
+	return r.querier(ctx).UpsertVideoSnapshot(ctx, params)
+}
+
+func toDomainVideoSnapshot(row sqlcgen.ListVideoSnapshotsByVideoIDRow) *domain.VideoSnapshot {
+	return &domain.VideoSnapshot{
+		VideoID:           valueobject.UUID(row.VideoID.String()),
+		CheckpointHour:    valueobject.CheckpointHour(row.CheckpointHour),
+		MeasuredAt:        row.MeasuredAt,
+		ViewsCount:        row.ViewCount,
+		LikesCount:        row.LikeCount,
+		SubscriptionCount: row.SubscriptionCount,
+		CommentsCount:     toInt64Ptr(row.CommentCount),
+		Drift:             time.Duration(row.DriftSeconds) * time.Second,
+	}
+}
+
+func toInt64Ptr(n sql.NullInt64) *int64 {
+	if !n.Valid {
+		return nil
+	}
+	return &n.Int64
+}
@@ -0,0 +1,21 @@
+package domain
+
+import "errors"
+
+// Domain errors
+var (
+	// Video errors
+	ErrVideoNotFound = errors.New("video not found")
+
+	// Snapshot errors
+	ErrSnapshotNotFound  = errors.New("snapshot not found")
+	ErrBaselineNotFound  = errors.New("baseline snapshot not found")
+	ErrInvalidCheckpoint = errors.New("invalid checkpoint hour")
+
+	// Event errors
+	ErrInvalidEvent = errors.New("invalid event")
+
+	// General errors
+	ErrInvalidInput = errors.New("invalid input")
+	ErrNotFound     = errors.New("not found")
+)
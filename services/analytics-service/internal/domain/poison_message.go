@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/domain/valueobject"
+)
+
+// PoisonMessage is a message that was set aside because it can never be processed
+type PoisonMessage struct {
+	ID              valueobject.UUID
+	MessageID       string
+	EventID         string
+	Subscription    string
+	Payload         []byte
+	Attributes      map[string]string
+	ErrorMessage    string
+	DeliveryAttempt int
+	ReceivedAt      time.Time
+	CreatedAt       time.Time
+}
@@ -0,0 +1,246 @@
+package service
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/domain/valueobject"
+)
+
+const (
+	// wilsonZ is the z-score for a 95% confidence interval
+	wilsonZ = 1.96
+	// likesPerSubscriptionScale and likesPerSubscriptionOffset shrink the heat
+	// metric of small channels towards zero: SCALE * likes / (subs + OFFSET)
+	likesPerSubscriptionScale  = 1000.0
+	likesPerSubscriptionOffset = 1000.0
+	// minViewsForRanking excludes checkpoints with too few samples from rankings
+	minViewsForRanking = 100
+	// minDriftTolerance and relativeDriftTolerance decide when a snapshot was
+	// measured too far from its checkpoint for the estimate to be trusted:
+	// |drift| > max(minDriftTolerance, relativeDriftTolerance * checkpoint)
+	minDriftTolerance      = time.Hour
+	relativeDriftTolerance = 0.1
+)
+
+// MetricsCalculator computes checkpoint metrics from video snapshots
+type MetricsCalculator interface {
+	// AffectedCheckpoints returns the checkpoints whose metrics depend on the
+	// snapshot taken at the changed checkpoint
+	AffectedCheckpoints(changed valueobject.CheckpointHour, snapshots []*domain.VideoSnapshot) []valueobject.CheckpointHour
+	// Calculate computes the metrics for a single checkpoint
+	Calculate(video *domain.Video, snapshots []*domain.VideoSnapshot, cp valueobject.CheckpointHour, now time.Time) (*domain.VideoMetricsCheckpoint, error)
+}
+
+type metricsCalculator struct{}
+
+// NewMetricsCalculator creates a new metrics calculator
+func NewMetricsCalculator() MetricsCalculator {
+	return &metricsCalculator{}
+}
+
+// AffectedCheckpoints returns the checkpoints whose estimate can use the
+// changed snapshot: every later checkpoint when the baseline changed, since all
+// growth rates are relative to it, otherwise the changed checkpoint and its
+// neighbours, which may interpolate through it
+func (c *metricsCalculator) AffectedCheckpoints(changed valueobject.CheckpointHour, snapshots []*domain.VideoSnapshot) []valueobject.CheckpointHour {
+	var checkpoints []valueobject.CheckpointHour
+	for _, s := range snapshots {
+		if !s.CheckpointHour.IsBaseline() {
+			checkpoints = append(checkpoints, s.CheckpointHour)
+		}
+	}
+	sort.Slice(checkpoints, func(i, j int) bool { return checkpoints[i] < checkpoints[j] })
+
+	if changed.IsBaseline() {
+		return checkpoints
+	}
+
+	i := sort.Search(len(checkpoints), func(i int) bool { return checkpoints[i] >= changed })
+	if i == len(checkpoints) || checkpoints[i] != changed {
+		return []valueobject.CheckpointHour{changed}
+	}
+	return checkpoints[max(i-1, 0):min(i+2, len(checkpoints))]
+}
+
+// Calculate computes the metrics for the checkpoint against the 0h baseline
+func (c *metricsCalculator) Calculate(video *domain.Video, snapshots []*domain.VideoSnapshot, cp valueobject.CheckpointHour, now time.Time) (*domain.VideoMetricsCheckpoint, error) {
+	if !cp.IsValid() || cp.IsBaseline() {
+		return nil, domain.ErrInvalidCheckpoint
+	}
+
+	baseline, ok := domain.FindSnapshot(snapshots, valueobject.CheckpointBaseline)
+	if !ok {
+		return nil, domain.ErrBaselineNotFound
+	}
+
+	point, ok := domain.FindSnapshot(snapshots, cp)
+	if !ok {
+		return nil, domain.ErrSnapshotNotFound
+	}
+
+	target := video.PublishedAt.Add(time.Duration(cp) * time.Hour)
+	at, interpolated := estimateCountsAt(snapshots, target)
+
+	// Measure growth over the time actually elapsed since the baseline was
+	// taken, which differs from the checkpoint hours when the baseline drifted
+	hours := target.Sub(baseline.MeasuredAt).Hours()
+	if hours <= 0 {
+		hours = cp.Hours()
+	}
+	viewGrowth := float64(at.views-baseline.ViewsCount) / hours
+	likeGrowth := float64(at.likes-baseline.LikesCount) / hours
+	subs := float64(max(at.subs, 1))
+
+	m := &domain.VideoMetricsCheckpoint{
+		VideoID:                   video.ID,
+		CheckpointHour:            cp,
+		PublishedAt:               video.PublishedAt,
+		ViewsCount:                at.views,
+		LikesCount:                at.likes,
+		SubscriptionCount:         at.subs,
+		ViewsBaselineCount:        baseline.ViewsCount,
+		LikesBaselineCount:        baseline.LikesCount,
+		SubscriptionBaselineCount: baseline.SubscriptionCount,
+		ViewGrowthRatePerHour:     viewGrowth,
+		LikeGrowthRatePerHour:     likeGrowth,
+		ViewsPerSubscriptionRate:  float64(at.views) / subs,
+		Drift:                     point.Drift,
+		Interpolated:              interpolated,
+		LowConfidence:             driftExceeds(point.Drift, cp) || driftExceeds(baseline.Drift, valueobject.CheckpointBaseline),
+		ExcludeFromRanking:        at.views < minViewsForRanking,
+		ComputedAt:                now,
+	}
+
+	if at.subs > 0 {
+		v := likeGrowth / subs
+		m.LikeGrowthRatePerSubscriptionPerHour = &v
+	}
+
+	if at.views > 0 {
+		rate := float64(at.likes) / float64(at.views)
+		wilson := WilsonLowerBound(at.likes, at.views)
+		m.LikeRateAtCheckpoint = &rate
+		m.WilsonLikeRateLowerBound = &wilson
+	}
+
+	lps := likesPerSubscriptionScale * float64(at.likes) / (float64(at.subs) + likesPerSubscriptionOffset)
+	m.LikesPerSubscriptionShrunkRate = &lps
+
+	// Comments are only estimated from snapshots that recorded them
+	if baseline.CommentsCount != nil && point.CommentsCount != nil {
+		c, _ := estimateCountsAt(withComments(snapshots), target)
+		growth := float64(c.comments-*baseline.CommentsCount) / hours
+		m.CommentsCount = &c.comments
+		m.CommentsBaselineCount = baseline.CommentsCount
+		m.CommentGrowthRatePerHour = &growth
+	}
+
+	return m, nil
+}
+
+// WilsonLowerBound returns the lower bound of the Wilson score interval for
+// the ratio of positives out of total trials
+func WilsonLowerBound(positives, total int64) float64 {
+	if total <= 0 {
+		return 0
+	}
+
+	n := float64(total)
+	p := math.Min(float64(positives)/n, 1)
+	z2 := wilsonZ * wilsonZ
+
+	centre := p + z2/(2*n)
+	margin := wilsonZ * math.Sqrt((p*(1-p)+z2/(4*n))/n)
+
+	return math.Max((centre-margin)/(1+z2/n), 0)
+}
+
+// snapshotCounts holds the counts of a video at a point in time
+type snapshotCounts struct {
+	views    int64
+	likes    int64
+	subs     int64
+	comments int64
+}
+
+// estimateCountsAt returns the counts at t, linearly interpolated between the
+// snapshots measured closest before and after t. When t is not bracketed the
+// trend of the two nearest snapshots is extended instead. The second result
+// reports whether the counts were estimated rather than measured at t.
+func estimateCountsAt(snapshots []*domain.VideoSnapshot, t time.Time) (snapshotCounts, bool) {
+	sorted := make([]*domain.VideoSnapshot, len(snapshots))
+	copy(sorted, snapshots)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].MeasuredAt.Before(sorted[j].MeasuredAt) })
+
+	n := len(sorted)
+	next := sort.Search(n, func(i int) bool { return !sorted[i].MeasuredAt.Before(t) })
+	if next < n && sorted[next].MeasuredAt.Equal(t) {
+		return countsOf(sorted[next]), false
+	}
+
+	var a, b *domain.VideoSnapshot
+	switch {
+	case n < 2:
+		return countsOf(sorted[0]), false
+	case next == 0:
+		a, b = sorted[0], sorted[1]
+	case next == n:
+		a, b = sorted[n-2], sorted[n-1]
+	default:
+		a, b = sorted[next-1], sorted[next]
+	}
+
+	span := b.MeasuredAt.Sub(a.MeasuredAt)
+	if span <= 0 {
+		return countsOf(b), false
+	}
+	frac := float64(t.Sub(a.MeasuredAt)) / float64(span)
+
+	ac, bc := countsOf(a), countsOf(b)
+	return snapshotCounts{
+		views:    lerp(ac.views, bc.views, frac),
+		likes:    lerp(ac.likes, bc.likes, frac),
+		subs:     lerp(ac.subs, bc.subs, frac),
+		comments: lerp(ac.comments, bc.comments, frac),
+	}, true
+}
+
+// countsOf returns the counts of the snapshot, with zero comments when they
+// were not recorded
+func countsOf(s *domain.VideoSnapshot) snapshotCounts {
+	c := snapshotCounts{views: s.ViewsCount, likes: s.LikesCount, subs: s.SubscriptionCount}
+	if s.CommentsCount != nil {
+		c.comments = *s.CommentsCount
+	}
+	return c
+}
+
+// withComments returns the snapshots that recorded comments
+func withComments(snapshots []*domain.VideoSnapshot) []*domain.VideoSnapshot {
+	var filtered []*domain.VideoSnapshot
+	for _, s := range snapshots {
+		if s.CommentsCount != nil {
+			filtered = append(filtered, s)
+		}
+	}
+	return filtered
+}
+
+// lerp interpolates between a and b, rounding to a count and never going
+// below zero when extrapolating
+func lerp(a, b int64, frac float64) int64 {
+	return max(int64(math.Round(float64(a)+float64(b-a)*frac)), 0)
+}
+
+// driftExceeds reports whether a snapshot measured drift away from the
+// checkpoint is too far off to be trusted
+func driftExceeds(drift time.Duration, cp valueobject.CheckpointHour) bool {
+	tolerance := max(minDriftTolerance, time.Duration(relativeDriftTolerance*float64(cp)*float64(time.Hour)))
+	if drift < 0 {
+		drift = -drift
+	}
+	return drift > tolerance
+}
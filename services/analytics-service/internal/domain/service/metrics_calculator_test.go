@@ -0,0 +1,234 @@
+package service
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/domain/valueobject"
+)
+
+func TestMetricsCalculator_Calculate(t *testing.T) {
+	calculator := NewMetricsCalculator()
+	video := &domain.Video{ID: "video-1", PublishedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	snapshot := func(cp valueobject.CheckpointHour, views, likes, subs int64) *domain.VideoSnapshot {
+		return &domain.VideoSnapshot{
+			VideoID:           video.ID,
+			CheckpointHour:    cp,
+			MeasuredAt:        video.PublishedAt.Add(time.Duration(cp) * time.Hour),
+			ViewsCount:        views,
+			LikesCount:        likes,
+			SubscriptionCount: subs,
+		}
+	}
+	drifted := func(s *domain.VideoSnapshot, drift time.Duration) *domain.VideoSnapshot {
+		s.MeasuredAt = s.MeasuredAt.Add(drift)
+		s.Drift = drift
+		return s
+	}
+	commented := func(s *domain.VideoSnapshot, comments int64) *domain.VideoSnapshot {
+		s.CommentsCount = &comments
+		return s
+	}
+	rate := func(v float64) *float64 { return &v }
+
+	tests := []struct {
+		name           string
+		snapshots      []*domain.VideoSnapshot
+		checkpoint     valueobject.CheckpointHour
+		wantErr        error
+		wantViewGrowth float64
+		wantLikeGrowth float64
+		wantRelViews   float64
+		wantExcluded   bool
+		wantInterp     bool
+		wantLowConf    bool
+		wantComments   *float64
+	}{
+		{
+			name:           "growth from baseline",
+			snapshots:      []*domain.VideoSnapshot{snapshot(0, 100, 10, 1000), snapshot(24, 2500, 130, 1000)},
+			checkpoint:     24,
+			wantViewGrowth: 100,
+			wantLikeGrowth: 5,
+			wantRelViews:   2.5,
+		},
+		{
+			name:           "low sample is excluded from ranking",
+			snapshots:      []*domain.VideoSnapshot{snapshot(0, 0, 0, 0), snapshot(3, 30, 3, 0)},
+			checkpoint:     3,
+			wantViewGrowth: 10,
+			wantLikeGrowth: 1,
+			wantRelViews:   30,
+			wantExcluded:   true,
+		},
+		{
+			name:           "slightly late snapshot is interpolated from the baseline",
+			snapshots:      []*domain.VideoSnapshot{snapshot(0, 100, 10, 1000), drifted(snapshot(24, 2600, 135, 1000), time.Hour)},
+			checkpoint:     24,
+			wantViewGrowth: 100,
+			wantLikeGrowth: 5,
+			wantRelViews:   2.5,
+			wantInterp:     true,
+		},
+		{
+			name:           "heavily late snapshot is flagged low confidence",
+			snapshots:      []*domain.VideoSnapshot{snapshot(0, 100, 10, 1000), drifted(snapshot(24, 3100, 160, 1000), 6*time.Hour)},
+			checkpoint:     24,
+			wantViewGrowth: 100,
+			wantLikeGrowth: 5,
+			wantRelViews:   2.5,
+			wantInterp:     true,
+			wantLowConf:    true,
+		},
+		{
+			name: "early snapshot is interpolated towards the next checkpoint",
+			snapshots: []*domain.VideoSnapshot{
+				snapshot(0, 100, 10, 1000),
+				drifted(snapshot(24, 2100, 110, 1000), -4*time.Hour),
+				snapshot(48, 4900, 250, 1000),
+			},
+			checkpoint:     24,
+			wantViewGrowth: 100,
+			wantLikeGrowth: 5,
+			wantRelViews:   2.5,
+			wantInterp:     true,
+			wantLowConf:    true,
+		},
+		{
+			name:           "comment velocity from baseline",
+			snapshots:      []*domain.VideoSnapshot{commented(snapshot(0, 100, 10, 1000), 10), commented(snapshot(24, 2500, 130, 1000), 250)},
+			checkpoint:     24,
+			wantViewGrowth: 100,
+			wantLikeGrowth: 5,
+			wantRelViews:   2.5,
+			wantComments:   rate(10),
+		},
+		{
+			name:           "comment velocity is unknown without baseline comments",
+			snapshots:      []*domain.VideoSnapshot{snapshot(0, 100, 10, 1000), commented(snapshot(24, 2500, 130, 1000), 250)},
+			checkpoint:     24,
+			wantViewGrowth: 100,
+			wantLikeGrowth: 5,
+			wantRelViews:   2.5,
+		},
+		{
+			name:       "missing baseline",
+			snapshots:  []*domain.VideoSnapshot{snapshot(24, 2500, 130, 1000)},
+			checkpoint: 24,
+			wantErr:    domain.ErrBaselineNotFound,
+		},
+		{
+			name:       "missing checkpoint",
+			snapshots:  []*domain.VideoSnapshot{snapshot(0, 100, 10, 1000)},
+			checkpoint: 24,
+			wantErr:    domain.ErrSnapshotNotFound,
+		},
+		{
+			name:       "baseline is not a ranking checkpoint",
+			snapshots:  []*domain.VideoSnapshot{snapshot(0, 100, 10, 1000)},
+			checkpoint: 0,
+			wantErr:    domain.ErrInvalidCheckpoint,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := calculator.Calculate(video, tt.snapshots, tt.checkpoint, time.Now())
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Calculate() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Calculate() unexpected error = %v", err)
+			}
+
+			if got.ViewGrowthRatePerHour != tt.wantViewGrowth {
+				t.Errorf("ViewGrowthRatePerHour = %v, want %v", got.ViewGrowthRatePerHour, tt.wantViewGrowth)
+			}
+			if got.LikeGrowthRatePerHour != tt.wantLikeGrowth {
+				t.Errorf("LikeGrowthRatePerHour = %v, want %v", got.LikeGrowthRatePerHour, tt.wantLikeGrowth)
+			}
+			if got.ViewsPerSubscriptionRate != tt.wantRelViews {
+				t.Errorf("ViewsPerSubscriptionRate = %v, want %v", got.ViewsPerSubscriptionRate, tt.wantRelViews)
+			}
+			if got.ExcludeFromRanking != tt.wantExcluded {
+				t.Errorf("ExcludeFromRanking = %v, want %v", got.ExcludeFromRanking, tt.wantExcluded)
+			}
+			if got.Interpolated != tt.wantInterp {
+				t.Errorf("Interpolated = %v, want %v", got.Interpolated, tt.wantInterp)
+			}
+			if got.LowConfidence != tt.wantLowConf {
+				t.Errorf("LowConfidence = %v, want %v", got.LowConfidence, tt.wantLowConf)
+			}
+			switch {
+			case tt.wantComments == nil && got.CommentGrowthRatePerHour != nil:
+				t.Errorf("CommentGrowthRatePerHour = %v, want nil", *got.CommentGrowthRatePerHour)
+			case tt.wantComments != nil && got.CommentGrowthRatePerHour == nil:
+				t.Errorf("CommentGrowthRatePerHour = nil, want %v", *tt.wantComments)
+			case tt.wantComments != nil && *got.CommentGrowthRatePerHour != *tt.wantComments:
+				t.Errorf("CommentGrowthRatePerHour = %v, want %v", *got.CommentGrowthRatePerHour, *tt.wantComments)
+			}
+		})
+	}
+}
+
+func TestMetricsCalculator_AffectedCheckpoints(t *testing.T) {
+	calculator := NewMetricsCalculator()
+	snapshots := []*domain.VideoSnapshot{
+		{CheckpointHour: 24}, {CheckpointHour: 0}, {CheckpointHour: 3}, {CheckpointHour: 6},
+	}
+
+	tests := []struct {
+		name    string
+		changed valueobject.CheckpointHour
+		want    []valueobject.CheckpointHour
+	}{
+		{name: "baseline change affects every later checkpoint", changed: 0, want: []valueobject.CheckpointHour{3, 6, 24}},
+		{name: "non-baseline change affects itself and its neighbours", changed: 6, want: []valueobject.CheckpointHour{3, 6, 24}},
+		{name: "first checkpoint has no earlier neighbour", changed: 3, want: []valueobject.CheckpointHour{3, 6}},
+		{name: "last checkpoint has no later neighbour", changed: 24, want: []valueobject.CheckpointHour{6, 24}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := calculator.AffectedCheckpoints(tt.changed, snapshots)
+			if len(got) != len(tt.want) {
+				t.Fatalf("AffectedCheckpoints() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("AffectedCheckpoints() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestWilsonLowerBound(t *testing.T) {
+	tests := []struct {
+		name      string
+		positives int64
+		total     int64
+		want      float64
+	}{
+		{name: "no trials", positives: 0, total: 0, want: 0},
+		{name: "no positives", positives: 0, total: 100, want: 0},
+		{name: "half of 100", positives: 50, total: 100, want: 0.4038},
+		{name: "half of 10000 is tighter", positives: 5000, total: 10000, want: 0.4902},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := WilsonLowerBound(tt.positives, tt.total)
+			if math.Abs(got-tt.want) > 0.0001 {
+				t.Errorf("WilsonLowerBound(%d, %d) = %v, want %v", tt.positives, tt.total, got, tt.want)
+			}
+		})
+	}
+}
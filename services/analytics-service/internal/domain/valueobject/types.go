@@ -0,0 +1,27 @@
+package valueobject
+
+// UUID represents a UUID value object
+type UUID string
+
+// CheckpointHour represents hours elapsed since a video was published
+type CheckpointHour int
+
+const (
+	// CheckpointBaseline is the checkpoint used as the 0h baseline for every metric
+	CheckpointBaseline CheckpointHour = 0
+)
+
+// IsBaseline returns true if the checkpoint is the 0h baseline
+func (c CheckpointHour) IsBaseline() bool {
+	return c == CheckpointBaseline
+}
+
+// IsValid checks if the checkpoint hour is a valid value
+func (c CheckpointHour) IsValid() bool {
+	return c >= CheckpointBaseline
+}
+
+// Hours returns the checkpoint as a float number of hours
+func (c CheckpointHour) Hours() float64 {
+	return float64(c)
+}
@@ -0,0 +1,14 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/domain/valueobject"
+)
+
+// Video is the copy of an ingested video needed to compute metrics, as
+// announced by snapshot-added events
+type Video struct {
+	ID          valueobject.UUID
+	PublishedAt time.Time
+}
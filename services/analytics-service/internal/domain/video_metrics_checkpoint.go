@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/domain/valueobject"
+)
+
+// VideoMetricsCheckpoint is the ranking read model for a video at a checkpoint.
+// Growth rates are measured from the 0h baseline to the exact checkpoint time.
+type VideoMetricsCheckpoint struct {
+	VideoID        valueobject.UUID
+	CheckpointHour valueobject.CheckpointHour
+	PublishedAt    time.Time
+
+	// Counts at the exact checkpoint time, interpolated from the neighbouring
+	// snapshots when the checkpoint snapshot was measured early or late
+	ViewsCount        int64
+	LikesCount        int64
+	SubscriptionCount int64
+
+	// Raw counts at the 0h baseline
+	ViewsBaselineCount        int64
+	LikesBaselineCount        int64
+	SubscriptionBaselineCount int64
+
+	// Derived metrics
+	ViewGrowthRatePerHour                float64
+	LikeGrowthRatePerHour                float64
+	LikeGrowthRatePerSubscriptionPerHour *float64
+	ViewsPerSubscriptionRate             float64
+	LikeRateAtCheckpoint                 *float64
+	WilsonLikeRateLowerBound             *float64
+	LikesPerSubscriptionShrunkRate       *float64
+
+	// Comment velocity, nil unless both the baseline and the checkpoint
+	// snapshot recorded comments
+	CommentsCount            *int64
+	CommentsBaselineCount    *int64
+	CommentGrowthRatePerHour *float64
+
+	// Estimation quality of the checkpoint counts
+	Drift         time.Duration
+	Interpolated  bool
+	LowConfidence bool
+
+	ExcludeFromRanking bool
+	ComputedAt         time.Time
+}
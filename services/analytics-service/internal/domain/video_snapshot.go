@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/domain/valueobject"
+)
+
+// VideoSnapshot is the copy of an ingested video snapshot, as announced by
+// snapshot-added events
+type VideoSnapshot struct {
+	VideoID           valueobject.UUID
+	CheckpointHour    valueobject.CheckpointHour
+	MeasuredAt        time.Time
+	ViewsCount        int64
+	LikesCount        int64
+	SubscriptionCount int64
+	// CommentsCount is nil for snapshots taken before comments were recorded
+	CommentsCount *int64
+	// Drift is how far MeasuredAt is from the exact checkpoint time; positive when late
+	Drift time.Duration
+}
+
+// FindSnapshot returns the snapshot taken at the given checkpoint, if any
+func FindSnapshot(snapshots []*VideoSnapshot, cp valueobject.CheckpointHour) (*VideoSnapshot, bool) {
+	for _, s := range snapshots {
+		if s.CheckpointHour == cp {
+			return s, true
+		}
+	}
+	return nil, false
+}
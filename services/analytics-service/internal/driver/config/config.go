@@ -0,0 +1,57 @@
+package config
+
+import (
+	"os"
+	"strconv"
+)
+
+// Config holds the application configuration
+type Config struct {
+	// Database configuration
+	DatabaseURL string
+
+	// Service configuration
+	Environment string
+	LogLevel    string
+
+	// Pub/Sub configuration
+	PubSubProjectID              string
+	SnapshotAddedSubscription    string
+	PubSubMaxOutstandingMessages int
+	PubSubMaxDeliveryAttempts    int
+}
+
+// Load loads configuration from environment variables
+func Load() *Config {
+	return &Config{
+		// Database
+		DatabaseURL: getEnv("DATABASE_URL", ""),
+
+		// Service
+		Environment: getEnv("ENVIRONMENT", "development"),
+		LogLevel:    getEnv("LOG_LEVEL", "info"),
+
+		// Pub/Sub
+		PubSubProjectID:              getEnv("PUBSUB_PROJECT_ID", ""),
+		SnapshotAddedSubscription:    getEnv("PUBSUB_SNAPSHOT_ADDED_SUBSCRIPTION", "analytics-snapshot-added"),
+		PubSubMaxOutstandingMessages: getEnvAsInt("PUBSUB_MAX_OUTSTANDING_MESSAGES", 10),
+		PubSubMaxDeliveryAttempts:    getEnvAsInt("PUBSUB_MAX_DELIVERY_ATTEMPTS", 5),
+	}
+}
+
+// getEnv gets an environment variable with a default value
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+// getEnvAsInt gets an environment variable as int with a default value
+func getEnvAsInt(key string, defaultValue int) int {
+	valueStr := getEnv(key, "")
+	if value, err := strconv.Atoi(valueStr); err == nil {
+		return value
+	}
+	return defaultValue
+}
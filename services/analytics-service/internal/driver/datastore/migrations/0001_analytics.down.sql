@@ -0,0 +1,2 @@
+-- Down migration: drop analytics schema and all tables
+DROP SCHEMA IF EXISTS analytics CASCADE;
@@ -0,0 +1,53 @@
+-- Up migration: create analytics schema and tables
+CREATE SCHEMA IF NOT EXISTS analytics;
+
+-- Metrics by checkpoint (read model for rankings)
+CREATE TABLE IF NOT EXISTS analytics.video_metrics_checkpoint (
+  video_id                                   uuid NOT NULL,
+  checkpoint_hour                            integer NOT NULL CHECK (checkpoint_hour > 0),
+  published_at                               timestamptz NOT NULL,
+  views_count                                bigint NOT NULL,
+  likes_count                                bigint NOT NULL,
+  subscription_count                         bigint NOT NULL,
+  views_baseline_count                       bigint NOT NULL,
+  likes_baseline_count                       bigint NOT NULL,
+  subscription_baseline_count                bigint NOT NULL,
+  view_growth_rate_per_hour                  double precision NOT NULL,
+  like_growth_rate_per_hour                  double precision NOT NULL,
+  like_growth_rate_per_subscription_per_hour double precision,
+  views_per_subscription_rate                double precision NOT NULL,
+  like_rate_at_checkpoint                    double precision,
+  wilson_like_rate_lower_bound               double precision,
+  likes_per_subscription_shrunk_rate         double precision,
+  exclude_from_ranking                       boolean NOT NULL DEFAULT false,
+  computed_at                                timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY (video_id, checkpoint_hour)
+);
+CREATE INDEX IF NOT EXISTS vmc_idx_pub_cp ON analytics.video_metrics_checkpoint(published_at, checkpoint_hour);
+CREATE INDEX IF NOT EXISTS vmc_idx_cp_view ON analytics.video_metrics_checkpoint(checkpoint_hour, view_growth_rate_per_hour DESC);
+CREATE INDEX IF NOT EXISTS vmc_idx_cp_rel ON analytics.video_metrics_checkpoint(checkpoint_hour, views_per_subscription_rate DESC);
+CREATE INDEX IF NOT EXISTS vmc_idx_cp_wilson ON analytics.video_metrics_checkpoint(checkpoint_hour, wilson_like_rate_lower_bound DESC);
+CREATE INDEX IF NOT EXISTS vmc_idx_cp_lps ON analytics.video_metrics_checkpoint(checkpoint_hour, likes_per_subscription_shrunk_rate DESC);
+
+-- Processed events (idempotency for Pub/Sub redeliveries)
+CREATE TABLE IF NOT EXISTS analytics.processed_events (
+  event_id     text PRIMARY KEY,
+  event_type   varchar(50) NOT NULL,
+  processed_at timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS processed_events_processed_at_idx ON analytics.processed_events(processed_at);
+
+-- Poison messages (events that can never be processed)
+CREATE TABLE IF NOT EXISTS analytics.poison_messages (
+  id               uuid PRIMARY KEY,
+  message_id       text NOT NULL,
+  event_id         text,
+  subscription     varchar(255) NOT NULL,
+  payload          bytea NOT NULL,
+  attributes       jsonb,
+  error_message    text NOT NULL,
+  delivery_attempt integer NOT NULL DEFAULT 0,
+  received_at      timestamptz NOT NULL,
+  created_at       timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS poison_messages_received_at_idx ON analytics.poison_messages(received_at DESC);
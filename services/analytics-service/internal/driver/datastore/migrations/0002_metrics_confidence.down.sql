@@ -0,0 +1,6 @@
+-- Down migration: drop checkpoint estimation quality
+
+ALTER TABLE analytics.video_metrics_checkpoint
+  DROP COLUMN IF EXISTS low_confidence,
+  DROP COLUMN IF EXISTS interpolated,
+  DROP COLUMN IF EXISTS drift_seconds;
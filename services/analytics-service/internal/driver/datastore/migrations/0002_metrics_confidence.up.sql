@@ -0,0 +1,6 @@
+-- Up migration: record how checkpoint counts were estimated
+
+ALTER TABLE analytics.video_metrics_checkpoint
+  ADD COLUMN IF NOT EXISTS drift_seconds  integer NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS interpolated   boolean NOT NULL DEFAULT false,
+  ADD COLUMN IF NOT EXISTS low_confidence boolean NOT NULL DEFAULT false;
@@ -0,0 +1,8 @@
+-- Down migration: drop comment velocity
+
+DROP INDEX IF EXISTS analytics.vmc_idx_cp_comment;
+
+ALTER TABLE analytics.video_metrics_checkpoint
+  DROP COLUMN IF EXISTS comment_growth_rate_per_hour,
+  DROP COLUMN IF EXISTS comments_baseline_count,
+  DROP COLUMN IF EXISTS comments_count;
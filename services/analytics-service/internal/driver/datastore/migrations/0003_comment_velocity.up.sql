@@ -0,0 +1,9 @@
+-- Up migration: comment velocity
+
+-- NULL when the baseline or checkpoint snapshot did not record comments
+ALTER TABLE analytics.video_metrics_checkpoint
+  ADD COLUMN IF NOT EXISTS comments_count               bigint,
+  ADD COLUMN IF NOT EXISTS comments_baseline_count      bigint,
+  ADD COLUMN IF NOT EXISTS comment_growth_rate_per_hour double precision;
+
+CREATE INDEX IF NOT EXISTS vmc_idx_cp_comment ON analytics.video_metrics_checkpoint(checkpoint_hour, comment_growth_rate_per_hour DESC);
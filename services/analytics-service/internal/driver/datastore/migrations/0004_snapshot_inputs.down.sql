@@ -0,0 +1,4 @@
+-- Down migration: drop the analytics copies of videos and snapshots
+
+DROP TABLE IF EXISTS analytics.video_snapshots;
+DROP TABLE IF EXISTS analytics.videos;
@@ -0,0 +1,24 @@
+-- Up migration: keep the inputs of metric computation in the analytics schema
+
+-- Videos and snapshots as announced by ingestion's snapshot-added events.
+-- Metrics are computed from these copies only, never from ingestion's tables.
+CREATE TABLE IF NOT EXISTS analytics.videos (
+  id           uuid PRIMARY KEY,
+  published_at timestamptz NOT NULL,
+  updated_at   timestamptz NOT NULL DEFAULT now()
+);
+
+-- One row per checkpoint; a later measurement of the same checkpoint replaces
+-- an earlier one whatever order the events arrive in
+CREATE TABLE IF NOT EXISTS analytics.video_snapshots (
+  video_id           uuid NOT NULL REFERENCES analytics.videos(id) ON DELETE CASCADE,
+  checkpoint_hour    integer NOT NULL CHECK (checkpoint_hour >= 0),
+  measured_at        timestamptz NOT NULL,
+  view_count         bigint NOT NULL,
+  like_count         bigint NOT NULL,
+  subscription_count bigint NOT NULL,
+  comment_count      bigint,
+  drift_seconds      integer NOT NULL DEFAULT 0,
+  updated_at         timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY (video_id, checkpoint_hour)
+);
@@ -0,0 +1,170 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/domain/valueobject"
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/port/input"
+)
+
+// snapshotAddedMessage is the wire format published by ingestion-service
+type snapshotAddedMessage struct {
+	EventID           string    `json:"eventId"`
+	VideoID           string    `json:"videoId"`
+	CheckpointHour    *int      `json:"checkpointHour"`
+	MeasuredAt        time.Time `json:"measuredAt"`
+	PublishedAt       time.Time `json:"publishedAt"`
+	ViewCount         int64     `json:"viewCount"`
+	LikeCount         int64     `json:"likeCount"`
+	SubscriptionCount int64     `json:"subscriptionCount"`
+	CommentCount      *int64    `json:"commentCount"`
+	DriftSeconds      int64     `json:"driftSeconds"`
+}
+
+// SnapshotAddedSubscriber consumes snapshot-added events and keeps
+// video_metrics_checkpoint up to date
+type SnapshotAddedSubscriber struct {
+	subscription        *pubsub.Subscription
+	metricsUseCase      input.MetricsInputPort
+	maxDeliveryAttempts int
+}
+
+// NewSnapshotAddedSubscriber creates a new snapshot-added subscriber
+func NewSnapshotAddedSubscriber(
+	client *pubsub.Client,
+	subscriptionID string,
+	maxOutstandingMessages int,
+	maxDeliveryAttempts int,
+	metricsUseCase input.MetricsInputPort,
+) *SnapshotAddedSubscriber {
+	sub := client.Subscription(subscriptionID)
+	sub.ReceiveSettings.MaxOutstandingMessages = maxOutstandingMessages
+
+	return &SnapshotAddedSubscriber{
+		subscription:        sub,
+		metricsUseCase:      metricsUseCase,
+		maxDeliveryAttempts: maxDeliveryAttempts,
+	}
+}
+
+// Run receives messages until ctx is cancelled
+func (s *SnapshotAddedSubscriber) Run(ctx context.Context) error {
+	log.Printf("analytics-service subscribed to %s", s.subscription.ID())
+	return s.subscription.Receive(ctx, s.handle)
+}
+
+// handle processes a single message. Messages that can never succeed are
+// quarantined and acked; transient failures are nacked for redelivery until
+// the delivery attempt limit is reached.
+func (s *SnapshotAddedSubscriber) handle(ctx context.Context, msg *pubsub.Message) {
+	in, err := decodeSnapshotAdded(msg)
+	if err != nil {
+		s.quarantine(ctx, msg, "", err)
+		return
+	}
+
+	result, err := s.metricsUseCase.HandleSnapshotAdded(ctx, in)
+	if err != nil {
+		if isPermanent(err) || s.exhausted(msg) {
+			s.quarantine(ctx, msg, in.EventID, err)
+			return
+		}
+		log.Printf("snapshot-added %s failed, will retry: %v", in.EventID, err)
+		msg.Nack()
+		return
+	}
+
+	switch {
+	case result.Duplicate:
+		log.Printf("snapshot-added %s already processed, skipping", in.EventID)
+	case result.BaselineMissing:
+		log.Printf("snapshot-added %s: baseline not yet available for video %s", in.EventID, in.VideoID)
+	default:
+		log.Printf("snapshot-added %s: updated checkpoints %v for video %s", in.EventID, result.CheckpointsUpdated, in.VideoID)
+	}
+	msg.Ack()
+}
+
+// quarantine stores the message as poison and acks it. If it cannot be
+// stored, the message is nacked so it is not lost.
+func (s *SnapshotAddedSubscriber) quarantine(ctx context.Context, msg *pubsub.Message, eventID string, cause error) {
+	err := s.metricsUseCase.QuarantineMessage(ctx, &input.QuarantineMessageInput{
+		MessageID:       msg.ID,
+		EventID:         eventID,
+		Subscription:    s.subscription.ID(),
+		Payload:         msg.Data,
+		Attributes:      msg.Attributes,
+		ErrorMessage:    cause.Error(),
+		DeliveryAttempt: deliveryAttempt(msg),
+		ReceivedAt:      time.Now(),
+	})
+	if err != nil {
+		log.Printf("failed to quarantine message %s: %v", msg.ID, err)
+		msg.Nack()
+		return
+	}
+
+	log.Printf("quarantined message %s: %v", msg.ID, cause)
+	msg.Ack()
+}
+
+// exhausted reports whether the message reached the delivery attempt limit.
+// DeliveryAttempt is only populated when the subscription has a dead-letter policy.
+func (s *SnapshotAddedSubscriber) exhausted(msg *pubsub.Message) bool {
+	return s.maxDeliveryAttempts > 0 && deliveryAttempt(msg) >= s.maxDeliveryAttempts
+}
+
+func decodeSnapshotAdded(msg *pubsub.Message) (*input.SnapshotAddedInput, error) {
+	var m snapshotAddedMessage
+	if err := json.Unmarshal(msg.Data, &m); err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidEvent, err)
+	}
+
+	// Events published before they carried the snapshot lack publishedAt
+	if m.VideoID == "" || m.CheckpointHour == nil || m.PublishedAt.IsZero() {
+		return nil, fmt.Errorf("%w: videoId, checkpointHour and publishedAt are required", domain.ErrInvalidEvent)
+	}
+
+	// Fall back to the attribute and then the broker message ID for events
+	// published before event IDs were added to the payload
+	eventID := m.EventID
+	if eventID == "" {
+		eventID = msg.Attributes["eventId"]
+	}
+	if eventID == "" {
+		eventID = msg.ID
+	}
+
+	return &input.SnapshotAddedInput{
+		EventID:           eventID,
+		VideoID:           valueobject.UUID(m.VideoID),
+		CheckpointHour:    valueobject.CheckpointHour(*m.CheckpointHour),
+		MeasuredAt:        m.MeasuredAt,
+		PublishedAt:       m.PublishedAt,
+		ViewsCount:        m.ViewCount,
+		LikesCount:        m.LikeCount,
+		SubscriptionCount: m.SubscriptionCount,
+		CommentsCount:     m.CommentCount,
+		Drift:             time.Duration(m.DriftSeconds) * time.Second,
+	}, nil
+}
+
+func isPermanent(err error) bool {
+	return errors.Is(err, domain.ErrInvalidEvent) ||
+		errors.Is(err, domain.ErrInvalidCheckpoint) ||
+		errors.Is(err, domain.ErrVideoNotFound)
+}
+
+func deliveryAttempt(msg *pubsub.Message) int {
+	if msg.DeliveryAttempt == nil {
+		return 0
+	}
+	return *msg.DeliveryAttempt
+}
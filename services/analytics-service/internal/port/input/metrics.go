@@ -0,0 +1,51 @@
+package input
+
+import (
+	"context"
+	"time"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/domain/valueobject"
+)
+
+// MetricsInputPort defines the input port for metrics maintenance
+type MetricsInputPort interface {
+	// HandleSnapshotAdded recomputes the metrics affected by a new snapshot.
+	// Events that were already processed are acknowledged without side effects.
+	HandleSnapshotAdded(ctx context.Context, input *SnapshotAddedInput) (*HandleSnapshotAddedResult, error)
+	// QuarantineMessage records a message that can never be processed
+	QuarantineMessage(ctx context.Context, input *QuarantineMessageInput) error
+}
+
+// SnapshotAddedInput represents input for handling a snapshot-added event,
+// which carries the snapshot and the publish time of its video
+type SnapshotAddedInput struct {
+	EventID           string
+	VideoID           valueobject.UUID
+	CheckpointHour    valueobject.CheckpointHour
+	MeasuredAt        time.Time
+	PublishedAt       time.Time
+	ViewsCount        int64
+	LikesCount        int64
+	SubscriptionCount int64
+	CommentsCount     *int64 // nil when comments were not recorded
+	Drift             time.Duration
+}
+
+// HandleSnapshotAddedResult represents the result of handling a snapshot-added event
+type HandleSnapshotAddedResult struct {
+	Duplicate          bool
+	BaselineMissing    bool
+	CheckpointsUpdated []valueobject.CheckpointHour
+}
+
+// QuarantineMessageInput represents input for quarantining a poison message
+type QuarantineMessageInput struct {
+	MessageID       string
+	EventID         string
+	Subscription    string
+	Payload         []byte
+	Attributes      map[string]string
+	ErrorMessage    string
+	DeliveryAttempt int
+	ReceivedAt      time.Time
+}
@@ -0,0 +1,36 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/domain/valueobject"
+)
+
+// VideoRepository stores the videos announced by snapshot-added events
+type VideoRepository interface {
+	Save(ctx context.Context, video *domain.Video) error
+}
+
+// VideoSnapshotRepository stores the snapshots announced by snapshot-added events
+type VideoSnapshotRepository interface {
+	ListByVideo(ctx context.Context, videoID valueobject.UUID) ([]*domain.VideoSnapshot, error)
+	// Save stores the snapshot unless a later measurement of its checkpoint is stored
+	Save(ctx context.Context, snapshot *domain.VideoSnapshot) error
+}
+
+// VideoMetricsRepository persists the checkpoint metrics read model
+type VideoMetricsRepository interface {
+	Upsert(ctx context.Context, metrics *domain.VideoMetricsCheckpoint) error
+}
+
+// ProcessedEventRepository tracks consumed events for idempotency
+type ProcessedEventRepository interface {
+	// MarkProcessed records the event and returns false if it was already recorded
+	MarkProcessed(ctx context.Context, eventID, eventType string) (bool, error)
+}
+
+// PoisonMessageRepository stores messages that could not be processed
+type PoisonMessageRepository interface {
+	Save(ctx context.Context, message *domain.PoisonMessage) error
+}
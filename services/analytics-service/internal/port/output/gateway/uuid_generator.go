@@ -0,0 +1,8 @@
+package gateway
+
+import "github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/domain/valueobject"
+
+// UUIDGenerator generates UUIDs
+type UUIDGenerator interface {
+	Generate() valueobject.UUID
+}
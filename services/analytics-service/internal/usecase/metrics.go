@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/domain/service"
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/domain/valueobject"
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/port/input"
+	"github.com/YukiOnishi1129/youtube-analytics/services/analytics-service/internal/port/output/gateway"
+)
+
+const eventTypeSnapshotAdded = "snapshot-added"
+
+type metricsUseCase struct {
+	videoRepo          gateway.VideoRepository
+	snapshotRepo       gateway.VideoSnapshotRepository
+	metricsRepo        gateway.VideoMetricsRepository
+	processedEventRepo gateway.ProcessedEventRepository
+	poisonMessageRepo  gateway.PoisonMessageRepository
+	txManager          gateway.TransactionManager
+	idGen              gateway.UUIDGenerator
+	calculator         service.MetricsCalculator
+}
+
+// NewMetricsUseCase creates a new metrics use case
+func NewMetricsUseCase(
+	videoRepo gateway.VideoRepository,
+	snapshotRepo gateway.VideoSnapshotRepository,
+	metricsRepo gateway.VideoMetricsRepository,
+	processedEventRepo gateway.ProcessedEventRepository,
+	poisonMessageRepo gateway.PoisonMessageRepository,
+	txManager gateway.TransactionManager,
+	idGen gateway.UUIDGenerator,
+	calculator service.MetricsCalculator,
+) input.MetricsInputPort {
+	return &metricsUseCase{
+		videoRepo:          videoRepo,
+		snapshotRepo:       snapshotRepo,
+		metricsRepo:        metricsRepo,
+		processedEventRepo: processedEventRepo,
+		poisonMessageRepo:  poisonMessageRepo,
+		txManager:          txManager,
+		idGen:              idGen,
+		calculator:         calculator,
+	}
+}
+
+// HandleSnapshotAdded recomputes the metrics affected by a new snapshot.
+// The event is marked as processed in the same transaction as the metric
+// writes, so a redelivery after a failure is retried and a redelivery after
+// success is a no-op.
+func (u *metricsUseCase) HandleSnapshotAdded(ctx context.Context, in *input.SnapshotAddedInput) (*input.HandleSnapshotAddedResult, error) {
+	if in.EventID == "" || in.VideoID == "" || in.PublishedAt.IsZero() {
+		return nil, domain.ErrInvalidEvent
+	}
+	if !in.CheckpointHour.IsValid() {
+		return nil, domain.ErrInvalidCheckpoint
+	}
+
+	result := &input.HandleSnapshotAddedResult{}
+
+	err := u.txManager.Execute(ctx, func(ctx context.Context) error {
+		first, err := u.processedEventRepo.MarkProcessed(ctx, in.EventID, eventTypeSnapshotAdded)
+		if err != nil {
+			return fmt.Errorf("failed to mark event processed: %w", err)
+		}
+		if !first {
+			result.Duplicate = true
+			return nil
+		}
+
+		// Keep our own copy of the video and snapshot the event carries, then
+		// recompute from every stored snapshot so that out-of-order deliveries
+		// converge on the same result
+		video := &domain.Video{ID: in.VideoID, PublishedAt: in.PublishedAt}
+		if err := u.videoRepo.Save(ctx, video); err != nil {
+			return fmt.Errorf("failed to save video: %w", err)
+		}
+		if err := u.snapshotRepo.Save(ctx, &domain.VideoSnapshot{
+			VideoID:           in.VideoID,
+			CheckpointHour:    in.CheckpointHour,
+			MeasuredAt:        in.MeasuredAt,
+			ViewsCount:        in.ViewsCount,
+			LikesCount:        in.LikesCount,
+			SubscriptionCount: in.SubscriptionCount,
+			CommentsCount:     in.CommentsCount,
+			Drift:             in.Drift,
+		}); err != nil {
+			return fmt.Errorf("failed to save snapshot: %w", err)
+		}
+
+		snapshots, err := u.snapshotRepo.ListByVideo(ctx, in.VideoID)
+		if err != nil {
+			return fmt.Errorf("failed to list snapshots: %w", err)
+		}
+
+		// Nothing can be computed until the baseline arrives; its own event
+		// will recompute every later checkpoint
+		if _, ok := domain.FindSnapshot(snapshots, valueobject.CheckpointBaseline); !ok {
+			result.BaselineMissing = true
+			return nil
+		}
+
+		now := time.Now()
+		for _, cp := range u.calculator.AffectedCheckpoints(in.CheckpointHour, snapshots) {
+			metrics, err := u.calculator.Calculate(video, snapshots, cp, now)
+			if err != nil {
+				return fmt.Errorf("failed to calculate metrics for checkpoint %d: %w", cp, err)
+			}
+
+			if err := u.metricsRepo.Upsert(ctx, metrics); err != nil {
+				return fmt.Errorf("failed to save metrics for checkpoint %d: %w", cp, err)
+			}
+			result.CheckpointsUpdated = append(result.CheckpointsUpdated, cp)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// QuarantineMessage records a message that can never be processed so it can
+// be inspected and replayed later
+func (u *metricsUseCase) QuarantineMessage(ctx context.Context, in *input.QuarantineMessageInput) error {
+	message := &domain.PoisonMessage{
+		ID:              u.idGen.Generate(),
+		MessageID:       in.MessageID,
+		EventID:         in.EventID,
+		Subscription:    in.Subscription,
+		Payload:         in.Payload,
+		Attributes:      in.Attributes,
+		ErrorMessage:    in.ErrorMessage,
+		DeliveryAttempt: in.DeliveryAttempt,
+		ReceivedAt:      in.ReceivedAt,
+		CreatedAt:       time.Now(),
+	}
+
+	if err := u.poisonMessageRepo.Save(ctx, message); err != nil {
+		return fmt.Errorf("failed to save poison message: %w", err)
+	}
+
+	return nil
+}
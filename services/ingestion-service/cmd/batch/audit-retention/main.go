@@ -0,0 +1,159 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/filesystem"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/gcs"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/postgres"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/driver/config"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/driver/datastore"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/input"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/usecase"
+)
+
+func main() {
+	// Load configuration
+	cfg := config.Load()
+
+	// Parse command line arguments
+	var (
+		days       = flag.Int("days", cfg.AuditLogRetentionDays, "Purge audit logs older than N days")
+		archiveDir = flag.String("archive-dir", cfg.AuditLogArchiveDir, "Archive purged audit logs as JSON Lines to this directory or gs://bucket/prefix (empty to purge without archiving)")
+		dryRun     = flag.Bool("dry-run", false, "Dry run mode - only count expired audit logs")
+	)
+	flag.Parse()
+
+	if *days <= 0 {
+		log.Fatalf("Retention must be at least one day: %d", *days)
+	}
+
+	// Setup signal handling
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sigCh
+		log.Println("Shutting down...")
+		cancel()
+	}()
+
+	// A dry run reads as usual but only reports the changes it would make
+	var report *domain.DryRunReport
+	if *dryRun {
+		report = &domain.DryRunReport{}
+		ctx = domain.WithDryRunReport(ctx, report)
+	}
+
+	// Initialize database connection
+	db, err := datastore.OpenPostgres(cfg.DatabaseURL)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	// Initialize use case
+	pgRepo := postgres.NewRepository(db)
+	auditLogUseCase := usecase.NewAuditLogUseCase(postgres.NewAuditLogRepository(pgRepo))
+	batchJobUseCase := usecase.NewBatchJobUseCase(
+		postgres.NewBatchJobRepository(pgRepo),
+		postgres.NewBatchJobLockRepository(pgRepo),
+		nil, // Jobs are retried through the gRPC server
+	)
+
+	before := time.Now().AddDate(0, 0, -*days).UTC()
+	log.Printf("Starting audit log retention batch (days=%d, before=%s, archive-dir=%q, dry-run=%v)",
+		*days, before.Format(time.RFC3339), *archiveDir, *dryRun)
+
+	// Execute purge as a tracked batch job
+	job, err := batchJobUseCase.RunBatchJob(ctx, &input.RunBatchJobInput{
+		JobType: string(domain.JobTypePurgeAuditLogs),
+		Parameters: map[string]interface{}{
+			"days":        *days,
+			"before":      before.Format(time.RFC3339),
+			"archive_dir": *archiveDir,
+			"dry_run":     *dryRun,
+		},
+	}, func(ctx context.Context) (map[string]interface{}, error) {
+		// Each run writes its own archive so earlier archives are never
+		// overwritten. The logs are deleted only once it is committed.
+		var archive gateway.ArchiveWriter
+		if *archiveDir != "" && !*dryRun {
+			archiver, err := newArchiver(ctx, *archiveDir)
+			if err != nil {
+				return nil, err
+			}
+			name := fmt.Sprintf("audit-logs-before-%s.jsonl", before.Format("20060102T150405Z"))
+			archive, err = archiver.Create(ctx, name)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create archive: %w", err)
+			}
+			// Discards the archive unless the purge committed it
+			defer archive.Abort()
+			log.Printf("Archiving to %s in %s", name, *archiveDir)
+		}
+
+		result, err := auditLogUseCase.PurgeAuditLogs(ctx, &input.PurgeAuditLogsInput{
+			Before:  before,
+			Archive: archive,
+			DryRun:  *dryRun,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to purge audit logs: %w", err)
+		}
+
+		// Log results
+		log.Printf("Completed: expired=%d, archived=%d, deleted=%d", result.Expired, result.Archived, result.Deleted)
+		return map[string]interface{}{
+			"expired":  result.Expired,
+			"archived": result.Archived,
+			"deleted":  result.Deleted,
+		}, nil
+	})
+	if errors.Is(err, domain.ErrBatchJobOverlap) {
+		log.Printf("Skipped: %v", err)
+		return
+	}
+	if errors.Is(err, domain.ErrBatchJobCancelled) {
+		log.Printf("Cancelled: %v", err)
+		return
+	}
+	if err != nil {
+		log.Fatalf("Batch job failed: %v", err)
+	}
+	if report != nil {
+		for _, change := range report.Changes() {
+			log.Printf("[dry-run] would %s", change)
+		}
+		for _, line := range report.Summary() {
+			log.Printf("[dry-run] total %s", line)
+		}
+		return
+	}
+	log.Printf("Recorded batch job %s", job.ID)
+}
+
+// newArchiver returns the archiver for a gs://bucket/prefix location or a local directory
+func newArchiver(ctx context.Context, location string) (gateway.Archiver, error) {
+	if rest, ok := strings.CutPrefix(location, "gs://"); ok {
+		bucket, prefix, _ := strings.Cut(rest, "/")
+		if bucket == "" {
+			return nil, fmt.Errorf("invalid archive location %q: missing bucket", location)
+		}
+		return gcs.NewArchiver(ctx, bucket, prefix)
+	}
+	return filesystem.NewArchiver(location), nil
+}
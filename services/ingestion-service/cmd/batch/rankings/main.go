@@ -2,17 +2,21 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
+	"fmt"
 	"log"
 	"os"
 	"os/signal"
 	"syscall"
-	"time"
 
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/postgres"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/driver/config"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/driver/datastore"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/input"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/usecase"
 )
 
 func main() {
@@ -40,6 +44,11 @@ func main() {
 		cancel()
 	}()
 
+	// A dry run reads as usual but only reports the changes it would make
+	if *dryRun {
+		ctx = domain.WithDryRunReport(ctx, &domain.DryRunReport{})
+	}
+
 	// Initialize database connection
 	db, err := datastore.OpenPostgres(cfg.DatabaseURL)
 	if err != nil {
@@ -49,52 +58,60 @@ func main() {
 
 	// Initialize repositories
 	pgRepo := postgres.NewRepository(db)
-	videoRepo := postgres.NewVideoRepository(pgRepo)
 	genreRepo := postgres.NewGenreRepository(pgRepo)
 	// metricsRepo := postgres.NewVideoMetricsRepository(pgRepo) // TODO: Implement
 	// rankingRepo := postgres.NewRankingHistoryRepository(pgRepo) // TODO: Implement
 
+	batchJobUseCase := usecase.NewBatchJobUseCase(
+		postgres.NewBatchJobRepository(pgRepo),
+		postgres.NewBatchJobLockRepository(pgRepo),
+		nil, // Jobs are retried through the gRPC server
+	)
+
 	// Log start
 	log.Printf("Starting ranking generation batch (genre=%s, checkpoint=%dh, top=%d, dry-run=%v)",
 		*genreID, *checkpoint, *topN, *dryRun)
-	start := time.Now()
 
-	// TODO: Implement ranking generation
-	log.Printf("Ranking generation batch not yet implemented")
-	log.Printf("Would generate top %d ranking for checkpoint %dh", *topN, *checkpoint)
-	
+	parameters := map[string]interface{}{
+		"checkpoint_hour": *checkpoint,
+		"top":             *topN,
+		"dry_run":         *dryRun,
+	}
 	if *genreID != "" {
-		// Generate for specific genre
-		id := valueobject.UUID(*genreID)
-		genre, err := genreRepo.FindByID(ctx, id)
-		if err != nil {
-			log.Printf("Error getting genre: %v", err)
-		} else {
-			log.Printf("Would generate ranking for genre: %s", genre.Code)
-		}
-		
-		// Show sample of what videos would be ranked
-		videos, err := videoRepo.ListActive(ctx, time.Now().Add(-7*24*time.Hour))
-		if err != nil {
-			log.Printf("Error getting videos: %v", err)
-		} else {
-			log.Printf("Sample active videos: %d", len(videos))
-		}
-	} else {
-		// Generate for all genres
-		genres, err := genreRepo.FindAll(ctx)
-		if err != nil {
-			log.Printf("Error getting genres: %v", err)
+		parameters["genre_id"] = *genreID
+	}
+
+	// TODO: Implement ranking generation. Until then each run is recorded as a
+	// failed job, so the job history shows the batch ran and ranked nothing.
+	job, err := batchJobUseCase.RunBatchJob(ctx, &input.RunBatchJobInput{
+		JobType:    string(domain.JobTypeGenerateRankings),
+		Parameters: parameters,
+	}, func(ctx context.Context) (map[string]interface{}, error) {
+		if *genreID != "" {
+			genre, err := genreRepo.FindByID(ctx, valueobject.UUID(*genreID))
+			if err != nil {
+				return nil, fmt.Errorf("failed to get genre: %w", err)
+			}
+			log.Printf("Would generate top %d ranking at %dh for genre: %s", *topN, *checkpoint, genre.Code)
 		} else {
-			log.Printf("Would generate rankings for %d genres", len(genres))
-			for _, genre := range genres {
-				if genre.Enabled {
-					log.Printf("  - %s", genre.Code)
-				}
+			genres, err := genreRepo.FindEnabled(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get genres: %w", err)
 			}
+			log.Printf("Would generate top %d rankings at %dh for %d genres", *topN, *checkpoint, len(genres))
 		}
+		return nil, fmt.Errorf("%w: ranking generation", domain.ErrBatchJobNotImplemented)
+	})
+	if errors.Is(err, domain.ErrBatchJobOverlap) {
+		log.Printf("Skipped: %v", err)
+		return
 	}
-
-	// Log results
-	log.Printf("Completed in %s", time.Since(start))
-}
\ No newline at end of file
+	if errors.Is(err, domain.ErrBatchJobCancelled) {
+		log.Printf("Cancelled: %v", err)
+		return
+	}
+	if err != nil {
+		log.Fatalf("Batch job failed: %v", err)
+	}
+	log.Printf("Recorded batch job %s", job.ID)
+}
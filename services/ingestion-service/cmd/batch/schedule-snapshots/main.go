@@ -2,7 +2,9 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
+	"fmt"
 	"log"
 	"os"
 	"os/signal"
@@ -11,21 +13,28 @@ import (
 
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/cloudtasks"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/postgres"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/pubsub"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/youtube"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/service"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/driver/config"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/driver/datastore"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/input"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/usecase"
 )
 
 func main() {
 	// Parse command line arguments
 	var (
-		hours  = flag.Int("hours", 24, "Schedule snapshots for videos published within the last N hours")
+		hours  = flag.Int("hours", 0, "Schedule snapshots for videos published within the last N hours (0 uses the last checkpoint of the longest active checkpoint profile)")
 		dryRun = flag.Bool("dry-run", false, "Dry run mode - only log what would be done")
 	)
 	flag.Parse()
 
+	if *hours < 0 {
+		log.Fatalf("Hours must not be negative: %d", *hours)
+	}
+
 	// Load configuration
 	cfg := config.Load()
 
@@ -41,6 +50,14 @@ func main() {
 		cancel()
 	}()
 
+	// A dry run reads and calls YouTube as usual but only reports the changes
+	// it would make
+	var report *domain.DryRunReport
+	if *dryRun {
+		report = &domain.DryRunReport{}
+		ctx = domain.WithDryRunReport(ctx, report)
+	}
+
 	// Initialize database connection
 	db, err := datastore.OpenPostgres(cfg.DatabaseURL)
 	if err != nil {
@@ -52,6 +69,8 @@ func main() {
 	pgRepo := postgres.NewRepository(db)
 	videoRepo := postgres.NewVideoRepository(pgRepo)
 	snapshotRepo := postgres.NewVideoSnapshotRepository(pgRepo)
+	checkpointProfileRepo := postgres.NewCheckpointProfileRepository(pgRepo)
+	channelSnapshotRepo := postgres.NewChannelSnapshotRepository(pgRepo)
 
 	// Initialize task scheduler
 	taskScheduler, err := cloudtasks.NewTaskScheduler(
@@ -69,6 +88,12 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to create YouTube client: %v", err)
 	}
+
+	// Initialize event publisher
+	eventPublisher, err := pubsub.NewEventPublisher(cfg.PubSubProjectID)
+	if err != nil {
+		log.Fatalf("Failed to create event publisher: %v", err)
+	}
 	
 	// Initialize snapshot scheduler
 	snapshotScheduler := service.NewSnapshotScheduler()
@@ -79,20 +104,66 @@ func main() {
 		snapshotRepo,
 		taskScheduler,
 		snapshotScheduler,
+		checkpointProfileRepo,
+		channelSnapshotRepo,
 		youtubeClient,
+		eventPublisher,
+	)
+
+	batchJobUseCase := usecase.NewBatchJobUseCase(
+		postgres.NewBatchJobRepository(pgRepo),
+		postgres.NewBatchJobLockRepository(pgRepo),
+		nil, // Jobs are retried through the gRPC server
 	)
 
 	// Log start
 	log.Printf("Starting snapshot scheduling batch (hours=%d, dry-run=%v)", *hours, *dryRun)
 	start := time.Now()
 
-	// Execute scheduling
-	result, err := systemUseCase.ScheduleSnapshots(ctx)
+	// Execute scheduling as a tracked batch job
+	job, err := batchJobUseCase.RunBatchJob(ctx, &input.RunBatchJobInput{
+		JobType: string(domain.JobTypeCollectSnapshots),
+		Parameters: map[string]interface{}{
+			"hours":   *hours,
+			"dry_run": *dryRun,
+		},
+	}, func(ctx context.Context) (map[string]interface{}, error) {
+		result, err := systemUseCase.ScheduleSnapshots(ctx, &input.ScheduleSnapshotsInput{
+			Window: time.Duration(*hours) * time.Hour,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to schedule snapshots: %w", err)
+		}
+
+		// Log results
+		log.Printf("Scheduled videos published since %s", result.PublishedSince.Format(time.RFC3339))
+		log.Printf("Completed: videos=%d, tasks=%d, duration=%s",
+			result.VideosProcessed, result.TasksScheduled, time.Since(start))
+		return map[string]interface{}{
+			"published_since":  result.PublishedSince.Format(time.RFC3339),
+			"videos_processed": result.VideosProcessed,
+			"tasks_scheduled":  result.TasksScheduled,
+		}, nil
+	})
+	if errors.Is(err, domain.ErrBatchJobOverlap) {
+		log.Printf("Skipped: %v", err)
+		return
+	}
+	if errors.Is(err, domain.ErrBatchJobCancelled) {
+		log.Printf("Cancelled: %v", err)
+		return
+	}
 	if err != nil {
-		log.Fatalf("Failed to schedule snapshots: %v", err)
+		log.Fatalf("Batch job failed: %v", err)
 	}
-
-	// Log results
-	log.Printf("Completed: videos=%d, tasks=%d, duration=%s",
-		result.VideosProcessed, result.TasksScheduled, time.Since(start))
-}
\ No newline at end of file
+	if report != nil {
+		for _, change := range report.Changes() {
+			log.Printf("[dry-run] would %s", change)
+		}
+		for _, line := range report.Summary() {
+			log.Printf("[dry-run] total %s", line)
+		}
+		return
+	}
+	log.Printf("Recorded batch job %s", job.ID)
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/postgres"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/pubsub"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/youtube"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/service"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/driver/config"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/driver/datastore"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/input"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/usecase"
+)
+
+func main() {
+	// Parse command line arguments
+	defaults := service.DefaultSnapshotAuditPolicy()
+	var (
+		hours             = flag.Int("hours", 0, "Audit videos published within the last N hours (default: the longest checkpoint of the active profiles plus its tolerance)")
+		minTolerance      = flag.Duration("min-tolerance", defaults.MinTolerance, "Minimum delay after which a missed checkpoint can no longer be backfilled")
+		relativeTolerance = flag.Float64("relative-tolerance", defaults.RelativeTolerance, "Allowed backfill delay as a fraction of the checkpoint hour")
+		dryRun            = flag.Bool("dry-run", false, "Dry run mode - only log what would be done")
+	)
+	flag.Parse()
+
+	// Load configuration
+	cfg := config.Load()
+
+	// Setup signal handling
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sigCh
+		log.Println("Shutting down...")
+		cancel()
+	}()
+
+	// A dry run reads and calls YouTube as usual but only reports the changes
+	// it would make
+	var report *domain.DryRunReport
+	if *dryRun {
+		report = &domain.DryRunReport{}
+		ctx = domain.WithDryRunReport(ctx, report)
+	}
+
+	// Initialize database connection
+	db, err := datastore.OpenPostgres(cfg.DatabaseURL)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	// Initialize repositories
+	pgRepo := postgres.NewRepository(db)
+	videoRepo := postgres.NewVideoRepository(pgRepo)
+	snapshotRepo := postgres.NewVideoSnapshotRepository(pgRepo)
+	gapRepo := postgres.NewSnapshotGapRepository(pgRepo)
+	videoGenreRepo := postgres.NewVideoGenreRepository(pgRepo)
+	genreRepo := postgres.NewGenreRepository(pgRepo)
+	checkpointProfileRepo := postgres.NewCheckpointProfileRepository(pgRepo)
+	channelSnapshotRepo := postgres.NewChannelSnapshotRepository(pgRepo)
+
+	// Initialize YouTube client
+	youtubeClient, err := youtube.NewClient(cfg.YouTubeAPIKey)
+	if err != nil {
+		log.Fatalf("Failed to create YouTube client: %v", err)
+	}
+
+	// Initialize event publisher
+	eventPublisher, err := pubsub.NewEventPublisher(cfg.PubSubProjectID)
+	if err != nil {
+		log.Fatalf("Failed to create event publisher: %v", err)
+	}
+
+	// Initialize use case
+	auditUseCase := usecase.NewSnapshotAuditUseCase(
+		videoRepo,
+		snapshotRepo,
+		gapRepo,
+		videoGenreRepo,
+		genreRepo,
+		checkpointProfileRepo,
+		channelSnapshotRepo,
+		service.NewSnapshotAuditor(service.SnapshotAuditPolicy{
+			MinTolerance:      *minTolerance,
+			RelativeTolerance: *relativeTolerance,
+		}),
+		youtubeClient,
+		eventPublisher,
+	)
+
+	// Log start
+	log.Printf("Starting snapshot audit batch (hours=%d, min-tolerance=%s, relative-tolerance=%.2f, dry-run=%v)",
+		*hours, *minTolerance, *relativeTolerance, *dryRun)
+
+	batchJobUseCase := usecase.NewBatchJobUseCase(
+		postgres.NewBatchJobRepository(pgRepo),
+		postgres.NewBatchJobLockRepository(pgRepo),
+		nil, // Jobs are retried through the gRPC server
+	)
+
+	// Execute audit as a tracked batch job
+	job, err := batchJobUseCase.RunBatchJob(ctx, &input.RunBatchJobInput{
+		JobType: string(domain.JobTypeAuditSnapshots),
+		Parameters: map[string]interface{}{
+			"hours":              *hours,
+			"min_tolerance":      minTolerance.String(),
+			"relative_tolerance": *relativeTolerance,
+			"dry_run":            *dryRun,
+		},
+	}, func(ctx context.Context) (map[string]interface{}, error) {
+		in := &input.AuditSnapshotsInput{DryRun: *dryRun}
+		if *hours > 0 {
+			in.PublishedSince = time.Now().Add(-time.Duration(*hours) * time.Hour)
+		}
+		result, err := auditUseCase.AuditSnapshots(ctx, in)
+		if err != nil {
+			return nil, fmt.Errorf("failed to audit snapshots: %w", err)
+		}
+		log.Printf("Audited videos published since %s", result.PublishedSince.Format(time.RFC3339))
+
+		// Log coverage report
+		log.Printf("%-20s %6s %6s %8s %10s %6s %9s", "GENRE", "CP", "DUE", "CAPTURED", "BACKFILLED", "GAPS", "COVERAGE")
+		for _, c := range result.Coverage {
+			genre := c.GenreCode
+			if genre == "" {
+				genre = "(none)"
+			}
+			log.Printf("%-20s %5dh %6d %8d %10d %6d %8.1f%%",
+				genre, c.CheckpointHour, c.Due, c.Captured, c.Backfilled, c.Gaps, c.CoverageRate()*100)
+		}
+
+		// Log results
+		log.Printf("Completed: videos=%d, missing=%d, backfilled=%d, backfill_failed=%d, gaps=%d, duration=%s",
+			result.VideosAudited, result.Missing, result.Backfilled, result.BackfillFailed, result.GapsMarked, result.Duration)
+		return map[string]interface{}{
+			"published_since": result.PublishedSince.Format(time.RFC3339),
+			"videos_audited":  result.VideosAudited,
+			"missing":         result.Missing,
+			"backfilled":      result.Backfilled,
+			"backfill_failed": result.BackfillFailed,
+			"gaps_marked":     result.GapsMarked,
+		}, nil
+	})
+	if errors.Is(err, domain.ErrBatchJobOverlap) {
+		log.Printf("Skipped: %v", err)
+		return
+	}
+	if errors.Is(err, domain.ErrBatchJobCancelled) {
+		log.Printf("Cancelled: %v", err)
+		return
+	}
+	if err != nil {
+		log.Fatalf("Batch job failed: %v", err)
+	}
+	if report != nil {
+		for _, change := range report.Changes() {
+			log.Printf("[dry-run] would %s", change)
+		}
+		for _, line := range report.Summary() {
+			log.Printf("[dry-run] total %s", line)
+		}
+		return
+	}
+	log.Printf("Recorded batch job %s", job.ID)
+}
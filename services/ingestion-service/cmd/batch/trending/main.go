@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"log"
 	"os"
@@ -12,6 +13,7 @@ import (
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/postgres"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/pubsub"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/youtube"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/driver/config"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/driver/datastore"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/usecase"
@@ -40,6 +42,14 @@ func main() {
 		cancel()
 	}()
 
+	// A dry run reads and calls YouTube as usual but only reports the changes
+	// it would make
+	var report *domain.DryRunReport
+	if *dryRun {
+		report = &domain.DryRunReport{}
+		ctx = domain.WithDryRunReport(ctx, report)
+	}
+
 	// Initialize database connection
 	db, err := datastore.OpenPostgres(cfg.DatabaseURL)
 	if err != nil {
@@ -51,8 +61,11 @@ func main() {
 	pgRepo := postgres.NewRepository(db)
 	channelRepo := postgres.NewChannelRepository(pgRepo)
 	videoRepo := postgres.NewVideoRepository(pgRepo)
+	videoSnapshotRepo := postgres.NewVideoSnapshotRepository(pgRepo)
+	videoGenreRepo := postgres.NewVideoGenreRepository(pgRepo)
 	genreRepo := postgres.NewGenreRepository(pgRepo)
-	// keywordGroupRepo := postgres.NewKeywordGroupRepository(pgRepo) // TODO: Implement
+	keywordGroupRepo := postgres.NewKeywordGroupRepository(pgRepo)
+	keywordSynonymRepo := postgres.NewKeywordSynonymRepository(pgRepo)
 
 	// Initialize gateways
 	youtubeClient, err := youtube.NewClient(cfg.YouTubeAPIKey)
@@ -66,18 +79,36 @@ func main() {
 	// idGen := uuid.NewGenerator() // Not needed for this batch
 
 	// Initialize use cases
-	videoUseCase := usecase.NewVideoUseCase(
-		videoRepo,
-		channelRepo,
-		youtubeClient,
-		eventPublisher,
+	batchJobUseCase := usecase.NewBatchJobUseCase(
+		postgres.NewBatchJobRepository(pgRepo),
+		postgres.NewBatchJobLockRepository(pgRepo),
+		nil, // Jobs are retried through the gRPC server
 	)
 
-	genreUseCase := usecase.NewGenreUseCase(genreRepo)
-	// keywordUseCase := usecase.NewKeywordUseCase(keywordGroupRepo) // TODO: Implement
+	// Videos are filtered by the keyword groups of each genre and assigned to
+	// it, audited like assignments made through the API
+	videoGenreUseCase := usecase.NewAuditedVideoGenreUseCase(
+		usecase.NewVideoGenreUseCase(videoGenreRepo, videoRepo, keywordGroupRepo, keywordSynonymRepo),
+		usecase.NewAuditLogUseCase(postgres.NewAuditLogRepository(pgRepo)),
+		postgres.NewTransactionManager(db),
+	)
 
-	// For now, use video use case directly
-	// TODO: Implement proper trending collection use case
+	// Collection runs as a batch job locked per genre, so it never overlaps
+	// with another scheduled or manual run for the same genre
+	videoUseCase := usecase.NewSingleFlightVideoUseCase(
+		usecase.NewVideoUseCase(
+			videoRepo,
+			channelRepo,
+			videoSnapshotRepo,
+			videoGenreRepo,
+			genreRepo,
+			youtubeClient,
+			eventPublisher,
+			videoGenreUseCase,
+		),
+		batchJobUseCase,
+		genreRepo,
+	)
 
 	// Log start
 	log.Printf("Starting trending video collection batch (dry-run=%v)", *dryRun)
@@ -88,48 +119,51 @@ func main() {
 		// Collect for specific genre
 		log.Printf("Collecting trending videos for genre: %s", *genreID)
 		result, err := videoUseCase.CollectTrending(ctx, genreID)
+		if errors.Is(err, domain.ErrBatchJobOverlap) {
+			log.Printf("Skipped: %v", err)
+			return
+		}
+		if errors.Is(err, domain.ErrBatchJobCancelled) {
+			log.Printf("Cancelled: %v", err)
+			return
+		}
 		if err != nil {
 			log.Fatalf("Failed to collect trending videos: %v", err)
 		}
-		log.Printf("Completed: collected=%d, created=%d, updated=%d, duration=%s",
-			result.VideosCollected, result.VideosCreated, result.VideosUpdated, result.Duration)
+		log.Printf("Completed: collected=%d, created=%d, updated=%d, filtered=%d, duration=%s",
+			result.VideosCollected, result.VideosCreated, result.VideosUpdated, result.VideosFiltered, result.Duration)
 	} else {
 		// Collect for all enabled genres
 		log.Println("Collecting trending videos for all enabled genres")
 
-		// Get all enabled genres
-		genres, err := genreUseCase.ListGenres(ctx)
+		result, err := videoUseCase.CollectAllTrending(ctx)
+		if errors.Is(err, domain.ErrBatchJobOverlap) {
+			log.Printf("Skipped: %v", err)
+			return
+		}
+		if errors.Is(err, domain.ErrBatchJobCancelled) {
+			log.Printf("Cancelled: %v", err)
+			return
+		}
 		if err != nil {
-			log.Fatalf("Failed to get genres: %v", err)
+			log.Fatalf("Failed to collect trending videos: %v", err)
 		}
 
-		totalCollected := 0
-		totalCreated := 0
-		totalUpdated := 0
-
-		for _, genre := range genres {
-			// Skip disabled genres
-			if !genre.Enabled {
-				continue
-			}
-
-			log.Printf("Processing genre: %s", genre.Code)
-			genreIDStr := string(genre.ID)
-			result, err := videoUseCase.CollectTrending(ctx, &genreIDStr)
-			if err != nil {
-				log.Printf("Error collecting for genre %s: %v", genre.Code, err)
-				continue
-			}
-			totalCollected += result.VideosCollected
-			totalCreated += result.VideosCreated
-			totalUpdated += result.VideosUpdated
-			log.Printf("  Genre %s: collected=%d, created=%d, updated=%d",
-				genre.Code, result.VideosCollected, result.VideosCreated, result.VideosUpdated)
+		for _, gr := range result.GenreResults {
+			log.Printf("  Genre %s: collected=%d, created=%d, updated=%d, filtered=%d",
+				gr.GenreCode, gr.VideosCollected, gr.VideosCreated, gr.VideosUpdated, gr.VideosFiltered)
 		}
-
-		log.Printf("Completed: genres=%d, total_collected=%d, total_created=%d, total_updated=%d, duration=%s",
-			len(genres), totalCollected, totalCreated, totalUpdated, time.Since(start))
+		log.Printf("Completed: genres=%d, failed=%d, total_collected=%d, total_created=%d, total_updated=%d, total_filtered=%d, duration=%s",
+			result.GenresProcessed, result.GenresFailed, result.TotalCollected, result.TotalCreated, result.TotalUpdated, result.TotalFiltered, result.Duration)
 	}
 
 	log.Printf("Total execution time: %s", time.Since(start))
+	if report != nil {
+		for _, change := range report.Changes() {
+			log.Printf("[dry-run] would %s", change)
+		}
+		for _, line := range report.Summary() {
+			log.Printf("[dry-run] total %s", line)
+		}
+	}
 }
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/postgres"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/youtube"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/driver/config"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/driver/datastore"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/input"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/usecase"
+)
+
+func main() {
+	// Parse command line arguments
+	dryRun := flag.Bool("dry-run", false, "Dry run mode - only log what would be done")
+	flag.Parse()
+
+	// Load configuration
+	cfg := config.Load()
+
+	// Setup signal handling
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sigCh
+		log.Println("Shutting down...")
+		cancel()
+	}()
+
+	// A dry run reads and calls YouTube as usual but only reports the changes
+	// it would make
+	var report *domain.DryRunReport
+	if *dryRun {
+		report = &domain.DryRunReport{}
+		ctx = domain.WithDryRunReport(ctx, report)
+	}
+
+	// Initialize database connection
+	db, err := datastore.OpenPostgres(cfg.DatabaseURL)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	// Initialize repositories
+	pgRepo := postgres.NewRepository(db)
+	channelRepo := postgres.NewChannelRepository(pgRepo)
+	channelSnapshotRepo := postgres.NewChannelSnapshotRepository(pgRepo)
+
+	// Initialize YouTube client
+	youtubeClient, err := youtube.NewClient(cfg.YouTubeAPIKey)
+	if err != nil {
+		log.Fatalf("Failed to create YouTube client: %v", err)
+	}
+
+	// Initialize use cases
+	channelUseCase := usecase.NewChannelUseCase(channelRepo, channelSnapshotRepo, youtubeClient)
+	batchJobUseCase := usecase.NewBatchJobUseCase(
+		postgres.NewBatchJobRepository(pgRepo),
+		postgres.NewBatchJobLockRepository(pgRepo),
+		nil, // Jobs are retried through the gRPC server
+	)
+
+	// Log start
+	log.Printf("Starting channel update batch (dry-run=%v)", *dryRun)
+
+	// Execute update as a tracked batch job
+	job, err := batchJobUseCase.RunBatchJob(ctx, &input.RunBatchJobInput{
+		JobType:    string(domain.JobTypeUpdateChannels),
+		Parameters: map[string]interface{}{"dry_run": *dryRun},
+	}, func(ctx context.Context) (map[string]interface{}, error) {
+		result, err := channelUseCase.UpdateChannels(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update channels: %w", err)
+		}
+
+		// Log results
+		log.Printf("Completed: processed=%d, updated=%d, snapshots=%d, duration=%s",
+			result.ChannelsProcessed, result.ChannelsUpdated, result.SnapshotsTaken, result.Duration)
+		return map[string]interface{}{
+			"channels_processed": result.ChannelsProcessed,
+			"channels_updated":   result.ChannelsUpdated,
+			"snapshots_taken":    result.SnapshotsTaken,
+		}, nil
+	})
+	if errors.Is(err, domain.ErrBatchJobOverlap) {
+		log.Printf("Skipped: %v", err)
+		return
+	}
+	if errors.Is(err, domain.ErrBatchJobCancelled) {
+		log.Printf("Cancelled: %v", err)
+		return
+	}
+	if err != nil {
+		log.Fatalf("Batch job failed: %v", err)
+	}
+	if report != nil {
+		for _, change := range report.Changes() {
+			log.Printf("[dry-run] would %s", change)
+		}
+		for _, line := range report.Summary() {
+			log.Printf("[dry-run] total %s", line)
+		}
+		return
+	}
+	log.Printf("Recorded batch job %s", job.ID)
+}
@@ -2,15 +2,20 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
+	"fmt"
 	"log"
 	"os"
 	"os/signal"
 	"syscall"
 
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/postgres"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/driver/config"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/driver/datastore"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/input"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/usecase"
 )
 
 func main() {
@@ -36,6 +41,11 @@ func main() {
 		cancel()
 	}()
 
+	// A dry run reads as usual but only reports the changes it would make
+	if *dryRun {
+		ctx = domain.WithDryRunReport(ctx, &domain.DryRunReport{})
+	}
+
 	// Initialize database connection
 	db, err := datastore.OpenPostgres(cfg.DatabaseURL)
 	if err != nil {
@@ -47,18 +57,42 @@ func main() {
 	pgRepo := postgres.NewRepository(db)
 	channelRepo := postgres.NewChannelRepository(pgRepo)
 
+	batchJobUseCase := usecase.NewBatchJobUseCase(
+		postgres.NewBatchJobRepository(pgRepo),
+		postgres.NewBatchJobLockRepository(pgRepo),
+		nil, // Jobs are retried through the gRPC server
+	)
+
 	// Log start
 	log.Printf("Starting WebSub renewal batch (days=%d, dry-run=%v)", *days, *dryRun)
-	
-	// TODO: Implement WebSub client and subscription renewal use case
-	log.Printf("WebSub renewal batch not yet implemented")
-	log.Printf("Would check channels from repository and renew subscriptions expiring in %d days", *days)
-	
-	// For now, just show what channels we would process
-	channels, err := channelRepo.ListSubscribed(ctx)
-	if err != nil {
-		log.Printf("Error listing channels: %v", err)
-	} else {
+
+	// TODO: Implement WebSub client and subscription renewal use case. Until
+	// then each run is recorded as a failed job, so the job history shows the
+	// batch ran and renewed nothing.
+	job, err := batchJobUseCase.RunBatchJob(ctx, &input.RunBatchJobInput{
+		JobType: string(domain.JobTypeRenewSubscriptions),
+		Parameters: map[string]interface{}{
+			"days":    *days,
+			"dry_run": *dryRun,
+		},
+	}, func(ctx context.Context) (map[string]interface{}, error) {
+		channels, err := channelRepo.ListSubscribed(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list channels: %w", err)
+		}
 		log.Printf("Found %d subscribed channels that might need renewal", len(channels))
+		return nil, fmt.Errorf("%w: WebSub subscription renewal", domain.ErrBatchJobNotImplemented)
+	})
+	if errors.Is(err, domain.ErrBatchJobOverlap) {
+		log.Printf("Skipped: %v", err)
+		return
+	}
+	if errors.Is(err, domain.ErrBatchJobCancelled) {
+		log.Printf("Cancelled: %v", err)
+		return
+	}
+	if err != nil {
+		log.Fatalf("Batch job failed: %v", err)
 	}
-}
\ No newline at end of file
+	log.Printf("Recorded batch job %s", job.ID)
+}
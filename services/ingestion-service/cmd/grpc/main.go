@@ -1,17 +1,21 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
 
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/cloudtasks"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/insecure"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/mock"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/postgres"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/youtube"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/config"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/driver/datastore"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/driver/transport"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
+	"github.com/YukiOnishi1129/youtube-analytics/services/pkg/identityauth"
 	"github.com/joho/godotenv"
 )
 
@@ -40,6 +44,15 @@ func main() {
 	videoRepo := postgres.NewVideoRepository(repo)
 	channelSnapshotRepo := postgres.NewChannelSnapshotRepository(repo)
 	videoSnapshotRepo := postgres.NewVideoSnapshotRepository(repo)
+	checkpointProfileRepo := postgres.NewCheckpointProfileRepository(repo)
+	videoGenreRepo := postgres.NewVideoGenreRepository(repo)
+	genreRepo := postgres.NewGenreRepository(repo)
+	youtubeCategoryRepo := postgres.NewYouTubeCategoryRepository(repo)
+	auditLogRepo := postgres.NewAuditLogRepository(repo)
+	batchJobRepo := postgres.NewBatchJobRepository(repo)
+	batchJobLockRepo := postgres.NewBatchJobLockRepository(repo)
+	keywordGroupRepo := postgres.NewKeywordGroupRepository(repo)
+	keywordSynonymRepo := postgres.NewKeywordSynonymRepository(repo)
 
 	// Use mock keyword repository for now until SQL queries are generated
 	keywordRepo := mock.NewKeywordRepository()
@@ -64,6 +77,18 @@ func main() {
 	// Initialize event publisher (using mock for now)
 	eventPublisher := mock.NewEventPublisher()
 
+	// Initialize token verifier (no-op only when local dev mode is explicit)
+	var tokenVerifier gateway.TokenVerifier
+	if cfg.Auth.InsecureDev {
+		log.Printf("[WARN] AUTH_INSECURE_DEV set; accepting any token as a dev user without roles")
+		tokenVerifier = insecure.NoopVerifier{}
+	} else {
+		tokenVerifier, err = identityauth.NewOIDCVerifier(context.Background(), cfg.Auth.OIDCIssuer, cfg.Auth.OIDCAudience)
+		if err != nil {
+			log.Fatalf("Failed to create token verifier: %v", err)
+		}
+	}
+
 	// Determine address
 	addr := fmt.Sprintf(":%d", cfg.Server.GRPCPort)
 	if envAddr := os.Getenv("GRPC_ADDR"); envAddr != "" {
@@ -71,16 +96,27 @@ func main() {
 	}
 
 	// Bootstrap and start gRPC server
-	if err := transport.BootstrapGRPCWithKeyword(
+	if err := transport.BootstrapGRPCWithAllUseCases(
 		addr,
 		channelRepo,
 		channelSnapshotRepo,
 		videoRepo,
 		videoSnapshotRepo,
+		checkpointProfileRepo,
+		videoGenreRepo,
+		genreRepo,
 		keywordRepo,
+		keywordGroupRepo,
+		keywordSynonymRepo,
+		youtubeCategoryRepo,
+		auditLogRepo,
+		batchJobRepo,
+		batchJobLockRepo,
+		postgres.NewTransactionManager(db),
 		youtubeClient,
 		taskScheduler,
 		eventPublisher,
+		tokenVerifier,
 	); err != nil {
 		log.Fatalf("Failed to start gRPC server: %v", err)
 	}
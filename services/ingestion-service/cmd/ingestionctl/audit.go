@@ -0,0 +1,173 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"time"
+
+	pb "github.com/YukiOnishi1129/youtube-analytics/services/pkg/pb/ingestion/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var auditActions = map[string]action{
+	"list":   listAuditLogs,
+	"get":    getAuditLog,
+	"export": exportAuditLogs,
+}
+
+var auditHeader = []string{"ID", "CREATED", "ACTOR", "ACTION", "RESOURCE", "RESOURCE ID"}
+
+// auditFilter holds the filter flags shared by list and export
+type auditFilter struct {
+	actorID, action, resourceType, resourceID, changedField string
+	after, before                                           string
+}
+
+func (f *auditFilter) register(flags *flag.FlagSet) {
+	flags.StringVar(&f.actorID, "actor", "", "Only logs of the actor ID")
+	flags.StringVar(&f.action, "action", "", "Only logs of the action, e.g. update")
+	flags.StringVar(&f.resourceType, "resource-type", "", "Only logs of the resource type, e.g. genre")
+	flags.StringVar(&f.resourceID, "resource-id", "", "Only logs of the resource ID")
+	flags.StringVar(&f.changedField, "changed-field", "", "Only logs changing the field, e.g. category_ids")
+	flags.StringVar(&f.after, "after", "", "Only logs created at or after the RFC 3339 time")
+	flags.StringVar(&f.before, "before", "", "Only logs created before the RFC 3339 time")
+}
+
+// times parses the after and before bounds
+func (f *auditFilter) times() (after, before *timestamppb.Timestamp, err error) {
+	parse := func(s string) (*timestamppb.Timestamp, error) {
+		if s == "" {
+			return nil, nil
+		}
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time %q: %w", s, err)
+		}
+		return timestamppb.New(t), nil
+	}
+	if after, err = parse(f.after); err != nil {
+		return nil, nil, err
+	}
+	if before, err = parse(f.before); err != nil {
+		return nil, nil, err
+	}
+	return after, before, nil
+}
+
+func listAuditLogs(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("audit list", flag.ContinueOnError)
+	var filter auditFilter
+	filter.register(flags)
+	pageSize := flags.Int("page-size", 0, "Logs per page (server default when 0)")
+	pageToken := flags.String("page-token", "", "Token of the page to list")
+	if _, err := parseFlags(flags, args, 0, ""); err != nil {
+		return err
+	}
+	after, before, err := filter.times()
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.client.ListAuditLogs(ctx, &pb.ListAuditLogsRequest{
+		ActorId:       filter.actorID,
+		Action:        filter.action,
+		ResourceType:  filter.resourceType,
+		ResourceId:    filter.resourceID,
+		ChangedField:  filter.changedField,
+		CreatedAfter:  after,
+		CreatedBefore: before,
+		PageSize:      int32(*pageSize),
+		PageToken:     *pageToken,
+	})
+	if err != nil {
+		return err
+	}
+	return c.out.print(resp, func() table {
+		t := table{header: auditHeader}
+		for _, l := range resp.AuditLogs {
+			t.rows = append(t.rows, auditRow(l))
+		}
+		t.footer = nextPage(resp.NextPageToken, resp.TotalCount)
+		return t
+	})
+}
+
+func getAuditLog(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("audit get", flag.ContinueOnError)
+	pos, err := parseFlags(flags, args, 1, "<id>")
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.GetAuditLog(ctx, &pb.GetAuditLogRequest{Id: pos[0]})
+	if err != nil {
+		return err
+	}
+	l := resp.AuditLog
+	return c.out.print(l, func() table {
+		return fields(
+			"id", l.Id,
+			"created_at", formatTime(l.CreatedAt),
+			"actor", orDash(l.ActorEmail)+" ("+orDash(l.ActorId)+")",
+			"action", l.Action,
+			"resource", l.ResourceType+" "+l.ResourceId,
+			"old_values", formatMap(l.OldValues),
+			"new_values", formatMap(l.NewValues),
+			"ip_address", orDash(l.IpAddress),
+			"user_agent", orDash(l.UserAgent),
+		)
+	})
+}
+
+// exportAuditLogs writes the matching logs to stdout as JSON Lines, whatever
+// the output format
+func exportAuditLogs(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("audit export", flag.ContinueOnError)
+	var filter auditFilter
+	filter.register(flags)
+	if _, err := parseFlags(flags, args, 0, ""); err != nil {
+		return err
+	}
+	after, before, err := filter.times()
+	if err != nil {
+		return err
+	}
+
+	stream, err := c.client.ExportAuditLogs(ctx, &pb.ExportAuditLogsRequest{
+		ActorId:       filter.actorID,
+		Action:        filter.action,
+		ResourceType:  filter.resourceType,
+		ResourceId:    filter.resourceID,
+		ChangedField:  filter.changedField,
+		CreatedAfter:  after,
+		CreatedBefore: before,
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := c.out.w.Write(chunk.Data); err != nil {
+			return err
+		}
+	}
+}
+
+func auditRow(l *pb.AuditLog) []string {
+	return []string{
+		l.Id,
+		formatTime(l.CreatedAt),
+		orDash(l.ActorEmail),
+		l.Action,
+		l.ResourceType,
+		l.ResourceId,
+	}
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"strconv"
+	"time"
+
+	pb "github.com/YukiOnishi1129/youtube-analytics/services/pkg/pb/ingestion/v1"
+)
+
+var channelActions = map[string]action{
+	"list":        listChannels,
+	"get":         getChannel,
+	"subscribe":   subscribeChannel,
+	"unsubscribe": unsubscribeChannel,
+	"update":      updateChannels,
+}
+
+var channelHeader = []string{"ID", "YOUTUBE ID", "TITLE", "SUBSCRIBERS", "VIDEOS", "SUBSCRIBED"}
+
+func listChannels(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("channels list", flag.ContinueOnError)
+	subscribedOnly := flags.Bool("subscribed", false, "Only subscribed channels")
+	query := flags.String("query", "", "Only channels whose title contains the query")
+	pageSize := flags.Int("page-size", 0, "Channels per page (server default when 0)")
+	pageToken := flags.String("page-token", "", "Token of the page to list")
+	if _, err := parseFlags(flags, args, 0, ""); err != nil {
+		return err
+	}
+
+	resp, err := c.client.ListChannels(ctx, &pb.ListChannelsRequest{
+		SubscribedOnly: *subscribedOnly,
+		Query:          *query,
+		PageSize:       int32(*pageSize),
+		PageToken:      *pageToken,
+	})
+	if err != nil {
+		return err
+	}
+	return c.out.print(resp, func() table {
+		t := table{header: channelHeader}
+		for _, ch := range resp.Channels {
+			t.rows = append(t.rows, channelRow(ch))
+		}
+		t.footer = nextPage(resp.NextPageToken, resp.TotalCount)
+		return t
+	})
+}
+
+func getChannel(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("channels get", flag.ContinueOnError)
+	pos, err := parseFlags(flags, args, 1, "<id>")
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.GetChannel(ctx, &pb.GetChannelRequest{Id: pos[0]})
+	if err != nil {
+		return err
+	}
+	return c.printChannel(resp.Channel)
+}
+
+// subscribeChannel subscribes to a channel, registering it when it is new
+func subscribeChannel(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("channels subscribe", flag.ContinueOnError)
+	pos, err := parseFlags(flags, args, 1, "<youtube-channel-id>")
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.SubscribeChannel(c.changeContext(ctx), &pb.SubscribeChannelRequest{YoutubeChannelId: pos[0]})
+	if err != nil {
+		return err
+	}
+	return c.printChannel(resp.Channel)
+}
+
+func unsubscribeChannel(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("channels unsubscribe", flag.ContinueOnError)
+	pos, err := parseFlags(flags, args, 1, "<id>")
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.UnsubscribeChannel(c.changeContext(ctx), &pb.UnsubscribeChannelRequest{ChannelId: pos[0]})
+	if err != nil {
+		return err
+	}
+	return c.printChannel(resp.Channel)
+}
+
+// updateChannels refreshes the metadata of every active channel and takes
+// their daily snapshots
+func updateChannels(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("channels update", flag.ContinueOnError)
+	if _, err := parseFlags(flags, args, 0, ""); err != nil {
+		return err
+	}
+	resp, err := c.client.UpdateChannels(c.changeContext(ctx), &pb.UpdateChannelsRequest{})
+	if err != nil {
+		return err
+	}
+	return c.out.print(resp, func() table {
+		return fields(
+			"channels_processed", strconv.Itoa(int(resp.ChannelsProcessed)),
+			"channels_updated", strconv.Itoa(int(resp.ChannelsUpdated)),
+			"snapshots_taken", strconv.Itoa(int(resp.SnapshotsTaken)),
+			"duration", formatDuration(resp.DurationMs),
+		)
+	})
+}
+
+func (c *cli) printChannel(ch *pb.Channel) error {
+	return c.out.print(ch, func() table {
+		return table{header: channelHeader, rows: [][]string{channelRow(ch)}}
+	})
+}
+
+func channelRow(ch *pb.Channel) []string {
+	return []string{
+		ch.Id,
+		ch.YoutubeChannelId,
+		ch.Title,
+		strconv.FormatInt(ch.SubscriptionCount, 10),
+		strconv.FormatInt(ch.VideoCount, 10),
+		strconv.FormatBool(ch.Subscribed),
+	}
+}
+
+// formatDuration formats a duration in milliseconds
+func formatDuration(ms int64) string {
+	return (time.Duration(ms) * time.Millisecond).String()
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"strconv"
+
+	pb "github.com/YukiOnishi1129/youtube-analytics/services/pkg/pb/ingestion/v1"
+)
+
+var collectActions = map[string]action{
+	"trending":      collectTrending,
+	"subscriptions": collectSubscriptions,
+}
+
+// collectTrending collects trending videos for one genre, or for every enabled
+// genre when -genre is not set
+func collectTrending(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("collect trending", flag.ContinueOnError)
+	genreID := flags.String("genre", "", "Genre ID to collect for (all enabled genres when empty)")
+	if _, err := parseFlags(flags, args, 0, ""); err != nil {
+		return err
+	}
+	ctx = c.changeContext(ctx)
+
+	if *genreID != "" {
+		resp, err := c.client.CollectTrendingByGenre(ctx, &pb.CollectTrendingByGenreRequest{GenreId: *genreID})
+		if err != nil {
+			return err
+		}
+		return c.out.print(resp, func() table {
+			t := table{header: []string{"GENRE", "PROCESSED", "ADDED", "DURATION"}}
+			t.rows = append(t.rows, genreResultRow(resp))
+			return t
+		})
+	}
+
+	resp, err := c.client.CollectAllTrending(ctx, &pb.CollectAllTrendingRequest{})
+	if err != nil {
+		return err
+	}
+	return c.out.print(resp, func() table {
+		t := table{header: []string{"GENRE", "PROCESSED", "ADDED", "DURATION"}}
+		for _, r := range resp.GenreResults {
+			t.rows = append(t.rows, genreResultRow(r))
+		}
+		t.rows = append(t.rows, []string{
+			"TOTAL (" + strconv.Itoa(int(resp.GenresProcessed)) + " genres)",
+			strconv.Itoa(int(resp.TotalVideos)),
+			strconv.Itoa(int(resp.TotalAdded)),
+			formatDuration(resp.DurationMs),
+		})
+		return t
+	})
+}
+
+func collectSubscriptions(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("collect subscriptions", flag.ContinueOnError)
+	if _, err := parseFlags(flags, args, 0, ""); err != nil {
+		return err
+	}
+	resp, err := c.client.CollectSubscriptions(c.changeContext(ctx), &pb.CollectSubscriptionsRequest{})
+	if err != nil {
+		return err
+	}
+	return c.out.print(resp, func() table {
+		return fields(
+			"channels_processed", strconv.Itoa(int(resp.ChannelsProcessed)),
+			"videos_processed", strconv.Itoa(int(resp.VideosProcessed)),
+			"videos_added", strconv.Itoa(int(resp.VideosAdded)),
+			"duration", formatDuration(resp.DurationMs),
+		)
+	})
+}
+
+func genreResultRow(r *pb.CollectTrendingByGenreResponse) []string {
+	return []string{
+		orDash(r.GenreCode),
+		strconv.Itoa(int(r.VideosProcessed)),
+		strconv.Itoa(int(r.VideosAdded)),
+		formatDuration(r.DurationMs),
+	}
+}
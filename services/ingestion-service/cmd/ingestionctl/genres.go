@@ -0,0 +1,202 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"strconv"
+	"strings"
+
+	pb "github.com/YukiOnishi1129/youtube-analytics/services/pkg/pb/ingestion/v1"
+	"github.com/google/uuid"
+)
+
+var genreActions = map[string]action{
+	"list":    listGenres,
+	"get":     getGenre,
+	"create":  createGenre,
+	"update":  updateGenre,
+	"enable":  enableGenre,
+	"disable": disableGenre,
+}
+
+func listGenres(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("genres list", flag.ContinueOnError)
+	enabledOnly := flags.Bool("enabled", false, "Only enabled genres")
+	pageSize := flags.Int("page-size", 0, "Genres per page (server default when 0)")
+	pageToken := flags.String("page-token", "", "Token of the page to list")
+	if _, err := parseFlags(flags, args, 0, ""); err != nil {
+		return err
+	}
+
+	resp, err := c.client.ListGenres(ctx, &pb.ListGenresRequest{
+		EnabledOnly: *enabledOnly,
+		PageSize:    int32(*pageSize),
+		PageToken:   *pageToken,
+	})
+	if err != nil {
+		return err
+	}
+	return c.out.print(resp, func() table {
+		t := table{header: []string{"ID", "CODE", "NAME", "LANGUAGE", "REGION", "CATEGORIES", "ENABLED", "CHECKPOINT PROFILE"}}
+		for _, g := range resp.Genres {
+			t.rows = append(t.rows, genreRow(g))
+		}
+		t.footer = nextPage(resp.NextPageToken, resp.TotalCount)
+		return t
+	})
+}
+
+// getGenre gets a genre by ID or, when the argument is not a UUID, by code
+func getGenre(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("genres get", flag.ContinueOnError)
+	pos, err := parseFlags(flags, args, 1, "<id|code>")
+	if err != nil {
+		return err
+	}
+
+	var genre *pb.Genre
+	if _, perr := uuid.Parse(pos[0]); perr == nil {
+		resp, err := c.client.GetGenre(ctx, &pb.GetGenreRequest{Id: pos[0]})
+		if err != nil {
+			return err
+		}
+		genre = resp.Genre
+	} else {
+		resp, err := c.client.GetGenreByCode(ctx, &pb.GetGenreByCodeRequest{Code: pos[0]})
+		if err != nil {
+			return err
+		}
+		genre = resp.Genre
+	}
+	return c.printGenre(genre)
+}
+
+func createGenre(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("genres create", flag.ContinueOnError)
+	code := flags.String("code", "", "Genre code, e.g. engineering_jp (required)")
+	name := flags.String("name", "", "Display name (required)")
+	language := flags.String("language", "", "Language code, e.g. ja (required)")
+	region := flags.String("region", "", "Region code, e.g. JP (required)")
+	categories := flags.String("categories", "", "Comma separated YouTube category IDs, e.g. 27,28")
+	profile := flags.String("checkpoint-profile", "", "Checkpoint profile code (default profile when empty)")
+	if _, err := parseFlags(flags, args, 0, ""); err != nil {
+		return err
+	}
+	categoryIDs, err := parseInt32s(*categories)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.client.CreateGenre(c.changeContext(ctx), &pb.CreateGenreRequest{
+		Code:              *code,
+		Name:              *name,
+		Language:          *language,
+		RegionCode:        *region,
+		CategoryIds:       categoryIDs,
+		CheckpointProfile: *profile,
+	})
+	if err != nil {
+		return err
+	}
+	return c.printGenre(resp.Genre)
+}
+
+// updateGenre changes the name, categories and checkpoint profile of a genre.
+// Unset flags keep their current values.
+func updateGenre(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("genres update", flag.ContinueOnError)
+	name := flags.String("name", "", "New display name")
+	categories := flags.String("categories", "", "New comma separated YouTube category IDs")
+	profile := flags.String("checkpoint-profile", "", "New checkpoint profile code, \"default\" for the default profile")
+	pos, err := parseFlags(flags, args, 1, "<id>")
+	if err != nil {
+		return err
+	}
+
+	current, err := c.client.GetGenre(ctx, &pb.GetGenreRequest{Id: pos[0]})
+	if err != nil {
+		return err
+	}
+	req := &pb.UpdateGenreRequest{
+		Id:                pos[0],
+		Code:              current.Genre.Code,
+		Name:              current.Genre.Name,
+		Language:          current.Genre.Language,
+		RegionCode:        current.Genre.RegionCode,
+		CategoryIds:       current.Genre.CategoryIds,
+		CheckpointProfile: optionalString(*profile),
+	}
+	if *name != "" {
+		req.Name = *name
+	}
+	if *categories != "" {
+		if req.CategoryIds, err = parseInt32s(*categories); err != nil {
+			return err
+		}
+	}
+
+	resp, err := c.client.UpdateGenre(c.changeContext(ctx), req)
+	if err != nil {
+		return err
+	}
+	return c.printGenre(resp.Genre)
+}
+
+func enableGenre(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("genres enable", flag.ContinueOnError)
+	pos, err := parseFlags(flags, args, 1, "<id>")
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.EnableGenre(c.changeContext(ctx), &pb.EnableGenreRequest{Id: pos[0]})
+	if err != nil {
+		return err
+	}
+	return c.printGenre(resp.Genre)
+}
+
+func disableGenre(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("genres disable", flag.ContinueOnError)
+	pos, err := parseFlags(flags, args, 1, "<id>")
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.DisableGenre(c.changeContext(ctx), &pb.DisableGenreRequest{Id: pos[0]})
+	if err != nil {
+		return err
+	}
+	return c.printGenre(resp.Genre)
+}
+
+func (c *cli) printGenre(g *pb.Genre) error {
+	return c.out.print(g, func() table {
+		t := table{header: []string{"ID", "CODE", "NAME", "LANGUAGE", "REGION", "CATEGORIES", "ENABLED", "CHECKPOINT PROFILE"}}
+		t.rows = append(t.rows, genreRow(g))
+		return t
+	})
+}
+
+func genreRow(g *pb.Genre) []string {
+	categories := make([]string, len(g.CategoryIds))
+	for i, id := range g.CategoryIds {
+		categories[i] = strconv.Itoa(int(id))
+	}
+	return []string{
+		g.Id,
+		g.Code,
+		g.Name,
+		g.Language,
+		g.RegionCode,
+		orDash(strings.Join(categories, ",")),
+		strconv.FormatBool(g.Enabled),
+		checkpointProfile(g.CheckpointProfileId),
+	}
+}
+
+// checkpointProfile names the profile a genre follows when it has none assigned
+func checkpointProfile(id string) string {
+	if id == "" {
+		return "default"
+	}
+	return id
+}
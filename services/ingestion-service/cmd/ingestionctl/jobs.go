@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	pb "github.com/YukiOnishi1129/youtube-analytics/services/pkg/pb/ingestion/v1"
+)
+
+var jobActions = map[string]action{
+	"list":   listJobs,
+	"get":    getJob,
+	"cancel": cancelJob,
+	"retry":  retryJob,
+}
+
+var jobHeader = []string{"ID", "TYPE", "STATUS", "PROGRESS", "STARTED", "COMPLETED", "ERROR"}
+
+func listJobs(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("jobs list", flag.ContinueOnError)
+	jobType := flags.String("type", "", "Only jobs of the type, e.g. collect_trending")
+	jobStatus := flags.String("status", "", "Only jobs in the status, e.g. running")
+	pageSize := flags.Int("page-size", 0, "Jobs per page (server default when 0)")
+	pageToken := flags.String("page-token", "", "Token of the page to list")
+	if _, err := parseFlags(flags, args, 0, ""); err != nil {
+		return err
+	}
+
+	resp, err := c.client.ListBatchJobs(ctx, &pb.ListBatchJobsRequest{
+		JobType:   *jobType,
+		Status:    *jobStatus,
+		PageSize:  int32(*pageSize),
+		PageToken: *pageToken,
+	})
+	if err != nil {
+		return err
+	}
+	return c.out.print(resp, func() table {
+		t := table{header: jobHeader}
+		for _, j := range resp.BatchJobs {
+			t.rows = append(t.rows, jobRow(j))
+		}
+		t.footer = nextPage(resp.NextPageToken, resp.TotalCount)
+		return t
+	})
+}
+
+// getJob shows a job with its parameters, statistics and progress
+func getJob(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("jobs get", flag.ContinueOnError)
+	pos, err := parseFlags(flags, args, 1, "<id>")
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.GetBatchJob(ctx, &pb.GetBatchJobRequest{Id: pos[0]})
+	if err != nil {
+		return err
+	}
+	j := resp.BatchJob
+	return c.out.print(j, func() table {
+		return fields(
+			"id", j.Id,
+			"type", j.JobType,
+			"status", j.Status,
+			"progress", formatProgress(j),
+			"parameters", formatMap(j.Parameters),
+			"statistics", formatMap(j.Statistics),
+			"error", orDash(j.ErrorMessage),
+			"retry_of", orDash(j.RetryOf),
+			"created_at", formatTime(j.CreatedAt),
+			"started_at", formatTime(j.StartedAt),
+			"completed_at", formatTime(j.CompletedAt),
+			"cancel_requested_at", formatTime(j.CancelRequestedAt),
+		)
+	})
+}
+
+func cancelJob(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("jobs cancel", flag.ContinueOnError)
+	pos, err := parseFlags(flags, args, 1, "<id>")
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.CancelBatchJob(ctx, &pb.CancelBatchJobRequest{Id: pos[0]})
+	if err != nil {
+		return err
+	}
+	return c.printJob(resp.BatchJob)
+}
+
+// retryJob re-runs a finished job and prints the new job
+func retryJob(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("jobs retry", flag.ContinueOnError)
+	pos, err := parseFlags(flags, args, 1, "<id>")
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.RetryBatchJob(ctx, &pb.RetryBatchJobRequest{Id: pos[0]})
+	if err != nil {
+		return err
+	}
+	return c.printJob(resp.BatchJob)
+}
+
+func (c *cli) printJob(j *pb.BatchJob) error {
+	return c.out.print(j, func() table {
+		return table{header: jobHeader, rows: [][]string{jobRow(j)}}
+	})
+}
+
+func jobRow(j *pb.BatchJob) []string {
+	return []string{
+		j.Id,
+		j.JobType,
+		j.Status,
+		formatProgress(j),
+		formatTime(j.StartedAt),
+		formatTime(j.CompletedAt),
+		orDash(j.ErrorMessage),
+	}
+}
+
+// formatProgress formats the progress of a job as processed/total (current)
+func formatProgress(j *pb.BatchJob) string {
+	if j.ProgressTotal == 0 && j.ProgressProcessed == 0 {
+		return "-"
+	}
+	s := fmt.Sprintf("%d/%d", j.ProgressProcessed, j.ProgressTotal)
+	if j.ProgressCurrent != "" {
+		s += " (" + j.ProgressCurrent + ")"
+	}
+	return s
+}
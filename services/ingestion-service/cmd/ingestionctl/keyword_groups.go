@@ -0,0 +1,330 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	pb "github.com/YukiOnishi1129/youtube-analytics/services/pkg/pb/ingestion/v1"
+)
+
+var keywordGroupActions = map[string]action{
+	"list":     listKeywordGroups,
+	"get":      getKeywordGroup,
+	"create":   createKeywordGroup,
+	"update":   updateKeywordGroup,
+	"keywords": setKeywordGroupKeywords,
+	"add":      addKeywordGroupItem,
+	"remove":   removeKeywordGroupItem,
+	"enable":   enableKeywordGroup,
+	"disable":  disableKeywordGroup,
+	"delete":   deleteKeywordGroup,
+	"pattern":  getKeywordGroupPattern,
+	"test":     testKeywordGroup,
+}
+
+var keywordGroupHeader = []string{"ID", "GENRE", "NAME", "TYPE", "FIELD", "ENABLED", "CROSS_LANG", "ROMAJI", "KEYWORDS"}
+
+func listKeywordGroups(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("keyword-groups list", flag.ContinueOnError)
+	genreID := flags.String("genre", "", "Genre ID (required)")
+	enabledOnly := flags.Bool("enabled", false, "Only enabled keyword groups")
+	pageSize := flags.Int("page-size", 0, "Keyword groups per page (server default when 0)")
+	pageToken := flags.String("page-token", "", "Token of the page to list")
+	if _, err := parseFlags(flags, args, 0, ""); err != nil {
+		return err
+	}
+
+	resp, err := c.client.ListKeywordGroups(ctx, &pb.ListKeywordGroupsRequest{
+		GenreId:     *genreID,
+		EnabledOnly: *enabledOnly,
+		PageSize:    int32(*pageSize),
+		PageToken:   *pageToken,
+	})
+	if err != nil {
+		return err
+	}
+	return c.out.print(resp, func() table {
+		t := table{header: keywordGroupHeader}
+		for _, g := range resp.KeywordGroups {
+			t.rows = append(t.rows, keywordGroupRow(g))
+		}
+		t.footer = nextPage(resp.NextPageToken, resp.TotalCount)
+		return t
+	})
+}
+
+func getKeywordGroup(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("keyword-groups get", flag.ContinueOnError)
+	pos, err := parseFlags(flags, args, 1, "<id>")
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.GetKeywordGroup(ctx, &pb.GetKeywordGroupRequest{Id: pos[0]})
+	if err != nil {
+		return err
+	}
+	return c.printKeywordGroup(resp.KeywordGroup)
+}
+
+func createKeywordGroup(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("keyword-groups create", flag.ContinueOnError)
+	genreID := flags.String("genre", "", "Genre ID (required)")
+	name := flags.String("name", "", "Keyword group name (required)")
+	filterType := flags.String("type", "include", "Filter type: include or exclude")
+	keywords := flags.String("keywords", "", "Comma separated keywords (required)")
+	targetField := flags.String("field", "title", "Field the keywords are matched against")
+	description := flags.String("description", "", "Description")
+	crossLanguage := flags.Bool("cross-language", false, "Apply cross-language synonyms, such as パイソン for Python")
+	romaji := flags.Bool("romaji", false, "Also match romaji spellings of kana keywords, such as ramen for ラーメン")
+	if _, err := parseFlags(flags, args, 0, ""); err != nil {
+		return err
+	}
+
+	resp, err := c.client.CreateKeywordGroup(c.changeContext(ctx), &pb.CreateKeywordGroupRequest{
+		GenreId:       *genreID,
+		Name:          *name,
+		FilterType:    *filterType,
+		TargetField:   *targetField,
+		Description:   *description,
+		Keywords:      parseList(*keywords),
+		CrossLanguage: *crossLanguage,
+		Romaji:        *romaji,
+	})
+	if err != nil {
+		return err
+	}
+	return c.printKeywordGroup(resp.KeywordGroup)
+}
+
+// updateKeywordGroup changes a keyword group. Unset flags keep their current values.
+func updateKeywordGroup(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("keyword-groups update", flag.ContinueOnError)
+	name := flags.String("name", "", "New keyword group name")
+	filterType := flags.String("type", "", "New filter type: include or exclude")
+	targetField := flags.String("field", "", "New target field")
+	description := flags.String("description", "", "New description")
+	crossLanguage := flags.Bool("cross-language", false, "Apply cross-language synonyms")
+	romaji := flags.Bool("romaji", false, "Also match romaji spellings of kana keywords")
+	pos, err := parseFlags(flags, args, 1, "<id>")
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.client.UpdateKeywordGroup(c.changeContext(ctx), &pb.UpdateKeywordGroupRequest{
+		Id:            pos[0],
+		Name:          optionalString(*name),
+		FilterType:    optionalString(*filterType),
+		TargetField:   optionalString(*targetField),
+		Description:   optionalString(*description),
+		CrossLanguage: optionalBool(flags, "cross-language", *crossLanguage),
+		Romaji:        optionalBool(flags, "romaji", *romaji),
+	})
+	if err != nil {
+		return err
+	}
+	return c.printKeywordGroup(resp.KeywordGroup)
+}
+
+// setKeywordGroupKeywords replaces all keywords of a keyword group
+func setKeywordGroupKeywords(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("keyword-groups keywords", flag.ContinueOnError)
+	pos, err := parseFlags(flags, args, 2, "<id> <keyword,...>")
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.UpdateKeywordGroupKeywords(c.changeContext(ctx), &pb.UpdateKeywordGroupKeywordsRequest{
+		Id:       pos[0],
+		Keywords: parseList(pos[1]),
+	})
+	if err != nil {
+		return err
+	}
+	return c.printKeywordGroup(resp.KeywordGroup)
+}
+
+func addKeywordGroupItem(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("keyword-groups add", flag.ContinueOnError)
+	pos, err := parseFlags(flags, args, 2, "<id> <keyword>")
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.AddKeywordGroupItem(c.changeContext(ctx), &pb.AddKeywordGroupItemRequest{Id: pos[0], Keyword: pos[1]})
+	if err != nil {
+		return err
+	}
+	return c.printKeywordGroup(resp.KeywordGroup)
+}
+
+func removeKeywordGroupItem(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("keyword-groups remove", flag.ContinueOnError)
+	pos, err := parseFlags(flags, args, 2, "<id> <keyword>")
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.RemoveKeywordGroupItem(c.changeContext(ctx), &pb.RemoveKeywordGroupItemRequest{Id: pos[0], Keyword: pos[1]})
+	if err != nil {
+		return err
+	}
+	return c.printKeywordGroup(resp.KeywordGroup)
+}
+
+func enableKeywordGroup(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("keyword-groups enable", flag.ContinueOnError)
+	pos, err := parseFlags(flags, args, 1, "<id>")
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.EnableKeywordGroup(c.changeContext(ctx), &pb.EnableKeywordGroupRequest{Id: pos[0]})
+	if err != nil {
+		return err
+	}
+	return c.printKeywordGroup(resp.KeywordGroup)
+}
+
+func disableKeywordGroup(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("keyword-groups disable", flag.ContinueOnError)
+	pos, err := parseFlags(flags, args, 1, "<id>")
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.DisableKeywordGroup(c.changeContext(ctx), &pb.DisableKeywordGroupRequest{Id: pos[0]})
+	if err != nil {
+		return err
+	}
+	return c.printKeywordGroup(resp.KeywordGroup)
+}
+
+func deleteKeywordGroup(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("keyword-groups delete", flag.ContinueOnError)
+	pos, err := parseFlags(flags, args, 1, "<id>")
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.DeleteKeywordGroup(c.changeContext(ctx), &pb.DeleteKeywordGroupRequest{Id: pos[0]})
+	if err != nil {
+		return err
+	}
+	return c.out.print(resp, func() table {
+		return fields("deleted", pos[0], "dry_run", strconv.FormatBool(c.dryRun))
+	})
+}
+
+func getKeywordGroupPattern(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("keyword-groups pattern", flag.ContinueOnError)
+	pos, err := parseFlags(flags, args, 1, "<id>")
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.GetKeywordGroupPattern(ctx, &pb.GetKeywordGroupPatternRequest{Id: pos[0]})
+	if err != nil {
+		return err
+	}
+	return c.out.print(resp, func() table {
+		return fields("id", pos[0], "pattern", resp.Pattern)
+	})
+}
+
+// testKeywordGroup shows which recent videos a new keyword group, or edits of
+// an existing one, would include or stop including. Nothing is saved.
+func testKeywordGroup(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("keyword-groups test", flag.ContinueOnError)
+	genreID := flags.String("genre", "", "Genre ID of a new group")
+	groupID := flags.String("id", "", "Keyword group the edits apply to")
+	keywords := flags.String("keywords", "", "Comma separated proposed keywords (default: those of the group)")
+	filterType := flags.String("type", "", "Proposed filter type: include or exclude")
+	crossLanguage := flags.Bool("cross-language", false, "Proposed cross-language synonyms setting (default: that of the group)")
+	romaji := flags.Bool("romaji", false, "Proposed romaji setting (default: that of the group)")
+	enabled := flags.Bool("enabled", true, "Proposed enabled state (default: that of the group)")
+	days := flags.Int("days", 30, "Test against videos published in the last days")
+	if _, err := parseFlags(flags, args, 0, ""); err != nil {
+		return err
+	}
+
+	resp, err := c.client.TestKeywordGroup(ctx, &pb.TestKeywordGroupRequest{
+		GenreId:       *genreID,
+		Id:            *groupID,
+		Keywords:      parseList(*keywords),
+		FilterType:    optionalString(*filterType),
+		CrossLanguage: optionalBool(flags, "cross-language", *crossLanguage),
+		Romaji:        optionalBool(flags, "romaji", *romaji),
+		Enabled:       optionalBool(flags, "enabled", *enabled),
+		Days:          int32(*days),
+	})
+	if err != nil {
+		return err
+	}
+	return c.out.print(resp, func() table {
+		t := table{header: []string{"CHANGE", "VIDEO", "BEFORE", "AFTER", "GROUP", "TITLE"}}
+		for _, m := range resp.NewlyIncluded {
+			t.rows = append(t.rows, []string{"+", m.VideoId, m.Before, m.After, m.KeywordGroup, m.HighlightedTitle})
+		}
+		for _, m := range resp.NewlyExcluded {
+			t.rows = append(t.rows, []string{"-", m.VideoId, m.Before, m.After, m.KeywordGroup, m.HighlightedTitle})
+		}
+		t.footer = fmt.Sprintf("%d videos evaluated, %d newly included, %d newly excluded", resp.VideosEvaluated, len(resp.NewlyIncluded), len(resp.NewlyExcluded))
+		if resp.Truncated {
+			t.footer += " (newest videos only)"
+		}
+		if !resp.Enabled {
+			t.footer += "; the group is disabled"
+		}
+		return t
+	})
+}
+
+func (c *cli) printKeywordGroup(g *pb.KeywordGroup) error {
+	return c.out.print(g, func() table {
+		return table{header: keywordGroupHeader, rows: [][]string{keywordGroupRow(g)}}
+	})
+}
+
+func keywordGroupRow(g *pb.KeywordGroup) []string {
+	return []string{
+		g.Id,
+		g.GenreId,
+		g.Name,
+		g.FilterType,
+		g.TargetField,
+		strconv.FormatBool(g.Enabled),
+		strconv.FormatBool(g.CrossLanguage),
+		strconv.FormatBool(g.Romaji),
+		strings.Join(g.Keywords, ", "),
+	}
+}
+
+// parseList parses a comma separated list such as keywords, dropping empty entries
+func parseList(s string) []string {
+	var values []string
+	for _, part := range strings.Split(s, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			values = append(values, part)
+		}
+	}
+	return values
+}
+
+// optionalString returns nil for an empty string, so the field is left unchanged
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// optionalBool returns the value of a bool flag only when it was given, so an
+// unset flag leaves the field unchanged
+func optionalBool(flags *flag.FlagSet, name string, value bool) *bool {
+	set := false
+	flags.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	if !set {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"strconv"
+
+	pb "github.com/YukiOnishi1129/youtube-analytics/services/pkg/pb/ingestion/v1"
+)
+
+var keywordActions = map[string]action{
+	"list":    listKeywords,
+	"get":     getKeyword,
+	"create":  createKeyword,
+	"update":  updateKeyword,
+	"enable":  enableKeyword,
+	"disable": disableKeyword,
+	"delete":  deleteKeyword,
+}
+
+var keywordHeader = []string{"ID", "GENRE", "NAME", "TYPE", "PATTERN", "FIELD", "ENABLED"}
+
+func listKeywords(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("keywords list", flag.ContinueOnError)
+	genreID := flags.String("genre", "", "Only keywords of the genre ID")
+	query := flags.String("query", "", "Only keywords whose name contains the query (ignored with -genre)")
+	enabledOnly := flags.Bool("enabled", false, "Only enabled keywords")
+	pageSize := flags.Int("page-size", 0, "Keywords per page (server default when 0)")
+	pageToken := flags.String("page-token", "", "Token of the page to list")
+	if _, err := parseFlags(flags, args, 0, ""); err != nil {
+		return err
+	}
+
+	var resp *pb.ListKeywordsResponse
+	if *genreID != "" {
+		r, err := c.client.ListKeywordsByGenre(ctx, &pb.ListKeywordsByGenreRequest{
+			GenreId:     *genreID,
+			EnabledOnly: *enabledOnly,
+			PageSize:    int32(*pageSize),
+			PageToken:   *pageToken,
+		})
+		if err != nil {
+			return err
+		}
+		// Both lists print the same way
+		resp = &pb.ListKeywordsResponse{Keywords: r.Keywords, NextPageToken: r.NextPageToken, TotalCount: r.TotalCount}
+	} else {
+		r, err := c.client.ListKeywords(ctx, &pb.ListKeywordsRequest{
+			Query:       *query,
+			EnabledOnly: *enabledOnly,
+			PageSize:    int32(*pageSize),
+			PageToken:   *pageToken,
+		})
+		if err != nil {
+			return err
+		}
+		resp = r
+	}
+
+	return c.out.print(resp, func() table {
+		t := table{header: keywordHeader}
+		for _, k := range resp.Keywords {
+			t.rows = append(t.rows, keywordRow(k))
+		}
+		t.footer = nextPage(resp.NextPageToken, resp.TotalCount)
+		return t
+	})
+}
+
+func getKeyword(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("keywords get", flag.ContinueOnError)
+	pos, err := parseFlags(flags, args, 1, "<id>")
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.GetKeyword(ctx, &pb.GetKeywordRequest{Id: pos[0]})
+	if err != nil {
+		return err
+	}
+	return c.printKeyword(resp.Keyword)
+}
+
+func createKeyword(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("keywords create", flag.ContinueOnError)
+	genreID := flags.String("genre", "", "Genre ID (required)")
+	name := flags.String("name", "", "Keyword name (required)")
+	filterType := flags.String("type", "include", "Filter type: include or exclude")
+	pattern := flags.String("pattern", "", "Regular expression matched against the target field (required)")
+	targetField := flags.String("field", "title", "Field the pattern is matched against")
+	description := flags.String("description", "", "Description")
+	if _, err := parseFlags(flags, args, 0, ""); err != nil {
+		return err
+	}
+
+	resp, err := c.client.CreateKeyword(c.changeContext(ctx), &pb.CreateKeywordRequest{
+		GenreId:     *genreID,
+		Name:        *name,
+		FilterType:  *filterType,
+		Pattern:     *pattern,
+		TargetField: *targetField,
+		Description: *description,
+	})
+	if err != nil {
+		return err
+	}
+	return c.printKeyword(resp.Keyword)
+}
+
+// updateKeyword changes a keyword. Unset flags keep their current values.
+func updateKeyword(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("keywords update", flag.ContinueOnError)
+	name := flags.String("name", "", "New keyword name")
+	filterType := flags.String("type", "", "New filter type: include or exclude")
+	pattern := flags.String("pattern", "", "New regular expression")
+	targetField := flags.String("field", "", "New target field")
+	description := flags.String("description", "", "New description")
+	pos, err := parseFlags(flags, args, 1, "<id>")
+	if err != nil {
+		return err
+	}
+
+	current, err := c.client.GetKeyword(ctx, &pb.GetKeywordRequest{Id: pos[0]})
+	if err != nil {
+		return err
+	}
+	k := current.Keyword
+	req := &pb.UpdateKeywordRequest{
+		Id:          k.Id,
+		Name:        firstNonEmpty(*name, k.Name),
+		FilterType:  firstNonEmpty(*filterType, k.FilterType),
+		Pattern:     firstNonEmpty(*pattern, k.Pattern),
+		TargetField: firstNonEmpty(*targetField, k.TargetField),
+		Description: firstNonEmpty(*description, k.Description),
+		Enabled:     k.Enabled,
+	}
+
+	resp, err := c.client.UpdateKeyword(c.changeContext(ctx), req)
+	if err != nil {
+		return err
+	}
+	return c.printKeyword(resp.Keyword)
+}
+
+func enableKeyword(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("keywords enable", flag.ContinueOnError)
+	pos, err := parseFlags(flags, args, 1, "<id>")
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.EnableKeyword(c.changeContext(ctx), &pb.EnableKeywordRequest{Id: pos[0]})
+	if err != nil {
+		return err
+	}
+	return c.printKeyword(resp.Keyword)
+}
+
+func disableKeyword(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("keywords disable", flag.ContinueOnError)
+	pos, err := parseFlags(flags, args, 1, "<id>")
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.DisableKeyword(c.changeContext(ctx), &pb.DisableKeywordRequest{Id: pos[0]})
+	if err != nil {
+		return err
+	}
+	return c.printKeyword(resp.Keyword)
+}
+
+func deleteKeyword(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("keywords delete", flag.ContinueOnError)
+	pos, err := parseFlags(flags, args, 1, "<id>")
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.DeleteKeyword(c.changeContext(ctx), &pb.DeleteKeywordRequest{Id: pos[0]})
+	if err != nil {
+		return err
+	}
+	return c.out.print(resp, func() table {
+		return fields("deleted", pos[0], "dry_run", strconv.FormatBool(c.dryRun))
+	})
+}
+
+func (c *cli) printKeyword(k *pb.Keyword) error {
+	return c.out.print(k, func() table {
+		return table{header: keywordHeader, rows: [][]string{keywordRow(k)}}
+	})
+}
+
+func keywordRow(k *pb.Keyword) []string {
+	return []string{
+		k.Id,
+		k.GenreId,
+		k.Name,
+		k.FilterType,
+		k.Pattern,
+		k.TargetField,
+		strconv.FormatBool(k.Enabled),
+	}
+}
+
+// firstNonEmpty returns s, or def when s is empty
+func firstNonEmpty(s, def string) string {
+	if s != "" {
+		return s
+	}
+	return def
+}
@@ -0,0 +1,235 @@
+// Command ingestionctl administers the ingestion service over gRPC.
+//
+// Usage:
+//
+//	ingestionctl [global flags] <resource> <action> [flags] [args]
+//
+// See README.md for the resources and their actions.
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	pb "github.com/YukiOnishi1129/youtube-analytics/services/pkg/pb/ingestion/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	ingestiongrpc "github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/driver/grpc"
+)
+
+// action runs one action of a resource with the arguments that follow it
+type action func(ctx context.Context, c *cli, args []string) error
+
+// resources maps each resource to its actions
+var resources = map[string]map[string]action{
+	"genres":         genreActions,
+	"keyword-groups": keywordGroupActions,
+	"synonyms":       synonymActions,
+	"keywords":       keywordActions, // Deprecated in favor of keyword-groups
+	"channels":       channelActions,
+	"collect":        collectActions,
+	"snapshots":      snapshotActions,
+	"jobs":           jobActions,
+	"audit":          auditActions,
+}
+
+// cli is the state shared by all actions
+type cli struct {
+	client pb.IngestionServiceClient
+	out    *printer
+	token  string
+	dryRun bool
+}
+
+func main() {
+	if err := run(); err != nil {
+		if s, ok := status.FromError(err); ok {
+			fmt.Fprintf(os.Stderr, "Error: %s: %s\n", s.Code(), s.Message())
+		} else {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		}
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	flags := flag.NewFlagSet("ingestionctl", flag.ContinueOnError)
+	var (
+		addr    = flags.String("addr", envOr("INGESTION_GRPC_ADDR", "localhost:50051"), "Address of the ingestion gRPC server")
+		token   = flags.String("token", os.Getenv("INGESTION_TOKEN"), "Bearer token sent with every call")
+		useTLS  = flags.Bool("tls", false, "Connect with TLS")
+		output  = flags.String("o", "table", "Output format: table, json or yaml")
+		dryRun  = flags.Bool("dry-run", false, "Validate and report changes without applying them")
+		timeout = flags.Duration("timeout", 30*time.Second, "Timeout of the command")
+	)
+	flags.Usage = func() { usage(flags) }
+	if err := flags.Parse(os.Args[1:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+
+	args := flags.Args()
+	if len(args) < 2 {
+		usage(flags)
+		return errors.New("resource and action are required")
+	}
+	actions, ok := resources[args[0]]
+	if !ok {
+		usage(flags)
+		return fmt.Errorf("unknown resource %q", args[0])
+	}
+	act, ok := actions[args[1]]
+	if !ok {
+		return fmt.Errorf("unknown action %q for %s; available: %s", args[1], args[0], actionNames(actions))
+	}
+
+	out, err := newPrinter(os.Stdout, *output)
+	if err != nil {
+		return err
+	}
+
+	creds := insecure.NewCredentials()
+	if *useTLS {
+		creds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	}
+	conn, err := grpc.NewClient(*addr,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithUnaryInterceptor(printPlannedChanges(os.Stderr)),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", *addr, err)
+	}
+	defer conn.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	ctx, cancel := context.WithTimeout(ctx, *timeout)
+	defer cancel()
+
+	c := &cli{
+		client: pb.NewIngestionServiceClient(conn),
+		out:    out,
+		token:  *token,
+		dryRun: *dryRun,
+	}
+	return act(c.callContext(ctx), c, args[2:])
+}
+
+// callContext returns the context of calls, carrying the bearer token
+func (c *cli) callContext(ctx context.Context) context.Context {
+	if c.token == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+c.token)
+}
+
+// changeContext returns the context of calls that change data, which are only
+// validated and reported by the server when -dry-run is set
+func (c *cli) changeContext(ctx context.Context) context.Context {
+	if !c.dryRun {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, ingestiongrpc.DryRunHeader, "true")
+}
+
+// printPlannedChanges prints the summary of the changes a dry run planned,
+// returned by the server in the x-dry-run-changes header, to w. Results keep
+// going to stdout so they can still be piped.
+func printPlannedChanges(w io.Writer) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		var header metadata.MD
+		err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&header))...)
+		for _, line := range header.Get(ingestiongrpc.DryRunChangesHeader) {
+			fmt.Fprintf(w, "dry run would %s\n", line)
+		}
+		return err
+	}
+}
+
+// usage prints the global usage with the available resources and actions
+func usage(flags *flag.FlagSet) {
+	w := flags.Output()
+	fmt.Fprintln(w, "Usage: ingestionctl [global flags] <resource> <action> [flags] [args]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Resources:")
+	names := make([]string, 0, len(resources))
+	for name := range resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(w, "  %-15s %s\n", name, actionNames(resources[name]))
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Global flags:")
+	flags.PrintDefaults()
+}
+
+// actionNames returns the sorted names of the actions, comma separated
+func actionNames(actions map[string]action) string {
+	names := make([]string, 0, len(actions))
+	for name := range actions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// parseFlags parses the flags of an action and returns its positional arguments,
+// of which exactly nargs are required
+func parseFlags(flags *flag.FlagSet, args []string, nargs int, argNames string) ([]string, error) {
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: ingestionctl %s [flags] %s\n", flags.Name(), argNames)
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+	if flags.NArg() != nargs {
+		flags.Usage()
+		return nil, fmt.Errorf("%s expects %d argument(s), got %d", flags.Name(), nargs, flags.NArg())
+	}
+	return flags.Args(), nil
+}
+
+// parseInt32s parses a comma separated list of integers such as category IDs
+func parseInt32s(s string) ([]int32, error) {
+	if s == "" {
+		return nil, nil
+	}
+	parts := strings.Split(s, ",")
+	values := make([]int32, len(parts))
+	for i, part := range parts {
+		v, err := strconv.ParseInt(strings.TrimSpace(part), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid integer %q", part)
+		}
+		values[i] = int32(v)
+	}
+	return values, nil
+}
+
+// envOr returns the environment variable, or def when it is unset
+func envOr(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"strconv"
+
+	pb "github.com/YukiOnishi1129/youtube-analytics/services/pkg/pb/ingestion/v1"
+)
+
+var snapshotActions = map[string]action{
+	"list":     listSnapshots,
+	"schedule": scheduleSnapshots,
+}
+
+func listSnapshots(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("snapshots list", flag.ContinueOnError)
+	videoID := flags.String("video", "", "Only snapshots of the video ID")
+	genreID := flags.String("genre", "", "Only snapshots of videos in the genre ID")
+	checkpoint := flags.Int("checkpoint", -1, "Only snapshots at the checkpoint hour")
+	pageSize := flags.Int("page-size", 0, "Snapshots per page (server default when 0)")
+	pageToken := flags.String("page-token", "", "Token of the page to list")
+	if _, err := parseFlags(flags, args, 0, ""); err != nil {
+		return err
+	}
+
+	req := &pb.ListSnapshotsRequest{
+		VideoId:   *videoID,
+		GenreId:   *genreID,
+		PageSize:  int32(*pageSize),
+		PageToken: *pageToken,
+	}
+	if *checkpoint >= 0 {
+		hour := int32(*checkpoint)
+		req.CheckpointHour = &hour
+	}
+	resp, err := c.client.ListSnapshots(ctx, req)
+	if err != nil {
+		return err
+	}
+	return c.out.print(resp, func() table {
+		t := table{header: []string{"ID", "VIDEO", "CHECKPOINT", "MEASURED AT", "VIEWS", "LIKES", "SOURCE"}}
+		for _, s := range resp.Snapshots {
+			t.rows = append(t.rows, []string{
+				s.Id,
+				s.VideoId,
+				strconv.Itoa(int(s.CheckpointHour)) + "h",
+				formatTime(s.MeasuredAt),
+				strconv.FormatInt(s.ViewsCount, 10),
+				strconv.FormatInt(s.LikesCount, 10),
+				s.Source,
+			})
+		}
+		if resp.NextPageToken != "" {
+			t.footer = "next page: -page-token " + resp.NextPageToken
+		}
+		return t
+	})
+}
+
+// scheduleSnapshots schedules snapshot tasks for the checkpoints of recent videos
+func scheduleSnapshots(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("snapshots schedule", flag.ContinueOnError)
+	hours := flags.Int("hours", 0, "Schedule videos published within the last N hours (longest active checkpoint profile when 0)")
+	if _, err := parseFlags(flags, args, 0, ""); err != nil {
+		return err
+	}
+	resp, err := c.client.ScheduleSnapshots(c.changeContext(ctx), &pb.ScheduleSnapshotsRequest{Hours: int32(*hours)})
+	if err != nil {
+		return err
+	}
+	return c.out.print(resp, func() table {
+		return fields(
+			"videos_processed", strconv.Itoa(int(resp.VideosProcessed)),
+			"tasks_scheduled", strconv.Itoa(int(resp.TasksScheduled)),
+			"published_since", formatTime(resp.PublishedSince),
+			"duration", formatDuration(resp.DurationMs),
+		)
+	})
+}
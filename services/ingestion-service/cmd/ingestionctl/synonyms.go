@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"strconv"
+
+	pb "github.com/YukiOnishi1129/youtube-analytics/services/pkg/pb/ingestion/v1"
+)
+
+var synonymActions = map[string]action{
+	"list":   listSynonyms,
+	"get":    getSynonym,
+	"create": createSynonym,
+	"update": updateSynonym,
+	"delete": deleteSynonym,
+}
+
+var synonymHeader = []string{"ID", "LANGUAGE", "TERM", "ALIAS", "ALIAS_LANGUAGE", "BIDIRECTIONAL"}
+
+func listSynonyms(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("synonyms list", flag.ContinueOnError)
+	language := flags.String("language", "", "Only entries of the language: en or ja")
+	pageSize := flags.Int("page-size", 0, "Entries per page (server default when 0)")
+	pageToken := flags.String("page-token", "", "Token of the page to list")
+	if _, err := parseFlags(flags, args, 0, ""); err != nil {
+		return err
+	}
+
+	resp, err := c.client.ListKeywordSynonyms(ctx, &pb.ListKeywordSynonymsRequest{
+		Language:  *language,
+		PageSize:  int32(*pageSize),
+		PageToken: *pageToken,
+	})
+	if err != nil {
+		return err
+	}
+	return c.out.print(resp, func() table {
+		t := table{header: synonymHeader}
+		for _, s := range resp.KeywordSynonyms {
+			t.rows = append(t.rows, synonymRow(s))
+		}
+		t.footer = nextPage(resp.NextPageToken, resp.TotalCount)
+		return t
+	})
+}
+
+func getSynonym(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("synonyms get", flag.ContinueOnError)
+	pos, err := parseFlags(flags, args, 1, "<id>")
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.GetKeywordSynonym(ctx, &pb.GetKeywordSynonymRequest{Id: pos[0]})
+	if err != nil {
+		return err
+	}
+	return c.printSynonym(resp.KeywordSynonym)
+}
+
+func createSynonym(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("synonyms create", flag.ContinueOnError)
+	language := flags.String("language", "", "Language of the term: en or ja (required)")
+	aliasLanguage := flags.String("alias-language", "", "Language of the alias (default: that of the term)")
+	oneWay := flags.Bool("one-way", false, "Only map the term to the alias, not the alias back to the term")
+	pos, err := parseFlags(flags, args, 2, "<term> <alias>")
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.client.CreateKeywordSynonym(c.changeContext(ctx), &pb.CreateKeywordSynonymRequest{
+		Language:      *language,
+		Term:          pos[0],
+		Alias:         pos[1],
+		AliasLanguage: *aliasLanguage,
+		Bidirectional: !*oneWay,
+	})
+	if err != nil {
+		return err
+	}
+	return c.printSynonym(resp.KeywordSynonym)
+}
+
+// updateSynonym changes a synonym entry. Unset flags keep their current values.
+func updateSynonym(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("synonyms update", flag.ContinueOnError)
+	term := flags.String("term", "", "New term")
+	alias := flags.String("alias", "", "New alias")
+	aliasLanguage := flags.String("alias-language", "", "New alias language: en or ja")
+	bidirectional := flags.Bool("bidirectional", true, "Also map the alias back to the term")
+	pos, err := parseFlags(flags, args, 1, "<id>")
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.client.UpdateKeywordSynonym(c.changeContext(ctx), &pb.UpdateKeywordSynonymRequest{
+		Id:            pos[0],
+		Term:          optionalString(*term),
+		Alias:         optionalString(*alias),
+		AliasLanguage: optionalString(*aliasLanguage),
+		Bidirectional: optionalBool(flags, "bidirectional", *bidirectional),
+	})
+	if err != nil {
+		return err
+	}
+	return c.printSynonym(resp.KeywordSynonym)
+}
+
+func deleteSynonym(ctx context.Context, c *cli, args []string) error {
+	flags := flag.NewFlagSet("synonyms delete", flag.ContinueOnError)
+	pos, err := parseFlags(flags, args, 1, "<id>")
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.DeleteKeywordSynonym(c.changeContext(ctx), &pb.DeleteKeywordSynonymRequest{Id: pos[0]})
+	if err != nil {
+		return err
+	}
+	return c.out.print(resp, func() table {
+		return fields("deleted", pos[0], "dry_run", strconv.FormatBool(c.dryRun))
+	})
+}
+
+func (c *cli) printSynonym(s *pb.KeywordSynonym) error {
+	return c.out.print(s, func() table {
+		return table{header: synonymHeader, rows: [][]string{synonymRow(s)}}
+	})
+}
+
+func synonymRow(s *pb.KeywordSynonym) []string {
+	return []string{
+		s.Id,
+		s.Language,
+		s.Term,
+		s.Alias,
+		s.AliasLanguage,
+		strconv.FormatBool(s.Bidirectional),
+	}
+}
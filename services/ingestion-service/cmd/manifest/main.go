@@ -0,0 +1,212 @@
+// Command manifest reconciles genres and their keyword groups with a
+// declarative YAML or JSON manifest kept in git.
+//
+// Usage:
+//
+//	manifest export [-o yaml|json]
+//	manifest plan -f manifests/genres.yaml
+//	manifest apply -f manifests/genres.yaml
+//
+// See README.md for the manifest format.
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/postgres"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/driver/config"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/driver/datastore"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/input"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/usecase"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+	command, args := os.Args[1], os.Args[2:]
+
+	flags := flag.NewFlagSet("manifest "+command, flag.ExitOnError)
+	var (
+		file   = flags.String("f", "", "Manifest file (.yaml, .yml or .json)")
+		output = flags.String("o", "yaml", "Export format: yaml or json")
+		actor  = flags.String("actor", envOr("USER", domain.SystemActorID), "Actor changes are attributed to in the audit log")
+	)
+	switch command {
+	case "export", "plan", "diff", "apply":
+		if err := flags.Parse(args); err != nil {
+			log.Fatal(err)
+		}
+	case "-h", "-help", "--help", "help":
+		usage()
+		return
+	default:
+		usage()
+		os.Exit(2)
+	}
+
+	// Setup signal handling
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sigCh
+		log.Println("Shutting down...")
+		cancel()
+	}()
+
+	ctx = domain.WithActor(ctx, domain.Actor{ID: *actor, UserAgent: "ingestion-manifest"})
+
+	cfg := config.Load()
+	db, err := datastore.OpenPostgres(cfg.DatabaseURL)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	// Changes go through the same use cases as the API, so they are
+	// validated and audited
+	pgRepo := postgres.NewRepository(db)
+	auditLogUseCase := usecase.NewAuditLogUseCase(postgres.NewAuditLogRepository(pgRepo))
+	profileRepo := postgres.NewCheckpointProfileRepository(pgRepo)
+	txManager := postgres.NewTransactionManager(db)
+	manifestUseCase := usecase.NewGenreManifestUseCase(
+		usecase.NewAuditedGenreUseCase(
+			usecase.NewGenreUseCase(postgres.NewGenreRepository(pgRepo), profileRepo),
+			auditLogUseCase,
+			txManager,
+		),
+		usecase.NewAuditedKeywordGroupUseCase(
+			usecase.NewKeywordGroupManagementUseCase(postgres.NewKeywordGroupRepository(pgRepo), postgres.NewKeywordSynonymRepository(pgRepo)),
+			auditLogUseCase,
+			txManager,
+		),
+		profileRepo,
+	)
+
+	switch command {
+	case "export":
+		err = export(ctx, manifestUseCase, *output, os.Stdout)
+	case "plan", "diff":
+		err = apply(domain.WithDryRun(ctx), manifestUseCase, *file, os.Stdout)
+	case "apply":
+		err = apply(ctx, manifestUseCase, *file, os.Stdout)
+	}
+	if err != nil {
+		log.Fatalf("Failed to %s manifest: %v", command, err)
+	}
+}
+
+// export writes the manifest of the current genres to w
+func export(ctx context.Context, uc input.GenreManifestInputPort, format string, w io.Writer) error {
+	manifest, err := uc.ExportManifest(ctx)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case "yaml":
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+		defer enc.Close()
+		return enc.Encode(manifest)
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(manifest)
+	default:
+		return fmt.Errorf("unknown output format %q; use yaml or json", format)
+	}
+}
+
+// apply reconciles the database with the manifest file and prints the changes.
+// With a dry run context it only prints the plan.
+func apply(ctx context.Context, uc input.GenreManifestInputPort, file string, w io.Writer) error {
+	manifest, err := readManifest(file)
+	if err != nil {
+		return err
+	}
+
+	verb := "applied"
+	if domain.IsDryRun(ctx) {
+		verb = "planned"
+	}
+
+	result, err := uc.ApplyManifest(ctx, manifest)
+	if result != nil {
+		for _, change := range result.Changes {
+			fmt.Fprintf(w, "%s %s\n", verb, change)
+		}
+		for _, code := range result.UnmanagedCodes {
+			fmt.Fprintf(w, "unmanaged genre %s (not in the manifest, left untouched)\n", code)
+		}
+		if err == nil {
+			fmt.Fprintf(w, "%d changes %s\n", len(result.Changes), verb)
+		}
+	}
+	return err
+}
+
+// readManifest reads a YAML or JSON manifest, chosen by the file extension
+func readManifest(file string) (*input.GenreManifest, error) {
+	if file == "" {
+		return nil, errors.New("a manifest file is required (-f)")
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest input.GenreManifest
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".json":
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(&manifest)
+	case ".yaml", ".yml":
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		err = dec.Decode(&manifest)
+	default:
+		return nil, fmt.Errorf("unknown manifest format %q; use .yaml, .yml or .json", filepath.Ext(file))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", file, err)
+	}
+	return &manifest, nil
+}
+
+func usage() {
+	fmt.Fprint(os.Stderr, `Usage: manifest <command> [flags]
+
+Commands:
+  export  Print the current genres and keyword groups as a manifest (-o yaml|json)
+  plan    Show the changes applying a manifest would make (-f file); alias diff
+  apply   Change genres and keyword groups to match a manifest (-f file)
+`)
+}
+
+func envOr(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
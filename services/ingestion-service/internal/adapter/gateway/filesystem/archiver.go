@@ -0,0 +1,99 @@
+package filesystem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
+)
+
+// archiver implements gateway.Archiver with files in a local directory
+type archiver struct {
+	dir string
+}
+
+// NewArchiver creates an archiver that stores archives as files in dir
+func NewArchiver(dir string) gateway.Archiver {
+	return &archiver{dir: dir}
+}
+
+// Create starts an archive in a temporary file that is renamed to its name on commit
+func (a *archiver) Create(ctx context.Context, name string) (gateway.ArchiveWriter, error) {
+	path := filepath.Join(a.dir, name)
+	if _, err := os.Stat(path); err == nil {
+		return nil, fmt.Errorf("archive %s already exists", path)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to check archive %s: %w", path, err)
+	}
+
+	f, err := os.OpenFile(path+".partial", os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create archive file: %w", err)
+	}
+	return &archiveFile{f: f, path: path}, nil
+}
+
+// archiveFile is an archive being written to a temporary file
+type archiveFile struct {
+	f    *os.File
+	path string
+	done bool
+}
+
+func (a *archiveFile) Write(p []byte) (int, error) {
+	return a.f.Write(p)
+}
+
+// Commit flushes the file to disk and moves it to its final path
+func (a *archiveFile) Commit() error {
+	if a.done {
+		return nil
+	}
+	a.done = true
+
+	if err := a.f.Sync(); err != nil {
+		a.f.Close()
+		os.Remove(a.f.Name())
+		return fmt.Errorf("failed to sync archive file: %w", err)
+	}
+	if err := a.f.Close(); err != nil {
+		os.Remove(a.f.Name())
+		return fmt.Errorf("failed to close archive file: %w", err)
+	}
+	// A link fails instead of replacing an archive created in the meantime
+	if err := os.Link(a.f.Name(), a.path); err != nil {
+		os.Remove(a.f.Name())
+		return fmt.Errorf("failed to store archive file: %w", err)
+	}
+	if err := os.Remove(a.f.Name()); err != nil {
+		return fmt.Errorf("failed to remove temporary archive file: %w", err)
+	}
+
+	// The new directory entry is durable only once the directory is synced
+	dir, err := os.Open(filepath.Dir(a.path))
+	if err != nil {
+		return fmt.Errorf("failed to open archive directory: %w", err)
+	}
+	defer dir.Close()
+	if err := dir.Sync(); err != nil {
+		return fmt.Errorf("failed to sync archive directory: %w", err)
+	}
+	return nil
+}
+
+// Abort removes the temporary file
+func (a *archiveFile) Abort() error {
+	if a.done {
+		return nil
+	}
+	a.done = true
+
+	a.f.Close()
+	if err := os.Remove(a.f.Name()); err != nil {
+		return fmt.Errorf("failed to remove temporary archive file: %w", err)
+	}
+	return nil
+}
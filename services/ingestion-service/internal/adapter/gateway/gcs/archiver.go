@@ -0,0 +1,78 @@
+package gcs
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"cloud.google.com/go/storage"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
+)
+
+// archiver implements gateway.Archiver with objects in a Cloud Storage bucket
+type archiver struct {
+	client *storage.Client
+	bucket string
+	prefix string
+}
+
+// NewArchiver creates an archiver that stores archives as objects under prefix in bucket
+func NewArchiver(ctx context.Context, bucket, prefix string) (gateway.Archiver, error) {
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cloud storage client: %w", err)
+	}
+
+	return &archiver{
+		client: client,
+		bucket: bucket,
+		prefix: prefix,
+	}, nil
+}
+
+// Create starts an upload of the archive object. The object must not exist yet.
+func (a *archiver) Create(ctx context.Context, name string) (gateway.ArchiveWriter, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	obj := a.client.Bucket(a.bucket).Object(path.Join(a.prefix, name))
+	w := obj.If(storage.Conditions{DoesNotExist: true}).NewWriter(ctx)
+	w.ContentType = "application/x-ndjson"
+	return &archiveObject{w: w, cancel: cancel}, nil
+}
+
+// archiveObject is an archive being uploaded
+type archiveObject struct {
+	w      *storage.Writer
+	cancel context.CancelFunc
+	done   bool
+}
+
+func (a *archiveObject) Write(p []byte) (int, error) {
+	return a.w.Write(p)
+}
+
+// Commit finishes the upload. The object exists once it returns without error.
+func (a *archiveObject) Commit() error {
+	if a.done {
+		return nil
+	}
+	a.done = true
+	defer a.cancel()
+
+	if err := a.w.Close(); err != nil {
+		return fmt.Errorf("failed to upload archive object: %w", err)
+	}
+	return nil
+}
+
+// Abort cancels the upload so no object is created
+func (a *archiveObject) Abort() error {
+	if a.done {
+		return nil
+	}
+	a.done = true
+
+	a.cancel()
+	// Closing a cancelled writer reports the cancellation
+	a.w.Close()
+	return nil
+}
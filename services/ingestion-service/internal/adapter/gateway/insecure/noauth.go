@@ -0,0 +1,23 @@
+package insecure
+
+import (
+	"context"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
+	"github.com/YukiOnishi1129/youtube-analytics/services/pkg/identityauth"
+)
+
+// NoopVerifier accepts any token and returns fixed claims for local dev.
+// The claims grant no roles, so only RPCs the policy allows without one pass.
+type NoopVerifier struct{}
+
+var _ gateway.TokenVerifier = NoopVerifier{}
+
+func (NoopVerifier) Verify(ctx context.Context, idToken string) (identityauth.Claims, error) {
+	return identityauth.Claims{
+		Subject:       "dev-user",
+		Email:         "dev@example.com",
+		EmailVerified: true,
+		DisplayName:   "Dev User",
+	}, nil
+}
@@ -2,6 +2,8 @@ package mock
 
 import (
 	"context"
+	"sort"
+	"strings"
 
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
@@ -78,4 +80,51 @@ func (r *keywordRepository) FindByGenreAndType(ctx context.Context, genreID valu
 func (r *keywordRepository) SoftDelete(ctx context.Context, id valueobject.UUID) error {
 	delete(r.keywords, string(id))
 	return nil
-}
\ No newline at end of file
+}
+
+// Search lists keywords matching the filter in name order, after the cursor
+func (r *keywordRepository) Search(ctx context.Context, filter domain.KeywordFilter, after *valueobject.PageCursor, limit int) ([]*domain.Keyword, error) {
+	matched := r.match(filter)
+	sort.Slice(matched, func(i, j int) bool {
+		if matched[i].Name != matched[j].Name {
+			return matched[i].Name < matched[j].Name
+		}
+		return matched[i].ID < matched[j].ID
+	})
+
+	result := make([]*domain.Keyword, 0, limit)
+	for _, k := range matched {
+		if after != nil && (k.Name < after.Text || k.Name == after.Text && string(k.ID) <= after.ID) {
+			continue
+		}
+		if len(result) == limit {
+			break
+		}
+		result = append(result, k)
+	}
+	return result, nil
+}
+
+// CountSearch counts keywords matching the filter
+func (r *keywordRepository) CountSearch(ctx context.Context, filter domain.KeywordFilter) (int, error) {
+	return len(r.match(filter)), nil
+}
+
+// match returns the keywords matching the filter
+func (r *keywordRepository) match(filter domain.KeywordFilter) []*domain.Keyword {
+	query := strings.ToLower(filter.Query)
+	var result []*domain.Keyword
+	for _, k := range r.keywords {
+		if filter.GenreID != "" && k.GenreID != filter.GenreID {
+			continue
+		}
+		if filter.EnabledOnly && !k.Enabled {
+			continue
+		}
+		if query != "" && !strings.Contains(strings.ToLower(k.Name), query) {
+			continue
+		}
+		result = append(result, k)
+	}
+	return result
+}
@@ -27,11 +27,12 @@ func (c *youtubeClient) GetVideoStats(ctx context.Context, ytVideoID valueobject
 func (c *youtubeClient) GetChannelStats(ctx context.Context, ytChannelID valueobject.YouTubeChannelID) (*gateway.ChannelStats, error) {
 	return &gateway.ChannelStats{
 		SubscriberCount: 10000,
+		ViewCount:       5000000,
 		VideoCount:      100,
 	}, nil
 }
 
-func (c *youtubeClient) ListMostPopular(ctx context.Context, categoryID valueobject.CategoryID, pageToken *string) (*gateway.TrendingVideos, error) {
+func (c *youtubeClient) ListMostPopular(ctx context.Context, regionCode string, categoryID valueobject.CategoryID, pageToken *string) (*gateway.TrendingVideos, error) {
 	return &gateway.TrendingVideos{
 		Videos: []gateway.VideoMeta{
 			{
@@ -78,7 +79,7 @@ func (c *youtubeClient) GetChannel(ctx context.Context, ytChannelID valueobject.
 	}, nil
 }
 
-func (c *youtubeClient) GetTrendingVideos(ctx context.Context) ([]*gateway.VideoMeta, error) {
+func (c *youtubeClient) GetTrendingVideos(ctx context.Context, regionCode string, categoryIDs []valueobject.CategoryID) ([]*gateway.VideoMeta, error) {
 	return []*gateway.VideoMeta{
 		{
 			ID:           "trending1",
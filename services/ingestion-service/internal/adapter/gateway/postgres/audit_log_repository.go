@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"net"
+	"time"
 
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/postgres/sqlcgen"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
@@ -31,11 +32,6 @@ func (r *auditLogRepository) Save(ctx context.Context, log *domain.AuditLog) err
 		return err
 	}
 
-	actorID, err := uuid.Parse(string(log.ActorID))
-	if err != nil {
-		return err
-	}
-
 	var oldValues, newValues pqtype.NullRawMessage
 	if log.OldValues != nil {
 		data, err := json.Marshal(log.OldValues)
@@ -55,12 +51,12 @@ func (r *auditLogRepository) Save(ctx context.Context, log *domain.AuditLog) err
 
 	var ipAddress pqtype.Inet
 	if log.IPAddress != nil {
-		ipAddress = pqtype.Inet{IPNet: net.IPNet{IP: log.IPAddress}, Valid: true}
+		ipAddress = pqtype.Inet{IPNet: hostIPNet(log.IPAddress), Valid: true}
 	}
 
 	return r.q.CreateAuditLog(ctx, sqlcgen.CreateAuditLogParams{
 		ID:           id,
-		ActorID:      actorID,
+		ActorID:      log.ActorID,
 		ActorEmail:   log.ActorEmail,
 		Action:       log.Action,
 		ResourceType: log.ResourceType,
@@ -73,38 +69,46 @@ func (r *auditLogRepository) Save(ctx context.Context, log *domain.AuditLog) err
 	})
 }
 
-// FindByActor finds audit logs by actor ID
-func (r *auditLogRepository) FindByActor(ctx context.Context, actorID valueobject.UUID, limit, offset int) ([]*domain.AuditLog, error) {
-	aid, err := uuid.Parse(string(actorID))
+// FindByID finds an audit log by ID
+func (r *auditLogRepository) FindByID(ctx context.Context, id valueobject.UUID) (*domain.AuditLog, error) {
+	logID, err := uuid.Parse(string(id))
 	if err != nil {
 		return nil, err
 	}
 
-	rows, err := r.q.ListAuditLogsByActor(ctx, sqlcgen.ListAuditLogsByActorParams{
-		ActorID: aid,
-		Limit:   int32(limit),
-		Offset:  int32(offset),
-	})
+	row, err := r.q.GetAuditLogByID(ctx, logID)
 	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrNotFound
+		}
 		return nil, err
 	}
+	return toDomainAuditLog(row), nil
+}
 
-	logs := make([]*domain.AuditLog, len(rows))
-	for i, row := range rows {
-		logs[i] = toDomainAuditLog(row)
+// Search lists audit logs matching the filter, one keyset page at a time
+func (r *auditLogRepository) Search(ctx context.Context, filter domain.AuditLogFilter, after *valueobject.PageCursor, limit int) ([]*domain.AuditLog, error) {
+	f := toCountSearchAuditLogsParams(filter)
+	params := sqlcgen.SearchAuditLogsParams{
+		ActorID:       f.ActorID,
+		Action:        f.Action,
+		ResourceType:  f.ResourceType,
+		ResourceID:    f.ResourceID,
+		ChangedField:  f.ChangedField,
+		CreatedAfter:  f.CreatedAfter,
+		CreatedBefore: f.CreatedBefore,
+		PageSize:      int32(limit),
+	}
+	if after != nil {
+		afterID, err := uuid.Parse(after.ID)
+		if err != nil {
+			return nil, err
+		}
+		params.AfterCreatedAt = sql.NullTime{Time: after.Key, Valid: true}
+		params.AfterID = uuid.NullUUID{UUID: afterID, Valid: true}
 	}
 
-	return logs, nil
-}
-
-// FindByResource finds audit logs by resource type and ID
-func (r *auditLogRepository) FindByResource(ctx context.Context, resourceType, resourceID string, limit, offset int) ([]*domain.AuditLog, error) {
-	rows, err := r.q.ListAuditLogsByResource(ctx, sqlcgen.ListAuditLogsByResourceParams{
-		ResourceType: resourceType,
-		ResourceID:   sql.NullString{String: resourceID, Valid: resourceID != ""},
-		Limit:        int32(limit),
-		Offset:       int32(offset),
-	})
+	rows, err := r.q.SearchAuditLogs(ctx, params)
 	if err != nil {
 		return nil, err
 	}
@@ -113,30 +117,71 @@ func (r *auditLogRepository) FindByResource(ctx context.Context, resourceType, r
 	for i, row := range rows {
 		logs[i] = toDomainAuditLog(row)
 	}
-
 	return logs, nil
 }
 
-// FindRecent finds recent audit logs
-func (r *auditLogRepository) FindRecent(ctx context.Context, limit int) ([]*domain.AuditLog, error) {
-	rows, err := r.q.ListRecentAuditLogs(ctx, int32(limit))
+// CountSearch counts the audit logs matching the filter
+func (r *auditLogRepository) CountSearch(ctx context.Context, filter domain.AuditLogFilter) (int, error) {
+	count, err := r.q.CountSearchAuditLogs(ctx, toCountSearchAuditLogsParams(filter))
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
+	return int(count), nil
+}
 
-	logs := make([]*domain.AuditLog, len(rows))
-	for i, row := range rows {
-		logs[i] = toDomainAuditLog(row)
+// DeleteBefore deletes up to limit of the oldest audit logs created before the cutoff
+func (r *auditLogRepository) DeleteBefore(ctx context.Context, before time.Time, limit int) (int, error) {
+	deleted, err := r.q.DeleteAuditLogsBefore(ctx, sqlcgen.DeleteAuditLogsBeforeParams{
+		CreatedBefore: before,
+		BatchSize:     int32(limit),
+	})
+	if err != nil {
+		return 0, err
 	}
+	return int(deleted), nil
+}
 
-	return logs, nil
+// toCountSearchAuditLogsParams converts an audit log filter to query parameters
+func toCountSearchAuditLogsParams(filter domain.AuditLogFilter) sqlcgen.CountSearchAuditLogsParams {
+	var f sqlcgen.CountSearchAuditLogsParams
+	if filter.ActorID != "" {
+		f.ActorID = sql.NullString{String: filter.ActorID, Valid: true}
+	}
+	if filter.Action != "" {
+		f.Action = sql.NullString{String: filter.Action, Valid: true}
+	}
+	if filter.ResourceType != "" {
+		f.ResourceType = sql.NullString{String: filter.ResourceType, Valid: true}
+	}
+	if filter.ResourceID != "" {
+		f.ResourceID = sql.NullString{String: filter.ResourceID, Valid: true}
+	}
+	if filter.ChangedField != "" {
+		f.ChangedField = sql.NullString{String: filter.ChangedField, Valid: true}
+	}
+	if filter.CreatedAfter != nil {
+		f.CreatedAfter = sql.NullTime{Time: *filter.CreatedAfter, Valid: true}
+	}
+	if filter.CreatedBefore != nil {
+		f.CreatedBefore = sql.NullTime{Time: *filter.CreatedBefore, Valid: true}
+	}
+	return f
+}
+
+// hostIPNet returns the single-host network of ip, which is how an inet column
+// stores a plain address
+func hostIPNet(ip net.IP) net.IPNet {
+	if v4 := ip.To4(); v4 != nil {
+		return net.IPNet{IP: v4, Mask: net.CIDRMask(32, 32)}
+	}
+	return net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}
 }
 
 // toDomainAuditLog converts a database row to a domain audit log
 func toDomainAuditLog(row sqlcgen.IngestionAuditLog) *domain.AuditLog {
 	log := &domain.AuditLog{
 		ID:           valueobject.UUID(row.ID.String()),
-		ActorID:      valueobject.UUID(row.ActorID.String()),
+		ActorID:      row.ActorID,
 		ActorEmail:   row.ActorEmail,
 		Action:       row.Action,
 		ResourceType: row.ResourceType,
@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/postgres/sqlcgen"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
+	"github.com/google/uuid"
+)
+
+// batchJobLockRepository implements gateway.BatchJobLockRepository interface.
+// Leases are rows rather than advisory locks so that they do not depend on a
+// pooled connection and survive as long as the holder heartbeats.
+type batchJobLockRepository struct {
+	*Repository
+}
+
+// NewBatchJobLockRepository creates a new batch job lock repository
+func NewBatchJobLockRepository(repo *Repository) gateway.BatchJobLockRepository {
+	return &batchJobLockRepository{Repository: repo}
+}
+
+// Acquire takes the lease when it is free or expired
+func (r *batchJobLockRepository) Acquire(ctx context.Context, key string, jobID valueobject.UUID, holder string, ttl time.Duration) (bool, error) {
+	id, err := uuid.Parse(string(jobID))
+	if err != nil {
+		return false, err
+	}
+
+	n, err := r.q.AcquireBatchJobLock(ctx, sqlcgen.AcquireBatchJobLockParams{
+		LockKey:    key,
+		JobID:      id,
+		Holder:     holder,
+		TtlSeconds: ttl.Seconds(),
+	})
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// Heartbeat extends the lease while the job holds it
+func (r *batchJobLockRepository) Heartbeat(ctx context.Context, key string, jobID valueobject.UUID, ttl time.Duration) (bool, error) {
+	id, err := uuid.Parse(string(jobID))
+	if err != nil {
+		return false, err
+	}
+
+	n, err := r.q.HeartbeatBatchJobLock(ctx, sqlcgen.HeartbeatBatchJobLockParams{
+		TtlSeconds: ttl.Seconds(),
+		LockKey:    key,
+		JobID:      id,
+	})
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// Release deletes the lease if the job still holds it
+func (r *batchJobLockRepository) Release(ctx context.Context, key string, jobID valueobject.UUID) error {
+	id, err := uuid.Parse(string(jobID))
+	if err != nil {
+		return err
+	}
+
+	return r.q.ReleaseBatchJobLock(ctx, sqlcgen.ReleaseBatchJobLockParams{
+		LockKey: key,
+		JobID:   id,
+	})
+}
+
+// FindByKey finds the lease of a lock key
+func (r *batchJobLockRepository) FindByKey(ctx context.Context, key string) (*domain.BatchJobLock, error) {
+	row, err := r.q.GetBatchJobLock(ctx, key)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return toDomainBatchJobLock(row), nil
+}
+
+// FindByJob finds the leases recorded for a job
+func (r *batchJobLockRepository) FindByJob(ctx context.Context, jobID valueobject.UUID) ([]*domain.BatchJobLock, error) {
+	id, err := uuid.Parse(string(jobID))
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.q.ListBatchJobLocksByJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	locks := make([]*domain.BatchJobLock, len(rows))
+	for i, row := range rows {
+		locks[i] = toDomainBatchJobLock(row)
+	}
+	return locks, nil
+}
+
+// toDomainBatchJobLock converts a lease row to its domain model
+func toDomainBatchJobLock(row sqlcgen.IngestionBatchJobLock) *domain.BatchJobLock {
+	return &domain.BatchJobLock{
+		Key:         row.LockKey,
+		JobID:       valueobject.UUID(row.JobID.String()),
+		Holder:      row.Holder,
+		AcquiredAt:  row.AcquiredAt,
+		HeartbeatAt: row.HeartbeatAt,
+		ExpiresAt:   row.ExpiresAt,
+	}
+}
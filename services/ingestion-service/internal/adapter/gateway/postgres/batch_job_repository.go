@@ -39,12 +39,22 @@ func (r *batchJobRepository) Save(ctx context.Context, job *domain.BatchJob) err
 		parameters = pqtype.NullRawMessage{RawMessage: data, Valid: true}
 	}
 
+	var retryOf uuid.NullUUID
+	if job.RetryOf != nil {
+		retryID, err := uuid.Parse(string(*job.RetryOf))
+		if err != nil {
+			return err
+		}
+		retryOf = uuid.NullUUID{UUID: retryID, Valid: true}
+	}
+
 	return r.q.CreateBatchJob(ctx, sqlcgen.CreateBatchJobParams{
 		ID:         id,
 		JobType:    string(job.JobType),
 		Status:     string(job.Status),
 		Parameters: parameters,
 		CreatedAt:  job.CreatedAt,
+		RetryOf:    retryOf,
 	})
 }
 
@@ -82,6 +92,39 @@ func (r *batchJobRepository) Update(ctx context.Context, job *domain.BatchJob) e
 	})
 }
 
+// RequestCancel records that cancellation of the job was requested
+func (r *batchJobRepository) RequestCancel(ctx context.Context, id valueobject.UUID) error {
+	jobID, err := uuid.Parse(string(id))
+	if err != nil {
+		return err
+	}
+
+	return r.q.RequestBatchJobCancel(ctx, jobID)
+}
+
+// SaveProgress records the progress of a running job and reports whether
+// cancellation of the job was requested
+func (r *batchJobRepository) SaveProgress(ctx context.Context, id valueobject.UUID, progress domain.BatchJobProgress) (bool, error) {
+	jobID, err := uuid.Parse(string(id))
+	if err != nil {
+		return false, err
+	}
+
+	cancelRequestedAt, err := r.q.SaveBatchJobProgress(ctx, sqlcgen.SaveBatchJobProgressParams{
+		ID:                jobID,
+		ProgressProcessed: int32(progress.Processed),
+		ProgressTotal:     int32(progress.Total),
+		ProgressCurrent:   sql.NullString{String: progress.Current, Valid: progress.Current != ""},
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, domain.ErrNotFound
+		}
+		return false, err
+	}
+	return cancelRequestedAt.Valid, nil
+}
+
 // FindByID finds a batch job by ID
 func (r *batchJobRepository) FindByID(ctx context.Context, id valueobject.UUID) (*domain.BatchJob, error) {
 	jobID, err := uuid.Parse(string(id))
@@ -118,17 +161,24 @@ func (r *batchJobRepository) FindByTypeAndStatus(ctx context.Context, jobType do
 	return jobs, nil
 }
 
-// FindRecent finds recent batch jobs
-func (r *batchJobRepository) FindRecent(ctx context.Context, jobType *domain.JobType, limit int) ([]*domain.BatchJob, error) {
-	var jobTypeStr string
-	if jobType != nil {
-		jobTypeStr = string(*jobType)
+// Search lists batch jobs matching the filter, one keyset page at a time
+func (r *batchJobRepository) Search(ctx context.Context, filter domain.BatchJobFilter, after *valueobject.PageCursor, limit int) ([]*domain.BatchJob, error) {
+	f := toCountSearchBatchJobsParams(filter)
+	params := sqlcgen.SearchBatchJobsParams{
+		JobType:  f.JobType,
+		Status:   f.Status,
+		PageSize: int32(limit),
+	}
+	if after != nil {
+		afterID, err := uuid.Parse(after.ID)
+		if err != nil {
+			return nil, err
+		}
+		params.AfterCreatedAt = sql.NullTime{Time: after.Key, Valid: true}
+		params.AfterID = uuid.NullUUID{UUID: afterID, Valid: true}
 	}
 
-	rows, err := r.q.ListRecentBatchJobs(ctx, sqlcgen.ListRecentBatchJobsParams{
-		Column1: jobTypeStr,
-		Limit:   int32(limit),
-	})
+	rows, err := r.q.SearchBatchJobs(ctx, params)
 	if err != nil {
 		return nil, err
 	}
@@ -137,10 +187,30 @@ func (r *batchJobRepository) FindRecent(ctx context.Context, jobType *domain.Job
 	for i, row := range rows {
 		jobs[i] = toDomainBatchJob(row)
 	}
-
 	return jobs, nil
 }
 
+// CountSearch counts the batch jobs matching the filter
+func (r *batchJobRepository) CountSearch(ctx context.Context, filter domain.BatchJobFilter) (int, error) {
+	count, err := r.q.CountSearchBatchJobs(ctx, toCountSearchBatchJobsParams(filter))
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// toCountSearchBatchJobsParams converts a batch job filter to query parameters
+func toCountSearchBatchJobsParams(filter domain.BatchJobFilter) sqlcgen.CountSearchBatchJobsParams {
+	var f sqlcgen.CountSearchBatchJobsParams
+	if filter.JobType != "" {
+		f.JobType = sql.NullString{String: string(filter.JobType), Valid: true}
+	}
+	if filter.Status != "" {
+		f.Status = sql.NullString{String: string(filter.Status), Valid: true}
+	}
+	return f
+}
+
 // GetRunningJobs finds all running batch jobs
 func (r *batchJobRepository) GetRunningJobs(ctx context.Context) ([]*domain.BatchJob, error) {
 	rows, err := r.q.ListRunningBatchJobs(ctx)
@@ -163,6 +233,20 @@ func toDomainBatchJob(row sqlcgen.IngestionBatchJob) *domain.BatchJob {
 		JobType:   domain.JobType(row.JobType),
 		Status:    domain.JobStatus(row.Status),
 		CreatedAt: row.CreatedAt,
+		Progress: domain.BatchJobProgress{
+			Processed: int(row.ProgressProcessed),
+			Total:     int(row.ProgressTotal),
+			Current:   row.ProgressCurrent.String,
+		},
+	}
+
+	if row.CancelRequestedAt.Valid {
+		job.CancelRequestedAt = &row.CancelRequestedAt.Time
+	}
+
+	if row.RetryOf.Valid {
+		retryOf := valueobject.UUID(row.RetryOf.UUID.String())
+		job.RetryOf = &retryOf
 	}
 
 	if row.Parameters.Valid {
@@ -78,17 +78,47 @@ func (r *channelRepository) Update(ctx context.Context, ch *domain.Channel) erro
 
 // SaveWithSnapshots saves channel and its new snapshots in a transaction
 func (r *channelRepository) SaveWithSnapshots(ctx context.Context, ch *domain.Channel) error {
-	// TODO: Implement transaction handling
-	// For now, just save channel
-	if err := r.Save(ctx, ch); err != nil {
+	id, err := uuid.Parse(string(ch.ID))
+	if err != nil {
 		return err
 	}
 
-	// Save snapshots
-	for _, snapshot := range ch.GetNewSnapshots() {
-		// TODO: Implement snapshot saving logic
-		// This would typically be done in a transaction
-		_ = snapshot
+	err = r.ExecTx(ctx, func(tx *Repository) error {
+		txRepo := &channelRepository{Repository: tx}
+		if _, err := tx.q.GetChannelByID(ctx, id); err != nil {
+			if err != sql.ErrNoRows {
+				return err
+			}
+			if err := txRepo.Save(ctx, ch); err != nil {
+				return err
+			}
+		} else if err := txRepo.Update(ctx, ch); err != nil {
+			return err
+		}
+
+		for _, snapshot := range ch.GetNewSnapshots() {
+			snapshotID, err := uuid.Parse(string(snapshot.ID))
+			if err != nil {
+				return err
+			}
+
+			if err := tx.q.CreateChannelSnapshot(ctx, sqlcgen.CreateChannelSnapshotParams{
+				ID:                snapshotID,
+				ChannelID:         id,
+				MeasuredAt:        snapshot.MeasuredAt,
+				SubscriptionCount: int32(snapshot.SubscriptionCount),
+				ViewCount:         sql.NullInt64{Int64: snapshot.ViewCount, Valid: true},
+				VideoCount:        sql.NullInt64{Int64: snapshot.VideoCount, Valid: true},
+				CreatedAt:         sql.NullTime{Time: snapshot.CreatedAt, Valid: true},
+				UpdatedAt:         snapshot.CreatedAt,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	// Clear new snapshots after saving
@@ -151,14 +181,24 @@ func (r *channelRepository) FindByYouTubeChannelID(ctx context.Context, youtubeC
 	return r.FindByYouTubeID(ctx, youtubeChannelID)
 }
 
-// List lists channels with pagination
-func (r *channelRepository) List(ctx context.Context, subscribed *bool, q *string, sort string, limit, offset int) ([]*domain.Channel, error) {
-	// TODO: Implement filtering by subscribed and query
-	// For now, just return all channels with pagination
-	rows, err := r.q.ListChannels(ctx, sqlcgen.ListChannelsParams{
-		Limit:  int32(limit),
-		Offset: int32(offset),
-	})
+// Search lists channels matching the filter, one keyset page at a time
+func (r *channelRepository) Search(ctx context.Context, filter domain.ChannelFilter, after *valueobject.PageCursor, limit int) ([]*domain.Channel, error) {
+	f := toCountSearchChannelsParams(filter)
+	params := sqlcgen.SearchChannelsParams{
+		Subscribed: f.Subscribed,
+		Query:      f.Query,
+		PageSize:   int32(limit),
+	}
+	if after != nil {
+		afterID, err := uuid.Parse(after.ID)
+		if err != nil {
+			return nil, err
+		}
+		params.AfterCreatedAt = sql.NullTime{Time: after.Key, Valid: true}
+		params.AfterID = uuid.NullUUID{UUID: afterID, Valid: true}
+	}
+
+	rows, err := r.q.SearchChannels(ctx, params)
 	if err != nil {
 		return nil, err
 	}
@@ -170,16 +210,27 @@ func (r *channelRepository) List(ctx context.Context, subscribed *bool, q *strin
 	return channels, nil
 }
 
-// Count counts channels
-func (r *channelRepository) Count(ctx context.Context, subscribed *bool, q *string) (int, error) {
-	// TODO: Implement filtering by subscribed and query
-	count, err := r.q.CountChannels(ctx)
+// CountSearch counts the channels matching the filter
+func (r *channelRepository) CountSearch(ctx context.Context, filter domain.ChannelFilter) (int, error) {
+	count, err := r.q.CountSearchChannels(ctx, toCountSearchChannelsParams(filter))
 	if err != nil {
 		return 0, err
 	}
 	return int(count), nil
 }
 
+// toCountSearchChannelsParams converts a channel filter to query parameters
+func toCountSearchChannelsParams(filter domain.ChannelFilter) sqlcgen.CountSearchChannelsParams {
+	var f sqlcgen.CountSearchChannelsParams
+	if filter.Subscribed != nil {
+		f.Subscribed = sql.NullBool{Bool: *filter.Subscribed, Valid: true}
+	}
+	if filter.Query != "" {
+		f.Query = sql.NullString{String: likeEscaper.Replace(filter.Query), Valid: true}
+	}
+	return f
+}
+
 // ListActive lists all active channels
 func (r *channelRepository) ListActive(ctx context.Context) ([]*domain.Channel, error) {
 	rows, err := r.q.ListActiveChannels(ctx)
@@ -312,10 +363,10 @@ func toDomainChannelFromYouTubeRow(row sqlcgen.GetChannelByYouTubeIDRow) *domain
 	return ch
 }
 
-// toDomainChannelFromListRow converts ListChannelsRow, ListActiveChannelsRow, ListSubscribedChannelsRow to domain channel
+// toDomainChannelFromListRow converts SearchChannelsRow, ListActiveChannelsRow, ListSubscribedChannelsRow to domain channel
 func toDomainChannelFromListRow(row interface{}) *domain.Channel {
 	switch r := row.(type) {
-	case sqlcgen.ListChannelsRow:
+	case sqlcgen.SearchChannelsRow:
 		return &domain.Channel{
 			ID:               valueobject.UUID(r.ID.String()),
 			YouTubeChannelID: valueobject.YouTubeChannelID(r.YoutubeChannelID),
@@ -2,10 +2,14 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/postgres/sqlcgen"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
+	"github.com/google/uuid"
 )
 
 // channelSnapshotRepository implements gateway.ChannelSnapshotRepository interface
@@ -18,15 +22,77 @@ func NewChannelSnapshotRepository(repo *Repository) gateway.ChannelSnapshotRepos
 	return &channelSnapshotRepository{Repository: repo}
 }
 
-
 // Latest gets the latest snapshot for a channel
 func (r *channelSnapshotRepository) Latest(ctx context.Context, channelID valueobject.UUID) (*domain.ChannelSnapshot, error) {
-	// TODO: Implement get latest channel snapshot
-	return nil, nil
+	id, err := uuid.Parse(string(channelID))
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := r.q.GetLatestChannelSnapshot(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrChannelSnapshotNotFound
+		}
+		return nil, err
+	}
+
+	return toDomainChannelSnapshot(row), nil
 }
 
-// ListByChannel lists snapshots for a channel
+// Nearest gets the snapshot of a channel measured closest to at
+func (r *channelSnapshotRepository) Nearest(ctx context.Context, channelID valueobject.UUID, at time.Time) (*domain.ChannelSnapshot, error) {
+	id, err := uuid.Parse(string(channelID))
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := r.q.GetNearestChannelSnapshot(ctx, sqlcgen.GetNearestChannelSnapshotParams{
+		ChannelID: id,
+		At:        at,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrChannelSnapshotNotFound
+		}
+		return nil, err
+	}
+
+	return toDomainChannelSnapshot(sqlcgen.GetLatestChannelSnapshotRow(row)), nil
+}
+
+// ListByChannel lists the most recent snapshots for a channel, newest first
 func (r *channelSnapshotRepository) ListByChannel(ctx context.Context, channelID valueobject.UUID, limit int) ([]*domain.ChannelSnapshot, error) {
-	// TODO: Implement list channel snapshots
-	return nil, nil
+	id, err := uuid.Parse(string(channelID))
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.q.ListChannelSnapshots(ctx, sqlcgen.ListChannelSnapshotsParams{
+		ChannelID: id,
+		Limit:     int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]*domain.ChannelSnapshot, len(rows))
+	for i, row := range rows {
+		snapshots[i] = toDomainChannelSnapshot(sqlcgen.GetLatestChannelSnapshotRow(row))
+	}
+	return snapshots, nil
+}
+
+// toDomainChannelSnapshot converts database row to domain channel snapshot.
+// All channel snapshot queries select the same columns, so their rows convert.
+func toDomainChannelSnapshot(row sqlcgen.GetLatestChannelSnapshotRow) *domain.ChannelSnapshot {
+	return &domain.ChannelSnapshot{
+		ID:                valueobject.UUID(row.ID.String()),
+		ChannelID:         valueobject.UUID(row.ChannelID.String()),
+		MeasuredAt:        row.MeasuredAt,
+		SubscriptionCount: int64(row.SubscriptionCount),
+		ViewCount:         nullInt64ToInt64(row.ViewCount),
+		VideoCount:        nullInt64ToInt64(row.VideoCount),
+		CreatedAt:         row.CreatedAt.Time,
+	}
 }
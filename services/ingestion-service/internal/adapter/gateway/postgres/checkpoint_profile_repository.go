@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/postgres/sqlcgen"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
+	"github.com/google/uuid"
+)
+
+// checkpointProfileRepository implements gateway.CheckpointProfileRepository interface
+type checkpointProfileRepository struct {
+	*Repository
+}
+
+// NewCheckpointProfileRepository creates a new checkpoint profile repository
+func NewCheckpointProfileRepository(repo *Repository) gateway.CheckpointProfileRepository {
+	return &checkpointProfileRepository{Repository: repo}
+}
+
+// FindByID finds a checkpoint profile by ID
+func (r *checkpointProfileRepository) FindByID(ctx context.Context, id valueobject.UUID) (*domain.CheckpointProfile, error) {
+	profileID, err := uuid.Parse(string(id))
+	if err != nil {
+		return nil, domain.ErrNotFound
+	}
+
+	row, err := r.q.GetCheckpointProfileByID(ctx, profileID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return r.toDomainCheckpointProfile(ctx, row)
+}
+
+// FindByCode finds a checkpoint profile by code
+func (r *checkpointProfileRepository) FindByCode(ctx context.Context, code string) (*domain.CheckpointProfile, error) {
+	row, err := r.q.GetCheckpointProfileByCode(ctx, code)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return r.toDomainCheckpointProfile(ctx, row)
+}
+
+// FindByVideo finds the checkpoint profiles assigned to the genres of a video
+func (r *checkpointProfileRepository) FindByVideo(ctx context.Context, videoID valueobject.UUID) ([]*domain.CheckpointProfile, error) {
+	uid, err := uuid.Parse(string(videoID))
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.q.ListCheckpointProfilesByVideo(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	profiles := make([]*domain.CheckpointProfile, len(rows))
+	for i, row := range rows {
+		if profiles[i], err = r.toDomainCheckpointProfile(ctx, row); err != nil {
+			return nil, err
+		}
+	}
+
+	return profiles, nil
+}
+
+// ListActive lists the checkpoint profiles assigned to enabled genres
+func (r *checkpointProfileRepository) ListActive(ctx context.Context) ([]*domain.CheckpointProfile, error) {
+	rows, err := r.q.ListActiveCheckpointProfiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	profiles := make([]*domain.CheckpointProfile, len(rows))
+	for i, row := range rows {
+		if profiles[i], err = r.toDomainCheckpointProfile(ctx, row); err != nil {
+			return nil, err
+		}
+	}
+
+	return profiles, nil
+}
+
+// toDomainCheckpointProfile loads the hours of a profile row and converts it to a domain profile
+func (r *checkpointProfileRepository) toDomainCheckpointProfile(ctx context.Context, row sqlcgen.IngestionCheckpointProfile) (*domain.CheckpointProfile, error) {
+	hourRows, err := r.q.ListCheckpointProfileHours(ctx, row.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	hours := make([]valueobject.CheckpointHour, len(hourRows))
+	for i, h := range hourRows {
+		hours[i] = valueobject.CheckpointHour(h)
+	}
+
+	return domain.NewCheckpointProfile(valueobject.UUID(row.ID.String()), row.Code, row.Name, hours)
+}
@@ -34,17 +34,23 @@ func (r *genreRepository) Save(ctx context.Context, g *domain.Genre) error {
 		categoryIDs[i] = int32(catID)
 	}
 
+	profileID, err := nullCheckpointProfileID(g.CheckpointProfileID)
+	if err != nil {
+		return err
+	}
+
 	now := time.Now()
 	return r.q.CreateGenre(ctx, sqlcgen.CreateGenreParams{
-		ID:          id,
-		Code:        g.Code,
-		Name:        g.Name,
-		Language:    g.Language,
-		RegionCode:  g.RegionCode,
-		CategoryIds: categoryIDs,
-		Enabled:     g.Enabled,
-		CreatedAt:   now,
-		UpdatedAt:   now,
+		ID:                  id,
+		Code:                g.Code,
+		Name:                g.Name,
+		Language:            g.Language,
+		RegionCode:          g.RegionCode,
+		CategoryIds:         categoryIDs,
+		Enabled:             g.Enabled,
+		CreatedAt:           now,
+		UpdatedAt:           now,
+		CheckpointProfileID: profileID,
 	})
 }
 
@@ -60,12 +66,18 @@ func (r *genreRepository) Update(ctx context.Context, g *domain.Genre) error {
 		categoryIDs[i] = int32(catID)
 	}
 
+	profileID, err := nullCheckpointProfileID(g.CheckpointProfileID)
+	if err != nil {
+		return err
+	}
+
 	return r.q.UpdateGenre(ctx, sqlcgen.UpdateGenreParams{
-		ID:          id,
-		Name:        g.Name,
-		CategoryIds: categoryIDs,
-		Enabled:     g.Enabled,
-		UpdatedAt:   time.Now(),
+		ID:                  id,
+		Name:                g.Name,
+		CategoryIds:         categoryIDs,
+		Enabled:             g.Enabled,
+		UpdatedAt:           time.Now(),
+		CheckpointProfileID: profileID,
 	})
 }
 
@@ -130,6 +142,49 @@ func (r *genreRepository) FindEnabled(ctx context.Context) ([]*domain.Genre, err
 	return genres, nil
 }
 
+// List lists genres ordered by code, one keyset page at a time
+func (r *genreRepository) List(ctx context.Context, enabledOnly bool, after *valueobject.PageCursor, limit int) ([]*domain.Genre, error) {
+	params := sqlcgen.SearchGenresParams{
+		EnabledOnly: enabledOnly,
+		PageSize:    int32(limit),
+	}
+	if after != nil {
+		params.AfterCode = sql.NullString{String: after.Text, Valid: true}
+	}
+
+	rows, err := r.q.SearchGenres(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	genres := make([]*domain.Genre, len(rows))
+	for i, row := range rows {
+		genres[i] = toDomainGenre(row)
+	}
+	return genres, nil
+}
+
+// Count counts genres
+func (r *genreRepository) Count(ctx context.Context, enabledOnly bool) (int, error) {
+	count, err := r.q.CountGenres(ctx, enabledOnly)
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// nullCheckpointProfileID converts a genre's profile ID, empty for the default profile
+func nullCheckpointProfileID(id valueobject.UUID) (uuid.NullUUID, error) {
+	if id == "" {
+		return uuid.NullUUID{}, nil
+	}
+	pid, err := uuid.Parse(string(id))
+	if err != nil {
+		return uuid.NullUUID{}, err
+	}
+	return uuid.NullUUID{UUID: pid, Valid: true}, nil
+}
+
 // toDomainGenre converts a database row to a domain genre
 func toDomainGenre(row sqlcgen.IngestionGenre) *domain.Genre {
 	categoryIDs := make([]valueobject.CategoryID, len(row.CategoryIds))
@@ -137,7 +192,7 @@ func toDomainGenre(row sqlcgen.IngestionGenre) *domain.Genre {
 		categoryIDs[i] = valueobject.CategoryID(id)
 	}
 
-	return &domain.Genre{
+	genre := &domain.Genre{
 		ID:          valueobject.UUID(row.ID.String()),
 		Code:        row.Code,
 		Name:        row.Name,
@@ -146,4 +201,9 @@ func toDomainGenre(row sqlcgen.IngestionGenre) *domain.Genre {
 		CategoryIDs: categoryIDs,
 		Enabled:     row.Enabled,
 	}
+	if row.CheckpointProfileID.Valid {
+		genre.CheckpointProfileID = valueobject.UUID(row.CheckpointProfileID.UUID.String())
+	}
+
+	return genre
 }
\ No newline at end of file
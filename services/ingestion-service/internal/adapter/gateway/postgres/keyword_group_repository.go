@@ -0,0 +1,306 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/postgres/sqlcgen"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/repository"
+	"github.com/google/uuid"
+)
+
+// keywordGroupRepository implements repository.KeywordGroupRepository against
+// the keyword_groups and keyword_items tables
+type keywordGroupRepository struct {
+	*Repository
+}
+
+// NewKeywordGroupRepository creates a new keyword group repository
+func NewKeywordGroupRepository(repo *Repository) repository.KeywordGroupRepository {
+	return &keywordGroupRepository{Repository: repo}
+}
+
+// Create creates a new keyword group with its items in a transaction
+func (r *keywordGroupRepository) Create(ctx context.Context, group *domain.KeywordGroup) error {
+	id, err := uuid.Parse(string(group.ID))
+	if err != nil {
+		return err
+	}
+	genreID, err := uuid.Parse(string(group.GenreID))
+	if err != nil {
+		return err
+	}
+
+	return r.ExecTx(ctx, func(tx *Repository) error {
+		if err := tx.q.CreateKeywordGroup(ctx, sqlcgen.CreateKeywordGroupParams{
+			ID:            id,
+			GenreID:       genreID,
+			Name:          group.Name,
+			FilterType:    string(group.FilterType),
+			TargetField:   group.TargetField,
+			Enabled:       sql.NullBool{Bool: group.Enabled, Valid: true},
+			Description:   toNullString(group.Description),
+			CreatedAt:     sql.NullTime{Time: group.CreatedAt, Valid: true},
+			CrossLanguage: group.CrossLanguage,
+			Romaji:        group.Romaji,
+		}); err != nil {
+			return err
+		}
+		return insertKeywordItems(ctx, tx, id, group.Items)
+	})
+}
+
+// Update updates an existing keyword group, excluding its items
+func (r *keywordGroupRepository) Update(ctx context.Context, group *domain.KeywordGroup) error {
+	return updateKeywordGroup(ctx, r.Repository, group)
+}
+
+// UpdateWithItems updates a keyword group and replaces all its items in a transaction
+func (r *keywordGroupRepository) UpdateWithItems(ctx context.Context, group *domain.KeywordGroup) error {
+	id, err := uuid.Parse(string(group.ID))
+	if err != nil {
+		return err
+	}
+
+	return r.ExecTx(ctx, func(tx *Repository) error {
+		if err := updateKeywordGroup(ctx, tx, group); err != nil {
+			return err
+		}
+		if err := tx.q.DeleteKeywordItemsByGroup(ctx, id); err != nil {
+			return err
+		}
+		return insertKeywordItems(ctx, tx, id, group.Items)
+	})
+}
+
+// Delete soft deletes a keyword group. Its items are kept with it.
+func (r *keywordGroupRepository) Delete(ctx context.Context, id valueobject.UUID) error {
+	groupID, err := uuid.Parse(string(id))
+	if err != nil {
+		return err
+	}
+
+	n, err := r.q.SoftDeleteKeywordGroup(ctx, sqlcgen.SoftDeleteKeywordGroupParams{
+		ID:        groupID,
+		DeletedAt: sql.NullTime{Time: time.Now(), Valid: true},
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domain.ErrKeywordGroupNotFound
+	}
+	return nil
+}
+
+// FindByID finds a keyword group by ID including its items
+func (r *keywordGroupRepository) FindByID(ctx context.Context, id valueobject.UUID) (*domain.KeywordGroup, error) {
+	groupID, err := uuid.Parse(string(id))
+	if err != nil {
+		return nil, domain.ErrKeywordGroupNotFound
+	}
+
+	row, err := r.q.GetKeywordGroupByID(ctx, groupID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrKeywordGroupNotFound
+		}
+		return nil, err
+	}
+
+	groups, err := r.withItems(ctx, []sqlcgen.IngestionKeywordGroup{row})
+	if err != nil {
+		return nil, err
+	}
+	return groups[0], nil
+}
+
+// FindByGenreID finds all keyword groups for a genre, enabled or not,
+// ordered by filter type and name
+func (r *keywordGroupRepository) FindByGenreID(ctx context.Context, genreID valueobject.UUID) ([]*domain.KeywordGroup, error) {
+	id, err := uuid.Parse(string(genreID))
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.q.ListKeywordGroupsByGenre(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return r.withItems(ctx, rows)
+}
+
+// ListByGenre lists a keyset page of the keyword groups of a genre
+func (r *keywordGroupRepository) ListByGenre(ctx context.Context, genreID valueobject.UUID, enabledOnly bool, after *valueobject.PageCursor, limit int) ([]*domain.KeywordGroup, error) {
+	id, err := uuid.Parse(string(genreID))
+	if err != nil {
+		return nil, err
+	}
+
+	params := sqlcgen.SearchKeywordGroupsByGenreParams{
+		GenreID:     id,
+		EnabledOnly: enabledOnly,
+		PageSize:    int32(limit),
+	}
+	if after != nil {
+		afterID, err := uuid.Parse(after.ID)
+		if err != nil {
+			return nil, err
+		}
+		params.AfterName = sql.NullString{String: after.Text, Valid: true}
+		params.AfterID = uuid.NullUUID{UUID: afterID, Valid: true}
+	}
+
+	rows, err := r.q.SearchKeywordGroupsByGenre(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return r.withItems(ctx, rows)
+}
+
+// CountByGenre counts the keyword groups of a genre
+func (r *keywordGroupRepository) CountByGenre(ctx context.Context, genreID valueobject.UUID, enabledOnly bool) (int, error) {
+	id, err := uuid.Parse(string(genreID))
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := r.q.CountKeywordGroupsByGenre(ctx, sqlcgen.CountKeywordGroupsByGenreParams{
+		GenreID:     id,
+		EnabledOnly: enabledOnly,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// List lists keyword groups with pagination, oldest first
+func (r *keywordGroupRepository) List(ctx context.Context, limit, offset int) ([]*domain.KeywordGroup, error) {
+	rows, err := r.q.ListKeywordGroups(ctx, sqlcgen.ListKeywordGroupsParams{
+		Limit:  int32(limit),
+		Offset: int32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.withItems(ctx, rows)
+}
+
+// ListByEnabled lists enabled or disabled keyword groups with pagination, oldest first
+func (r *keywordGroupRepository) ListByEnabled(ctx context.Context, enabled bool, limit, offset int) ([]*domain.KeywordGroup, error) {
+	rows, err := r.q.ListKeywordGroupsByEnabled(ctx, sqlcgen.ListKeywordGroupsByEnabledParams{
+		Enabled: sql.NullBool{Bool: enabled, Valid: true},
+		Limit:   int32(limit),
+		Offset:  int32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.withItems(ctx, rows)
+}
+
+// withItems converts group rows to domain groups and loads their items with a
+// single query
+func (r *keywordGroupRepository) withItems(ctx context.Context, rows []sqlcgen.IngestionKeywordGroup) ([]*domain.KeywordGroup, error) {
+	groups := make([]*domain.KeywordGroup, len(rows))
+	if len(rows) == 0 {
+		return groups, nil
+	}
+
+	ids := make([]uuid.UUID, len(rows))
+	byID := make(map[uuid.UUID]*domain.KeywordGroup, len(rows))
+	for i, row := range rows {
+		groups[i] = toDomainKeywordGroup(row)
+		ids[i] = row.ID
+		byID[row.ID] = groups[i]
+	}
+
+	items, err := r.q.ListKeywordItemsByGroups(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		group := byID[item.KeywordGroupID]
+		group.Items = append(group.Items, domain.KeywordItem{
+			ID:        valueobject.UUID(item.ID.String()),
+			GroupID:   group.ID,
+			Keyword:   item.Keyword,
+			CreatedAt: item.CreatedAt.Time,
+			UpdatedAt: nullTimeToPtr(item.UpdatedAt),
+		})
+	}
+	return groups, nil
+}
+
+// updateKeywordGroup updates the group row, failing when the group does not exist
+func updateKeywordGroup(ctx context.Context, repo *Repository, group *domain.KeywordGroup) error {
+	id, err := uuid.Parse(string(group.ID))
+	if err != nil {
+		return err
+	}
+
+	updatedAt := time.Now()
+	if group.UpdatedAt != nil {
+		updatedAt = *group.UpdatedAt
+	}
+	n, err := repo.q.UpdateKeywordGroup(ctx, sqlcgen.UpdateKeywordGroupParams{
+		ID:            id,
+		Name:          group.Name,
+		FilterType:    string(group.FilterType),
+		TargetField:   group.TargetField,
+		Enabled:       sql.NullBool{Bool: group.Enabled, Valid: true},
+		Description:   toNullString(group.Description),
+		UpdatedAt:     sql.NullTime{Time: updatedAt, Valid: true},
+		CrossLanguage: group.CrossLanguage,
+		Romaji:        group.Romaji,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domain.ErrKeywordGroupNotFound
+	}
+	return nil
+}
+
+// insertKeywordItems inserts the items of a group
+func insertKeywordItems(ctx context.Context, repo *Repository, groupID uuid.UUID, items []domain.KeywordItem) error {
+	for _, item := range items {
+		id, err := uuid.Parse(string(item.ID))
+		if err != nil {
+			return err
+		}
+		if err := repo.q.CreateKeywordItem(ctx, sqlcgen.CreateKeywordItemParams{
+			ID:             id,
+			KeywordGroupID: groupID,
+			Keyword:        item.Keyword,
+			CreatedAt:      sql.NullTime{Time: item.CreatedAt, Valid: true},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// toDomainKeywordGroup converts a database row to a domain keyword group without items
+func toDomainKeywordGroup(row sqlcgen.IngestionKeywordGroup) *domain.KeywordGroup {
+	return &domain.KeywordGroup{
+		ID:            valueobject.UUID(row.ID.String()),
+		GenreID:       valueobject.UUID(row.GenreID.String()),
+		Name:          row.Name,
+		FilterType:    valueobject.FilterType(row.FilterType),
+		TargetField:   row.TargetField,
+		Enabled:       !row.Enabled.Valid || row.Enabled.Bool, // The column defaults to true
+		Description:   nullStringToPtr(row.Description),
+		CreatedAt:     row.CreatedAt.Time,
+		UpdatedAt:     nullTimeToPtr(row.UpdatedAt),
+		DeletedAt:     nullTimeToPtr(row.DeletedAt),
+		CrossLanguage: row.CrossLanguage,
+		Romaji:        row.Romaji,
+	}
+}
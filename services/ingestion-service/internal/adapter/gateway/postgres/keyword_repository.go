@@ -211,6 +211,83 @@ func (r *keywordRepository) SoftDelete(ctx context.Context, id valueobject.UUID)
 	})
 }
 
+// Search lists keywords matching the filter, one keyset page at a time
+func (r *keywordRepository) Search(ctx context.Context, filter domain.KeywordFilter, after *valueobject.PageCursor, limit int) ([]*domain.Keyword, error) {
+	f, err := toCountSearchKeywordsParams(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	params := sqlcgen.SearchKeywordsParams{
+		GenreID:     f.GenreID,
+		EnabledOnly: f.EnabledOnly,
+		Query:       f.Query,
+		PageSize:    int32(limit),
+	}
+	if after != nil {
+		afterID, err := uuid.Parse(after.ID)
+		if err != nil {
+			return nil, err
+		}
+		params.AfterName = sql.NullString{String: after.Text, Valid: true}
+		params.AfterID = uuid.NullUUID{UUID: afterID, Valid: true}
+	}
+
+	rows, err := r.q.SearchKeywords(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	keywords := make([]*domain.Keyword, len(rows))
+	for i, row := range rows {
+		keywords[i] = &domain.Keyword{
+			ID:          valueobject.UUID(row.ID.String()),
+			GenreID:     valueobject.UUID(row.GenreID.String()),
+			Name:        row.Name,
+			FilterType:  valueobject.FilterType(row.FilterType),
+			Pattern:     row.Pattern,
+			TargetField: row.TargetField,
+			Enabled:     row.Enabled.Bool,
+			Description: nullStringToPtr(row.Description),
+			CreatedAt:   row.CreatedAt.Time,
+			UpdatedAt:   nullTimeToPtr(row.UpdatedAt),
+		}
+	}
+	return keywords, nil
+}
+
+// CountSearch counts the keywords matching the filter
+func (r *keywordRepository) CountSearch(ctx context.Context, filter domain.KeywordFilter) (int, error) {
+	f, err := toCountSearchKeywordsParams(filter)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := r.q.CountSearchKeywords(ctx, f)
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// toCountSearchKeywordsParams converts a keyword filter to query parameters
+func toCountSearchKeywordsParams(filter domain.KeywordFilter) (sqlcgen.CountSearchKeywordsParams, error) {
+	f := sqlcgen.CountSearchKeywordsParams{
+		EnabledOnly: filter.EnabledOnly,
+	}
+	if filter.GenreID != "" {
+		id, err := uuid.Parse(string(filter.GenreID))
+		if err != nil {
+			return f, err
+		}
+		f.GenreID = uuid.NullUUID{UUID: id, Valid: true}
+	}
+	if filter.Query != "" {
+		f.Query = sql.NullString{String: likeEscaper.Replace(filter.Query), Valid: true}
+	}
+	return f, nil
+}
+
 // toNullString converts *string to sql.NullString
 func toNullString(s *string) sql.NullString {
 	if s == nil {
@@ -0,0 +1,178 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/postgres/sqlcgen"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/repository"
+	"github.com/google/uuid"
+)
+
+// keywordSynonymRepository implements repository.KeywordSynonymRepository
+// against the keyword_synonyms table
+type keywordSynonymRepository struct {
+	*Repository
+}
+
+// NewKeywordSynonymRepository creates a new keyword synonym repository
+func NewKeywordSynonymRepository(repo *Repository) repository.KeywordSynonymRepository {
+	return &keywordSynonymRepository{Repository: repo}
+}
+
+// Create creates a new synonym entry
+func (r *keywordSynonymRepository) Create(ctx context.Context, synonym *domain.KeywordSynonym) error {
+	id, err := uuid.Parse(string(synonym.ID))
+	if err != nil {
+		return err
+	}
+
+	return r.q.CreateKeywordSynonym(ctx, sqlcgen.CreateKeywordSynonymParams{
+		ID:            id,
+		Language:      synonym.Language,
+		Term:          synonym.Term,
+		Alias:         synonym.Alias,
+		AliasLanguage: synonym.AliasLanguage,
+		Bidirectional: synonym.Bidirectional,
+		CreatedAt:     synonym.CreatedAt,
+	})
+}
+
+// Update updates an existing synonym entry. Its language cannot change.
+func (r *keywordSynonymRepository) Update(ctx context.Context, synonym *domain.KeywordSynonym) error {
+	id, err := uuid.Parse(string(synonym.ID))
+	if err != nil {
+		return err
+	}
+
+	updatedAt := time.Now()
+	if synonym.UpdatedAt != nil {
+		updatedAt = *synonym.UpdatedAt
+	}
+	n, err := r.q.UpdateKeywordSynonym(ctx, sqlcgen.UpdateKeywordSynonymParams{
+		ID:            id,
+		Term:          synonym.Term,
+		Alias:         synonym.Alias,
+		AliasLanguage: synonym.AliasLanguage,
+		Bidirectional: synonym.Bidirectional,
+		UpdatedAt:     sql.NullTime{Time: updatedAt, Valid: true},
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domain.ErrKeywordSynonymNotFound
+	}
+	return nil
+}
+
+// Delete deletes a synonym entry
+func (r *keywordSynonymRepository) Delete(ctx context.Context, id valueobject.UUID) error {
+	synonymID, err := uuid.Parse(string(id))
+	if err != nil {
+		return domain.ErrKeywordSynonymNotFound
+	}
+
+	n, err := r.q.DeleteKeywordSynonym(ctx, synonymID)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domain.ErrKeywordSynonymNotFound
+	}
+	return nil
+}
+
+// FindByID finds a synonym entry by ID
+func (r *keywordSynonymRepository) FindByID(ctx context.Context, id valueobject.UUID) (*domain.KeywordSynonym, error) {
+	synonymID, err := uuid.Parse(string(id))
+	if err != nil {
+		return nil, domain.ErrKeywordSynonymNotFound
+	}
+
+	row, err := r.q.GetKeywordSynonymByID(ctx, synonymID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrKeywordSynonymNotFound
+		}
+		return nil, err
+	}
+	return toDomainKeywordSynonym(row), nil
+}
+
+// List lists the synonym entries of a language, or of all languages when
+// language is empty, ordered by language, term and alias
+func (r *keywordSynonymRepository) List(ctx context.Context, language string) ([]*domain.KeywordSynonym, error) {
+	rows, err := r.q.ListKeywordSynonyms(ctx, sql.NullString{String: language, Valid: language != ""})
+	if err != nil {
+		return nil, err
+	}
+
+	synonyms := make([]*domain.KeywordSynonym, len(rows))
+	for i, row := range rows {
+		synonyms[i] = toDomainKeywordSynonym(row)
+	}
+	return synonyms, nil
+}
+
+// ListPage lists a keyset page of the synonym entries of a language, or of all languages
+func (r *keywordSynonymRepository) ListPage(ctx context.Context, language string, after *valueobject.PageCursor, limit int) ([]*domain.KeywordSynonym, error) {
+	params := sqlcgen.SearchKeywordSynonymsParams{
+		Language: sql.NullString{String: language, Valid: language != ""},
+		PageSize: int32(limit),
+	}
+	if after != nil {
+		afterID, err := uuid.Parse(after.ID)
+		if err != nil {
+			return nil, err
+		}
+		params.AfterLanguage, params.AfterTerm, params.AfterAlias, err = domain.ParseKeywordSynonymPageKey(after.Text)
+		if err != nil {
+			return nil, err
+		}
+		params.AfterID = uuid.NullUUID{UUID: afterID, Valid: true}
+	}
+
+	rows, err := r.q.SearchKeywordSynonyms(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	synonyms := make([]*domain.KeywordSynonym, len(rows))
+	for i, row := range rows {
+		synonyms[i] = toDomainKeywordSynonym(row)
+	}
+	return synonyms, nil
+}
+
+// Count counts the synonym entries of a language, or of all languages
+func (r *keywordSynonymRepository) Count(ctx context.Context, language string) (int, error) {
+	count, err := r.q.CountKeywordSynonyms(ctx, sql.NullString{String: language, Valid: language != ""})
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// Version returns the dictionary version maintained by a trigger on keyword_synonyms
+func (r *keywordSynonymRepository) Version(ctx context.Context) (int64, error) {
+	return r.q.GetKeywordSynonymVersion(ctx)
+}
+
+// toDomainKeywordSynonym converts a database row to a domain synonym entry
+func toDomainKeywordSynonym(row sqlcgen.IngestionKeywordSynonym) *domain.KeywordSynonym {
+	return &domain.KeywordSynonym{
+		ID:            valueobject.UUID(row.ID.String()),
+		Language:      row.Language,
+		Term:          row.Term,
+		Alias:         row.Alias,
+		AliasLanguage: row.AliasLanguage,
+		Bidirectional: row.Bidirectional,
+		CreatedAt:     row.CreatedAt,
+		UpdatedAt:     nullTimeToPtr(row.UpdatedAt),
+	}
+}
@@ -23,13 +23,18 @@ SELECT id, youtube_channel_id, title, thumbnail_url, description, country,
 FROM ingestion.channels
 WHERE youtube_channel_id = $1 AND deleted_at IS NULL;
 
--- name: ListChannels :many
+-- name: SearchChannels :many
+-- Keyset page of channels matching the filters, ordered by (created_at, id) descending
 SELECT id, youtube_channel_id, title, thumbnail_url, description, country,
        view_count, subscription_count, video_count, subscribed, created_at, updated_at
 FROM ingestion.channels
 WHERE deleted_at IS NULL
-ORDER BY created_at DESC
-LIMIT $1 OFFSET $2;
+  AND (sqlc.narg(subscribed)::boolean IS NULL OR subscribed = sqlc.narg(subscribed))
+  AND (sqlc.narg(query)::text IS NULL OR title ILIKE '%' || sqlc.narg(query) || '%' ESCAPE '\')
+  AND (sqlc.narg(after_created_at)::timestamptz IS NULL
+       OR (created_at, id) < (sqlc.narg(after_created_at), sqlc.narg(after_id)::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(page_size);
 
 -- name: ListSubscribedChannels :many
 SELECT id, youtube_channel_id, title, thumbnail_url, description, country,
@@ -45,22 +50,27 @@ FROM ingestion.channels
 WHERE deleted_at IS NULL
 ORDER BY created_at DESC;
 
--- name: CountChannels :one
-SELECT COUNT(*) FROM ingestion.channels WHERE deleted_at IS NULL;
+-- name: CountSearchChannels :one
+SELECT COUNT(*) FROM ingestion.channels
+WHERE deleted_at IS NULL
+  AND (sqlc.narg(subscribed)::boolean IS NULL OR subscribed = sqlc.narg(subscribed))
+  AND (sqlc.narg(query)::text IS NULL OR title ILIKE '%' || sqlc.narg(query) || '%' ESCAPE '\');
 
 -- name: CreateVideo :exec
 INSERT INTO ingestion.videos (
     id, youtube_video_id, channel_id, youtube_channel_id, title,
-    published_at, category_id, created_at
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+    published_at, category_id, created_at, duration_seconds
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
 
 -- name: GetVideoByID :one
-SELECT id, youtube_video_id, channel_id, youtube_channel_id, title, published_at, category_id, created_at
+SELECT id, youtube_video_id, channel_id, youtube_channel_id, title, published_at, category_id, created_at,
+       duration_seconds
 FROM ingestion.videos
 WHERE id = $1 AND deleted_at IS NULL;
 
 -- name: GetVideoByYouTubeID :one
-SELECT id, youtube_video_id, channel_id, youtube_channel_id, title, published_at, category_id, created_at
+SELECT id, youtube_video_id, channel_id, youtube_channel_id, title, published_at, category_id, created_at,
+       duration_seconds
 FROM ingestion.videos
 WHERE youtube_video_id = $1 AND deleted_at IS NULL;
 
@@ -87,21 +97,172 @@ ORDER BY published_at DESC;
 SELECT COUNT(*) FROM ingestion.videos
 WHERE channel_id = $1 AND deleted_at IS NULL;
 
+-- name: SearchVideosByCreatedAt :many
+-- Keyset page of videos matching the filters, ordered by (created_at, id) descending
+SELECT v.id, v.youtube_video_id, v.channel_id, v.youtube_channel_id, v.title, v.published_at, v.category_id, v.created_at,
+       v.duration_seconds
+FROM ingestion.videos v
+WHERE v.deleted_at IS NULL
+  AND (sqlc.narg(channel_id)::uuid IS NULL OR v.channel_id = sqlc.narg(channel_id))
+  AND (sqlc.narg(genre_id)::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM ingestion.video_genres vg
+      WHERE vg.video_id = v.id AND vg.genre_id = sqlc.narg(genre_id)
+  ))
+  AND (sqlc.narg(category_id)::integer IS NULL OR v.category_id = sqlc.narg(category_id))
+  AND (sqlc.narg(published_from)::timestamptz IS NULL OR v.published_at >= sqlc.narg(published_from))
+  AND (sqlc.narg(published_to)::timestamptz IS NULL OR v.published_at < sqlc.narg(published_to))
+  AND (sqlc.narg(shorts)::boolean IS NULL
+       OR (v.duration_seconds IS NOT NULL AND v.duration_seconds <= sqlc.arg(shorts_max_seconds)::integer) = sqlc.narg(shorts))
+  AND (sqlc.narg(query)::text IS NULL OR v.title ILIKE '%' || sqlc.narg(query) || '%' ESCAPE '\')
+  AND (sqlc.narg(after_key)::timestamptz IS NULL
+       OR (v.created_at, v.id) < (sqlc.narg(after_key), sqlc.narg(after_id)::uuid))
+ORDER BY v.created_at DESC, v.id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: SearchVideosByCreatedAtAsc :many
+-- Keyset page of videos matching the filters, ordered by (created_at, id) ascending
+SELECT v.id, v.youtube_video_id, v.channel_id, v.youtube_channel_id, v.title, v.published_at, v.category_id, v.created_at,
+       v.duration_seconds
+FROM ingestion.videos v
+WHERE v.deleted_at IS NULL
+  AND (sqlc.narg(channel_id)::uuid IS NULL OR v.channel_id = sqlc.narg(channel_id))
+  AND (sqlc.narg(genre_id)::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM ingestion.video_genres vg
+      WHERE vg.video_id = v.id AND vg.genre_id = sqlc.narg(genre_id)
+  ))
+  AND (sqlc.narg(category_id)::integer IS NULL OR v.category_id = sqlc.narg(category_id))
+  AND (sqlc.narg(published_from)::timestamptz IS NULL OR v.published_at >= sqlc.narg(published_from))
+  AND (sqlc.narg(published_to)::timestamptz IS NULL OR v.published_at < sqlc.narg(published_to))
+  AND (sqlc.narg(shorts)::boolean IS NULL
+       OR (v.duration_seconds IS NOT NULL AND v.duration_seconds <= sqlc.arg(shorts_max_seconds)::integer) = sqlc.narg(shorts))
+  AND (sqlc.narg(query)::text IS NULL OR v.title ILIKE '%' || sqlc.narg(query) || '%' ESCAPE '\')
+  AND (sqlc.narg(after_key)::timestamptz IS NULL
+       OR (v.created_at, v.id) > (sqlc.narg(after_key), sqlc.narg(after_id)::uuid))
+ORDER BY v.created_at ASC, v.id ASC
+LIMIT sqlc.arg(page_size);
+
+-- name: SearchVideosByPublishedAt :many
+-- Keyset page of videos matching the filters, ordered by (published_at, id) descending
+SELECT v.id, v.youtube_video_id, v.channel_id, v.youtube_channel_id, v.title, v.published_at, v.category_id, v.created_at,
+       v.duration_seconds
+FROM ingestion.videos v
+WHERE v.deleted_at IS NULL
+  AND (sqlc.narg(channel_id)::uuid IS NULL OR v.channel_id = sqlc.narg(channel_id))
+  AND (sqlc.narg(genre_id)::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM ingestion.video_genres vg
+      WHERE vg.video_id = v.id AND vg.genre_id = sqlc.narg(genre_id)
+  ))
+  AND (sqlc.narg(category_id)::integer IS NULL OR v.category_id = sqlc.narg(category_id))
+  AND (sqlc.narg(published_from)::timestamptz IS NULL OR v.published_at >= sqlc.narg(published_from))
+  AND (sqlc.narg(published_to)::timestamptz IS NULL OR v.published_at < sqlc.narg(published_to))
+  AND (sqlc.narg(shorts)::boolean IS NULL
+       OR (v.duration_seconds IS NOT NULL AND v.duration_seconds <= sqlc.arg(shorts_max_seconds)::integer) = sqlc.narg(shorts))
+  AND (sqlc.narg(query)::text IS NULL OR v.title ILIKE '%' || sqlc.narg(query) || '%' ESCAPE '\')
+  AND (sqlc.narg(after_key)::timestamptz IS NULL
+       OR (v.published_at, v.id) < (sqlc.narg(after_key), sqlc.narg(after_id)::uuid))
+ORDER BY v.published_at DESC, v.id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: SearchVideosByPublishedAtAsc :many
+-- Keyset page of videos matching the filters, ordered by (published_at, id) ascending
+SELECT v.id, v.youtube_video_id, v.channel_id, v.youtube_channel_id, v.title, v.published_at, v.category_id, v.created_at,
+       v.duration_seconds
+FROM ingestion.videos v
+WHERE v.deleted_at IS NULL
+  AND (sqlc.narg(channel_id)::uuid IS NULL OR v.channel_id = sqlc.narg(channel_id))
+  AND (sqlc.narg(genre_id)::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM ingestion.video_genres vg
+      WHERE vg.video_id = v.id AND vg.genre_id = sqlc.narg(genre_id)
+  ))
+  AND (sqlc.narg(category_id)::integer IS NULL OR v.category_id = sqlc.narg(category_id))
+  AND (sqlc.narg(published_from)::timestamptz IS NULL OR v.published_at >= sqlc.narg(published_from))
+  AND (sqlc.narg(published_to)::timestamptz IS NULL OR v.published_at < sqlc.narg(published_to))
+  AND (sqlc.narg(shorts)::boolean IS NULL
+       OR (v.duration_seconds IS NOT NULL AND v.duration_seconds <= sqlc.arg(shorts_max_seconds)::integer) = sqlc.narg(shorts))
+  AND (sqlc.narg(query)::text IS NULL OR v.title ILIKE '%' || sqlc.narg(query) || '%' ESCAPE '\')
+  AND (sqlc.narg(after_key)::timestamptz IS NULL
+       OR (v.published_at, v.id) > (sqlc.narg(after_key), sqlc.narg(after_id)::uuid))
+ORDER BY v.published_at ASC, v.id ASC
+LIMIT sqlc.arg(page_size);
+
+-- name: SearchVideosInGenreScope :many
+-- Keyset page of the videos published since published_from that belong to the
+-- genre, or are in its categories and were assigned to a genre of the same
+-- language and region or come from a channel based in the region. Ordered by
+-- (published_at, id) descending.
+SELECT v.id, v.youtube_video_id, v.channel_id, v.youtube_channel_id, v.title, v.published_at, v.category_id, v.created_at,
+       v.duration_seconds
+FROM ingestion.videos v
+WHERE v.deleted_at IS NULL
+  AND v.published_at >= sqlc.arg(published_from)
+  AND (EXISTS (
+      SELECT 1 FROM ingestion.video_genres vg
+      WHERE vg.video_id = v.id AND vg.genre_id = sqlc.arg(genre_id)
+  ) OR (v.category_id = ANY(sqlc.arg(category_ids)::integer[]) AND (EXISTS (
+      SELECT 1 FROM ingestion.video_genres vg
+      JOIN ingestion.genres g ON g.id = vg.genre_id
+      WHERE vg.video_id = v.id AND g.language = sqlc.arg(language) AND g.region_code = sqlc.arg(region_code)
+  ) OR EXISTS (
+      SELECT 1 FROM ingestion.channels c
+      WHERE c.id = v.channel_id AND c.country = sqlc.arg(region_code)
+  ))))
+  AND (sqlc.narg(after_published_at)::timestamptz IS NULL
+       OR (v.published_at, v.id) < (sqlc.narg(after_published_at), sqlc.narg(after_id)::uuid))
+ORDER BY v.published_at DESC, v.id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: CountSearchVideos :one
+SELECT COUNT(*) FROM ingestion.videos v
+WHERE v.deleted_at IS NULL
+  AND (sqlc.narg(channel_id)::uuid IS NULL OR v.channel_id = sqlc.narg(channel_id))
+  AND (sqlc.narg(genre_id)::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM ingestion.video_genres vg
+      WHERE vg.video_id = v.id AND vg.genre_id = sqlc.narg(genre_id)
+  ))
+  AND (sqlc.narg(category_id)::integer IS NULL OR v.category_id = sqlc.narg(category_id))
+  AND (sqlc.narg(published_from)::timestamptz IS NULL OR v.published_at >= sqlc.narg(published_from))
+  AND (sqlc.narg(published_to)::timestamptz IS NULL OR v.published_at < sqlc.narg(published_to))
+  AND (sqlc.narg(shorts)::boolean IS NULL
+       OR (v.duration_seconds IS NOT NULL AND v.duration_seconds <= sqlc.arg(shorts_max_seconds)::integer) = sqlc.narg(shorts))
+  AND (sqlc.narg(query)::text IS NULL OR v.title ILIKE '%' || sqlc.narg(query) || '%' ESCAPE '\');
+
 -- name: CreateVideoSnapshot :exec
 INSERT INTO ingestion.video_snapshots (
     id, video_id, checkpoint_hour, measured_at, view_count,
-    like_count, subscription_count, source, created_at, updated_at
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+    like_count, subscription_count, source, created_at, updated_at, drift_seconds,
+    comment_count, metrics
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13);
 
 -- name: GetVideoSnapshotByVideoAndCheckpoint :one
 SELECT id, video_id, checkpoint_hour, measured_at, view_count, 
-       like_count, subscription_count, source, created_at, updated_at
+       like_count, subscription_count, source, created_at, updated_at, drift_seconds,
+       comment_count, metrics
 FROM ingestion.video_snapshots
 WHERE video_id = $1 AND checkpoint_hour = $2;
 
+-- name: SearchVideoSnapshots :many
+-- Keyset page of snapshots matching the filters, ordered by (measured_at, id)
+SELECT s.id, s.video_id, s.checkpoint_hour, s.measured_at, s.view_count,
+       s.like_count, s.subscription_count, s.source, s.created_at, s.updated_at, s.drift_seconds,
+       s.comment_count, s.metrics
+FROM ingestion.video_snapshots s
+WHERE (sqlc.narg(video_id)::uuid IS NULL OR s.video_id = sqlc.narg(video_id))
+  AND (sqlc.narg(genre_id)::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM ingestion.video_genres vg
+      WHERE vg.video_id = s.video_id AND vg.genre_id = sqlc.narg(genre_id)
+  ))
+  AND (sqlc.narg(checkpoint_hour)::integer IS NULL OR s.checkpoint_hour = sqlc.narg(checkpoint_hour))
+  AND (sqlc.narg(measured_from)::timestamptz IS NULL OR s.measured_at >= sqlc.narg(measured_from))
+  AND (sqlc.narg(measured_to)::timestamptz IS NULL OR s.measured_at < sqlc.narg(measured_to))
+  AND (sqlc.narg(after_measured_at)::timestamptz IS NULL
+       OR (s.measured_at, s.id) > (sqlc.narg(after_measured_at), sqlc.narg(after_id)::uuid))
+ORDER BY s.measured_at, s.id
+LIMIT sqlc.arg(page_size);
+
 -- name: ListVideoSnapshots :many
 SELECT id, video_id, checkpoint_hour, measured_at, view_count, 
-       like_count, subscription_count, source, created_at, updated_at
+       like_count, subscription_count, source, created_at, updated_at, drift_seconds,
+       comment_count, metrics
 FROM ingestion.video_snapshots
 WHERE video_id = $1
 ORDER BY checkpoint_hour ASC;
@@ -118,6 +279,28 @@ WHERE channel_id = $1
 ORDER BY measured_at DESC
 LIMIT 1;
 
+-- name: GetNearestChannelSnapshot :one
+-- Snapshot of the channel measured closest to at, the later one on a tie. The
+-- latest snapshot at or before at and the earliest one after it are each a
+-- single probe of the (channel_id, measured_at) index behind
+-- channel_snapshots_channel_measured_unique; the closer of the two is kept.
+SELECT id, channel_id, measured_at, subscription_count, view_count, video_count, created_at, updated_at
+FROM (
+    (SELECT id, channel_id, measured_at, subscription_count, view_count, video_count, created_at, updated_at
+     FROM ingestion.channel_snapshots
+     WHERE channel_id = $1 AND measured_at <= sqlc.arg(at)::timestamptz
+     ORDER BY measured_at DESC
+     LIMIT 1)
+    UNION ALL
+    (SELECT id, channel_id, measured_at, subscription_count, view_count, video_count, created_at, updated_at
+     FROM ingestion.channel_snapshots
+     WHERE channel_id = $1 AND measured_at > sqlc.arg(at)
+     ORDER BY measured_at ASC
+     LIMIT 1)
+) nearest
+ORDER BY abs(extract(epoch FROM (measured_at - sqlc.arg(at)))), measured_at DESC
+LIMIT 1;
+
 -- name: ListChannelSnapshots :many
 SELECT id, channel_id, measured_at, subscription_count, view_count, video_count, created_at, updated_at
 FROM ingestion.channel_snapshots
@@ -164,6 +347,147 @@ FROM ingestion.keywords
 WHERE genre_id = $1 AND filter_type = $2 AND ($3::boolean IS NULL OR enabled = $3) AND deleted_at IS NULL
 ORDER BY name ASC;
 
+-- name: SearchKeywords :many
+-- Keyset page of keywords matching the filters, ordered by (name, id)
+SELECT id, genre_id, name, filter_type, pattern, target_field, enabled, description, created_at, updated_at
+FROM ingestion.keywords
+WHERE deleted_at IS NULL
+  AND (sqlc.narg(genre_id)::uuid IS NULL OR genre_id = sqlc.narg(genre_id))
+  AND (NOT sqlc.arg(enabled_only)::boolean OR enabled = true)
+  AND (sqlc.narg(query)::text IS NULL OR name ILIKE '%' || sqlc.narg(query) || '%' ESCAPE '\')
+  AND (sqlc.narg(after_name)::text IS NULL
+       OR (name, id) > (sqlc.narg(after_name), sqlc.narg(after_id)::uuid))
+ORDER BY name ASC, id ASC
+LIMIT sqlc.arg(page_size);
+
+-- name: CountSearchKeywords :one
+SELECT COUNT(*) FROM ingestion.keywords
+WHERE deleted_at IS NULL
+  AND (sqlc.narg(genre_id)::uuid IS NULL OR genre_id = sqlc.narg(genre_id))
+  AND (NOT sqlc.arg(enabled_only)::boolean OR enabled = true)
+  AND (sqlc.narg(query)::text IS NULL OR name ILIKE '%' || sqlc.narg(query) || '%' ESCAPE '\');
+
+-- Keyword group queries
+-- name: CreateKeywordGroup :exec
+INSERT INTO ingestion.keyword_groups (
+    id, genre_id, name, filter_type, target_field, enabled, description, created_at, cross_language, romaji
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+
+-- name: UpdateKeywordGroup :execrows
+UPDATE ingestion.keyword_groups
+SET name = $2, filter_type = $3, target_field = $4,
+    enabled = $5, description = $6, updated_at = $7, cross_language = $8, romaji = $9
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: SoftDeleteKeywordGroup :execrows
+UPDATE ingestion.keyword_groups
+SET deleted_at = $2, updated_at = $2
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: GetKeywordGroupByID :one
+SELECT id, genre_id, name, filter_type, target_field, enabled, description, created_at, updated_at, deleted_at, cross_language, romaji
+FROM ingestion.keyword_groups
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: ListKeywordGroupsByGenre :many
+SELECT id, genre_id, name, filter_type, target_field, enabled, description, created_at, updated_at, deleted_at, cross_language, romaji
+FROM ingestion.keyword_groups
+WHERE genre_id = $1 AND deleted_at IS NULL
+ORDER BY filter_type ASC, name ASC;
+
+-- name: SearchKeywordGroupsByGenre :many
+-- Keyset page of the keyword groups of a genre, ordered by (name, id)
+SELECT id, genre_id, name, filter_type, target_field, enabled, description, created_at, updated_at, deleted_at, cross_language, romaji
+FROM ingestion.keyword_groups
+WHERE genre_id = sqlc.arg(genre_id) AND deleted_at IS NULL
+  AND (NOT sqlc.arg(enabled_only)::boolean OR enabled = true)
+  AND (sqlc.narg(after_name)::text IS NULL
+       OR (name, id) > (sqlc.narg(after_name), sqlc.narg(after_id)::uuid))
+ORDER BY name ASC, id ASC
+LIMIT sqlc.arg(page_size);
+
+-- name: CountKeywordGroupsByGenre :one
+SELECT COUNT(*) FROM ingestion.keyword_groups
+WHERE genre_id = sqlc.arg(genre_id) AND deleted_at IS NULL
+  AND (NOT sqlc.arg(enabled_only)::boolean OR enabled = true);
+
+-- name: ListKeywordGroups :many
+SELECT id, genre_id, name, filter_type, target_field, enabled, description, created_at, updated_at, deleted_at, cross_language, romaji
+FROM ingestion.keyword_groups
+WHERE deleted_at IS NULL
+ORDER BY created_at ASC, id ASC
+LIMIT $1 OFFSET $2;
+
+-- name: ListKeywordGroupsByEnabled :many
+SELECT id, genre_id, name, filter_type, target_field, enabled, description, created_at, updated_at, deleted_at, cross_language, romaji
+FROM ingestion.keyword_groups
+WHERE enabled = $1 AND deleted_at IS NULL
+ORDER BY created_at ASC, id ASC
+LIMIT $2 OFFSET $3;
+
+-- name: CreateKeywordItem :exec
+INSERT INTO ingestion.keyword_items (
+    id, keyword_group_id, keyword, created_at
+) VALUES ($1, $2, $3, $4);
+
+-- name: DeleteKeywordItemsByGroup :exec
+DELETE FROM ingestion.keyword_items
+WHERE keyword_group_id = $1;
+
+-- name: ListKeywordItemsByGroups :many
+-- Items of the given groups, ordered by keyword within each group
+SELECT id, keyword_group_id, keyword, created_at, updated_at
+FROM ingestion.keyword_items
+WHERE keyword_group_id = ANY($1::uuid[])
+ORDER BY keyword_group_id ASC, keyword ASC;
+
+-- Keyword synonym queries
+-- name: CreateKeywordSynonym :exec
+INSERT INTO ingestion.keyword_synonyms (
+    id, language, term, alias, alias_language, bidirectional, created_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: UpdateKeywordSynonym :execrows
+UPDATE ingestion.keyword_synonyms
+SET term = $2, alias = $3, alias_language = $4, bidirectional = $5, updated_at = $6
+WHERE id = $1;
+
+-- name: DeleteKeywordSynonym :execrows
+DELETE FROM ingestion.keyword_synonyms
+WHERE id = $1;
+
+-- name: GetKeywordSynonymByID :one
+SELECT id, language, term, alias, alias_language, bidirectional, created_at, updated_at
+FROM ingestion.keyword_synonyms
+WHERE id = $1;
+
+-- name: ListKeywordSynonyms :many
+-- Entries of a language, or of all languages when language is NULL
+SELECT id, language, term, alias, alias_language, bidirectional, created_at, updated_at
+FROM ingestion.keyword_synonyms
+WHERE sqlc.narg(language)::varchar IS NULL OR language = sqlc.narg(language)
+ORDER BY language ASC, lower(term) ASC, lower(alias) ASC;
+
+-- name: SearchKeywordSynonyms :many
+-- Keyset page of the entries of a language, or of all languages when language
+-- is NULL, ordered by (language, lower(term), lower(alias), id)
+SELECT id, language, term, alias, alias_language, bidirectional, created_at, updated_at
+FROM ingestion.keyword_synonyms
+WHERE (sqlc.narg(language)::varchar IS NULL OR language = sqlc.narg(language))
+  AND (sqlc.narg(after_id)::uuid IS NULL
+       OR (language, lower(term), lower(alias), id)
+          > (sqlc.arg(after_language)::varchar, lower(sqlc.arg(after_term)::text), lower(sqlc.arg(after_alias)::text), sqlc.narg(after_id)))
+ORDER BY language ASC, lower(term) ASC, lower(alias) ASC, id ASC
+LIMIT sqlc.arg(page_size);
+
+-- name: CountKeywordSynonyms :one
+SELECT COUNT(*) FROM ingestion.keyword_synonyms
+WHERE sqlc.narg(language)::varchar IS NULL OR language = sqlc.narg(language);
+
+-- name: GetKeywordSynonymVersion :one
+SELECT version FROM ingestion.keyword_synonym_version
+WHERE singleton;
+
 -- name: CreateSnapshotTask :exec
 INSERT INTO ingestion.snapshot_tasks (
     video_id, checkpoint_hour, scheduled_at
@@ -180,38 +504,97 @@ WHERE scheduled_at <= $1
 ORDER BY scheduled_at ASC
 LIMIT $2;
 
+-- Snapshot gap queries
+-- name: CreateSnapshotGap :exec
+INSERT INTO ingestion.snapshot_gaps (
+    video_id, checkpoint_hour, due_at, reason, detected_at
+) VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (video_id, checkpoint_hour) DO NOTHING;
+
+-- name: ListSnapshotGapsByVideo :many
+SELECT video_id, checkpoint_hour, due_at, reason, detected_at
+FROM ingestion.snapshot_gaps
+WHERE video_id = $1
+ORDER BY checkpoint_hour ASC;
+
 -- Genre queries
 -- name: CreateGenre :exec
 INSERT INTO ingestion.genres (
-    id, code, name, language, region_code, category_ids, enabled, created_at, updated_at
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+    id, code, name, language, region_code, category_ids, enabled, created_at, updated_at, checkpoint_profile_id
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
 
 -- name: UpdateGenre :exec
 UPDATE ingestion.genres
-SET name = $2, category_ids = $3, enabled = $4, updated_at = $5
+SET name = $2, category_ids = $3, enabled = $4, updated_at = $5, checkpoint_profile_id = $6
 WHERE id = $1;
 
 -- name: GetGenreByID :one
-SELECT id, code, name, language, region_code, category_ids, enabled, created_at, updated_at
+SELECT id, code, name, language, region_code, category_ids, enabled, created_at, updated_at, checkpoint_profile_id
 FROM ingestion.genres
 WHERE id = $1;
 
 -- name: GetGenreByCode :one
-SELECT id, code, name, language, region_code, category_ids, enabled, created_at, updated_at
+SELECT id, code, name, language, region_code, category_ids, enabled, created_at, updated_at, checkpoint_profile_id
 FROM ingestion.genres
 WHERE code = $1;
 
 -- name: ListGenres :many
-SELECT id, code, name, language, region_code, category_ids, enabled, created_at, updated_at
+SELECT id, code, name, language, region_code, category_ids, enabled, created_at, updated_at, checkpoint_profile_id
 FROM ingestion.genres
 ORDER BY code ASC;
 
 -- name: ListEnabledGenres :many
-SELECT id, code, name, language, region_code, category_ids, enabled, created_at, updated_at
+SELECT id, code, name, language, region_code, category_ids, enabled, created_at, updated_at, checkpoint_profile_id
 FROM ingestion.genres
 WHERE enabled = true
 ORDER BY code ASC;
 
+-- name: SearchGenres :many
+-- Keyset page of genres ordered by code
+SELECT id, code, name, language, region_code, category_ids, enabled, created_at, updated_at, checkpoint_profile_id
+FROM ingestion.genres
+WHERE (NOT sqlc.arg(enabled_only)::boolean OR enabled = true)
+  AND (sqlc.narg(after_code)::text IS NULL OR code > sqlc.narg(after_code))
+ORDER BY code ASC
+LIMIT sqlc.arg(page_size);
+
+-- name: CountGenres :one
+SELECT COUNT(*) FROM ingestion.genres
+WHERE (NOT sqlc.arg(enabled_only)::boolean OR enabled = true);
+
+-- Checkpoint profile queries
+-- name: GetCheckpointProfileByID :one
+SELECT id, code, name, created_at, updated_at
+FROM ingestion.checkpoint_profiles
+WHERE id = $1;
+
+-- name: GetCheckpointProfileByCode :one
+SELECT id, code, name, created_at, updated_at
+FROM ingestion.checkpoint_profiles
+WHERE code = $1;
+
+-- name: ListActiveCheckpointProfiles :many
+-- Profiles assigned to enabled genres
+SELECT DISTINCT p.id, p.code, p.name, p.created_at, p.updated_at
+FROM ingestion.checkpoint_profiles p
+JOIN ingestion.genres g ON g.checkpoint_profile_id = p.id
+WHERE g.enabled = true
+ORDER BY p.code ASC;
+
+-- name: ListCheckpointProfilesByVideo :many
+SELECT DISTINCT p.id, p.code, p.name, p.created_at, p.updated_at
+FROM ingestion.checkpoint_profiles p
+JOIN ingestion.genres g ON g.checkpoint_profile_id = p.id
+JOIN ingestion.video_genres vg ON vg.genre_id = g.id
+WHERE vg.video_id = $1
+ORDER BY p.code ASC;
+
+-- name: ListCheckpointProfileHours :many
+SELECT checkpoint_hour
+FROM ingestion.checkpoint_profile_hours
+WHERE profile_id = $1
+ORDER BY checkpoint_hour ASC;
+
 -- YouTube Category queries
 -- name: CreateYouTubeCategory :exec
 INSERT INTO ingestion.youtube_categories (
@@ -239,19 +622,46 @@ FROM ingestion.youtube_categories
 WHERE assignable = true
 ORDER BY id ASC;
 
+-- name: SearchYouTubeCategories :many
+-- Keyset page of YouTube categories ordered by id
+SELECT id, name, assignable, created_at, updated_at
+FROM ingestion.youtube_categories
+WHERE (NOT sqlc.arg(assignable_only)::boolean OR assignable = true)
+  AND (sqlc.narg(after_id)::integer IS NULL OR id > sqlc.narg(after_id))
+ORDER BY id ASC
+LIMIT sqlc.arg(page_size);
+
+-- name: CountYouTubeCategories :one
+SELECT COUNT(*) FROM ingestion.youtube_categories
+WHERE (NOT sqlc.arg(assignable_only)::boolean OR assignable = true);
+
 -- Video Genre queries
 -- name: CreateVideoGenre :exec
 INSERT INTO ingestion.video_genres (
-    id, video_id, genre_id, created_at
-) VALUES ($1, $2, $3, $4);
+    id, video_id, genre_id, created_at, match_provenance
+) VALUES ($1, $2, $3, $4, $5);
 
 -- name: ListVideoGenresByVideo :many
-SELECT id, video_id, genre_id, created_at
+SELECT id, video_id, genre_id, created_at, match_provenance
+FROM ingestion.video_genres
+WHERE video_id = $1;
+
+-- name: SearchVideoGenresByVideo :many
+-- Keyset page of the genre assignments of a video, ordered by (created_at, id)
+SELECT id, video_id, genre_id, created_at, match_provenance
 FROM ingestion.video_genres
+WHERE video_id = sqlc.arg(video_id)
+  AND (sqlc.narg(after_created_at)::timestamptz IS NULL
+       OR (created_at, id) > (sqlc.narg(after_created_at), sqlc.narg(after_id)::uuid))
+ORDER BY created_at ASC, id ASC
+LIMIT sqlc.arg(page_size);
+
+-- name: CountVideoGenresByVideo :one
+SELECT COUNT(*) FROM ingestion.video_genres
 WHERE video_id = $1;
 
 -- name: ListVideoGenresByGenre :many
-SELECT id, video_id, genre_id, created_at
+SELECT id, video_id, genre_id, created_at, match_provenance
 FROM ingestion.video_genres
 WHERE genre_id = $1;
 
@@ -276,34 +686,56 @@ INSERT INTO ingestion.audit_logs (
     old_values, new_values, ip_address, user_agent, created_at
 ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);
 
--- name: ListAuditLogsByActor :many
-SELECT id, actor_id, actor_email, action, resource_type, resource_id,
-       old_values, new_values, ip_address, user_agent, created_at
-FROM ingestion.audit_logs
-WHERE actor_id = $1
-ORDER BY created_at DESC
-LIMIT $2 OFFSET $3;
-
--- name: ListAuditLogsByResource :many
+-- name: GetAuditLogByID :one
 SELECT id, actor_id, actor_email, action, resource_type, resource_id,
        old_values, new_values, ip_address, user_agent, created_at
 FROM ingestion.audit_logs
-WHERE resource_type = $1 AND resource_id = $2
-ORDER BY created_at DESC
-LIMIT $3 OFFSET $4;
+WHERE id = $1;
 
--- name: ListRecentAuditLogs :many
+-- name: SearchAuditLogs :many
+-- Keyset page of audit logs matching the filters, ordered by (created_at, id) descending
 SELECT id, actor_id, actor_email, action, resource_type, resource_id,
        old_values, new_values, ip_address, user_agent, created_at
 FROM ingestion.audit_logs
-ORDER BY created_at DESC
-LIMIT $1;
+WHERE (sqlc.narg(actor_id)::text IS NULL OR actor_id = sqlc.narg(actor_id))
+  AND (sqlc.narg(action)::text IS NULL OR action = sqlc.narg(action))
+  AND (sqlc.narg(resource_type)::text IS NULL OR resource_type = sqlc.narg(resource_type))
+  AND (sqlc.narg(resource_id)::text IS NULL OR resource_id = sqlc.narg(resource_id))
+  AND (sqlc.narg(changed_field)::text IS NULL
+       OR old_values ? sqlc.narg(changed_field) OR new_values ? sqlc.narg(changed_field))
+  AND (sqlc.narg(created_after)::timestamptz IS NULL OR created_at >= sqlc.narg(created_after))
+  AND (sqlc.narg(created_before)::timestamptz IS NULL OR created_at < sqlc.narg(created_before))
+  AND (sqlc.narg(after_created_at)::timestamptz IS NULL
+       OR (created_at, id) < (sqlc.narg(after_created_at), sqlc.narg(after_id)::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: CountSearchAuditLogs :one
+SELECT COUNT(*) FROM ingestion.audit_logs
+WHERE (sqlc.narg(actor_id)::text IS NULL OR actor_id = sqlc.narg(actor_id))
+  AND (sqlc.narg(action)::text IS NULL OR action = sqlc.narg(action))
+  AND (sqlc.narg(resource_type)::text IS NULL OR resource_type = sqlc.narg(resource_type))
+  AND (sqlc.narg(resource_id)::text IS NULL OR resource_id = sqlc.narg(resource_id))
+  AND (sqlc.narg(changed_field)::text IS NULL
+       OR old_values ? sqlc.narg(changed_field) OR new_values ? sqlc.narg(changed_field))
+  AND (sqlc.narg(created_after)::timestamptz IS NULL OR created_at >= sqlc.narg(created_after))
+  AND (sqlc.narg(created_before)::timestamptz IS NULL OR created_at < sqlc.narg(created_before));
+
+-- name: DeleteAuditLogsBefore :execrows
+-- Deletes up to batch_size of the oldest audit logs created before the cutoff
+DELETE FROM ingestion.audit_logs
+WHERE id IN (
+    SELECT id FROM ingestion.audit_logs
+    WHERE created_at < sqlc.arg(created_before)
+    ORDER BY created_at, id
+    LIMIT sqlc.arg(batch_size)
+);
 
 -- Batch Job queries
 -- name: CreateBatchJob :exec
 INSERT INTO ingestion.batch_jobs (
-    id, job_type, status, parameters, created_at
-) VALUES ($1, $2, $3, $4, $5);
+    id, job_type, status, parameters, created_at, retry_of
+) VALUES ($1, $2, $3, $4, $5, $6);
 
 -- name: UpdateBatchJob :exec
 UPDATE ingestion.batch_jobs
@@ -312,28 +744,88 @@ WHERE id = $1;
 
 -- name: GetBatchJobByID :one
 SELECT id, job_type, status, parameters, started_at, completed_at,
-       error_message, statistics, created_at
+       error_message, statistics, created_at,
+       cancel_requested_at, retry_of, progress_processed, progress_total, progress_current
 FROM ingestion.batch_jobs
 WHERE id = $1;
 
 -- name: ListBatchJobsByTypeAndStatus :many
 SELECT id, job_type, status, parameters, started_at, completed_at,
-       error_message, statistics, created_at
+       error_message, statistics, created_at,
+       cancel_requested_at, retry_of, progress_processed, progress_total, progress_current
 FROM ingestion.batch_jobs
 WHERE job_type = $1 AND status = $2
 ORDER BY created_at DESC;
 
--- name: ListRecentBatchJobs :many
+-- name: SearchBatchJobs :many
+-- Keyset page of batch jobs matching the filters, ordered by (created_at, id) descending
 SELECT id, job_type, status, parameters, started_at, completed_at,
-       error_message, statistics, created_at
+       error_message, statistics, created_at,
+       cancel_requested_at, retry_of, progress_processed, progress_total, progress_current
 FROM ingestion.batch_jobs
-WHERE ($1::text IS NULL OR job_type = $1)
-ORDER BY created_at DESC
-LIMIT $2;
+WHERE (sqlc.narg(job_type)::text IS NULL OR job_type = sqlc.narg(job_type))
+  AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status))
+  AND (sqlc.narg(after_created_at)::timestamptz IS NULL
+       OR (created_at, id) < (sqlc.narg(after_created_at), sqlc.narg(after_id)::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: CountSearchBatchJobs :one
+SELECT COUNT(*) FROM ingestion.batch_jobs
+WHERE (sqlc.narg(job_type)::text IS NULL OR job_type = sqlc.narg(job_type))
+  AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status));
+
+-- name: RequestBatchJobCancel :exec
+UPDATE ingestion.batch_jobs
+SET cancel_requested_at = COALESCE(cancel_requested_at, now())
+WHERE id = $1;
+
+-- name: SaveBatchJobProgress :one
+-- Records progress of a running job and returns whether cancellation was requested
+UPDATE ingestion.batch_jobs
+SET progress_processed = $2, progress_total = $3, progress_current = $4
+WHERE id = $1
+RETURNING cancel_requested_at;
 
 -- name: ListRunningBatchJobs :many
 SELECT id, job_type, status, parameters, started_at, completed_at,
-       error_message, statistics, created_at
+       error_message, statistics, created_at,
+       cancel_requested_at, retry_of, progress_processed, progress_total, progress_current
 FROM ingestion.batch_jobs
 WHERE status = 'running'
-ORDER BY started_at ASC;
\ No newline at end of file
+ORDER BY started_at ASC;
+
+-- Batch Job Lock queries
+-- name: AcquireBatchJobLock :execrows
+-- Takes the lease when it is free or its holder stopped heartbeating
+INSERT INTO ingestion.batch_job_locks (
+    lock_key, job_id, holder, acquired_at, heartbeat_at, expires_at
+) VALUES (
+    sqlc.arg(lock_key), sqlc.arg(job_id), sqlc.arg(holder), now(), now(),
+    now() + make_interval(secs => sqlc.arg(ttl_seconds)::float8)
+)
+ON CONFLICT (lock_key) DO UPDATE
+SET job_id = EXCLUDED.job_id, holder = EXCLUDED.holder, acquired_at = EXCLUDED.acquired_at,
+    heartbeat_at = EXCLUDED.heartbeat_at, expires_at = EXCLUDED.expires_at
+WHERE ingestion.batch_job_locks.expires_at < now();
+
+-- name: HeartbeatBatchJobLock :execrows
+-- Extends the lease while it is still held by the job
+UPDATE ingestion.batch_job_locks
+SET heartbeat_at = now(), expires_at = now() + make_interval(secs => sqlc.arg(ttl_seconds)::float8)
+WHERE lock_key = sqlc.arg(lock_key) AND job_id = sqlc.arg(job_id);
+
+-- name: ReleaseBatchJobLock :exec
+DELETE FROM ingestion.batch_job_locks
+WHERE lock_key = $1 AND job_id = $2;
+
+-- name: GetBatchJobLock :one
+SELECT lock_key, job_id, holder, acquired_at, heartbeat_at, expires_at
+FROM ingestion.batch_job_locks
+WHERE lock_key = $1;
+
+-- name: ListBatchJobLocksByJob :many
+SELECT lock_key, job_id, holder, acquired_at, heartbeat_at, expires_at
+FROM ingestion.batch_job_locks
+WHERE job_id = $1
+ORDER BY lock_key ASC;
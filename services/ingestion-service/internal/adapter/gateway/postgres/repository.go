@@ -13,11 +13,12 @@ type Repository struct {
 	q  sqlcgen.Querier
 }
 
-// NewRepository creates a new repository instance
+// NewRepository creates a new repository instance. Its queries run in the
+// transaction of their context when the transaction manager started one.
 func NewRepository(db *sql.DB) *Repository {
 	return &Repository{
 		db: db,
-		q:  sqlcgen.New(db),
+		q:  sqlcgen.New(conn{db: db}),
 	}
 }
 
@@ -34,8 +35,13 @@ func (r *Repository) DB() *sql.DB {
 	return r.db
 }
 
-// ExecTx executes a function within a database transaction
+// ExecTx executes a function within a database transaction. Inside a
+// transaction of the transaction manager it joins that transaction instead.
 func (r *Repository) ExecTx(ctx context.Context, fn func(*Repository) error) error {
+	if _, ok := GetTx(ctx); ok {
+		return fn(r)
+	}
+
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
@@ -51,4 +57,33 @@ func (r *Repository) ExecTx(ctx context.Context, fn func(*Repository) error) err
 	}
 
 	return tx.Commit()
-}
\ No newline at end of file
+}
+
+// conn runs queries in the transaction of the context, or on the database
+// when there is none
+type conn struct {
+	db *sql.DB
+}
+
+func (c conn) dbtx(ctx context.Context) sqlcgen.DBTX {
+	if tx, ok := GetTx(ctx); ok {
+		return tx
+	}
+	return c.db
+}
+
+func (c conn) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return c.dbtx(ctx).ExecContext(ctx, query, args...)
+}
+
+func (c conn) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return c.dbtx(ctx).PrepareContext(ctx, query)
+}
+
+func (c conn) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return c.dbtx(ctx).QueryContext(ctx, query, args...)
+}
+
+func (c conn) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return c.dbtx(ctx).QueryRowContext(ctx, query, args...)
+}
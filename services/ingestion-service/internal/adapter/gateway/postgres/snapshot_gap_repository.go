@@ -0,0 +1,62 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/postgres/sqlcgen"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
+	"github.com/google/uuid"
+)
+
+// snapshotGapRepository implements gateway.SnapshotGapRepository interface
+type snapshotGapRepository struct {
+	*Repository
+}
+
+// NewSnapshotGapRepository creates a new snapshot gap repository
+func NewSnapshotGapRepository(repo *Repository) gateway.SnapshotGapRepository {
+	return &snapshotGapRepository{Repository: repo}
+}
+
+// Save records a snapshot gap; recording the same gap twice is a no-op
+func (r *snapshotGapRepository) Save(ctx context.Context, gap *domain.SnapshotGap) error {
+	videoID, err := uuid.Parse(string(gap.VideoID))
+	if err != nil {
+		return err
+	}
+
+	return r.q.CreateSnapshotGap(ctx, sqlcgen.CreateSnapshotGapParams{
+		VideoID:        videoID,
+		CheckpointHour: int32(gap.CheckpointHour),
+		DueAt:          gap.DueAt,
+		Reason:         string(gap.Reason),
+		DetectedAt:     gap.DetectedAt,
+	})
+}
+
+// ListByVideo lists the snapshot gaps of a video
+func (r *snapshotGapRepository) ListByVideo(ctx context.Context, videoID valueobject.UUID) ([]*domain.SnapshotGap, error) {
+	uid, err := uuid.Parse(string(videoID))
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.q.ListSnapshotGapsByVideo(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	gaps := make([]*domain.SnapshotGap, len(rows))
+	for i, row := range rows {
+		gaps[i] = &domain.SnapshotGap{
+			VideoID:        valueobject.UUID(row.VideoID.String()),
+			CheckpointHour: valueobject.CheckpointHour(row.CheckpointHour),
+			DueAt:          row.DueAt,
+			Reason:         domain.GapReason(row.Reason),
+			DetectedAt:     row.DetectedAt,
+		}
+	}
+	return gaps, nil
+}
@@ -6,6 +6,7 @@ package sqlcgen
 
 import (
 	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -14,7 +15,7 @@ import (
 
 type IngestionAuditLog struct {
 	ID           uuid.UUID             `json:"id"`
-	ActorID      uuid.UUID             `json:"actor_id"`
+	ActorID      string                `json:"actor_id"`
 	ActorEmail   string                `json:"actor_email"`
 	Action       string                `json:"action"`
 	ResourceType string                `json:"resource_type"`
@@ -27,15 +28,29 @@ type IngestionAuditLog struct {
 }
 
 type IngestionBatchJob struct {
-	ID           uuid.UUID             `json:"id"`
-	JobType      string                `json:"job_type"`
-	Status       string                `json:"status"`
-	Parameters   pqtype.NullRawMessage `json:"parameters"`
-	StartedAt    sql.NullTime          `json:"started_at"`
-	CompletedAt  sql.NullTime          `json:"completed_at"`
-	ErrorMessage sql.NullString        `json:"error_message"`
-	Statistics   pqtype.NullRawMessage `json:"statistics"`
-	CreatedAt    time.Time             `json:"created_at"`
+	ID                uuid.UUID             `json:"id"`
+	JobType           string                `json:"job_type"`
+	Status            string                `json:"status"`
+	Parameters        pqtype.NullRawMessage `json:"parameters"`
+	StartedAt         sql.NullTime          `json:"started_at"`
+	CompletedAt       sql.NullTime          `json:"completed_at"`
+	ErrorMessage      sql.NullString        `json:"error_message"`
+	Statistics        pqtype.NullRawMessage `json:"statistics"`
+	CreatedAt         time.Time             `json:"created_at"`
+	CancelRequestedAt sql.NullTime          `json:"cancel_requested_at"`
+	RetryOf           uuid.NullUUID         `json:"retry_of"`
+	ProgressProcessed int32                 `json:"progress_processed"`
+	ProgressTotal     int32                 `json:"progress_total"`
+	ProgressCurrent   sql.NullString        `json:"progress_current"`
+}
+
+type IngestionBatchJobLock struct {
+	LockKey     string    `json:"lock_key"`
+	JobID       uuid.UUID `json:"job_id"`
+	Holder      string    `json:"holder"`
+	AcquiredAt  time.Time `json:"acquired_at"`
+	HeartbeatAt time.Time `json:"heartbeat_at"`
+	ExpiresAt   time.Time `json:"expires_at"`
 }
 
 type IngestionChannel struct {
@@ -65,16 +80,35 @@ type IngestionChannelSnapshot struct {
 	UpdatedAt         time.Time     `json:"updated_at"`
 }
 
+type IngestionCheckpointHour struct {
+	Hour      int32     `json:"hour"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type IngestionCheckpointProfile struct {
+	ID        uuid.UUID `json:"id"`
+	Code      string    `json:"code"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type IngestionCheckpointProfileHour struct {
+	ProfileID      uuid.UUID `json:"profile_id"`
+	CheckpointHour int32     `json:"checkpoint_hour"`
+}
+
 type IngestionGenre struct {
-	ID          uuid.UUID `json:"id"`
-	Code        string    `json:"code"`
-	Name        string    `json:"name"`
-	Language    string    `json:"language"`
-	RegionCode  string    `json:"region_code"`
-	CategoryIds []int32   `json:"category_ids"`
-	Enabled     bool      `json:"enabled"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID                  uuid.UUID     `json:"id"`
+	Code                string        `json:"code"`
+	Name                string        `json:"name"`
+	Language            string        `json:"language"`
+	RegionCode          string        `json:"region_code"`
+	CategoryIds         []int32       `json:"category_ids"`
+	Enabled             bool          `json:"enabled"`
+	CreatedAt           time.Time     `json:"created_at"`
+	UpdatedAt           time.Time     `json:"updated_at"`
+	CheckpointProfileID uuid.NullUUID `json:"checkpoint_profile_id"`
 }
 
 type IngestionKeyword struct {
@@ -91,6 +125,54 @@ type IngestionKeyword struct {
 	TargetField string         `json:"target_field"`
 }
 
+type IngestionKeywordGroup struct {
+	ID            uuid.UUID      `json:"id"`
+	GenreID       uuid.UUID      `json:"genre_id"`
+	Name          string         `json:"name"`
+	FilterType    string         `json:"filter_type"`
+	TargetField   string         `json:"target_field"`
+	Enabled       sql.NullBool   `json:"enabled"`
+	Description   sql.NullString `json:"description"`
+	CreatedAt     sql.NullTime   `json:"created_at"`
+	UpdatedAt     sql.NullTime   `json:"updated_at"`
+	DeletedAt     sql.NullTime   `json:"deleted_at"`
+	CrossLanguage bool           `json:"cross_language"`
+	Romaji        bool           `json:"romaji"`
+}
+
+type IngestionKeywordItem struct {
+	ID             uuid.UUID    `json:"id"`
+	KeywordGroupID uuid.UUID    `json:"keyword_group_id"`
+	Keyword        string       `json:"keyword"`
+	CreatedAt      sql.NullTime `json:"created_at"`
+	UpdatedAt      sql.NullTime `json:"updated_at"`
+}
+
+type IngestionKeywordSynonym struct {
+	ID            uuid.UUID    `json:"id"`
+	Language      string       `json:"language"`
+	Term          string       `json:"term"`
+	Alias         string       `json:"alias"`
+	AliasLanguage string       `json:"alias_language"`
+	Bidirectional bool         `json:"bidirectional"`
+	CreatedAt     time.Time    `json:"created_at"`
+	UpdatedAt     sql.NullTime `json:"updated_at"`
+}
+
+type IngestionKeywordSynonymVersion struct {
+	Singleton bool      `json:"singleton"`
+	Version   int64     `json:"version"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type IngestionSnapshotGap struct {
+	VideoID        uuid.UUID `json:"video_id"`
+	CheckpointHour int32     `json:"checkpoint_hour"`
+	DueAt          time.Time `json:"due_at"`
+	Reason         string    `json:"reason"`
+	DetectedAt     time.Time `json:"detected_at"`
+}
+
 type IngestionSnapshotTask struct {
 	VideoID        uuid.UUID `json:"video_id"`
 	CheckpointHour int32     `json:"checkpoint_hour"`
@@ -98,36 +180,41 @@ type IngestionSnapshotTask struct {
 }
 
 type IngestionVideo struct {
-	ID               uuid.UUID    `json:"id"`
-	YoutubeVideoID   string       `json:"youtube_video_id"`
-	ChannelID        uuid.UUID    `json:"channel_id"`
-	YoutubeChannelID string       `json:"youtube_channel_id"`
-	Title            string       `json:"title"`
-	PublishedAt      time.Time    `json:"published_at"`
-	CategoryID       int32        `json:"category_id"`
-	CreatedAt        sql.NullTime `json:"created_at"`
-	UpdatedAt        sql.NullTime `json:"updated_at"`
-	DeletedAt        sql.NullTime `json:"deleted_at"`
+	ID               uuid.UUID     `json:"id"`
+	YoutubeVideoID   string        `json:"youtube_video_id"`
+	ChannelID        uuid.UUID     `json:"channel_id"`
+	YoutubeChannelID string        `json:"youtube_channel_id"`
+	Title            string        `json:"title"`
+	PublishedAt      time.Time     `json:"published_at"`
+	CategoryID       int32         `json:"category_id"`
+	CreatedAt        sql.NullTime  `json:"created_at"`
+	UpdatedAt        sql.NullTime  `json:"updated_at"`
+	DeletedAt        sql.NullTime  `json:"deleted_at"`
+	DurationSeconds  sql.NullInt32 `json:"duration_seconds"`
 }
 
 type IngestionVideoGenre struct {
-	ID        uuid.UUID `json:"id"`
-	VideoID   uuid.UUID `json:"video_id"`
-	GenreID   uuid.UUID `json:"genre_id"`
-	CreatedAt time.Time `json:"created_at"`
+	ID              uuid.UUID             `json:"id"`
+	VideoID         uuid.UUID             `json:"video_id"`
+	GenreID         uuid.UUID             `json:"genre_id"`
+	CreatedAt       time.Time             `json:"created_at"`
+	MatchProvenance pqtype.NullRawMessage `json:"match_provenance"`
 }
 
 type IngestionVideoSnapshot struct {
-	ID                uuid.UUID    `json:"id"`
-	VideoID           uuid.UUID    `json:"video_id"`
-	CheckpointHour    int32        `json:"checkpoint_hour"`
-	MeasuredAt        time.Time    `json:"measured_at"`
-	ViewCount         int64        `json:"view_count"`
-	LikeCount         int64        `json:"like_count"`
-	SubscriptionCount int64        `json:"subscription_count"`
-	Source            string       `json:"source"`
-	CreatedAt         sql.NullTime `json:"created_at"`
-	UpdatedAt         time.Time    `json:"updated_at"`
+	ID                uuid.UUID       `json:"id"`
+	VideoID           uuid.UUID       `json:"video_id"`
+	CheckpointHour    int32           `json:"checkpoint_hour"`
+	MeasuredAt        time.Time       `json:"measured_at"`
+	ViewCount         int64           `json:"view_count"`
+	LikeCount         int64           `json:"like_count"`
+	SubscriptionCount int64           `json:"subscription_count"`
+	Source            string          `json:"source"`
+	CreatedAt         sql.NullTime    `json:"created_at"`
+	UpdatedAt         time.Time       `json:"updated_at"`
+	DriftSeconds      int32           `json:"drift_seconds"`
+	CommentCount      sql.NullInt64   `json:"comment_count"`
+	Metrics           json.RawMessage `json:"metrics"`
 }
 
 type IngestionYoutubeCategory struct {
@@ -6,16 +6,28 @@ package sqlcgen
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type Querier interface {
+	// Takes the lease when it is free or its holder stopped heartbeating
+	AcquireBatchJobLock(ctx context.Context, arg AcquireBatchJobLockParams) (int64, error)
 	CheckVideoExists(ctx context.Context, youtubeVideoID string) (bool, error)
 	CheckVideoGenreExists(ctx context.Context, arg CheckVideoGenreExistsParams) (bool, error)
-	CountChannels(ctx context.Context) (int64, error)
+	CountGenres(ctx context.Context, enabledOnly bool) (int64, error)
+	CountKeywordGroupsByGenre(ctx context.Context, arg CountKeywordGroupsByGenreParams) (int64, error)
+	CountKeywordSynonyms(ctx context.Context, language sql.NullString) (int64, error)
+	CountSearchAuditLogs(ctx context.Context, arg CountSearchAuditLogsParams) (int64, error)
+	CountSearchBatchJobs(ctx context.Context, arg CountSearchBatchJobsParams) (int64, error)
+	CountSearchChannels(ctx context.Context, arg CountSearchChannelsParams) (int64, error)
+	CountSearchKeywords(ctx context.Context, arg CountSearchKeywordsParams) (int64, error)
+	CountSearchVideos(ctx context.Context, arg CountSearchVideosParams) (int64, error)
+	CountVideoGenresByVideo(ctx context.Context, videoID uuid.UUID) (int64, error)
 	CountVideosByChannel(ctx context.Context, channelID uuid.UUID) (int64, error)
+	CountYouTubeCategories(ctx context.Context, assignableOnly bool) (int64, error)
 	// Audit Log queries
 	CreateAuditLog(ctx context.Context, arg CreateAuditLogParams) error
 	// Batch Job queries
@@ -25,6 +37,13 @@ type Querier interface {
 	// Genre queries
 	CreateGenre(ctx context.Context, arg CreateGenreParams) error
 	CreateKeyword(ctx context.Context, arg CreateKeywordParams) error
+	// Keyword group queries
+	CreateKeywordGroup(ctx context.Context, arg CreateKeywordGroupParams) error
+	CreateKeywordItem(ctx context.Context, arg CreateKeywordItemParams) error
+	// Keyword synonym queries
+	CreateKeywordSynonym(ctx context.Context, arg CreateKeywordSynonymParams) error
+	// Snapshot gap queries
+	CreateSnapshotGap(ctx context.Context, arg CreateSnapshotGapParams) error
 	CreateSnapshotTask(ctx context.Context, arg CreateSnapshotTaskParams) error
 	CreateVideo(ctx context.Context, arg CreateVideoParams) error
 	// Video Genre queries
@@ -32,48 +51,115 @@ type Querier interface {
 	CreateVideoSnapshot(ctx context.Context, arg CreateVideoSnapshotParams) error
 	// YouTube Category queries
 	CreateYouTubeCategory(ctx context.Context, arg CreateYouTubeCategoryParams) error
+	// Deletes up to batch_size of the oldest audit logs created before the cutoff
+	DeleteAuditLogsBefore(ctx context.Context, arg DeleteAuditLogsBeforeParams) (int64, error)
+	DeleteKeywordItemsByGroup(ctx context.Context, keywordGroupID uuid.UUID) error
+	DeleteKeywordSynonym(ctx context.Context, id uuid.UUID) (int64, error)
 	DeleteSnapshotTask(ctx context.Context, arg DeleteSnapshotTaskParams) error
 	DeleteVideoGenresByGenre(ctx context.Context, genreID uuid.UUID) error
 	DeleteVideoGenresByVideo(ctx context.Context, videoID uuid.UUID) error
+	GetAuditLogByID(ctx context.Context, id uuid.UUID) (IngestionAuditLog, error)
 	GetBatchJobByID(ctx context.Context, id uuid.UUID) (IngestionBatchJob, error)
+	GetBatchJobLock(ctx context.Context, lockKey string) (IngestionBatchJobLock, error)
 	GetChannelByID(ctx context.Context, id uuid.UUID) (GetChannelByIDRow, error)
 	GetChannelByYouTubeID(ctx context.Context, youtubeChannelID string) (GetChannelByYouTubeIDRow, error)
+	GetCheckpointProfileByCode(ctx context.Context, code string) (IngestionCheckpointProfile, error)
+	GetCheckpointProfileByID(ctx context.Context, id uuid.UUID) (IngestionCheckpointProfile, error)
 	GetGenreByCode(ctx context.Context, code string) (IngestionGenre, error)
 	GetGenreByID(ctx context.Context, id uuid.UUID) (IngestionGenre, error)
 	GetKeywordByID(ctx context.Context, id uuid.UUID) (GetKeywordByIDRow, error)
+	GetKeywordGroupByID(ctx context.Context, id uuid.UUID) (IngestionKeywordGroup, error)
+	GetKeywordSynonymByID(ctx context.Context, id uuid.UUID) (IngestionKeywordSynonym, error)
+	GetKeywordSynonymVersion(ctx context.Context) (int64, error)
 	GetLatestChannelSnapshot(ctx context.Context, channelID uuid.UUID) (GetLatestChannelSnapshotRow, error)
+	// Snapshot of the channel measured closest to at, the later one on a tie. The
+	// latest snapshot at or before at and the earliest one after it are each a
+	// single probe of the (channel_id, measured_at) index behind
+	// channel_snapshots_channel_measured_unique; the closer of the two is kept.
+	GetNearestChannelSnapshot(ctx context.Context, arg GetNearestChannelSnapshotParams) (GetNearestChannelSnapshotRow, error)
 	GetPendingSnapshotTasks(ctx context.Context, arg GetPendingSnapshotTasksParams) ([]IngestionSnapshotTask, error)
 	GetVideoByID(ctx context.Context, id uuid.UUID) (GetVideoByIDRow, error)
 	GetVideoByYouTubeID(ctx context.Context, youtubeVideoID string) (GetVideoByYouTubeIDRow, error)
 	GetVideoSnapshotByVideoAndCheckpoint(ctx context.Context, arg GetVideoSnapshotByVideoAndCheckpointParams) (IngestionVideoSnapshot, error)
 	GetYouTubeCategoryByID(ctx context.Context, id int32) (IngestionYoutubeCategory, error)
+	// Extends the lease while it is still held by the job
+	HeartbeatBatchJobLock(ctx context.Context, arg HeartbeatBatchJobLockParams) (int64, error)
 	ListActiveChannels(ctx context.Context) ([]ListActiveChannelsRow, error)
+	// Profiles assigned to enabled genres
+	ListActiveCheckpointProfiles(ctx context.Context) ([]IngestionCheckpointProfile, error)
 	ListActiveVideos(ctx context.Context, publishedAt time.Time) ([]ListActiveVideosRow, error)
 	ListAssignableYouTubeCategories(ctx context.Context) ([]IngestionYoutubeCategory, error)
-	ListAuditLogsByActor(ctx context.Context, arg ListAuditLogsByActorParams) ([]IngestionAuditLog, error)
-	ListAuditLogsByResource(ctx context.Context, arg ListAuditLogsByResourceParams) ([]IngestionAuditLog, error)
+	ListBatchJobLocksByJob(ctx context.Context, jobID uuid.UUID) ([]IngestionBatchJobLock, error)
 	ListBatchJobsByTypeAndStatus(ctx context.Context, arg ListBatchJobsByTypeAndStatusParams) ([]IngestionBatchJob, error)
 	ListChannelSnapshots(ctx context.Context, arg ListChannelSnapshotsParams) ([]ListChannelSnapshotsRow, error)
-	ListChannels(ctx context.Context, arg ListChannelsParams) ([]ListChannelsRow, error)
+	ListCheckpointProfileHours(ctx context.Context, profileID uuid.UUID) ([]int32, error)
+	ListCheckpointProfilesByVideo(ctx context.Context, videoID uuid.UUID) ([]IngestionCheckpointProfile, error)
 	ListEnabledGenres(ctx context.Context) ([]IngestionGenre, error)
 	ListEnabledKeywords(ctx context.Context) ([]ListEnabledKeywordsRow, error)
 	ListGenres(ctx context.Context) ([]IngestionGenre, error)
+	ListKeywordGroups(ctx context.Context, arg ListKeywordGroupsParams) ([]IngestionKeywordGroup, error)
+	ListKeywordGroupsByEnabled(ctx context.Context, arg ListKeywordGroupsByEnabledParams) ([]IngestionKeywordGroup, error)
+	ListKeywordGroupsByGenre(ctx context.Context, genreID uuid.UUID) ([]IngestionKeywordGroup, error)
+	// Items of the given groups, ordered by keyword within each group
+	ListKeywordItemsByGroups(ctx context.Context, groupIds []uuid.UUID) ([]IngestionKeywordItem, error)
+	// Entries of a language, or of all languages when language is NULL
+	ListKeywordSynonyms(ctx context.Context, language sql.NullString) ([]IngestionKeywordSynonym, error)
 	ListKeywordsByGenre(ctx context.Context, arg ListKeywordsByGenreParams) ([]ListKeywordsByGenreRow, error)
 	ListKeywordsByGenreAndType(ctx context.Context, arg ListKeywordsByGenreAndTypeParams) ([]ListKeywordsByGenreAndTypeRow, error)
-	ListRecentAuditLogs(ctx context.Context, limit int32) ([]IngestionAuditLog, error)
-	ListRecentBatchJobs(ctx context.Context, arg ListRecentBatchJobsParams) ([]IngestionBatchJob, error)
 	ListRunningBatchJobs(ctx context.Context) ([]IngestionBatchJob, error)
+	ListSnapshotGapsByVideo(ctx context.Context, videoID uuid.UUID) ([]IngestionSnapshotGap, error)
 	ListSubscribedChannels(ctx context.Context) ([]ListSubscribedChannelsRow, error)
 	ListVideoGenresByGenre(ctx context.Context, genreID uuid.UUID) ([]IngestionVideoGenre, error)
 	ListVideoGenresByVideo(ctx context.Context, videoID uuid.UUID) ([]IngestionVideoGenre, error)
 	ListVideoSnapshots(ctx context.Context, videoID uuid.UUID) ([]IngestionVideoSnapshot, error)
 	ListVideosByChannel(ctx context.Context, arg ListVideosByChannelParams) ([]ListVideosByChannelRow, error)
 	ListYouTubeCategories(ctx context.Context) ([]IngestionYoutubeCategory, error)
+	ReleaseBatchJobLock(ctx context.Context, arg ReleaseBatchJobLockParams) error
+	RequestBatchJobCancel(ctx context.Context, id uuid.UUID) error
+	// Records progress of a running job and returns whether cancellation was requested
+	SaveBatchJobProgress(ctx context.Context, arg SaveBatchJobProgressParams) (sql.NullTime, error)
+	// Keyset page of audit logs matching the filters, ordered by (created_at, id) descending
+	SearchAuditLogs(ctx context.Context, arg SearchAuditLogsParams) ([]IngestionAuditLog, error)
+	// Keyset page of batch jobs matching the filters, ordered by (created_at, id) descending
+	SearchBatchJobs(ctx context.Context, arg SearchBatchJobsParams) ([]IngestionBatchJob, error)
+	// Keyset page of channels matching the filters, ordered by (created_at, id) descending
+	SearchChannels(ctx context.Context, arg SearchChannelsParams) ([]SearchChannelsRow, error)
+	// Keyset page of genres ordered by code
+	SearchGenres(ctx context.Context, arg SearchGenresParams) ([]IngestionGenre, error)
+	// Keyset page of the keyword groups of a genre, ordered by (name, id)
+	SearchKeywordGroupsByGenre(ctx context.Context, arg SearchKeywordGroupsByGenreParams) ([]IngestionKeywordGroup, error)
+	// Keyset page of the entries of a language, or of all languages when language
+	// is NULL, ordered by (language, lower(term), lower(alias), id)
+	SearchKeywordSynonyms(ctx context.Context, arg SearchKeywordSynonymsParams) ([]IngestionKeywordSynonym, error)
+	// Keyset page of keywords matching the filters, ordered by (name, id)
+	SearchKeywords(ctx context.Context, arg SearchKeywordsParams) ([]SearchKeywordsRow, error)
+	// Keyset page of the genre assignments of a video, ordered by (created_at, id)
+	SearchVideoGenresByVideo(ctx context.Context, arg SearchVideoGenresByVideoParams) ([]IngestionVideoGenre, error)
+	// Keyset page of snapshots matching the filters, ordered by (measured_at, id)
+	SearchVideoSnapshots(ctx context.Context, arg SearchVideoSnapshotsParams) ([]IngestionVideoSnapshot, error)
+	// Keyset page of videos matching the filters, ordered by (created_at, id) descending
+	SearchVideosByCreatedAt(ctx context.Context, arg SearchVideosByCreatedAtParams) ([]SearchVideosByCreatedAtRow, error)
+	// Keyset page of videos matching the filters, ordered by (created_at, id) ascending
+	SearchVideosByCreatedAtAsc(ctx context.Context, arg SearchVideosByCreatedAtAscParams) ([]SearchVideosByCreatedAtAscRow, error)
+	// Keyset page of videos matching the filters, ordered by (published_at, id) descending
+	SearchVideosByPublishedAt(ctx context.Context, arg SearchVideosByPublishedAtParams) ([]SearchVideosByPublishedAtRow, error)
+	// Keyset page of videos matching the filters, ordered by (published_at, id) ascending
+	SearchVideosByPublishedAtAsc(ctx context.Context, arg SearchVideosByPublishedAtAscParams) ([]SearchVideosByPublishedAtAscRow, error)
+	// Keyset page of the videos published since published_from that belong to the
+	// genre, or are in its categories and were assigned to a genre of the same
+	// language and region or come from a channel based in the region. Ordered by
+	// (published_at, id) descending.
+	SearchVideosInGenreScope(ctx context.Context, arg SearchVideosInGenreScopeParams) ([]SearchVideosInGenreScopeRow, error)
+	// Keyset page of YouTube categories ordered by id
+	SearchYouTubeCategories(ctx context.Context, arg SearchYouTubeCategoriesParams) ([]IngestionYoutubeCategory, error)
 	SoftDeleteKeyword(ctx context.Context, arg SoftDeleteKeywordParams) error
+	SoftDeleteKeywordGroup(ctx context.Context, arg SoftDeleteKeywordGroupParams) (int64, error)
 	UpdateBatchJob(ctx context.Context, arg UpdateBatchJobParams) error
 	UpdateChannel(ctx context.Context, arg UpdateChannelParams) error
 	UpdateGenre(ctx context.Context, arg UpdateGenreParams) error
 	UpdateKeyword(ctx context.Context, arg UpdateKeywordParams) error
+	UpdateKeywordGroup(ctx context.Context, arg UpdateKeywordGroupParams) (int64, error)
+	UpdateKeywordSynonym(ctx context.Context, arg UpdateKeywordSynonymParams) (int64, error)
 	UpdateYouTubeCategory(ctx context.Context, arg UpdateYouTubeCategoryParams) error
 }
 
@@ -8,6 +8,7 @@ package sqlcgen
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -15,6 +16,40 @@ import (
 	"github.com/sqlc-dev/pqtype"
 )
 
+const acquireBatchJobLock = `-- name: AcquireBatchJobLock :execrows
+INSERT INTO ingestion.batch_job_locks (
+    lock_key, job_id, holder, acquired_at, heartbeat_at, expires_at
+) VALUES (
+    $1, $2, $3, now(), now(),
+    now() + make_interval(secs => $4::float8)
+)
+ON CONFLICT (lock_key) DO UPDATE
+SET job_id = EXCLUDED.job_id, holder = EXCLUDED.holder, acquired_at = EXCLUDED.acquired_at,
+    heartbeat_at = EXCLUDED.heartbeat_at, expires_at = EXCLUDED.expires_at
+WHERE ingestion.batch_job_locks.expires_at < now()
+`
+
+type AcquireBatchJobLockParams struct {
+	LockKey    string    `json:"lock_key"`
+	JobID      uuid.UUID `json:"job_id"`
+	Holder     string    `json:"holder"`
+	TtlSeconds float64   `json:"ttl_seconds"`
+}
+
+// Takes the lease when it is free or its holder stopped heartbeating
+func (q *Queries) AcquireBatchJobLock(ctx context.Context, arg AcquireBatchJobLockParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, acquireBatchJobLock,
+		arg.LockKey,
+		arg.JobID,
+		arg.Holder,
+		arg.TtlSeconds,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const checkVideoExists = `-- name: CheckVideoExists :one
 SELECT EXISTS(
     SELECT 1 FROM ingestion.videos 
@@ -48,12 +83,193 @@ func (q *Queries) CheckVideoGenreExists(ctx context.Context, arg CheckVideoGenre
 	return exists, err
 }
 
-const countChannels = `-- name: CountChannels :one
-SELECT COUNT(*) FROM ingestion.channels WHERE deleted_at IS NULL
+const countGenres = `-- name: CountGenres :one
+SELECT COUNT(*) FROM ingestion.genres
+WHERE (NOT $1::boolean OR enabled = true)
+`
+
+func (q *Queries) CountGenres(ctx context.Context, enabledOnly bool) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countGenres, enabledOnly)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countKeywordGroupsByGenre = `-- name: CountKeywordGroupsByGenre :one
+SELECT COUNT(*) FROM ingestion.keyword_groups
+WHERE genre_id = $1 AND deleted_at IS NULL
+  AND (NOT $2::boolean OR enabled = true)
+`
+
+type CountKeywordGroupsByGenreParams struct {
+	GenreID     uuid.UUID `json:"genre_id"`
+	EnabledOnly bool      `json:"enabled_only"`
+}
+
+func (q *Queries) CountKeywordGroupsByGenre(ctx context.Context, arg CountKeywordGroupsByGenreParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countKeywordGroupsByGenre, arg.GenreID, arg.EnabledOnly)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countKeywordSynonyms = `-- name: CountKeywordSynonyms :one
+SELECT COUNT(*) FROM ingestion.keyword_synonyms
+WHERE $1::varchar IS NULL OR language = $1
+`
+
+func (q *Queries) CountKeywordSynonyms(ctx context.Context, language sql.NullString) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countKeywordSynonyms, language)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countSearchAuditLogs = `-- name: CountSearchAuditLogs :one
+SELECT COUNT(*) FROM ingestion.audit_logs
+WHERE ($1::text IS NULL OR actor_id = $1)
+  AND ($2::text IS NULL OR action = $2)
+  AND ($3::text IS NULL OR resource_type = $3)
+  AND ($4::text IS NULL OR resource_id = $4)
+  AND ($5::text IS NULL
+       OR old_values ? $5 OR new_values ? $5)
+  AND ($6::timestamptz IS NULL OR created_at >= $6)
+  AND ($7::timestamptz IS NULL OR created_at < $7)
+`
+
+type CountSearchAuditLogsParams struct {
+	ActorID       sql.NullString `json:"actor_id"`
+	Action        sql.NullString `json:"action"`
+	ResourceType  sql.NullString `json:"resource_type"`
+	ResourceID    sql.NullString `json:"resource_id"`
+	ChangedField  sql.NullString `json:"changed_field"`
+	CreatedAfter  sql.NullTime   `json:"created_after"`
+	CreatedBefore sql.NullTime   `json:"created_before"`
+}
+
+func (q *Queries) CountSearchAuditLogs(ctx context.Context, arg CountSearchAuditLogsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countSearchAuditLogs,
+		arg.ActorID,
+		arg.Action,
+		arg.ResourceType,
+		arg.ResourceID,
+		arg.ChangedField,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countSearchBatchJobs = `-- name: CountSearchBatchJobs :one
+SELECT COUNT(*) FROM ingestion.batch_jobs
+WHERE ($1::text IS NULL OR job_type = $1)
+  AND ($2::text IS NULL OR status = $2)
+`
+
+type CountSearchBatchJobsParams struct {
+	JobType sql.NullString `json:"job_type"`
+	Status  sql.NullString `json:"status"`
+}
+
+func (q *Queries) CountSearchBatchJobs(ctx context.Context, arg CountSearchBatchJobsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countSearchBatchJobs, arg.JobType, arg.Status)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countSearchChannels = `-- name: CountSearchChannels :one
+SELECT COUNT(*) FROM ingestion.channels
+WHERE deleted_at IS NULL
+  AND ($1::boolean IS NULL OR subscribed = $1)
+  AND ($2::text IS NULL OR title ILIKE '%' || $2 || '%' ESCAPE '\')
+`
+
+type CountSearchChannelsParams struct {
+	Subscribed sql.NullBool   `json:"subscribed"`
+	Query      sql.NullString `json:"query"`
+}
+
+func (q *Queries) CountSearchChannels(ctx context.Context, arg CountSearchChannelsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countSearchChannels, arg.Subscribed, arg.Query)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countSearchKeywords = `-- name: CountSearchKeywords :one
+SELECT COUNT(*) FROM ingestion.keywords
+WHERE deleted_at IS NULL
+  AND ($1::uuid IS NULL OR genre_id = $1)
+  AND (NOT $2::boolean OR enabled = true)
+  AND ($3::text IS NULL OR name ILIKE '%' || $3 || '%' ESCAPE '\')
+`
+
+type CountSearchKeywordsParams struct {
+	GenreID     uuid.NullUUID  `json:"genre_id"`
+	EnabledOnly bool           `json:"enabled_only"`
+	Query       sql.NullString `json:"query"`
+}
+
+func (q *Queries) CountSearchKeywords(ctx context.Context, arg CountSearchKeywordsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countSearchKeywords, arg.GenreID, arg.EnabledOnly, arg.Query)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countSearchVideos = `-- name: CountSearchVideos :one
+SELECT COUNT(*) FROM ingestion.videos v
+WHERE v.deleted_at IS NULL
+  AND ($1::uuid IS NULL OR v.channel_id = $1)
+  AND ($2::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM ingestion.video_genres vg
+      WHERE vg.video_id = v.id AND vg.genre_id = $2
+  ))
+  AND ($3::integer IS NULL OR v.category_id = $3)
+  AND ($4::timestamptz IS NULL OR v.published_at >= $4)
+  AND ($5::timestamptz IS NULL OR v.published_at < $5)
+  AND ($6::boolean IS NULL
+       OR (v.duration_seconds IS NOT NULL AND v.duration_seconds <= $7::integer) = $6)
+  AND ($8::text IS NULL OR v.title ILIKE '%' || $8 || '%' ESCAPE '\')
+`
+
+type CountSearchVideosParams struct {
+	ChannelID        uuid.NullUUID  `json:"channel_id"`
+	GenreID          uuid.NullUUID  `json:"genre_id"`
+	CategoryID       sql.NullInt32  `json:"category_id"`
+	PublishedFrom    sql.NullTime   `json:"published_from"`
+	PublishedTo      sql.NullTime   `json:"published_to"`
+	Shorts           sql.NullBool   `json:"shorts"`
+	ShortsMaxSeconds int32          `json:"shorts_max_seconds"`
+	Query            sql.NullString `json:"query"`
+}
+
+func (q *Queries) CountSearchVideos(ctx context.Context, arg CountSearchVideosParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countSearchVideos,
+		arg.ChannelID,
+		arg.GenreID,
+		arg.CategoryID,
+		arg.PublishedFrom,
+		arg.PublishedTo,
+		arg.Shorts,
+		arg.ShortsMaxSeconds,
+		arg.Query,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countVideoGenresByVideo = `-- name: CountVideoGenresByVideo :one
+SELECT COUNT(*) FROM ingestion.video_genres
+WHERE video_id = $1
 `
 
-func (q *Queries) CountChannels(ctx context.Context) (int64, error) {
-	row := q.db.QueryRowContext(ctx, countChannels)
+func (q *Queries) CountVideoGenresByVideo(ctx context.Context, videoID uuid.UUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countVideoGenresByVideo, videoID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -71,6 +287,18 @@ func (q *Queries) CountVideosByChannel(ctx context.Context, channelID uuid.UUID)
 	return count, err
 }
 
+const countYouTubeCategories = `-- name: CountYouTubeCategories :one
+SELECT COUNT(*) FROM ingestion.youtube_categories
+WHERE (NOT $1::boolean OR assignable = true)
+`
+
+func (q *Queries) CountYouTubeCategories(ctx context.Context, assignableOnly bool) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countYouTubeCategories, assignableOnly)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createAuditLog = `-- name: CreateAuditLog :exec
 INSERT INTO ingestion.audit_logs (
     id, actor_id, actor_email, action, resource_type, resource_id,
@@ -80,7 +308,7 @@ INSERT INTO ingestion.audit_logs (
 
 type CreateAuditLogParams struct {
 	ID           uuid.UUID             `json:"id"`
-	ActorID      uuid.UUID             `json:"actor_id"`
+	ActorID      string                `json:"actor_id"`
 	ActorEmail   string                `json:"actor_email"`
 	Action       string                `json:"action"`
 	ResourceType string                `json:"resource_type"`
@@ -112,8 +340,8 @@ func (q *Queries) CreateAuditLog(ctx context.Context, arg CreateAuditLogParams)
 
 const createBatchJob = `-- name: CreateBatchJob :exec
 INSERT INTO ingestion.batch_jobs (
-    id, job_type, status, parameters, created_at
-) VALUES ($1, $2, $3, $4, $5)
+    id, job_type, status, parameters, created_at, retry_of
+) VALUES ($1, $2, $3, $4, $5, $6)
 `
 
 type CreateBatchJobParams struct {
@@ -122,6 +350,7 @@ type CreateBatchJobParams struct {
 	Status     string                `json:"status"`
 	Parameters pqtype.NullRawMessage `json:"parameters"`
 	CreatedAt  time.Time             `json:"created_at"`
+	RetryOf    uuid.NullUUID         `json:"retry_of"`
 }
 
 // Batch Job queries
@@ -132,6 +361,7 @@ func (q *Queries) CreateBatchJob(ctx context.Context, arg CreateBatchJobParams)
 		arg.Status,
 		arg.Parameters,
 		arg.CreatedAt,
+		arg.RetryOf,
 	)
 	return err
 }
@@ -207,20 +437,21 @@ func (q *Queries) CreateChannelSnapshot(ctx context.Context, arg CreateChannelSn
 
 const createGenre = `-- name: CreateGenre :exec
 INSERT INTO ingestion.genres (
-    id, code, name, language, region_code, category_ids, enabled, created_at, updated_at
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+    id, code, name, language, region_code, category_ids, enabled, created_at, updated_at, checkpoint_profile_id
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 `
 
 type CreateGenreParams struct {
-	ID          uuid.UUID `json:"id"`
-	Code        string    `json:"code"`
-	Name        string    `json:"name"`
-	Language    string    `json:"language"`
-	RegionCode  string    `json:"region_code"`
-	CategoryIds []int32   `json:"category_ids"`
-	Enabled     bool      `json:"enabled"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID                  uuid.UUID     `json:"id"`
+	Code                string        `json:"code"`
+	Name                string        `json:"name"`
+	Language            string        `json:"language"`
+	RegionCode          string        `json:"region_code"`
+	CategoryIds         []int32       `json:"category_ids"`
+	Enabled             bool          `json:"enabled"`
+	CreatedAt           time.Time     `json:"created_at"`
+	UpdatedAt           time.Time     `json:"updated_at"`
+	CheckpointProfileID uuid.NullUUID `json:"checkpoint_profile_id"`
 }
 
 // Genre queries
@@ -235,6 +466,7 @@ func (q *Queries) CreateGenre(ctx context.Context, arg CreateGenreParams) error
 		arg.Enabled,
 		arg.CreatedAt,
 		arg.UpdatedAt,
+		arg.CheckpointProfileID,
 	)
 	return err
 }
@@ -272,6 +504,122 @@ func (q *Queries) CreateKeyword(ctx context.Context, arg CreateKeywordParams) er
 	return err
 }
 
+const createKeywordGroup = `-- name: CreateKeywordGroup :exec
+INSERT INTO ingestion.keyword_groups (
+    id, genre_id, name, filter_type, target_field, enabled, description, created_at, cross_language, romaji
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+`
+
+type CreateKeywordGroupParams struct {
+	ID            uuid.UUID      `json:"id"`
+	GenreID       uuid.UUID      `json:"genre_id"`
+	Name          string         `json:"name"`
+	FilterType    string         `json:"filter_type"`
+	TargetField   string         `json:"target_field"`
+	Enabled       sql.NullBool   `json:"enabled"`
+	Description   sql.NullString `json:"description"`
+	CreatedAt     sql.NullTime   `json:"created_at"`
+	CrossLanguage bool           `json:"cross_language"`
+	Romaji        bool           `json:"romaji"`
+}
+
+// Keyword group queries
+func (q *Queries) CreateKeywordGroup(ctx context.Context, arg CreateKeywordGroupParams) error {
+	_, err := q.db.ExecContext(ctx, createKeywordGroup,
+		arg.ID,
+		arg.GenreID,
+		arg.Name,
+		arg.FilterType,
+		arg.TargetField,
+		arg.Enabled,
+		arg.Description,
+		arg.CreatedAt,
+		arg.CrossLanguage,
+		arg.Romaji,
+	)
+	return err
+}
+
+const createKeywordItem = `-- name: CreateKeywordItem :exec
+INSERT INTO ingestion.keyword_items (
+    id, keyword_group_id, keyword, created_at
+) VALUES ($1, $2, $3, $4)
+`
+
+type CreateKeywordItemParams struct {
+	ID             uuid.UUID    `json:"id"`
+	KeywordGroupID uuid.UUID    `json:"keyword_group_id"`
+	Keyword        string       `json:"keyword"`
+	CreatedAt      sql.NullTime `json:"created_at"`
+}
+
+func (q *Queries) CreateKeywordItem(ctx context.Context, arg CreateKeywordItemParams) error {
+	_, err := q.db.ExecContext(ctx, createKeywordItem,
+		arg.ID,
+		arg.KeywordGroupID,
+		arg.Keyword,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const createKeywordSynonym = `-- name: CreateKeywordSynonym :exec
+INSERT INTO ingestion.keyword_synonyms (
+    id, language, term, alias, alias_language, bidirectional, created_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+
+type CreateKeywordSynonymParams struct {
+	ID            uuid.UUID `json:"id"`
+	Language      string    `json:"language"`
+	Term          string    `json:"term"`
+	Alias         string    `json:"alias"`
+	AliasLanguage string    `json:"alias_language"`
+	Bidirectional bool      `json:"bidirectional"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// Keyword synonym queries
+func (q *Queries) CreateKeywordSynonym(ctx context.Context, arg CreateKeywordSynonymParams) error {
+	_, err := q.db.ExecContext(ctx, createKeywordSynonym,
+		arg.ID,
+		arg.Language,
+		arg.Term,
+		arg.Alias,
+		arg.AliasLanguage,
+		arg.Bidirectional,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const createSnapshotGap = `-- name: CreateSnapshotGap :exec
+INSERT INTO ingestion.snapshot_gaps (
+    video_id, checkpoint_hour, due_at, reason, detected_at
+) VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (video_id, checkpoint_hour) DO NOTHING
+`
+
+type CreateSnapshotGapParams struct {
+	VideoID        uuid.UUID `json:"video_id"`
+	CheckpointHour int32     `json:"checkpoint_hour"`
+	DueAt          time.Time `json:"due_at"`
+	Reason         string    `json:"reason"`
+	DetectedAt     time.Time `json:"detected_at"`
+}
+
+// Snapshot gap queries
+func (q *Queries) CreateSnapshotGap(ctx context.Context, arg CreateSnapshotGapParams) error {
+	_, err := q.db.ExecContext(ctx, createSnapshotGap,
+		arg.VideoID,
+		arg.CheckpointHour,
+		arg.DueAt,
+		arg.Reason,
+		arg.DetectedAt,
+	)
+	return err
+}
+
 const createSnapshotTask = `-- name: CreateSnapshotTask :exec
 INSERT INTO ingestion.snapshot_tasks (
     video_id, checkpoint_hour, scheduled_at
@@ -292,19 +640,20 @@ func (q *Queries) CreateSnapshotTask(ctx context.Context, arg CreateSnapshotTask
 const createVideo = `-- name: CreateVideo :exec
 INSERT INTO ingestion.videos (
     id, youtube_video_id, channel_id, youtube_channel_id, title,
-    published_at, category_id, created_at
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+    published_at, category_id, created_at, duration_seconds
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 `
 
 type CreateVideoParams struct {
-	ID               uuid.UUID    `json:"id"`
-	YoutubeVideoID   string       `json:"youtube_video_id"`
-	ChannelID        uuid.UUID    `json:"channel_id"`
-	YoutubeChannelID string       `json:"youtube_channel_id"`
-	Title            string       `json:"title"`
-	PublishedAt      time.Time    `json:"published_at"`
-	CategoryID       int32        `json:"category_id"`
-	CreatedAt        sql.NullTime `json:"created_at"`
+	ID               uuid.UUID     `json:"id"`
+	YoutubeVideoID   string        `json:"youtube_video_id"`
+	ChannelID        uuid.UUID     `json:"channel_id"`
+	YoutubeChannelID string        `json:"youtube_channel_id"`
+	Title            string        `json:"title"`
+	PublishedAt      time.Time     `json:"published_at"`
+	CategoryID       int32         `json:"category_id"`
+	CreatedAt        sql.NullTime  `json:"created_at"`
+	DurationSeconds  sql.NullInt32 `json:"duration_seconds"`
 }
 
 func (q *Queries) CreateVideo(ctx context.Context, arg CreateVideoParams) error {
@@ -317,21 +666,23 @@ func (q *Queries) CreateVideo(ctx context.Context, arg CreateVideoParams) error
 		arg.PublishedAt,
 		arg.CategoryID,
 		arg.CreatedAt,
+		arg.DurationSeconds,
 	)
 	return err
 }
 
 const createVideoGenre = `-- name: CreateVideoGenre :exec
 INSERT INTO ingestion.video_genres (
-    id, video_id, genre_id, created_at
-) VALUES ($1, $2, $3, $4)
+    id, video_id, genre_id, created_at, match_provenance
+) VALUES ($1, $2, $3, $4, $5)
 `
 
 type CreateVideoGenreParams struct {
-	ID        uuid.UUID `json:"id"`
-	VideoID   uuid.UUID `json:"video_id"`
-	GenreID   uuid.UUID `json:"genre_id"`
-	CreatedAt time.Time `json:"created_at"`
+	ID              uuid.UUID             `json:"id"`
+	VideoID         uuid.UUID             `json:"video_id"`
+	GenreID         uuid.UUID             `json:"genre_id"`
+	CreatedAt       time.Time             `json:"created_at"`
+	MatchProvenance pqtype.NullRawMessage `json:"match_provenance"`
 }
 
 // Video Genre queries
@@ -341,6 +692,7 @@ func (q *Queries) CreateVideoGenre(ctx context.Context, arg CreateVideoGenrePara
 		arg.VideoID,
 		arg.GenreID,
 		arg.CreatedAt,
+		arg.MatchProvenance,
 	)
 	return err
 }
@@ -348,21 +700,25 @@ func (q *Queries) CreateVideoGenre(ctx context.Context, arg CreateVideoGenrePara
 const createVideoSnapshot = `-- name: CreateVideoSnapshot :exec
 INSERT INTO ingestion.video_snapshots (
     id, video_id, checkpoint_hour, measured_at, view_count,
-    like_count, subscription_count, source, created_at, updated_at
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+    like_count, subscription_count, source, created_at, updated_at, drift_seconds,
+    comment_count, metrics
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 `
 
 type CreateVideoSnapshotParams struct {
-	ID                uuid.UUID    `json:"id"`
-	VideoID           uuid.UUID    `json:"video_id"`
-	CheckpointHour    int32        `json:"checkpoint_hour"`
-	MeasuredAt        time.Time    `json:"measured_at"`
-	ViewCount         int64        `json:"view_count"`
-	LikeCount         int64        `json:"like_count"`
-	SubscriptionCount int64        `json:"subscription_count"`
-	Source            string       `json:"source"`
-	CreatedAt         sql.NullTime `json:"created_at"`
-	UpdatedAt         time.Time    `json:"updated_at"`
+	ID                uuid.UUID       `json:"id"`
+	VideoID           uuid.UUID       `json:"video_id"`
+	CheckpointHour    int32           `json:"checkpoint_hour"`
+	MeasuredAt        time.Time       `json:"measured_at"`
+	ViewCount         int64           `json:"view_count"`
+	LikeCount         int64           `json:"like_count"`
+	SubscriptionCount int64           `json:"subscription_count"`
+	Source            string          `json:"source"`
+	CreatedAt         sql.NullTime    `json:"created_at"`
+	UpdatedAt         time.Time       `json:"updated_at"`
+	DriftSeconds      int32           `json:"drift_seconds"`
+	CommentCount      sql.NullInt64   `json:"comment_count"`
+	Metrics           json.RawMessage `json:"metrics"`
 }
 
 func (q *Queries) CreateVideoSnapshot(ctx context.Context, arg CreateVideoSnapshotParams) error {
@@ -377,6 +733,9 @@ func (q *Queries) CreateVideoSnapshot(ctx context.Context, arg CreateVideoSnapsh
 		arg.Source,
 		arg.CreatedAt,
 		arg.UpdatedAt,
+		arg.DriftSeconds,
+		arg.CommentCount,
+		arg.Metrics,
 	)
 	return err
 }
@@ -407,6 +766,53 @@ func (q *Queries) CreateYouTubeCategory(ctx context.Context, arg CreateYouTubeCa
 	return err
 }
 
+const deleteAuditLogsBefore = `-- name: DeleteAuditLogsBefore :execrows
+DELETE FROM ingestion.audit_logs
+WHERE id IN (
+    SELECT id FROM ingestion.audit_logs
+    WHERE created_at < $1
+    ORDER BY created_at, id
+    LIMIT $2
+)
+`
+
+type DeleteAuditLogsBeforeParams struct {
+	CreatedBefore time.Time `json:"created_before"`
+	BatchSize     int32     `json:"batch_size"`
+}
+
+// Deletes up to batch_size of the oldest audit logs created before the cutoff
+func (q *Queries) DeleteAuditLogsBefore(ctx context.Context, arg DeleteAuditLogsBeforeParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteAuditLogsBefore, arg.CreatedBefore, arg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteKeywordItemsByGroup = `-- name: DeleteKeywordItemsByGroup :exec
+DELETE FROM ingestion.keyword_items
+WHERE keyword_group_id = $1
+`
+
+func (q *Queries) DeleteKeywordItemsByGroup(ctx context.Context, keywordGroupID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteKeywordItemsByGroup, keywordGroupID)
+	return err
+}
+
+const deleteKeywordSynonym = `-- name: DeleteKeywordSynonym :execrows
+DELETE FROM ingestion.keyword_synonyms
+WHERE id = $1
+`
+
+func (q *Queries) DeleteKeywordSynonym(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteKeywordSynonym, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const deleteSnapshotTask = `-- name: DeleteSnapshotTask :exec
 DELETE FROM ingestion.snapshot_tasks
 WHERE video_id = $1 AND checkpoint_hour = $2
@@ -442,9 +848,36 @@ func (q *Queries) DeleteVideoGenresByVideo(ctx context.Context, videoID uuid.UUI
 	return err
 }
 
+const getAuditLogByID = `-- name: GetAuditLogByID :one
+SELECT id, actor_id, actor_email, action, resource_type, resource_id,
+       old_values, new_values, ip_address, user_agent, created_at
+FROM ingestion.audit_logs
+WHERE id = $1
+`
+
+func (q *Queries) GetAuditLogByID(ctx context.Context, id uuid.UUID) (IngestionAuditLog, error) {
+	row := q.db.QueryRowContext(ctx, getAuditLogByID, id)
+	var i IngestionAuditLog
+	err := row.Scan(
+		&i.ID,
+		&i.ActorID,
+		&i.ActorEmail,
+		&i.Action,
+		&i.ResourceType,
+		&i.ResourceID,
+		&i.OldValues,
+		&i.NewValues,
+		&i.IpAddress,
+		&i.UserAgent,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const getBatchJobByID = `-- name: GetBatchJobByID :one
 SELECT id, job_type, status, parameters, started_at, completed_at,
-       error_message, statistics, created_at
+       error_message, statistics, created_at,
+       cancel_requested_at, retry_of, progress_processed, progress_total, progress_current
 FROM ingestion.batch_jobs
 WHERE id = $1
 `
@@ -462,6 +895,31 @@ func (q *Queries) GetBatchJobByID(ctx context.Context, id uuid.UUID) (IngestionB
 		&i.ErrorMessage,
 		&i.Statistics,
 		&i.CreatedAt,
+		&i.CancelRequestedAt,
+		&i.RetryOf,
+		&i.ProgressProcessed,
+		&i.ProgressTotal,
+		&i.ProgressCurrent,
+	)
+	return i, err
+}
+
+const getBatchJobLock = `-- name: GetBatchJobLock :one
+SELECT lock_key, job_id, holder, acquired_at, heartbeat_at, expires_at
+FROM ingestion.batch_job_locks
+WHERE lock_key = $1
+`
+
+func (q *Queries) GetBatchJobLock(ctx context.Context, lockKey string) (IngestionBatchJobLock, error) {
+	row := q.db.QueryRowContext(ctx, getBatchJobLock, lockKey)
+	var i IngestionBatchJobLock
+	err := row.Scan(
+		&i.LockKey,
+		&i.JobID,
+		&i.Holder,
+		&i.AcquiredAt,
+		&i.HeartbeatAt,
+		&i.ExpiresAt,
 	)
 	return i, err
 }
@@ -550,8 +1008,46 @@ func (q *Queries) GetChannelByYouTubeID(ctx context.Context, youtubeChannelID st
 	return i, err
 }
 
+const getCheckpointProfileByCode = `-- name: GetCheckpointProfileByCode :one
+SELECT id, code, name, created_at, updated_at
+FROM ingestion.checkpoint_profiles
+WHERE code = $1
+`
+
+func (q *Queries) GetCheckpointProfileByCode(ctx context.Context, code string) (IngestionCheckpointProfile, error) {
+	row := q.db.QueryRowContext(ctx, getCheckpointProfileByCode, code)
+	var i IngestionCheckpointProfile
+	err := row.Scan(
+		&i.ID,
+		&i.Code,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getCheckpointProfileByID = `-- name: GetCheckpointProfileByID :one
+SELECT id, code, name, created_at, updated_at
+FROM ingestion.checkpoint_profiles
+WHERE id = $1
+`
+
+func (q *Queries) GetCheckpointProfileByID(ctx context.Context, id uuid.UUID) (IngestionCheckpointProfile, error) {
+	row := q.db.QueryRowContext(ctx, getCheckpointProfileByID, id)
+	var i IngestionCheckpointProfile
+	err := row.Scan(
+		&i.ID,
+		&i.Code,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const getGenreByCode = `-- name: GetGenreByCode :one
-SELECT id, code, name, language, region_code, category_ids, enabled, created_at, updated_at
+SELECT id, code, name, language, region_code, category_ids, enabled, created_at, updated_at, checkpoint_profile_id
 FROM ingestion.genres
 WHERE code = $1
 `
@@ -569,12 +1065,13 @@ func (q *Queries) GetGenreByCode(ctx context.Context, code string) (IngestionGen
 		&i.Enabled,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.CheckpointProfileID,
 	)
 	return i, err
 }
 
 const getGenreByID = `-- name: GetGenreByID :one
-SELECT id, code, name, language, region_code, category_ids, enabled, created_at, updated_at
+SELECT id, code, name, language, region_code, category_ids, enabled, created_at, updated_at, checkpoint_profile_id
 FROM ingestion.genres
 WHERE id = $1
 `
@@ -592,6 +1089,7 @@ func (q *Queries) GetGenreByID(ctx context.Context, id uuid.UUID) (IngestionGenr
 		&i.Enabled,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.CheckpointProfileID,
 	)
 	return i, err
 }
@@ -633,32 +1131,147 @@ func (q *Queries) GetKeywordByID(ctx context.Context, id uuid.UUID) (GetKeywordB
 	return i, err
 }
 
-const getLatestChannelSnapshot = `-- name: GetLatestChannelSnapshot :one
-SELECT id, channel_id, measured_at, subscription_count, view_count, video_count, created_at, updated_at
-FROM ingestion.channel_snapshots
-WHERE channel_id = $1
-ORDER BY measured_at DESC
-LIMIT 1
+const getKeywordGroupByID = `-- name: GetKeywordGroupByID :one
+SELECT id, genre_id, name, filter_type, target_field, enabled, description, created_at, updated_at, deleted_at, cross_language, romaji
+FROM ingestion.keyword_groups
+WHERE id = $1 AND deleted_at IS NULL
 `
 
-type GetLatestChannelSnapshotRow struct {
-	ID                uuid.UUID     `json:"id"`
-	ChannelID         uuid.UUID     `json:"channel_id"`
-	MeasuredAt        time.Time     `json:"measured_at"`
-	SubscriptionCount int32         `json:"subscription_count"`
-	ViewCount         sql.NullInt64 `json:"view_count"`
-	VideoCount        sql.NullInt64 `json:"video_count"`
-	CreatedAt         sql.NullTime  `json:"created_at"`
-	UpdatedAt         time.Time     `json:"updated_at"`
-}
-
-func (q *Queries) GetLatestChannelSnapshot(ctx context.Context, channelID uuid.UUID) (GetLatestChannelSnapshotRow, error) {
-	row := q.db.QueryRowContext(ctx, getLatestChannelSnapshot, channelID)
-	var i GetLatestChannelSnapshotRow
+func (q *Queries) GetKeywordGroupByID(ctx context.Context, id uuid.UUID) (IngestionKeywordGroup, error) {
+	row := q.db.QueryRowContext(ctx, getKeywordGroupByID, id)
+	var i IngestionKeywordGroup
 	err := row.Scan(
 		&i.ID,
-		&i.ChannelID,
-		&i.MeasuredAt,
+		&i.GenreID,
+		&i.Name,
+		&i.FilterType,
+		&i.TargetField,
+		&i.Enabled,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.CrossLanguage,
+		&i.Romaji,
+	)
+	return i, err
+}
+
+const getKeywordSynonymByID = `-- name: GetKeywordSynonymByID :one
+SELECT id, language, term, alias, alias_language, bidirectional, created_at, updated_at
+FROM ingestion.keyword_synonyms
+WHERE id = $1
+`
+
+func (q *Queries) GetKeywordSynonymByID(ctx context.Context, id uuid.UUID) (IngestionKeywordSynonym, error) {
+	row := q.db.QueryRowContext(ctx, getKeywordSynonymByID, id)
+	var i IngestionKeywordSynonym
+	err := row.Scan(
+		&i.ID,
+		&i.Language,
+		&i.Term,
+		&i.Alias,
+		&i.AliasLanguage,
+		&i.Bidirectional,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getKeywordSynonymVersion = `-- name: GetKeywordSynonymVersion :one
+SELECT version FROM ingestion.keyword_synonym_version
+WHERE singleton
+`
+
+func (q *Queries) GetKeywordSynonymVersion(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getKeywordSynonymVersion)
+	var version int64
+	err := row.Scan(&version)
+	return version, err
+}
+
+const getLatestChannelSnapshot = `-- name: GetLatestChannelSnapshot :one
+SELECT id, channel_id, measured_at, subscription_count, view_count, video_count, created_at, updated_at
+FROM ingestion.channel_snapshots
+WHERE channel_id = $1
+ORDER BY measured_at DESC
+LIMIT 1
+`
+
+type GetLatestChannelSnapshotRow struct {
+	ID                uuid.UUID     `json:"id"`
+	ChannelID         uuid.UUID     `json:"channel_id"`
+	MeasuredAt        time.Time     `json:"measured_at"`
+	SubscriptionCount int32         `json:"subscription_count"`
+	ViewCount         sql.NullInt64 `json:"view_count"`
+	VideoCount        sql.NullInt64 `json:"video_count"`
+	CreatedAt         sql.NullTime  `json:"created_at"`
+	UpdatedAt         time.Time     `json:"updated_at"`
+}
+
+func (q *Queries) GetLatestChannelSnapshot(ctx context.Context, channelID uuid.UUID) (GetLatestChannelSnapshotRow, error) {
+	row := q.db.QueryRowContext(ctx, getLatestChannelSnapshot, channelID)
+	var i GetLatestChannelSnapshotRow
+	err := row.Scan(
+		&i.ID,
+		&i.ChannelID,
+		&i.MeasuredAt,
+		&i.SubscriptionCount,
+		&i.ViewCount,
+		&i.VideoCount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getNearestChannelSnapshot = `-- name: GetNearestChannelSnapshot :one
+SELECT id, channel_id, measured_at, subscription_count, view_count, video_count, created_at, updated_at
+FROM (
+    (SELECT id, channel_id, measured_at, subscription_count, view_count, video_count, created_at, updated_at
+     FROM ingestion.channel_snapshots
+     WHERE channel_id = $1 AND measured_at <= $2::timestamptz
+     ORDER BY measured_at DESC
+     LIMIT 1)
+    UNION ALL
+    (SELECT id, channel_id, measured_at, subscription_count, view_count, video_count, created_at, updated_at
+     FROM ingestion.channel_snapshots
+     WHERE channel_id = $1 AND measured_at > $2
+     ORDER BY measured_at ASC
+     LIMIT 1)
+) nearest
+ORDER BY abs(extract(epoch FROM (measured_at - $2))), measured_at DESC
+LIMIT 1
+`
+
+type GetNearestChannelSnapshotParams struct {
+	ChannelID uuid.UUID `json:"channel_id"`
+	At        time.Time `json:"at"`
+}
+
+type GetNearestChannelSnapshotRow struct {
+	ID                uuid.UUID     `json:"id"`
+	ChannelID         uuid.UUID     `json:"channel_id"`
+	MeasuredAt        time.Time     `json:"measured_at"`
+	SubscriptionCount int32         `json:"subscription_count"`
+	ViewCount         sql.NullInt64 `json:"view_count"`
+	VideoCount        sql.NullInt64 `json:"video_count"`
+	CreatedAt         sql.NullTime  `json:"created_at"`
+	UpdatedAt         time.Time     `json:"updated_at"`
+}
+
+// Snapshot of the channel measured closest to at, the later one on a tie. The
+// latest snapshot at or before at and the earliest one after it are each a
+// single probe of the (channel_id, measured_at) index behind
+// channel_snapshots_channel_measured_unique; the closer of the two is kept.
+func (q *Queries) GetNearestChannelSnapshot(ctx context.Context, arg GetNearestChannelSnapshotParams) (GetNearestChannelSnapshotRow, error) {
+	row := q.db.QueryRowContext(ctx, getNearestChannelSnapshot, arg.ChannelID, arg.At)
+	var i GetNearestChannelSnapshotRow
+	err := row.Scan(
+		&i.ID,
+		&i.ChannelID,
+		&i.MeasuredAt,
 		&i.SubscriptionCount,
 		&i.ViewCount,
 		&i.VideoCount,
@@ -705,20 +1318,22 @@ func (q *Queries) GetPendingSnapshotTasks(ctx context.Context, arg GetPendingSna
 }
 
 const getVideoByID = `-- name: GetVideoByID :one
-SELECT id, youtube_video_id, channel_id, youtube_channel_id, title, published_at, category_id, created_at
+SELECT id, youtube_video_id, channel_id, youtube_channel_id, title, published_at, category_id, created_at,
+       duration_seconds
 FROM ingestion.videos
 WHERE id = $1 AND deleted_at IS NULL
 `
 
 type GetVideoByIDRow struct {
-	ID               uuid.UUID    `json:"id"`
-	YoutubeVideoID   string       `json:"youtube_video_id"`
-	ChannelID        uuid.UUID    `json:"channel_id"`
-	YoutubeChannelID string       `json:"youtube_channel_id"`
-	Title            string       `json:"title"`
-	PublishedAt      time.Time    `json:"published_at"`
-	CategoryID       int32        `json:"category_id"`
-	CreatedAt        sql.NullTime `json:"created_at"`
+	ID               uuid.UUID     `json:"id"`
+	YoutubeVideoID   string        `json:"youtube_video_id"`
+	ChannelID        uuid.UUID     `json:"channel_id"`
+	YoutubeChannelID string        `json:"youtube_channel_id"`
+	Title            string        `json:"title"`
+	PublishedAt      time.Time     `json:"published_at"`
+	CategoryID       int32         `json:"category_id"`
+	CreatedAt        sql.NullTime  `json:"created_at"`
+	DurationSeconds  sql.NullInt32 `json:"duration_seconds"`
 }
 
 func (q *Queries) GetVideoByID(ctx context.Context, id uuid.UUID) (GetVideoByIDRow, error) {
@@ -733,25 +1348,28 @@ func (q *Queries) GetVideoByID(ctx context.Context, id uuid.UUID) (GetVideoByIDR
 		&i.PublishedAt,
 		&i.CategoryID,
 		&i.CreatedAt,
+		&i.DurationSeconds,
 	)
 	return i, err
 }
 
 const getVideoByYouTubeID = `-- name: GetVideoByYouTubeID :one
-SELECT id, youtube_video_id, channel_id, youtube_channel_id, title, published_at, category_id, created_at
+SELECT id, youtube_video_id, channel_id, youtube_channel_id, title, published_at, category_id, created_at,
+       duration_seconds
 FROM ingestion.videos
 WHERE youtube_video_id = $1 AND deleted_at IS NULL
 `
 
 type GetVideoByYouTubeIDRow struct {
-	ID               uuid.UUID    `json:"id"`
-	YoutubeVideoID   string       `json:"youtube_video_id"`
-	ChannelID        uuid.UUID    `json:"channel_id"`
-	YoutubeChannelID string       `json:"youtube_channel_id"`
-	Title            string       `json:"title"`
-	PublishedAt      time.Time    `json:"published_at"`
-	CategoryID       int32        `json:"category_id"`
-	CreatedAt        sql.NullTime `json:"created_at"`
+	ID               uuid.UUID     `json:"id"`
+	YoutubeVideoID   string        `json:"youtube_video_id"`
+	ChannelID        uuid.UUID     `json:"channel_id"`
+	YoutubeChannelID string        `json:"youtube_channel_id"`
+	Title            string        `json:"title"`
+	PublishedAt      time.Time     `json:"published_at"`
+	CategoryID       int32         `json:"category_id"`
+	CreatedAt        sql.NullTime  `json:"created_at"`
+	DurationSeconds  sql.NullInt32 `json:"duration_seconds"`
 }
 
 func (q *Queries) GetVideoByYouTubeID(ctx context.Context, youtubeVideoID string) (GetVideoByYouTubeIDRow, error) {
@@ -766,13 +1384,15 @@ func (q *Queries) GetVideoByYouTubeID(ctx context.Context, youtubeVideoID string
 		&i.PublishedAt,
 		&i.CategoryID,
 		&i.CreatedAt,
+		&i.DurationSeconds,
 	)
 	return i, err
 }
 
 const getVideoSnapshotByVideoAndCheckpoint = `-- name: GetVideoSnapshotByVideoAndCheckpoint :one
 SELECT id, video_id, checkpoint_hour, measured_at, view_count, 
-       like_count, subscription_count, source, created_at, updated_at
+       like_count, subscription_count, source, created_at, updated_at, drift_seconds,
+       comment_count, metrics
 FROM ingestion.video_snapshots
 WHERE video_id = $1 AND checkpoint_hour = $2
 `
@@ -796,6 +1416,9 @@ func (q *Queries) GetVideoSnapshotByVideoAndCheckpoint(ctx context.Context, arg
 		&i.Source,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DriftSeconds,
+		&i.CommentCount,
+		&i.Metrics,
 	)
 	return i, err
 }
@@ -819,6 +1442,27 @@ func (q *Queries) GetYouTubeCategoryByID(ctx context.Context, id int32) (Ingesti
 	return i, err
 }
 
+const heartbeatBatchJobLock = `-- name: HeartbeatBatchJobLock :execrows
+UPDATE ingestion.batch_job_locks
+SET heartbeat_at = now(), expires_at = now() + make_interval(secs => $1::float8)
+WHERE lock_key = $2 AND job_id = $3
+`
+
+type HeartbeatBatchJobLockParams struct {
+	TtlSeconds float64   `json:"ttl_seconds"`
+	LockKey    string    `json:"lock_key"`
+	JobID      uuid.UUID `json:"job_id"`
+}
+
+// Extends the lease while it is still held by the job
+func (q *Queries) HeartbeatBatchJobLock(ctx context.Context, arg HeartbeatBatchJobLockParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, heartbeatBatchJobLock, arg.TtlSeconds, arg.LockKey, arg.JobID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const listActiveChannels = `-- name: ListActiveChannels :many
 SELECT id, youtube_channel_id, title, thumbnail_url, description, country,
        view_count, subscription_count, video_count, subscribed, created_at, updated_at
@@ -878,6 +1522,44 @@ func (q *Queries) ListActiveChannels(ctx context.Context) ([]ListActiveChannelsR
 	return items, nil
 }
 
+const listActiveCheckpointProfiles = `-- name: ListActiveCheckpointProfiles :many
+SELECT DISTINCT p.id, p.code, p.name, p.created_at, p.updated_at
+FROM ingestion.checkpoint_profiles p
+JOIN ingestion.genres g ON g.checkpoint_profile_id = p.id
+WHERE g.enabled = true
+ORDER BY p.code ASC
+`
+
+// Profiles assigned to enabled genres
+func (q *Queries) ListActiveCheckpointProfiles(ctx context.Context) ([]IngestionCheckpointProfile, error) {
+	rows, err := q.db.QueryContext(ctx, listActiveCheckpointProfiles)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []IngestionCheckpointProfile
+	for rows.Next() {
+		var i IngestionCheckpointProfile
+		if err := rows.Scan(
+			&i.ID,
+			&i.Code,
+			&i.Name,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listActiveVideos = `-- name: ListActiveVideos :many
 SELECT id, youtube_video_id, channel_id, youtube_channel_id, title, published_at, category_id, created_at
 FROM ingestion.videos
@@ -964,98 +1646,29 @@ func (q *Queries) ListAssignableYouTubeCategories(ctx context.Context) ([]Ingest
 	return items, nil
 }
 
-const listAuditLogsByActor = `-- name: ListAuditLogsByActor :many
-SELECT id, actor_id, actor_email, action, resource_type, resource_id,
-       old_values, new_values, ip_address, user_agent, created_at
-FROM ingestion.audit_logs
-WHERE actor_id = $1
-ORDER BY created_at DESC
-LIMIT $2 OFFSET $3
-`
-
-type ListAuditLogsByActorParams struct {
-	ActorID uuid.UUID `json:"actor_id"`
-	Limit   int32     `json:"limit"`
-	Offset  int32     `json:"offset"`
-}
-
-func (q *Queries) ListAuditLogsByActor(ctx context.Context, arg ListAuditLogsByActorParams) ([]IngestionAuditLog, error) {
-	rows, err := q.db.QueryContext(ctx, listAuditLogsByActor, arg.ActorID, arg.Limit, arg.Offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []IngestionAuditLog
-	for rows.Next() {
-		var i IngestionAuditLog
-		if err := rows.Scan(
-			&i.ID,
-			&i.ActorID,
-			&i.ActorEmail,
-			&i.Action,
-			&i.ResourceType,
-			&i.ResourceID,
-			&i.OldValues,
-			&i.NewValues,
-			&i.IpAddress,
-			&i.UserAgent,
-			&i.CreatedAt,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
-const listAuditLogsByResource = `-- name: ListAuditLogsByResource :many
-SELECT id, actor_id, actor_email, action, resource_type, resource_id,
-       old_values, new_values, ip_address, user_agent, created_at
-FROM ingestion.audit_logs
-WHERE resource_type = $1 AND resource_id = $2
-ORDER BY created_at DESC
-LIMIT $3 OFFSET $4
+const listBatchJobLocksByJob = `-- name: ListBatchJobLocksByJob :many
+SELECT lock_key, job_id, holder, acquired_at, heartbeat_at, expires_at
+FROM ingestion.batch_job_locks
+WHERE job_id = $1
+ORDER BY lock_key ASC
 `
 
-type ListAuditLogsByResourceParams struct {
-	ResourceType string         `json:"resource_type"`
-	ResourceID   sql.NullString `json:"resource_id"`
-	Limit        int32          `json:"limit"`
-	Offset       int32          `json:"offset"`
-}
-
-func (q *Queries) ListAuditLogsByResource(ctx context.Context, arg ListAuditLogsByResourceParams) ([]IngestionAuditLog, error) {
-	rows, err := q.db.QueryContext(ctx, listAuditLogsByResource,
-		arg.ResourceType,
-		arg.ResourceID,
-		arg.Limit,
-		arg.Offset,
-	)
+func (q *Queries) ListBatchJobLocksByJob(ctx context.Context, jobID uuid.UUID) ([]IngestionBatchJobLock, error) {
+	rows, err := q.db.QueryContext(ctx, listBatchJobLocksByJob, jobID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []IngestionAuditLog
+	var items []IngestionBatchJobLock
 	for rows.Next() {
-		var i IngestionAuditLog
+		var i IngestionBatchJobLock
 		if err := rows.Scan(
-			&i.ID,
-			&i.ActorID,
-			&i.ActorEmail,
-			&i.Action,
-			&i.ResourceType,
-			&i.ResourceID,
-			&i.OldValues,
-			&i.NewValues,
-			&i.IpAddress,
-			&i.UserAgent,
-			&i.CreatedAt,
+			&i.LockKey,
+			&i.JobID,
+			&i.Holder,
+			&i.AcquiredAt,
+			&i.HeartbeatAt,
+			&i.ExpiresAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1072,7 +1685,8 @@ func (q *Queries) ListAuditLogsByResource(ctx context.Context, arg ListAuditLogs
 
 const listBatchJobsByTypeAndStatus = `-- name: ListBatchJobsByTypeAndStatus :many
 SELECT id, job_type, status, parameters, started_at, completed_at,
-       error_message, statistics, created_at
+       error_message, statistics, created_at,
+       cancel_requested_at, retry_of, progress_processed, progress_total, progress_current
 FROM ingestion.batch_jobs
 WHERE job_type = $1 AND status = $2
 ORDER BY created_at DESC
@@ -1102,6 +1716,11 @@ func (q *Queries) ListBatchJobsByTypeAndStatus(ctx context.Context, arg ListBatc
 			&i.ErrorMessage,
 			&i.Statistics,
 			&i.CreatedAt,
+			&i.CancelRequestedAt,
+			&i.RetryOf,
+			&i.ProgressProcessed,
+			&i.ProgressTotal,
+			&i.ProgressCurrent,
 		); err != nil {
 			return nil, err
 		}
@@ -1172,55 +1791,58 @@ func (q *Queries) ListChannelSnapshots(ctx context.Context, arg ListChannelSnaps
 	return items, nil
 }
 
-const listChannels = `-- name: ListChannels :many
-SELECT id, youtube_channel_id, title, thumbnail_url, description, country,
-       view_count, subscription_count, video_count, subscribed, created_at, updated_at
-FROM ingestion.channels
-WHERE deleted_at IS NULL
-ORDER BY created_at DESC
-LIMIT $1 OFFSET $2
+const listCheckpointProfileHours = `-- name: ListCheckpointProfileHours :many
+SELECT checkpoint_hour
+FROM ingestion.checkpoint_profile_hours
+WHERE profile_id = $1
+ORDER BY checkpoint_hour ASC
 `
 
-type ListChannelsParams struct {
-	Limit  int32 `json:"limit"`
-	Offset int32 `json:"offset"`
+func (q *Queries) ListCheckpointProfileHours(ctx context.Context, profileID uuid.UUID) ([]int32, error) {
+	rows, err := q.db.QueryContext(ctx, listCheckpointProfileHours, profileID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var checkpoint_hour int32
+		if err := rows.Scan(&checkpoint_hour); err != nil {
+			return nil, err
+		}
+		items = append(items, checkpoint_hour)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-type ListChannelsRow struct {
-	ID                uuid.UUID      `json:"id"`
-	YoutubeChannelID  string         `json:"youtube_channel_id"`
-	Title             string         `json:"title"`
-	ThumbnailUrl      string         `json:"thumbnail_url"`
-	Description       sql.NullString `json:"description"`
-	Country           sql.NullString `json:"country"`
-	ViewCount         sql.NullInt64  `json:"view_count"`
-	SubscriptionCount sql.NullInt64  `json:"subscription_count"`
-	VideoCount        sql.NullInt64  `json:"video_count"`
-	Subscribed        sql.NullBool   `json:"subscribed"`
-	CreatedAt         sql.NullTime   `json:"created_at"`
-	UpdatedAt         sql.NullTime   `json:"updated_at"`
-}
+const listCheckpointProfilesByVideo = `-- name: ListCheckpointProfilesByVideo :many
+SELECT DISTINCT p.id, p.code, p.name, p.created_at, p.updated_at
+FROM ingestion.checkpoint_profiles p
+JOIN ingestion.genres g ON g.checkpoint_profile_id = p.id
+JOIN ingestion.video_genres vg ON vg.genre_id = g.id
+WHERE vg.video_id = $1
+ORDER BY p.code ASC
+`
 
-func (q *Queries) ListChannels(ctx context.Context, arg ListChannelsParams) ([]ListChannelsRow, error) {
-	rows, err := q.db.QueryContext(ctx, listChannels, arg.Limit, arg.Offset)
+func (q *Queries) ListCheckpointProfilesByVideo(ctx context.Context, videoID uuid.UUID) ([]IngestionCheckpointProfile, error) {
+	rows, err := q.db.QueryContext(ctx, listCheckpointProfilesByVideo, videoID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListChannelsRow
+	var items []IngestionCheckpointProfile
 	for rows.Next() {
-		var i ListChannelsRow
+		var i IngestionCheckpointProfile
 		if err := rows.Scan(
 			&i.ID,
-			&i.YoutubeChannelID,
-			&i.Title,
-			&i.ThumbnailUrl,
-			&i.Description,
-			&i.Country,
-			&i.ViewCount,
-			&i.SubscriptionCount,
-			&i.VideoCount,
-			&i.Subscribed,
+			&i.Code,
+			&i.Name,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -1238,7 +1860,7 @@ func (q *Queries) ListChannels(ctx context.Context, arg ListChannelsParams) ([]L
 }
 
 const listEnabledGenres = `-- name: ListEnabledGenres :many
-SELECT id, code, name, language, region_code, category_ids, enabled, created_at, updated_at
+SELECT id, code, name, language, region_code, category_ids, enabled, created_at, updated_at, checkpoint_profile_id
 FROM ingestion.genres
 WHERE enabled = true
 ORDER BY code ASC
@@ -1263,6 +1885,7 @@ func (q *Queries) ListEnabledGenres(ctx context.Context) ([]IngestionGenre, erro
 			&i.Enabled,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.CheckpointProfileID,
 		); err != nil {
 			return nil, err
 		}
@@ -1332,7 +1955,7 @@ func (q *Queries) ListEnabledKeywords(ctx context.Context) ([]ListEnabledKeyword
 }
 
 const listGenres = `-- name: ListGenres :many
-SELECT id, code, name, language, region_code, category_ids, enabled, created_at, updated_at
+SELECT id, code, name, language, region_code, category_ids, enabled, created_at, updated_at, checkpoint_profile_id
 FROM ingestion.genres
 ORDER BY code ASC
 `
@@ -1356,6 +1979,7 @@ func (q *Queries) ListGenres(ctx context.Context) ([]IngestionGenre, error) {
 			&i.Enabled,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.CheckpointProfileID,
 		); err != nil {
 			return nil, err
 		}
@@ -1370,12 +1994,231 @@ func (q *Queries) ListGenres(ctx context.Context) ([]IngestionGenre, error) {
 	return items, nil
 }
 
-const listKeywordsByGenre = `-- name: ListKeywordsByGenre :many
-SELECT id, genre_id, name, filter_type, pattern, target_field, enabled, description, created_at, updated_at
-FROM ingestion.keywords
-WHERE genre_id = $1 AND ($2::boolean IS NULL OR enabled = $2) AND deleted_at IS NULL
-ORDER BY name ASC
-`
+const listKeywordGroups = `-- name: ListKeywordGroups :many
+SELECT id, genre_id, name, filter_type, target_field, enabled, description, created_at, updated_at, deleted_at, cross_language, romaji
+FROM ingestion.keyword_groups
+WHERE deleted_at IS NULL
+ORDER BY created_at ASC, id ASC
+LIMIT $1 OFFSET $2
+`
+
+type ListKeywordGroupsParams struct {
+	Limit  int32 `json:"limit"`
+	Offset int32 `json:"offset"`
+}
+
+func (q *Queries) ListKeywordGroups(ctx context.Context, arg ListKeywordGroupsParams) ([]IngestionKeywordGroup, error) {
+	rows, err := q.db.QueryContext(ctx, listKeywordGroups, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []IngestionKeywordGroup
+	for rows.Next() {
+		var i IngestionKeywordGroup
+		if err := rows.Scan(
+			&i.ID,
+			&i.GenreID,
+			&i.Name,
+			&i.FilterType,
+			&i.TargetField,
+			&i.Enabled,
+			&i.Description,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.CrossLanguage,
+			&i.Romaji,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listKeywordGroupsByEnabled = `-- name: ListKeywordGroupsByEnabled :many
+SELECT id, genre_id, name, filter_type, target_field, enabled, description, created_at, updated_at, deleted_at, cross_language, romaji
+FROM ingestion.keyword_groups
+WHERE enabled = $1 AND deleted_at IS NULL
+ORDER BY created_at ASC, id ASC
+LIMIT $2 OFFSET $3
+`
+
+type ListKeywordGroupsByEnabledParams struct {
+	Enabled sql.NullBool `json:"enabled"`
+	Limit   int32        `json:"limit"`
+	Offset  int32        `json:"offset"`
+}
+
+func (q *Queries) ListKeywordGroupsByEnabled(ctx context.Context, arg ListKeywordGroupsByEnabledParams) ([]IngestionKeywordGroup, error) {
+	rows, err := q.db.QueryContext(ctx, listKeywordGroupsByEnabled, arg.Enabled, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []IngestionKeywordGroup
+	for rows.Next() {
+		var i IngestionKeywordGroup
+		if err := rows.Scan(
+			&i.ID,
+			&i.GenreID,
+			&i.Name,
+			&i.FilterType,
+			&i.TargetField,
+			&i.Enabled,
+			&i.Description,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.CrossLanguage,
+			&i.Romaji,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listKeywordGroupsByGenre = `-- name: ListKeywordGroupsByGenre :many
+SELECT id, genre_id, name, filter_type, target_field, enabled, description, created_at, updated_at, deleted_at, cross_language, romaji
+FROM ingestion.keyword_groups
+WHERE genre_id = $1 AND deleted_at IS NULL
+ORDER BY filter_type ASC, name ASC
+`
+
+func (q *Queries) ListKeywordGroupsByGenre(ctx context.Context, genreID uuid.UUID) ([]IngestionKeywordGroup, error) {
+	rows, err := q.db.QueryContext(ctx, listKeywordGroupsByGenre, genreID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []IngestionKeywordGroup
+	for rows.Next() {
+		var i IngestionKeywordGroup
+		if err := rows.Scan(
+			&i.ID,
+			&i.GenreID,
+			&i.Name,
+			&i.FilterType,
+			&i.TargetField,
+			&i.Enabled,
+			&i.Description,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.CrossLanguage,
+			&i.Romaji,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listKeywordItemsByGroups = `-- name: ListKeywordItemsByGroups :many
+SELECT id, keyword_group_id, keyword, created_at, updated_at
+FROM ingestion.keyword_items
+WHERE keyword_group_id = ANY($1::uuid[])
+ORDER BY keyword_group_id ASC, keyword ASC
+`
+
+// Items of the given groups, ordered by keyword within each group
+func (q *Queries) ListKeywordItemsByGroups(ctx context.Context, groupIds []uuid.UUID) ([]IngestionKeywordItem, error) {
+	rows, err := q.db.QueryContext(ctx, listKeywordItemsByGroups, pq.Array(groupIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []IngestionKeywordItem
+	for rows.Next() {
+		var i IngestionKeywordItem
+		if err := rows.Scan(
+			&i.ID,
+			&i.KeywordGroupID,
+			&i.Keyword,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listKeywordSynonyms = `-- name: ListKeywordSynonyms :many
+SELECT id, language, term, alias, alias_language, bidirectional, created_at, updated_at
+FROM ingestion.keyword_synonyms
+WHERE $1::varchar IS NULL OR language = $1
+ORDER BY language ASC, lower(term) ASC, lower(alias) ASC
+`
+
+// Entries of a language, or of all languages when language is NULL
+func (q *Queries) ListKeywordSynonyms(ctx context.Context, language sql.NullString) ([]IngestionKeywordSynonym, error) {
+	rows, err := q.db.QueryContext(ctx, listKeywordSynonyms, language)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []IngestionKeywordSynonym
+	for rows.Next() {
+		var i IngestionKeywordSynonym
+		if err := rows.Scan(
+			&i.ID,
+			&i.Language,
+			&i.Term,
+			&i.Alias,
+			&i.AliasLanguage,
+			&i.Bidirectional,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listKeywordsByGenre = `-- name: ListKeywordsByGenre :many
+SELECT id, genre_id, name, filter_type, pattern, target_field, enabled, description, created_at, updated_at
+FROM ingestion.keywords
+WHERE genre_id = $1 AND ($2::boolean IS NULL OR enabled = $2) AND deleted_at IS NULL
+ORDER BY name ASC
+`
 
 type ListKeywordsByGenreParams struct {
 	GenreID uuid.UUID `json:"genre_id"`
@@ -1489,65 +2332,17 @@ func (q *Queries) ListKeywordsByGenreAndType(ctx context.Context, arg ListKeywor
 	return items, nil
 }
 
-const listRecentAuditLogs = `-- name: ListRecentAuditLogs :many
-SELECT id, actor_id, actor_email, action, resource_type, resource_id,
-       old_values, new_values, ip_address, user_agent, created_at
-FROM ingestion.audit_logs
-ORDER BY created_at DESC
-LIMIT $1
-`
-
-func (q *Queries) ListRecentAuditLogs(ctx context.Context, limit int32) ([]IngestionAuditLog, error) {
-	rows, err := q.db.QueryContext(ctx, listRecentAuditLogs, limit)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []IngestionAuditLog
-	for rows.Next() {
-		var i IngestionAuditLog
-		if err := rows.Scan(
-			&i.ID,
-			&i.ActorID,
-			&i.ActorEmail,
-			&i.Action,
-			&i.ResourceType,
-			&i.ResourceID,
-			&i.OldValues,
-			&i.NewValues,
-			&i.IpAddress,
-			&i.UserAgent,
-			&i.CreatedAt,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
-const listRecentBatchJobs = `-- name: ListRecentBatchJobs :many
+const listRunningBatchJobs = `-- name: ListRunningBatchJobs :many
 SELECT id, job_type, status, parameters, started_at, completed_at,
-       error_message, statistics, created_at
+       error_message, statistics, created_at,
+       cancel_requested_at, retry_of, progress_processed, progress_total, progress_current
 FROM ingestion.batch_jobs
-WHERE ($1::text IS NULL OR job_type = $1)
-ORDER BY created_at DESC
-LIMIT $2
+WHERE status = 'running'
+ORDER BY started_at ASC
 `
 
-type ListRecentBatchJobsParams struct {
-	Column1 string `json:"column_1"`
-	Limit   int32  `json:"limit"`
-}
-
-func (q *Queries) ListRecentBatchJobs(ctx context.Context, arg ListRecentBatchJobsParams) ([]IngestionBatchJob, error) {
-	rows, err := q.db.QueryContext(ctx, listRecentBatchJobs, arg.Column1, arg.Limit)
+func (q *Queries) ListRunningBatchJobs(ctx context.Context) ([]IngestionBatchJob, error) {
+	rows, err := q.db.QueryContext(ctx, listRunningBatchJobs)
 	if err != nil {
 		return nil, err
 	}
@@ -1565,6 +2360,11 @@ func (q *Queries) ListRecentBatchJobs(ctx context.Context, arg ListRecentBatchJo
 			&i.ErrorMessage,
 			&i.Statistics,
 			&i.CreatedAt,
+			&i.CancelRequestedAt,
+			&i.RetryOf,
+			&i.ProgressProcessed,
+			&i.ProgressTotal,
+			&i.ProgressCurrent,
 		); err != nil {
 			return nil, err
 		}
@@ -1579,33 +2379,28 @@ func (q *Queries) ListRecentBatchJobs(ctx context.Context, arg ListRecentBatchJo
 	return items, nil
 }
 
-const listRunningBatchJobs = `-- name: ListRunningBatchJobs :many
-SELECT id, job_type, status, parameters, started_at, completed_at,
-       error_message, statistics, created_at
-FROM ingestion.batch_jobs
-WHERE status = 'running'
-ORDER BY started_at ASC
+const listSnapshotGapsByVideo = `-- name: ListSnapshotGapsByVideo :many
+SELECT video_id, checkpoint_hour, due_at, reason, detected_at
+FROM ingestion.snapshot_gaps
+WHERE video_id = $1
+ORDER BY checkpoint_hour ASC
 `
 
-func (q *Queries) ListRunningBatchJobs(ctx context.Context) ([]IngestionBatchJob, error) {
-	rows, err := q.db.QueryContext(ctx, listRunningBatchJobs)
+func (q *Queries) ListSnapshotGapsByVideo(ctx context.Context, videoID uuid.UUID) ([]IngestionSnapshotGap, error) {
+	rows, err := q.db.QueryContext(ctx, listSnapshotGapsByVideo, videoID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []IngestionBatchJob
+	var items []IngestionSnapshotGap
 	for rows.Next() {
-		var i IngestionBatchJob
+		var i IngestionSnapshotGap
 		if err := rows.Scan(
-			&i.ID,
-			&i.JobType,
-			&i.Status,
-			&i.Parameters,
-			&i.StartedAt,
-			&i.CompletedAt,
-			&i.ErrorMessage,
-			&i.Statistics,
-			&i.CreatedAt,
+			&i.VideoID,
+			&i.CheckpointHour,
+			&i.DueAt,
+			&i.Reason,
+			&i.DetectedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1680,7 +2475,7 @@ func (q *Queries) ListSubscribedChannels(ctx context.Context) ([]ListSubscribedC
 }
 
 const listVideoGenresByGenre = `-- name: ListVideoGenresByGenre :many
-SELECT id, video_id, genre_id, created_at
+SELECT id, video_id, genre_id, created_at, match_provenance
 FROM ingestion.video_genres
 WHERE genre_id = $1
 `
@@ -1699,6 +2494,7 @@ func (q *Queries) ListVideoGenresByGenre(ctx context.Context, genreID uuid.UUID)
 			&i.VideoID,
 			&i.GenreID,
 			&i.CreatedAt,
+			&i.MatchProvenance,
 		); err != nil {
 			return nil, err
 		}
@@ -1714,7 +2510,7 @@ func (q *Queries) ListVideoGenresByGenre(ctx context.Context, genreID uuid.UUID)
 }
 
 const listVideoGenresByVideo = `-- name: ListVideoGenresByVideo :many
-SELECT id, video_id, genre_id, created_at
+SELECT id, video_id, genre_id, created_at, match_provenance
 FROM ingestion.video_genres
 WHERE video_id = $1
 `
@@ -1733,6 +2529,7 @@ func (q *Queries) ListVideoGenresByVideo(ctx context.Context, videoID uuid.UUID)
 			&i.VideoID,
 			&i.GenreID,
 			&i.CreatedAt,
+			&i.MatchProvenance,
 		); err != nil {
 			return nil, err
 		}
@@ -1749,7 +2546,8 @@ func (q *Queries) ListVideoGenresByVideo(ctx context.Context, videoID uuid.UUID)
 
 const listVideoSnapshots = `-- name: ListVideoSnapshots :many
 SELECT id, video_id, checkpoint_hour, measured_at, view_count, 
-       like_count, subscription_count, source, created_at, updated_at
+       like_count, subscription_count, source, created_at, updated_at, drift_seconds,
+       comment_count, metrics
 FROM ingestion.video_snapshots
 WHERE video_id = $1
 ORDER BY checkpoint_hour ASC
@@ -1775,6 +2573,9 @@ func (q *Queries) ListVideoSnapshots(ctx context.Context, videoID uuid.UUID) ([]
 			&i.Source,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.DriftSeconds,
+			&i.CommentCount,
+			&i.Metrics,
 		); err != nil {
 			return nil, err
 		}
@@ -1881,44 +2682,1231 @@ func (q *Queries) ListYouTubeCategories(ctx context.Context) ([]IngestionYoutube
 	return items, nil
 }
 
-const softDeleteKeyword = `-- name: SoftDeleteKeyword :exec
-UPDATE ingestion.keywords
-SET deleted_at = $2, updated_at = $2
-WHERE id = $1 AND deleted_at IS NULL
+const releaseBatchJobLock = `-- name: ReleaseBatchJobLock :exec
+DELETE FROM ingestion.batch_job_locks
+WHERE lock_key = $1 AND job_id = $2
 `
 
-type SoftDeleteKeywordParams struct {
-	ID        uuid.UUID    `json:"id"`
-	DeletedAt sql.NullTime `json:"deleted_at"`
+type ReleaseBatchJobLockParams struct {
+	LockKey string    `json:"lock_key"`
+	JobID   uuid.UUID `json:"job_id"`
 }
 
-func (q *Queries) SoftDeleteKeyword(ctx context.Context, arg SoftDeleteKeywordParams) error {
-	_, err := q.db.ExecContext(ctx, softDeleteKeyword, arg.ID, arg.DeletedAt)
+func (q *Queries) ReleaseBatchJobLock(ctx context.Context, arg ReleaseBatchJobLockParams) error {
+	_, err := q.db.ExecContext(ctx, releaseBatchJobLock, arg.LockKey, arg.JobID)
 	return err
 }
 
-const updateBatchJob = `-- name: UpdateBatchJob :exec
+const requestBatchJobCancel = `-- name: RequestBatchJobCancel :exec
 UPDATE ingestion.batch_jobs
-SET status = $2, started_at = $3, completed_at = $4, error_message = $5, statistics = $6
+SET cancel_requested_at = COALESCE(cancel_requested_at, now())
 WHERE id = $1
 `
 
-type UpdateBatchJobParams struct {
-	ID           uuid.UUID             `json:"id"`
-	Status       string                `json:"status"`
-	StartedAt    sql.NullTime          `json:"started_at"`
-	CompletedAt  sql.NullTime          `json:"completed_at"`
-	ErrorMessage sql.NullString        `json:"error_message"`
-	Statistics   pqtype.NullRawMessage `json:"statistics"`
+func (q *Queries) RequestBatchJobCancel(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, requestBatchJobCancel, id)
+	return err
 }
 
-func (q *Queries) UpdateBatchJob(ctx context.Context, arg UpdateBatchJobParams) error {
-	_, err := q.db.ExecContext(ctx, updateBatchJob,
-		arg.ID,
-		arg.Status,
-		arg.StartedAt,
-		arg.CompletedAt,
-		arg.ErrorMessage,
+const saveBatchJobProgress = `-- name: SaveBatchJobProgress :one
+UPDATE ingestion.batch_jobs
+SET progress_processed = $2, progress_total = $3, progress_current = $4
+WHERE id = $1
+RETURNING cancel_requested_at
+`
+
+type SaveBatchJobProgressParams struct {
+	ID                uuid.UUID      `json:"id"`
+	ProgressProcessed int32          `json:"progress_processed"`
+	ProgressTotal     int32          `json:"progress_total"`
+	ProgressCurrent   sql.NullString `json:"progress_current"`
+}
+
+// Records progress of a running job and returns whether cancellation was requested
+func (q *Queries) SaveBatchJobProgress(ctx context.Context, arg SaveBatchJobProgressParams) (sql.NullTime, error) {
+	row := q.db.QueryRowContext(ctx, saveBatchJobProgress,
+		arg.ID,
+		arg.ProgressProcessed,
+		arg.ProgressTotal,
+		arg.ProgressCurrent,
+	)
+	var cancel_requested_at sql.NullTime
+	err := row.Scan(&cancel_requested_at)
+	return cancel_requested_at, err
+}
+
+const searchAuditLogs = `-- name: SearchAuditLogs :many
+SELECT id, actor_id, actor_email, action, resource_type, resource_id,
+       old_values, new_values, ip_address, user_agent, created_at
+FROM ingestion.audit_logs
+WHERE ($1::text IS NULL OR actor_id = $1)
+  AND ($2::text IS NULL OR action = $2)
+  AND ($3::text IS NULL OR resource_type = $3)
+  AND ($4::text IS NULL OR resource_id = $4)
+  AND ($5::text IS NULL
+       OR old_values ? $5 OR new_values ? $5)
+  AND ($6::timestamptz IS NULL OR created_at >= $6)
+  AND ($7::timestamptz IS NULL OR created_at < $7)
+  AND ($8::timestamptz IS NULL
+       OR (created_at, id) < ($8, $9::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT $10
+`
+
+type SearchAuditLogsParams struct {
+	ActorID        sql.NullString `json:"actor_id"`
+	Action         sql.NullString `json:"action"`
+	ResourceType   sql.NullString `json:"resource_type"`
+	ResourceID     sql.NullString `json:"resource_id"`
+	ChangedField   sql.NullString `json:"changed_field"`
+	CreatedAfter   sql.NullTime   `json:"created_after"`
+	CreatedBefore  sql.NullTime   `json:"created_before"`
+	AfterCreatedAt sql.NullTime   `json:"after_created_at"`
+	AfterID        uuid.NullUUID  `json:"after_id"`
+	PageSize       int32          `json:"page_size"`
+}
+
+// Keyset page of audit logs matching the filters, ordered by (created_at, id) descending
+func (q *Queries) SearchAuditLogs(ctx context.Context, arg SearchAuditLogsParams) ([]IngestionAuditLog, error) {
+	rows, err := q.db.QueryContext(ctx, searchAuditLogs,
+		arg.ActorID,
+		arg.Action,
+		arg.ResourceType,
+		arg.ResourceID,
+		arg.ChangedField,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []IngestionAuditLog
+	for rows.Next() {
+		var i IngestionAuditLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.ActorID,
+			&i.ActorEmail,
+			&i.Action,
+			&i.ResourceType,
+			&i.ResourceID,
+			&i.OldValues,
+			&i.NewValues,
+			&i.IpAddress,
+			&i.UserAgent,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchBatchJobs = `-- name: SearchBatchJobs :many
+SELECT id, job_type, status, parameters, started_at, completed_at,
+       error_message, statistics, created_at,
+       cancel_requested_at, retry_of, progress_processed, progress_total, progress_current
+FROM ingestion.batch_jobs
+WHERE ($1::text IS NULL OR job_type = $1)
+  AND ($2::text IS NULL OR status = $2)
+  AND ($3::timestamptz IS NULL
+       OR (created_at, id) < ($3, $4::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT $5
+`
+
+type SearchBatchJobsParams struct {
+	JobType        sql.NullString `json:"job_type"`
+	Status         sql.NullString `json:"status"`
+	AfterCreatedAt sql.NullTime   `json:"after_created_at"`
+	AfterID        uuid.NullUUID  `json:"after_id"`
+	PageSize       int32          `json:"page_size"`
+}
+
+// Keyset page of batch jobs matching the filters, ordered by (created_at, id) descending
+func (q *Queries) SearchBatchJobs(ctx context.Context, arg SearchBatchJobsParams) ([]IngestionBatchJob, error) {
+	rows, err := q.db.QueryContext(ctx, searchBatchJobs,
+		arg.JobType,
+		arg.Status,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []IngestionBatchJob
+	for rows.Next() {
+		var i IngestionBatchJob
+		if err := rows.Scan(
+			&i.ID,
+			&i.JobType,
+			&i.Status,
+			&i.Parameters,
+			&i.StartedAt,
+			&i.CompletedAt,
+			&i.ErrorMessage,
+			&i.Statistics,
+			&i.CreatedAt,
+			&i.CancelRequestedAt,
+			&i.RetryOf,
+			&i.ProgressProcessed,
+			&i.ProgressTotal,
+			&i.ProgressCurrent,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchChannels = `-- name: SearchChannels :many
+SELECT id, youtube_channel_id, title, thumbnail_url, description, country,
+       view_count, subscription_count, video_count, subscribed, created_at, updated_at
+FROM ingestion.channels
+WHERE deleted_at IS NULL
+  AND ($1::boolean IS NULL OR subscribed = $1)
+  AND ($2::text IS NULL OR title ILIKE '%' || $2 || '%' ESCAPE '\')
+  AND ($3::timestamptz IS NULL
+       OR (created_at, id) < ($3, $4::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT $5
+`
+
+type SearchChannelsParams struct {
+	Subscribed     sql.NullBool   `json:"subscribed"`
+	Query          sql.NullString `json:"query"`
+	AfterCreatedAt sql.NullTime   `json:"after_created_at"`
+	AfterID        uuid.NullUUID  `json:"after_id"`
+	PageSize       int32          `json:"page_size"`
+}
+
+type SearchChannelsRow struct {
+	ID                uuid.UUID      `json:"id"`
+	YoutubeChannelID  string         `json:"youtube_channel_id"`
+	Title             string         `json:"title"`
+	ThumbnailUrl      string         `json:"thumbnail_url"`
+	Description       sql.NullString `json:"description"`
+	Country           sql.NullString `json:"country"`
+	ViewCount         sql.NullInt64  `json:"view_count"`
+	SubscriptionCount sql.NullInt64  `json:"subscription_count"`
+	VideoCount        sql.NullInt64  `json:"video_count"`
+	Subscribed        sql.NullBool   `json:"subscribed"`
+	CreatedAt         sql.NullTime   `json:"created_at"`
+	UpdatedAt         sql.NullTime   `json:"updated_at"`
+}
+
+// Keyset page of channels matching the filters, ordered by (created_at, id) descending
+func (q *Queries) SearchChannels(ctx context.Context, arg SearchChannelsParams) ([]SearchChannelsRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchChannels,
+		arg.Subscribed,
+		arg.Query,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchChannelsRow
+	for rows.Next() {
+		var i SearchChannelsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.YoutubeChannelID,
+			&i.Title,
+			&i.ThumbnailUrl,
+			&i.Description,
+			&i.Country,
+			&i.ViewCount,
+			&i.SubscriptionCount,
+			&i.VideoCount,
+			&i.Subscribed,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchGenres = `-- name: SearchGenres :many
+SELECT id, code, name, language, region_code, category_ids, enabled, created_at, updated_at, checkpoint_profile_id
+FROM ingestion.genres
+WHERE (NOT $1::boolean OR enabled = true)
+  AND ($2::text IS NULL OR code > $2)
+ORDER BY code ASC
+LIMIT $3
+`
+
+type SearchGenresParams struct {
+	EnabledOnly bool           `json:"enabled_only"`
+	AfterCode   sql.NullString `json:"after_code"`
+	PageSize    int32          `json:"page_size"`
+}
+
+// Keyset page of genres ordered by code
+func (q *Queries) SearchGenres(ctx context.Context, arg SearchGenresParams) ([]IngestionGenre, error) {
+	rows, err := q.db.QueryContext(ctx, searchGenres, arg.EnabledOnly, arg.AfterCode, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []IngestionGenre
+	for rows.Next() {
+		var i IngestionGenre
+		if err := rows.Scan(
+			&i.ID,
+			&i.Code,
+			&i.Name,
+			&i.Language,
+			&i.RegionCode,
+			pq.Array(&i.CategoryIds),
+			&i.Enabled,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.CheckpointProfileID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchKeywordGroupsByGenre = `-- name: SearchKeywordGroupsByGenre :many
+SELECT id, genre_id, name, filter_type, target_field, enabled, description, created_at, updated_at, deleted_at, cross_language, romaji
+FROM ingestion.keyword_groups
+WHERE genre_id = $1 AND deleted_at IS NULL
+  AND (NOT $2::boolean OR enabled = true)
+  AND ($3::text IS NULL
+       OR (name, id) > ($3, $4::uuid))
+ORDER BY name ASC, id ASC
+LIMIT $5
+`
+
+type SearchKeywordGroupsByGenreParams struct {
+	GenreID     uuid.UUID      `json:"genre_id"`
+	EnabledOnly bool           `json:"enabled_only"`
+	AfterName   sql.NullString `json:"after_name"`
+	AfterID     uuid.NullUUID  `json:"after_id"`
+	PageSize    int32          `json:"page_size"`
+}
+
+// Keyset page of the keyword groups of a genre, ordered by (name, id)
+func (q *Queries) SearchKeywordGroupsByGenre(ctx context.Context, arg SearchKeywordGroupsByGenreParams) ([]IngestionKeywordGroup, error) {
+	rows, err := q.db.QueryContext(ctx, searchKeywordGroupsByGenre,
+		arg.GenreID,
+		arg.EnabledOnly,
+		arg.AfterName,
+		arg.AfterID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []IngestionKeywordGroup
+	for rows.Next() {
+		var i IngestionKeywordGroup
+		if err := rows.Scan(
+			&i.ID,
+			&i.GenreID,
+			&i.Name,
+			&i.FilterType,
+			&i.TargetField,
+			&i.Enabled,
+			&i.Description,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.CrossLanguage,
+			&i.Romaji,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchKeywordSynonyms = `-- name: SearchKeywordSynonyms :many
+SELECT id, language, term, alias, alias_language, bidirectional, created_at, updated_at
+FROM ingestion.keyword_synonyms
+WHERE ($1::varchar IS NULL OR language = $1)
+  AND ($2::uuid IS NULL
+       OR (language, lower(term), lower(alias), id)
+          > ($3::varchar, lower($4::text), lower($5::text), $2))
+ORDER BY language ASC, lower(term) ASC, lower(alias) ASC, id ASC
+LIMIT $6
+`
+
+type SearchKeywordSynonymsParams struct {
+	Language      sql.NullString `json:"language"`
+	AfterID       uuid.NullUUID  `json:"after_id"`
+	AfterLanguage string         `json:"after_language"`
+	AfterTerm     string         `json:"after_term"`
+	AfterAlias    string         `json:"after_alias"`
+	PageSize      int32          `json:"page_size"`
+}
+
+// Keyset page of the entries of a language, or of all languages when language
+// is NULL, ordered by (language, lower(term), lower(alias), id)
+func (q *Queries) SearchKeywordSynonyms(ctx context.Context, arg SearchKeywordSynonymsParams) ([]IngestionKeywordSynonym, error) {
+	rows, err := q.db.QueryContext(ctx, searchKeywordSynonyms,
+		arg.Language,
+		arg.AfterID,
+		arg.AfterLanguage,
+		arg.AfterTerm,
+		arg.AfterAlias,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []IngestionKeywordSynonym
+	for rows.Next() {
+		var i IngestionKeywordSynonym
+		if err := rows.Scan(
+			&i.ID,
+			&i.Language,
+			&i.Term,
+			&i.Alias,
+			&i.AliasLanguage,
+			&i.Bidirectional,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchKeywords = `-- name: SearchKeywords :many
+SELECT id, genre_id, name, filter_type, pattern, target_field, enabled, description, created_at, updated_at
+FROM ingestion.keywords
+WHERE deleted_at IS NULL
+  AND ($1::uuid IS NULL OR genre_id = $1)
+  AND (NOT $2::boolean OR enabled = true)
+  AND ($3::text IS NULL OR name ILIKE '%' || $3 || '%' ESCAPE '\')
+  AND ($4::text IS NULL
+       OR (name, id) > ($4, $5::uuid))
+ORDER BY name ASC, id ASC
+LIMIT $6
+`
+
+type SearchKeywordsParams struct {
+	GenreID     uuid.NullUUID  `json:"genre_id"`
+	EnabledOnly bool           `json:"enabled_only"`
+	Query       sql.NullString `json:"query"`
+	AfterName   sql.NullString `json:"after_name"`
+	AfterID     uuid.NullUUID  `json:"after_id"`
+	PageSize    int32          `json:"page_size"`
+}
+
+type SearchKeywordsRow struct {
+	ID          uuid.UUID      `json:"id"`
+	GenreID     uuid.UUID      `json:"genre_id"`
+	Name        string         `json:"name"`
+	FilterType  string         `json:"filter_type"`
+	Pattern     string         `json:"pattern"`
+	TargetField string         `json:"target_field"`
+	Enabled     sql.NullBool   `json:"enabled"`
+	Description sql.NullString `json:"description"`
+	CreatedAt   sql.NullTime   `json:"created_at"`
+	UpdatedAt   sql.NullTime   `json:"updated_at"`
+}
+
+// Keyset page of keywords matching the filters, ordered by (name, id)
+func (q *Queries) SearchKeywords(ctx context.Context, arg SearchKeywordsParams) ([]SearchKeywordsRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchKeywords,
+		arg.GenreID,
+		arg.EnabledOnly,
+		arg.Query,
+		arg.AfterName,
+		arg.AfterID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchKeywordsRow
+	for rows.Next() {
+		var i SearchKeywordsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.GenreID,
+			&i.Name,
+			&i.FilterType,
+			&i.Pattern,
+			&i.TargetField,
+			&i.Enabled,
+			&i.Description,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchVideoGenresByVideo = `-- name: SearchVideoGenresByVideo :many
+SELECT id, video_id, genre_id, created_at, match_provenance
+FROM ingestion.video_genres
+WHERE video_id = $1
+  AND ($2::timestamptz IS NULL
+       OR (created_at, id) > ($2, $3::uuid))
+ORDER BY created_at ASC, id ASC
+LIMIT $4
+`
+
+type SearchVideoGenresByVideoParams struct {
+	VideoID        uuid.UUID     `json:"video_id"`
+	AfterCreatedAt sql.NullTime  `json:"after_created_at"`
+	AfterID        uuid.NullUUID `json:"after_id"`
+	PageSize       int32         `json:"page_size"`
+}
+
+// Keyset page of the genre assignments of a video, ordered by (created_at, id)
+func (q *Queries) SearchVideoGenresByVideo(ctx context.Context, arg SearchVideoGenresByVideoParams) ([]IngestionVideoGenre, error) {
+	rows, err := q.db.QueryContext(ctx, searchVideoGenresByVideo,
+		arg.VideoID,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []IngestionVideoGenre
+	for rows.Next() {
+		var i IngestionVideoGenre
+		if err := rows.Scan(
+			&i.ID,
+			&i.VideoID,
+			&i.GenreID,
+			&i.CreatedAt,
+			&i.MatchProvenance,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchVideoSnapshots = `-- name: SearchVideoSnapshots :many
+SELECT s.id, s.video_id, s.checkpoint_hour, s.measured_at, s.view_count,
+       s.like_count, s.subscription_count, s.source, s.created_at, s.updated_at, s.drift_seconds,
+       s.comment_count, s.metrics
+FROM ingestion.video_snapshots s
+WHERE ($1::uuid IS NULL OR s.video_id = $1)
+  AND ($2::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM ingestion.video_genres vg
+      WHERE vg.video_id = s.video_id AND vg.genre_id = $2
+  ))
+  AND ($3::integer IS NULL OR s.checkpoint_hour = $3)
+  AND ($4::timestamptz IS NULL OR s.measured_at >= $4)
+  AND ($5::timestamptz IS NULL OR s.measured_at < $5)
+  AND ($6::timestamptz IS NULL
+       OR (s.measured_at, s.id) > ($6, $7::uuid))
+ORDER BY s.measured_at, s.id
+LIMIT $8
+`
+
+type SearchVideoSnapshotsParams struct {
+	VideoID         uuid.NullUUID `json:"video_id"`
+	GenreID         uuid.NullUUID `json:"genre_id"`
+	CheckpointHour  sql.NullInt32 `json:"checkpoint_hour"`
+	MeasuredFrom    sql.NullTime  `json:"measured_from"`
+	MeasuredTo      sql.NullTime  `json:"measured_to"`
+	AfterMeasuredAt sql.NullTime  `json:"after_measured_at"`
+	AfterID         uuid.NullUUID `json:"after_id"`
+	PageSize        int32         `json:"page_size"`
+}
+
+// Keyset page of snapshots matching the filters, ordered by (measured_at, id)
+func (q *Queries) SearchVideoSnapshots(ctx context.Context, arg SearchVideoSnapshotsParams) ([]IngestionVideoSnapshot, error) {
+	rows, err := q.db.QueryContext(ctx, searchVideoSnapshots,
+		arg.VideoID,
+		arg.GenreID,
+		arg.CheckpointHour,
+		arg.MeasuredFrom,
+		arg.MeasuredTo,
+		arg.AfterMeasuredAt,
+		arg.AfterID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []IngestionVideoSnapshot
+	for rows.Next() {
+		var i IngestionVideoSnapshot
+		if err := rows.Scan(
+			&i.ID,
+			&i.VideoID,
+			&i.CheckpointHour,
+			&i.MeasuredAt,
+			&i.ViewCount,
+			&i.LikeCount,
+			&i.SubscriptionCount,
+			&i.Source,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DriftSeconds,
+			&i.CommentCount,
+			&i.Metrics,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchVideosByCreatedAt = `-- name: SearchVideosByCreatedAt :many
+SELECT v.id, v.youtube_video_id, v.channel_id, v.youtube_channel_id, v.title, v.published_at, v.category_id, v.created_at,
+       v.duration_seconds
+FROM ingestion.videos v
+WHERE v.deleted_at IS NULL
+  AND ($1::uuid IS NULL OR v.channel_id = $1)
+  AND ($2::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM ingestion.video_genres vg
+      WHERE vg.video_id = v.id AND vg.genre_id = $2
+  ))
+  AND ($3::integer IS NULL OR v.category_id = $3)
+  AND ($4::timestamptz IS NULL OR v.published_at >= $4)
+  AND ($5::timestamptz IS NULL OR v.published_at < $5)
+  AND ($6::boolean IS NULL
+       OR (v.duration_seconds IS NOT NULL AND v.duration_seconds <= $7::integer) = $6)
+  AND ($8::text IS NULL OR v.title ILIKE '%' || $8 || '%' ESCAPE '\')
+  AND ($9::timestamptz IS NULL
+       OR (v.created_at, v.id) < ($9, $10::uuid))
+ORDER BY v.created_at DESC, v.id DESC
+LIMIT $11
+`
+
+type SearchVideosByCreatedAtParams struct {
+	ChannelID        uuid.NullUUID  `json:"channel_id"`
+	GenreID          uuid.NullUUID  `json:"genre_id"`
+	CategoryID       sql.NullInt32  `json:"category_id"`
+	PublishedFrom    sql.NullTime   `json:"published_from"`
+	PublishedTo      sql.NullTime   `json:"published_to"`
+	Shorts           sql.NullBool   `json:"shorts"`
+	ShortsMaxSeconds int32          `json:"shorts_max_seconds"`
+	Query            sql.NullString `json:"query"`
+	AfterKey         sql.NullTime   `json:"after_key"`
+	AfterID          uuid.NullUUID  `json:"after_id"`
+	PageSize         int32          `json:"page_size"`
+}
+
+type SearchVideosByCreatedAtRow struct {
+	ID               uuid.UUID     `json:"id"`
+	YoutubeVideoID   string        `json:"youtube_video_id"`
+	ChannelID        uuid.UUID     `json:"channel_id"`
+	YoutubeChannelID string        `json:"youtube_channel_id"`
+	Title            string        `json:"title"`
+	PublishedAt      time.Time     `json:"published_at"`
+	CategoryID       int32         `json:"category_id"`
+	CreatedAt        sql.NullTime  `json:"created_at"`
+	DurationSeconds  sql.NullInt32 `json:"duration_seconds"`
+}
+
+// Keyset page of videos matching the filters, ordered by (created_at, id) descending
+func (q *Queries) SearchVideosByCreatedAt(ctx context.Context, arg SearchVideosByCreatedAtParams) ([]SearchVideosByCreatedAtRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchVideosByCreatedAt,
+		arg.ChannelID,
+		arg.GenreID,
+		arg.CategoryID,
+		arg.PublishedFrom,
+		arg.PublishedTo,
+		arg.Shorts,
+		arg.ShortsMaxSeconds,
+		arg.Query,
+		arg.AfterKey,
+		arg.AfterID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchVideosByCreatedAtRow
+	for rows.Next() {
+		var i SearchVideosByCreatedAtRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.YoutubeVideoID,
+			&i.ChannelID,
+			&i.YoutubeChannelID,
+			&i.Title,
+			&i.PublishedAt,
+			&i.CategoryID,
+			&i.CreatedAt,
+			&i.DurationSeconds,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchVideosByCreatedAtAsc = `-- name: SearchVideosByCreatedAtAsc :many
+SELECT v.id, v.youtube_video_id, v.channel_id, v.youtube_channel_id, v.title, v.published_at, v.category_id, v.created_at,
+       v.duration_seconds
+FROM ingestion.videos v
+WHERE v.deleted_at IS NULL
+  AND ($1::uuid IS NULL OR v.channel_id = $1)
+  AND ($2::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM ingestion.video_genres vg
+      WHERE vg.video_id = v.id AND vg.genre_id = $2
+  ))
+  AND ($3::integer IS NULL OR v.category_id = $3)
+  AND ($4::timestamptz IS NULL OR v.published_at >= $4)
+  AND ($5::timestamptz IS NULL OR v.published_at < $5)
+  AND ($6::boolean IS NULL
+       OR (v.duration_seconds IS NOT NULL AND v.duration_seconds <= $7::integer) = $6)
+  AND ($8::text IS NULL OR v.title ILIKE '%' || $8 || '%' ESCAPE '\')
+  AND ($9::timestamptz IS NULL
+       OR (v.created_at, v.id) > ($9, $10::uuid))
+ORDER BY v.created_at ASC, v.id ASC
+LIMIT $11
+`
+
+type SearchVideosByCreatedAtAscParams struct {
+	ChannelID        uuid.NullUUID  `json:"channel_id"`
+	GenreID          uuid.NullUUID  `json:"genre_id"`
+	CategoryID       sql.NullInt32  `json:"category_id"`
+	PublishedFrom    sql.NullTime   `json:"published_from"`
+	PublishedTo      sql.NullTime   `json:"published_to"`
+	Shorts           sql.NullBool   `json:"shorts"`
+	ShortsMaxSeconds int32          `json:"shorts_max_seconds"`
+	Query            sql.NullString `json:"query"`
+	AfterKey         sql.NullTime   `json:"after_key"`
+	AfterID          uuid.NullUUID  `json:"after_id"`
+	PageSize         int32          `json:"page_size"`
+}
+
+type SearchVideosByCreatedAtAscRow struct {
+	ID               uuid.UUID     `json:"id"`
+	YoutubeVideoID   string        `json:"youtube_video_id"`
+	ChannelID        uuid.UUID     `json:"channel_id"`
+	YoutubeChannelID string        `json:"youtube_channel_id"`
+	Title            string        `json:"title"`
+	PublishedAt      time.Time     `json:"published_at"`
+	CategoryID       int32         `json:"category_id"`
+	CreatedAt        sql.NullTime  `json:"created_at"`
+	DurationSeconds  sql.NullInt32 `json:"duration_seconds"`
+}
+
+// Keyset page of videos matching the filters, ordered by (created_at, id) ascending
+func (q *Queries) SearchVideosByCreatedAtAsc(ctx context.Context, arg SearchVideosByCreatedAtAscParams) ([]SearchVideosByCreatedAtAscRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchVideosByCreatedAtAsc,
+		arg.ChannelID,
+		arg.GenreID,
+		arg.CategoryID,
+		arg.PublishedFrom,
+		arg.PublishedTo,
+		arg.Shorts,
+		arg.ShortsMaxSeconds,
+		arg.Query,
+		arg.AfterKey,
+		arg.AfterID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchVideosByCreatedAtAscRow
+	for rows.Next() {
+		var i SearchVideosByCreatedAtAscRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.YoutubeVideoID,
+			&i.ChannelID,
+			&i.YoutubeChannelID,
+			&i.Title,
+			&i.PublishedAt,
+			&i.CategoryID,
+			&i.CreatedAt,
+			&i.DurationSeconds,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchVideosByPublishedAt = `-- name: SearchVideosByPublishedAt :many
+SELECT v.id, v.youtube_video_id, v.channel_id, v.youtube_channel_id, v.title, v.published_at, v.category_id, v.created_at,
+       v.duration_seconds
+FROM ingestion.videos v
+WHERE v.deleted_at IS NULL
+  AND ($1::uuid IS NULL OR v.channel_id = $1)
+  AND ($2::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM ingestion.video_genres vg
+      WHERE vg.video_id = v.id AND vg.genre_id = $2
+  ))
+  AND ($3::integer IS NULL OR v.category_id = $3)
+  AND ($4::timestamptz IS NULL OR v.published_at >= $4)
+  AND ($5::timestamptz IS NULL OR v.published_at < $5)
+  AND ($6::boolean IS NULL
+       OR (v.duration_seconds IS NOT NULL AND v.duration_seconds <= $7::integer) = $6)
+  AND ($8::text IS NULL OR v.title ILIKE '%' || $8 || '%' ESCAPE '\')
+  AND ($9::timestamptz IS NULL
+       OR (v.published_at, v.id) < ($9, $10::uuid))
+ORDER BY v.published_at DESC, v.id DESC
+LIMIT $11
+`
+
+type SearchVideosByPublishedAtParams struct {
+	ChannelID        uuid.NullUUID  `json:"channel_id"`
+	GenreID          uuid.NullUUID  `json:"genre_id"`
+	CategoryID       sql.NullInt32  `json:"category_id"`
+	PublishedFrom    sql.NullTime   `json:"published_from"`
+	PublishedTo      sql.NullTime   `json:"published_to"`
+	Shorts           sql.NullBool   `json:"shorts"`
+	ShortsMaxSeconds int32          `json:"shorts_max_seconds"`
+	Query            sql.NullString `json:"query"`
+	AfterKey         sql.NullTime   `json:"after_key"`
+	AfterID          uuid.NullUUID  `json:"after_id"`
+	PageSize         int32          `json:"page_size"`
+}
+
+type SearchVideosByPublishedAtRow struct {
+	ID               uuid.UUID     `json:"id"`
+	YoutubeVideoID   string        `json:"youtube_video_id"`
+	ChannelID        uuid.UUID     `json:"channel_id"`
+	YoutubeChannelID string        `json:"youtube_channel_id"`
+	Title            string        `json:"title"`
+	PublishedAt      time.Time     `json:"published_at"`
+	CategoryID       int32         `json:"category_id"`
+	CreatedAt        sql.NullTime  `json:"created_at"`
+	DurationSeconds  sql.NullInt32 `json:"duration_seconds"`
+}
+
+// Keyset page of videos matching the filters, ordered by (published_at, id) descending
+func (q *Queries) SearchVideosByPublishedAt(ctx context.Context, arg SearchVideosByPublishedAtParams) ([]SearchVideosByPublishedAtRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchVideosByPublishedAt,
+		arg.ChannelID,
+		arg.GenreID,
+		arg.CategoryID,
+		arg.PublishedFrom,
+		arg.PublishedTo,
+		arg.Shorts,
+		arg.ShortsMaxSeconds,
+		arg.Query,
+		arg.AfterKey,
+		arg.AfterID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchVideosByPublishedAtRow
+	for rows.Next() {
+		var i SearchVideosByPublishedAtRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.YoutubeVideoID,
+			&i.ChannelID,
+			&i.YoutubeChannelID,
+			&i.Title,
+			&i.PublishedAt,
+			&i.CategoryID,
+			&i.CreatedAt,
+			&i.DurationSeconds,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchVideosByPublishedAtAsc = `-- name: SearchVideosByPublishedAtAsc :many
+SELECT v.id, v.youtube_video_id, v.channel_id, v.youtube_channel_id, v.title, v.published_at, v.category_id, v.created_at,
+       v.duration_seconds
+FROM ingestion.videos v
+WHERE v.deleted_at IS NULL
+  AND ($1::uuid IS NULL OR v.channel_id = $1)
+  AND ($2::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM ingestion.video_genres vg
+      WHERE vg.video_id = v.id AND vg.genre_id = $2
+  ))
+  AND ($3::integer IS NULL OR v.category_id = $3)
+  AND ($4::timestamptz IS NULL OR v.published_at >= $4)
+  AND ($5::timestamptz IS NULL OR v.published_at < $5)
+  AND ($6::boolean IS NULL
+       OR (v.duration_seconds IS NOT NULL AND v.duration_seconds <= $7::integer) = $6)
+  AND ($8::text IS NULL OR v.title ILIKE '%' || $8 || '%' ESCAPE '\')
+  AND ($9::timestamptz IS NULL
+       OR (v.published_at, v.id) > ($9, $10::uuid))
+ORDER BY v.published_at ASC, v.id ASC
+LIMIT $11
+`
+
+type SearchVideosByPublishedAtAscParams struct {
+	ChannelID        uuid.NullUUID  `json:"channel_id"`
+	GenreID          uuid.NullUUID  `json:"genre_id"`
+	CategoryID       sql.NullInt32  `json:"category_id"`
+	PublishedFrom    sql.NullTime   `json:"published_from"`
+	PublishedTo      sql.NullTime   `json:"published_to"`
+	Shorts           sql.NullBool   `json:"shorts"`
+	ShortsMaxSeconds int32          `json:"shorts_max_seconds"`
+	Query            sql.NullString `json:"query"`
+	AfterKey         sql.NullTime   `json:"after_key"`
+	AfterID          uuid.NullUUID  `json:"after_id"`
+	PageSize         int32          `json:"page_size"`
+}
+
+type SearchVideosByPublishedAtAscRow struct {
+	ID               uuid.UUID     `json:"id"`
+	YoutubeVideoID   string        `json:"youtube_video_id"`
+	ChannelID        uuid.UUID     `json:"channel_id"`
+	YoutubeChannelID string        `json:"youtube_channel_id"`
+	Title            string        `json:"title"`
+	PublishedAt      time.Time     `json:"published_at"`
+	CategoryID       int32         `json:"category_id"`
+	CreatedAt        sql.NullTime  `json:"created_at"`
+	DurationSeconds  sql.NullInt32 `json:"duration_seconds"`
+}
+
+// Keyset page of videos matching the filters, ordered by (published_at, id) ascending
+func (q *Queries) SearchVideosByPublishedAtAsc(ctx context.Context, arg SearchVideosByPublishedAtAscParams) ([]SearchVideosByPublishedAtAscRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchVideosByPublishedAtAsc,
+		arg.ChannelID,
+		arg.GenreID,
+		arg.CategoryID,
+		arg.PublishedFrom,
+		arg.PublishedTo,
+		arg.Shorts,
+		arg.ShortsMaxSeconds,
+		arg.Query,
+		arg.AfterKey,
+		arg.AfterID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchVideosByPublishedAtAscRow
+	for rows.Next() {
+		var i SearchVideosByPublishedAtAscRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.YoutubeVideoID,
+			&i.ChannelID,
+			&i.YoutubeChannelID,
+			&i.Title,
+			&i.PublishedAt,
+			&i.CategoryID,
+			&i.CreatedAt,
+			&i.DurationSeconds,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchVideosInGenreScope = `-- name: SearchVideosInGenreScope :many
+SELECT v.id, v.youtube_video_id, v.channel_id, v.youtube_channel_id, v.title, v.published_at, v.category_id, v.created_at,
+       v.duration_seconds
+FROM ingestion.videos v
+WHERE v.deleted_at IS NULL
+  AND v.published_at >= $1
+  AND (EXISTS (
+      SELECT 1 FROM ingestion.video_genres vg
+      WHERE vg.video_id = v.id AND vg.genre_id = $2
+  ) OR (v.category_id = ANY($3::integer[]) AND (EXISTS (
+      SELECT 1 FROM ingestion.video_genres vg
+      JOIN ingestion.genres g ON g.id = vg.genre_id
+      WHERE vg.video_id = v.id AND g.language = $4 AND g.region_code = $5
+  ) OR EXISTS (
+      SELECT 1 FROM ingestion.channels c
+      WHERE c.id = v.channel_id AND c.country = $5
+  ))))
+  AND ($6::timestamptz IS NULL
+       OR (v.published_at, v.id) < ($6, $7::uuid))
+ORDER BY v.published_at DESC, v.id DESC
+LIMIT $8
+`
+
+type SearchVideosInGenreScopeParams struct {
+	PublishedFrom    time.Time     `json:"published_from"`
+	GenreID          uuid.UUID     `json:"genre_id"`
+	CategoryIds      []int32       `json:"category_ids"`
+	Language         string        `json:"language"`
+	RegionCode       string        `json:"region_code"`
+	AfterPublishedAt sql.NullTime  `json:"after_published_at"`
+	AfterID          uuid.NullUUID `json:"after_id"`
+	PageSize         int32         `json:"page_size"`
+}
+
+type SearchVideosInGenreScopeRow struct {
+	ID               uuid.UUID     `json:"id"`
+	YoutubeVideoID   string        `json:"youtube_video_id"`
+	ChannelID        uuid.UUID     `json:"channel_id"`
+	YoutubeChannelID string        `json:"youtube_channel_id"`
+	Title            string        `json:"title"`
+	PublishedAt      time.Time     `json:"published_at"`
+	CategoryID       int32         `json:"category_id"`
+	CreatedAt        sql.NullTime  `json:"created_at"`
+	DurationSeconds  sql.NullInt32 `json:"duration_seconds"`
+}
+
+// Keyset page of the videos published since published_from that belong to the
+// genre, or are in its categories and were assigned to a genre of the same
+// language and region or come from a channel based in the region. Ordered by
+// (published_at, id) descending.
+func (q *Queries) SearchVideosInGenreScope(ctx context.Context, arg SearchVideosInGenreScopeParams) ([]SearchVideosInGenreScopeRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchVideosInGenreScope,
+		arg.PublishedFrom,
+		arg.GenreID,
+		pq.Array(arg.CategoryIds),
+		arg.Language,
+		arg.RegionCode,
+		arg.AfterPublishedAt,
+		arg.AfterID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchVideosInGenreScopeRow
+	for rows.Next() {
+		var i SearchVideosInGenreScopeRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.YoutubeVideoID,
+			&i.ChannelID,
+			&i.YoutubeChannelID,
+			&i.Title,
+			&i.PublishedAt,
+			&i.CategoryID,
+			&i.CreatedAt,
+			&i.DurationSeconds,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchYouTubeCategories = `-- name: SearchYouTubeCategories :many
+SELECT id, name, assignable, created_at, updated_at
+FROM ingestion.youtube_categories
+WHERE (NOT $1::boolean OR assignable = true)
+  AND ($2::integer IS NULL OR id > $2)
+ORDER BY id ASC
+LIMIT $3
+`
+
+type SearchYouTubeCategoriesParams struct {
+	AssignableOnly bool          `json:"assignable_only"`
+	AfterID        sql.NullInt32 `json:"after_id"`
+	PageSize       int32         `json:"page_size"`
+}
+
+// Keyset page of YouTube categories ordered by id
+func (q *Queries) SearchYouTubeCategories(ctx context.Context, arg SearchYouTubeCategoriesParams) ([]IngestionYoutubeCategory, error) {
+	rows, err := q.db.QueryContext(ctx, searchYouTubeCategories, arg.AssignableOnly, arg.AfterID, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []IngestionYoutubeCategory
+	for rows.Next() {
+		var i IngestionYoutubeCategory
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Assignable,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const softDeleteKeyword = `-- name: SoftDeleteKeyword :exec
+UPDATE ingestion.keywords
+SET deleted_at = $2, updated_at = $2
+WHERE id = $1 AND deleted_at IS NULL
+`
+
+type SoftDeleteKeywordParams struct {
+	ID        uuid.UUID    `json:"id"`
+	DeletedAt sql.NullTime `json:"deleted_at"`
+}
+
+func (q *Queries) SoftDeleteKeyword(ctx context.Context, arg SoftDeleteKeywordParams) error {
+	_, err := q.db.ExecContext(ctx, softDeleteKeyword, arg.ID, arg.DeletedAt)
+	return err
+}
+
+const softDeleteKeywordGroup = `-- name: SoftDeleteKeywordGroup :execrows
+UPDATE ingestion.keyword_groups
+SET deleted_at = $2, updated_at = $2
+WHERE id = $1 AND deleted_at IS NULL
+`
+
+type SoftDeleteKeywordGroupParams struct {
+	ID        uuid.UUID    `json:"id"`
+	DeletedAt sql.NullTime `json:"deleted_at"`
+}
+
+func (q *Queries) SoftDeleteKeywordGroup(ctx context.Context, arg SoftDeleteKeywordGroupParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, softDeleteKeywordGroup, arg.ID, arg.DeletedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateBatchJob = `-- name: UpdateBatchJob :exec
+UPDATE ingestion.batch_jobs
+SET status = $2, started_at = $3, completed_at = $4, error_message = $5, statistics = $6
+WHERE id = $1
+`
+
+type UpdateBatchJobParams struct {
+	ID           uuid.UUID             `json:"id"`
+	Status       string                `json:"status"`
+	StartedAt    sql.NullTime          `json:"started_at"`
+	CompletedAt  sql.NullTime          `json:"completed_at"`
+	ErrorMessage sql.NullString        `json:"error_message"`
+	Statistics   pqtype.NullRawMessage `json:"statistics"`
+}
+
+func (q *Queries) UpdateBatchJob(ctx context.Context, arg UpdateBatchJobParams) error {
+	_, err := q.db.ExecContext(ctx, updateBatchJob,
+		arg.ID,
+		arg.Status,
+		arg.StartedAt,
+		arg.CompletedAt,
+		arg.ErrorMessage,
 		arg.Statistics,
 	)
 	return err
@@ -1965,16 +3953,17 @@ func (q *Queries) UpdateChannel(ctx context.Context, arg UpdateChannelParams) er
 
 const updateGenre = `-- name: UpdateGenre :exec
 UPDATE ingestion.genres
-SET name = $2, category_ids = $3, enabled = $4, updated_at = $5
+SET name = $2, category_ids = $3, enabled = $4, updated_at = $5, checkpoint_profile_id = $6
 WHERE id = $1
 `
 
 type UpdateGenreParams struct {
-	ID          uuid.UUID `json:"id"`
-	Name        string    `json:"name"`
-	CategoryIds []int32   `json:"category_ids"`
-	Enabled     bool      `json:"enabled"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID                  uuid.UUID     `json:"id"`
+	Name                string        `json:"name"`
+	CategoryIds         []int32       `json:"category_ids"`
+	Enabled             bool          `json:"enabled"`
+	UpdatedAt           time.Time     `json:"updated_at"`
+	CheckpointProfileID uuid.NullUUID `json:"checkpoint_profile_id"`
 }
 
 func (q *Queries) UpdateGenre(ctx context.Context, arg UpdateGenreParams) error {
@@ -1984,6 +3973,7 @@ func (q *Queries) UpdateGenre(ctx context.Context, arg UpdateGenreParams) error
 		pq.Array(arg.CategoryIds),
 		arg.Enabled,
 		arg.UpdatedAt,
+		arg.CheckpointProfileID,
 	)
 	return err
 }
@@ -2020,6 +4010,73 @@ func (q *Queries) UpdateKeyword(ctx context.Context, arg UpdateKeywordParams) er
 	return err
 }
 
+const updateKeywordGroup = `-- name: UpdateKeywordGroup :execrows
+UPDATE ingestion.keyword_groups
+SET name = $2, filter_type = $3, target_field = $4,
+    enabled = $5, description = $6, updated_at = $7, cross_language = $8, romaji = $9
+WHERE id = $1 AND deleted_at IS NULL
+`
+
+type UpdateKeywordGroupParams struct {
+	ID            uuid.UUID      `json:"id"`
+	Name          string         `json:"name"`
+	FilterType    string         `json:"filter_type"`
+	TargetField   string         `json:"target_field"`
+	Enabled       sql.NullBool   `json:"enabled"`
+	Description   sql.NullString `json:"description"`
+	UpdatedAt     sql.NullTime   `json:"updated_at"`
+	CrossLanguage bool           `json:"cross_language"`
+	Romaji        bool           `json:"romaji"`
+}
+
+func (q *Queries) UpdateKeywordGroup(ctx context.Context, arg UpdateKeywordGroupParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateKeywordGroup,
+		arg.ID,
+		arg.Name,
+		arg.FilterType,
+		arg.TargetField,
+		arg.Enabled,
+		arg.Description,
+		arg.UpdatedAt,
+		arg.CrossLanguage,
+		arg.Romaji,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateKeywordSynonym = `-- name: UpdateKeywordSynonym :execrows
+UPDATE ingestion.keyword_synonyms
+SET term = $2, alias = $3, alias_language = $4, bidirectional = $5, updated_at = $6
+WHERE id = $1
+`
+
+type UpdateKeywordSynonymParams struct {
+	ID            uuid.UUID    `json:"id"`
+	Term          string       `json:"term"`
+	Alias         string       `json:"alias"`
+	AliasLanguage string       `json:"alias_language"`
+	Bidirectional bool         `json:"bidirectional"`
+	UpdatedAt     sql.NullTime `json:"updated_at"`
+}
+
+func (q *Queries) UpdateKeywordSynonym(ctx context.Context, arg UpdateKeywordSynonymParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateKeywordSynonym,
+		arg.ID,
+		arg.Term,
+		arg.Alias,
+		arg.AliasLanguage,
+		arg.Bidirectional,
+		arg.UpdatedAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const updateYouTubeCategory = `-- name: UpdateYouTubeCategory :exec
 UPDATE ingestion.youtube_categories
 SET name = $2, assignable = $3, updated_at = $4
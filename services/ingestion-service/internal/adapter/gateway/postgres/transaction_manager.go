@@ -17,8 +17,16 @@ func NewTransactionManager(db *sql.DB) gateway.TransactionManager {
 	return &transactionManager{db: db}
 }
 
-// Execute executes a function within a database transaction
+// Execute executes a function within a database transaction. Repositories
+// called with the context passed to fn run their queries in it, and a nested
+// call joins the transaction already in the context. Transactions run at the
+// database default, read committed: audit rows only need to commit or roll
+// back with the change they record.
 func (tm *transactionManager) Execute(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := GetTx(ctx); ok {
+		return fn(ctx)
+	}
+
 	tx, err := tm.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
@@ -2,6 +2,8 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/postgres/sqlcgen"
@@ -9,6 +11,7 @@ import (
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
 	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
 )
 
 // videoGenreRepository implements gateway.VideoGenreRepository interface
@@ -38,11 +41,17 @@ func (r *videoGenreRepository) Save(ctx context.Context, vg *domain.VideoGenre)
 		return err
 	}
 
+	match, err := genreMatchToJSON(vg.Match)
+	if err != nil {
+		return err
+	}
+
 	return r.q.CreateVideoGenre(ctx, sqlcgen.CreateVideoGenreParams{
-		ID:        id,
-		VideoID:   videoID,
-		GenreID:   genreID,
-		CreatedAt: time.Now(),
+		ID:              id,
+		VideoID:         videoID,
+		GenreID:         genreID,
+		CreatedAt:       time.Now(),
+		MatchProvenance: match,
 	})
 }
 
@@ -65,11 +74,17 @@ func (r *videoGenreRepository) SaveBatch(ctx context.Context, vgs []*domain.Vide
 				return err
 			}
 
+			match, err := genreMatchToJSON(vg.Match)
+			if err != nil {
+				return err
+			}
+
 			if err := repo.q.CreateVideoGenre(ctx, sqlcgen.CreateVideoGenreParams{
-				ID:        id,
-				VideoID:   videoID,
-				GenreID:   genreID,
-				CreatedAt: vg.CreatedAt,
+				ID:              id,
+				VideoID:         videoID,
+				GenreID:         genreID,
+				CreatedAt:       vg.CreatedAt,
+				MatchProvenance: match,
 			}); err != nil {
 				return err
 			}
@@ -98,6 +113,52 @@ func (r *videoGenreRepository) FindByVideo(ctx context.Context, videoID valueobj
 	return videoGenres, nil
 }
 
+// ListByVideo lists the genre assignments of a video, one keyset page at a time
+func (r *videoGenreRepository) ListByVideo(ctx context.Context, videoID valueobject.UUID, after *valueobject.PageCursor, limit int) ([]*domain.VideoGenre, error) {
+	vid, err := uuid.Parse(string(videoID))
+	if err != nil {
+		return nil, err
+	}
+
+	params := sqlcgen.SearchVideoGenresByVideoParams{
+		VideoID:  vid,
+		PageSize: int32(limit),
+	}
+	if after != nil {
+		afterID, err := uuid.Parse(after.ID)
+		if err != nil {
+			return nil, err
+		}
+		params.AfterCreatedAt = sql.NullTime{Time: after.Key, Valid: true}
+		params.AfterID = uuid.NullUUID{UUID: afterID, Valid: true}
+	}
+
+	rows, err := r.q.SearchVideoGenresByVideo(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	videoGenres := make([]*domain.VideoGenre, len(rows))
+	for i, row := range rows {
+		videoGenres[i] = toDomainVideoGenre(row)
+	}
+	return videoGenres, nil
+}
+
+// CountByVideo counts the genre assignments of a video
+func (r *videoGenreRepository) CountByVideo(ctx context.Context, videoID valueobject.UUID) (int, error) {
+	vid, err := uuid.Parse(string(videoID))
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := r.q.CountVideoGenresByVideo(ctx, vid)
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
 // FindByGenre finds all video-genre associations for a genre
 func (r *videoGenreRepository) FindByGenre(ctx context.Context, genreID valueobject.UUID) ([]*domain.VideoGenre, error) {
 	gid, err := uuid.Parse(string(genreID))
@@ -163,5 +224,89 @@ func toDomainVideoGenre(row sqlcgen.IngestionVideoGenre) *domain.VideoGenre {
 		VideoID:   valueobject.UUID(row.VideoID.String()),
 		GenreID:   valueobject.UUID(row.GenreID.String()),
 		CreatedAt: row.CreatedAt,
+		Match:     genreMatchFromJSON(row.MatchProvenance),
+	}
+}
+
+// genreMatchJSON is the encoding of a genre match in the match_provenance column
+type genreMatchJSON struct {
+	Result      string                  `json:"result"`
+	Matches     []genreKeywordMatchJSON `json:"matches"`
+	EvaluatedAt time.Time               `json:"evaluated_at"`
+}
+
+type genreKeywordMatchJSON struct {
+	KeywordID   string `json:"keyword_id"`
+	KeywordName string `json:"keyword_name"`
+	FilterType  string `json:"filter_type"`
+	Field       string `json:"field"`
+	Start       int    `json:"start"`
+	End         int    `json:"end"`
+	MatchedText string `json:"matched_text"`
+	Decisive    bool   `json:"decisive,omitempty"`
+	Overridden  bool   `json:"overridden,omitempty"`
+}
+
+// genreMatchToJSON encodes the match of an assignment, if any, for the jsonb column
+func genreMatchToJSON(match *domain.GenreMatch) (pqtype.NullRawMessage, error) {
+	if match == nil {
+		return pqtype.NullRawMessage{}, nil
+	}
+
+	m := genreMatchJSON{
+		Result:      match.Result,
+		Matches:     make([]genreKeywordMatchJSON, len(match.Matches)),
+		EvaluatedAt: match.EvaluatedAt,
+	}
+	for i, km := range match.Matches {
+		m.Matches[i] = genreKeywordMatchJSON{
+			KeywordID:   string(km.KeywordID),
+			KeywordName: km.KeywordName,
+			FilterType:  string(km.FilterType),
+			Field:       km.Field,
+			Start:       km.Start,
+			End:         km.End,
+			MatchedText: km.MatchedText,
+			Decisive:    km.Decisive,
+			Overridden:  km.Overridden,
+		}
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return pqtype.NullRawMessage{}, err
+	}
+	return pqtype.NullRawMessage{RawMessage: data, Valid: true}, nil
+}
+
+// genreMatchFromJSON decodes the match_provenance column written by
+// genreMatchToJSON. Assignments without one, or with one that cannot be
+// decoded, have no match.
+func genreMatchFromJSON(raw pqtype.NullRawMessage) *domain.GenreMatch {
+	if !raw.Valid {
+		return nil
+	}
+	var m genreMatchJSON
+	if err := json.Unmarshal(raw.RawMessage, &m); err != nil {
+		return nil
+	}
+
+	match := &domain.GenreMatch{
+		Result:      m.Result,
+		Matches:     make([]domain.GenreKeywordMatch, len(m.Matches)),
+		EvaluatedAt: m.EvaluatedAt,
+	}
+	for i, km := range m.Matches {
+		match.Matches[i] = domain.GenreKeywordMatch{
+			KeywordID:   valueobject.UUID(km.KeywordID),
+			KeywordName: km.KeywordName,
+			FilterType:  valueobject.FilterType(km.FilterType),
+			Field:       km.Field,
+			Start:       km.Start,
+			End:         km.End,
+			MatchedText: km.MatchedText,
+			Decisive:    km.Decisive,
+			Overridden:  km.Overridden,
+		}
 	}
+	return match
 }
\ No newline at end of file
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -43,27 +44,65 @@ func (r *videoRepository) Save(ctx context.Context, v *domain.Video) error {
 		PublishedAt:      v.PublishedAt,
 		CategoryID:       int32(v.CategoryID),
 		CreatedAt:        sql.NullTime{Time: v.CreatedAt, Valid: true},
+		DurationSeconds:  sql.NullInt32{Int32: int32(v.Duration / time.Second), Valid: v.Duration > 0},
 	})
 }
 
-// SaveWithSnapshots saves video and its new snapshots in a transaction
+// SaveWithSnapshots saves video and its new snapshots in a transaction.
+// The video is created if it does not exist yet.
 func (r *videoRepository) SaveWithSnapshots(ctx context.Context, v *domain.Video) error {
-	// TODO: Implement transaction handling
-	// For now, just save video
-	if err := r.Save(ctx, v); err != nil {
+	id, err := uuid.Parse(string(v.ID))
+	if err != nil {
 		return err
 	}
 
-	// Save snapshots
-	for _, snapshot := range v.GetNewSnapshots() {
-		// TODO: Implement snapshot saving logic
-		// This would typically be done in a transaction
-		_ = snapshot
+	err = r.ExecTx(ctx, func(tx *Repository) error {
+		if _, err := tx.q.GetVideoByID(ctx, id); err != nil {
+			if err != sql.ErrNoRows {
+				return err
+			}
+			if err := (&videoRepository{Repository: tx}).Save(ctx, v); err != nil {
+				return err
+			}
+		}
+
+		for _, snapshot := range v.GetNewSnapshots() {
+			snapshotID, err := uuid.Parse(string(snapshot.ID))
+			if err != nil {
+				return err
+			}
+			metrics, err := snapshotMetricsToJSON(snapshot.Metrics)
+			if err != nil {
+				return err
+			}
+
+			if err := tx.q.CreateVideoSnapshot(ctx, sqlcgen.CreateVideoSnapshotParams{
+				ID:                snapshotID,
+				VideoID:           id,
+				CheckpointHour:    int32(snapshot.CheckpointHour),
+				MeasuredAt:        snapshot.MeasuredAt,
+				ViewCount:         snapshot.ViewsCount,
+				LikeCount:         snapshot.LikesCount,
+				SubscriptionCount: snapshot.SubscriptionCount,
+				Source:            string(snapshot.Source),
+				CreatedAt:         sql.NullTime{Time: snapshot.CreatedAt, Valid: true},
+				UpdatedAt:         snapshot.CreatedAt,
+				DriftSeconds:      int32(snapshot.Drift / time.Second),
+				CommentCount:      int64PtrToNullInt64(snapshot.CommentsCount),
+				Metrics:           metrics,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	// Clear new snapshots after saving
 	v.ClearNewSnapshots()
-	
+
 	return nil
 }
 
@@ -174,6 +213,170 @@ func (r *videoRepository) ListActive(ctx context.Context, since time.Time) ([]*d
 	return videos, nil
 }
 
+// Search lists videos matching the filter, one keyset page at a time
+func (r *videoRepository) Search(ctx context.Context, filter domain.VideoFilter, order domain.VideoOrder, after *valueobject.PageCursor, limit int) ([]*domain.Video, error) {
+	f, err := toSearchVideosFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each sort order has its own query so that the (published_at, id) and
+	// (created_at, id) indexes serve the keyset scan
+	params := sqlcgen.SearchVideosByPublishedAtParams{
+		ChannelID:        f.ChannelID,
+		GenreID:          f.GenreID,
+		CategoryID:       f.CategoryID,
+		PublishedFrom:    f.PublishedFrom,
+		PublishedTo:      f.PublishedTo,
+		Shorts:           f.Shorts,
+		ShortsMaxSeconds: f.ShortsMaxSeconds,
+		Query:            f.Query,
+		PageSize:         int32(limit),
+	}
+	if after != nil {
+		afterID, err := uuid.Parse(after.ID)
+		if err != nil {
+			return nil, err
+		}
+		params.AfterKey = sql.NullTime{Time: after.Key, Valid: true}
+		params.AfterID = uuid.NullUUID{UUID: afterID, Valid: true}
+	}
+
+	videos := make([]*domain.Video, 0, limit)
+	switch {
+	case order.Field == domain.VideoSortCreatedAt && order.Ascending:
+		rows, err := r.q.SearchVideosByCreatedAtAsc(ctx, sqlcgen.SearchVideosByCreatedAtAscParams(params))
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			videos = append(videos, toDomainVideoFromRow(sqlcgen.GetVideoByIDRow(row)))
+		}
+	case order.Field == domain.VideoSortCreatedAt:
+		rows, err := r.q.SearchVideosByCreatedAt(ctx, sqlcgen.SearchVideosByCreatedAtParams(params))
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			videos = append(videos, toDomainVideoFromRow(sqlcgen.GetVideoByIDRow(row)))
+		}
+	case order.Ascending:
+		rows, err := r.q.SearchVideosByPublishedAtAsc(ctx, sqlcgen.SearchVideosByPublishedAtAscParams(params))
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			videos = append(videos, toDomainVideoFromRow(sqlcgen.GetVideoByIDRow(row)))
+		}
+	default:
+		rows, err := r.q.SearchVideosByPublishedAt(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			videos = append(videos, toDomainVideoFromRow(sqlcgen.GetVideoByIDRow(row)))
+		}
+	}
+	return videos, nil
+}
+
+// CountSearch counts the videos matching the filter
+func (r *videoRepository) CountSearch(ctx context.Context, filter domain.VideoFilter) (int, error) {
+	f, err := toSearchVideosFilter(filter)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := r.q.CountSearchVideos(ctx, f)
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// SearchGenreScope lists up to limit videos of the genre scope, most recently
+// published first, starting after the cursor position
+func (r *videoRepository) SearchGenreScope(ctx context.Context, scope domain.GenreVideoScope, after *valueobject.PageCursor, limit int) ([]*domain.Video, error) {
+	genreID, err := uuid.Parse(string(scope.GenreID))
+	if err != nil {
+		return nil, err
+	}
+	categoryIDs := make([]int32, len(scope.CategoryIDs))
+	for i, catID := range scope.CategoryIDs {
+		categoryIDs[i] = int32(catID)
+	}
+
+	params := sqlcgen.SearchVideosInGenreScopeParams{
+		PublishedFrom: scope.PublishedAfter,
+		GenreID:       genreID,
+		CategoryIds:   categoryIDs,
+		Language:      scope.Language,
+		RegionCode:    scope.RegionCode,
+		PageSize:      int32(limit),
+	}
+	if after != nil {
+		afterID, err := uuid.Parse(after.ID)
+		if err != nil {
+			return nil, err
+		}
+		params.AfterPublishedAt = sql.NullTime{Time: after.Key, Valid: true}
+		params.AfterID = uuid.NullUUID{UUID: afterID, Valid: true}
+	}
+
+	rows, err := r.q.SearchVideosInGenreScope(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	videos := make([]*domain.Video, len(rows))
+	for i, row := range rows {
+		videos[i] = toDomainVideoFromRow(sqlcgen.GetVideoByIDRow(row))
+	}
+	return videos, nil
+}
+
+// likeEscaper escapes LIKE wildcards with the backslash the search queries
+// declare as their ESCAPE character, so that a search query matches literally
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// toSearchVideosFilter converts a video filter to query parameters. The count
+// query takes exactly the filter parameters, so its params double as the filter.
+func toSearchVideosFilter(filter domain.VideoFilter) (sqlcgen.CountSearchVideosParams, error) {
+	f := sqlcgen.CountSearchVideosParams{
+		ShortsMaxSeconds: int32(domain.ShortsMaxDuration / time.Second),
+	}
+	if filter.ChannelID != "" {
+		id, err := uuid.Parse(string(filter.ChannelID))
+		if err != nil {
+			return f, err
+		}
+		f.ChannelID = uuid.NullUUID{UUID: id, Valid: true}
+	}
+	if filter.GenreID != "" {
+		id, err := uuid.Parse(string(filter.GenreID))
+		if err != nil {
+			return f, err
+		}
+		f.GenreID = uuid.NullUUID{UUID: id, Valid: true}
+	}
+	if filter.CategoryID != nil {
+		f.CategoryID = sql.NullInt32{Int32: int32(*filter.CategoryID), Valid: true}
+	}
+	if filter.PublishedAfter != nil {
+		f.PublishedFrom = sql.NullTime{Time: *filter.PublishedAfter, Valid: true}
+	}
+	if filter.PublishedBefore != nil {
+		f.PublishedTo = sql.NullTime{Time: *filter.PublishedBefore, Valid: true}
+	}
+	if filter.Shorts != nil {
+		f.Shorts = sql.NullBool{Bool: *filter.Shorts, Valid: true}
+	}
+	if filter.Query != "" {
+		f.Query = sql.NullString{String: likeEscaper.Replace(filter.Query), Valid: true}
+	}
+	return f, nil
+}
+
 // toDomainVideo converts database row to domain video
 func toDomainVideo(id uuid.UUID, channelID uuid.UUID, youtubeVideoID, youtubeChannelID, title string, 
 	publishedAt time.Time, categoryID int32, createdAt sql.NullTime, updatedAt, deletedAt sql.NullTime) *domain.Video {
@@ -208,6 +411,7 @@ func toDomainVideoFromRow(row sqlcgen.GetVideoByIDRow) *domain.Video {
 		Title:            row.Title,
 		PublishedAt:      row.PublishedAt,
 		CategoryID:       valueobject.CategoryID(row.CategoryID),
+		Duration:         time.Duration(row.DurationSeconds.Int32) * time.Second,
 		CreatedAt:        row.CreatedAt.Time,
 	}
 }
@@ -222,6 +426,7 @@ func toDomainVideoFromYouTubeRow(row sqlcgen.GetVideoByYouTubeIDRow) *domain.Vid
 		Title:            row.Title,
 		PublishedAt:      row.PublishedAt,
 		CategoryID:       valueobject.CategoryID(row.CategoryID),
+		Duration:         time.Duration(row.DurationSeconds.Int32) * time.Second,
 		CreatedAt:        row.CreatedAt.Time,
 	}
 }
\ No newline at end of file
@@ -3,10 +3,14 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"time"
 
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/postgres/sqlcgen"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
+	"github.com/google/uuid"
 )
 
 // videoSnapshotRepository implements gateway.VideoSnapshotRepository interface
@@ -19,19 +23,37 @@ func NewVideoSnapshotRepository(repo *Repository) gateway.VideoSnapshotRepositor
 	return &videoSnapshotRepository{Repository: repo}
 }
 
-
 // Exists checks if a snapshot exists for a video at a specific checkpoint
 func (r *videoSnapshotRepository) Exists(ctx context.Context, videoID valueobject.UUID, cp valueobject.CheckpointHour) (bool, error) {
-	// TODO: Implement once SQL query is generated
-	// For now, return false
-	return false, nil
+	_, err := r.FindByVideoAndCP(ctx, videoID, cp)
+	if err != nil {
+		if err == domain.ErrSnapshotNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
 }
 
 // FindByVideoAndCP finds a snapshot by video ID and checkpoint
 func (r *videoSnapshotRepository) FindByVideoAndCP(ctx context.Context, videoID valueobject.UUID, cp valueobject.CheckpointHour) (*domain.VideoSnapshot, error) {
-	// TODO: Implement once SQL query is generated
-	// For now, return not found
-	return nil, sql.ErrNoRows
+	uid, err := uuid.Parse(string(videoID))
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := r.q.GetVideoSnapshotByVideoAndCheckpoint(ctx, sqlcgen.GetVideoSnapshotByVideoAndCheckpointParams{
+		VideoID:        uid,
+		CheckpointHour: int32(cp),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrSnapshotNotFound
+		}
+		return nil, err
+	}
+
+	return toDomainVideoSnapshot(row), nil
 }
 
 // ListByVideo lists all snapshots for a video
@@ -41,7 +63,120 @@ func (r *videoSnapshotRepository) ListByVideo(ctx context.Context, videoID value
 
 // ListByVideoID lists all snapshots for a video ID
 func (r *videoSnapshotRepository) ListByVideoID(ctx context.Context, videoID valueobject.UUID) ([]*domain.VideoSnapshot, error) {
-	// TODO: Implement once SQL query is generated
-	// For now, return empty list
-	return []*domain.VideoSnapshot{}, nil
+	uid, err := uuid.Parse(string(videoID))
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.q.ListVideoSnapshots(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]*domain.VideoSnapshot, len(rows))
+	for i, row := range rows {
+		snapshots[i] = toDomainVideoSnapshot(row)
+	}
+	return snapshots, nil
+}
+
+// Search lists snapshots across videos matching the filter, one keyset page at a time
+func (r *videoSnapshotRepository) Search(ctx context.Context, filter domain.SnapshotFilter, after *valueobject.PageCursor, limit int) ([]*domain.VideoSnapshot, error) {
+	params := sqlcgen.SearchVideoSnapshotsParams{
+		PageSize: int32(limit),
+	}
+	if filter.VideoID != "" {
+		id, err := uuid.Parse(string(filter.VideoID))
+		if err != nil {
+			return nil, err
+		}
+		params.VideoID = uuid.NullUUID{UUID: id, Valid: true}
+	}
+	if filter.GenreID != "" {
+		id, err := uuid.Parse(string(filter.GenreID))
+		if err != nil {
+			return nil, err
+		}
+		params.GenreID = uuid.NullUUID{UUID: id, Valid: true}
+	}
+	if filter.CheckpointHour != nil {
+		params.CheckpointHour = sql.NullInt32{Int32: int32(*filter.CheckpointHour), Valid: true}
+	}
+	if filter.MeasuredAfter != nil {
+		params.MeasuredFrom = sql.NullTime{Time: *filter.MeasuredAfter, Valid: true}
+	}
+	if filter.MeasuredBefore != nil {
+		params.MeasuredTo = sql.NullTime{Time: *filter.MeasuredBefore, Valid: true}
+	}
+	if after != nil {
+		afterID, err := uuid.Parse(after.ID)
+		if err != nil {
+			return nil, err
+		}
+		params.AfterMeasuredAt = sql.NullTime{Time: after.Key, Valid: true}
+		params.AfterID = uuid.NullUUID{UUID: afterID, Valid: true}
+	}
+
+	rows, err := r.q.SearchVideoSnapshots(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]*domain.VideoSnapshot, len(rows))
+	for i, row := range rows {
+		snapshots[i] = toDomainVideoSnapshot(row)
+	}
+	return snapshots, nil
+}
+
+// toDomainVideoSnapshot converts database model to domain video snapshot
+func toDomainVideoSnapshot(row sqlcgen.IngestionVideoSnapshot) *domain.VideoSnapshot {
+	return &domain.VideoSnapshot{
+		ID:                valueobject.UUID(row.ID.String()),
+		VideoID:           valueobject.UUID(row.VideoID.String()),
+		CheckpointHour:    valueobject.CheckpointHour(row.CheckpointHour),
+		MeasuredAt:        row.MeasuredAt,
+		ViewsCount:        row.ViewCount,
+		LikesCount:        row.LikeCount,
+		SubscriptionCount: row.SubscriptionCount,
+		CommentsCount:     nullInt64ToPtr(row.CommentCount),
+		Metrics:           snapshotMetricsFromJSON(row.Metrics),
+		Source:            valueobject.Source(row.Source),
+		Drift:             time.Duration(row.DriftSeconds) * time.Second,
+		CreatedAt:         row.CreatedAt.Time,
+	}
+}
+
+// snapshotMetricsToJSON encodes the optional snapshot metrics for the jsonb column
+func snapshotMetricsToJSON(metrics map[string]int64) (json.RawMessage, error) {
+	if metrics == nil {
+		metrics = map[string]int64{}
+	}
+	return json.Marshal(metrics)
+}
+
+// snapshotMetricsFromJSON decodes the jsonb metrics column written by
+// snapshotMetricsToJSON
+func snapshotMetricsFromJSON(raw json.RawMessage) map[string]int64 {
+	metrics := make(map[string]int64)
+	if len(raw) > 0 {
+		_ = json.Unmarshal(raw, &metrics)
+	}
+	return metrics
+}
+
+// int64PtrToNullInt64 converts *int64 to sql.NullInt64
+func int64PtrToNullInt64(v *int64) sql.NullInt64 {
+	if v == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: *v, Valid: true}
+}
+
+// nullInt64ToPtr converts sql.NullInt64 to *int64
+func nullInt64ToPtr(ni sql.NullInt64) *int64 {
+	if ni.Valid {
+		return &ni.Int64
+	}
+	return nil
 }
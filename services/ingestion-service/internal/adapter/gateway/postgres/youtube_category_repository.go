@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"strconv"
 	"time"
 
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/postgres/sqlcgen"
@@ -86,6 +87,41 @@ func (r *youtubeCategoryRepository) FindAssignable(ctx context.Context) ([]*doma
 	return categories, nil
 }
 
+// List lists YouTube categories ordered by ID, one keyset page at a time
+func (r *youtubeCategoryRepository) List(ctx context.Context, assignableOnly bool, after *valueobject.PageCursor, limit int) ([]*domain.YouTubeCategory, error) {
+	params := sqlcgen.SearchYouTubeCategoriesParams{
+		AssignableOnly: assignableOnly,
+		PageSize:       int32(limit),
+	}
+	if after != nil {
+		afterID, err := strconv.Atoi(after.ID)
+		if err != nil {
+			return nil, err
+		}
+		params.AfterID = sql.NullInt32{Int32: int32(afterID), Valid: true}
+	}
+
+	rows, err := r.q.SearchYouTubeCategories(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make([]*domain.YouTubeCategory, len(rows))
+	for i, row := range rows {
+		categories[i] = toDomainYouTubeCategory(row)
+	}
+	return categories, nil
+}
+
+// Count counts YouTube categories
+func (r *youtubeCategoryRepository) Count(ctx context.Context, assignableOnly bool) (int, error) {
+	count, err := r.q.CountYouTubeCategories(ctx, assignableOnly)
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
 // toDomainYouTubeCategory converts a database row to a domain YouTube category
 func toDomainYouTubeCategory(row sqlcgen.IngestionYoutubeCategory) *domain.YouTubeCategory {
 	return &domain.YouTubeCategory{
@@ -41,6 +41,10 @@ func (p *eventPublisher) PublishSnapshotAdded(ctx context.Context, event gateway
 
 	result := p.snapshotAddedTopic.Publish(ctx, &pubsub.Message{
 		Data: data,
+		Attributes: map[string]string{
+			"eventId":   event.EventID,
+			"eventType": "snapshot-added",
+		},
 	})
 
 	// Wait for the publish to complete
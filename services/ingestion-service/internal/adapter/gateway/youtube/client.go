@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
 	"google.golang.org/api/option"
@@ -39,14 +40,15 @@ func (c *client) GetVideoStats(ctx context.Context, ytVideoID valueobject.YouTub
 	}
 
 	if len(response.Items) == 0 {
-		return nil, fmt.Errorf("video not found: %s", ytVideoID)
+		return nil, fmt.Errorf("%w: %s", domain.ErrVideoNotFound, ytVideoID)
 	}
 
 	stats := response.Items[0].Statistics
 	return &gateway.VideoStats{
-		ViewCount:    int64(stats.ViewCount),
-		LikeCount:    int64(stats.LikeCount),
-		CommentCount: int64(stats.CommentCount),
+		ViewCount:     int64(stats.ViewCount),
+		LikeCount:     int64(stats.LikeCount),
+		CommentCount:  int64(stats.CommentCount),
+		FavoriteCount: optionalCount(stats.FavoriteCount),
 	}, nil
 }
 
@@ -58,18 +60,29 @@ func (c *client) GetVideoStatistics(ctx context.Context, ytVideoID string) (*gat
 		return nil, fmt.Errorf("failed to get video statistics: %w", err)
 	}
 
-		if len(response.Items) == 0 {
-		return nil, fmt.Errorf("video not found: %s", ytVideoID)
+	if len(response.Items) == 0 {
+		return nil, fmt.Errorf("%w: %s", domain.ErrVideoNotFound, ytVideoID)
 	}
 
 	stats := response.Items[0].Statistics
 	return &gateway.VideoStats{
-		ViewCount:    int64(stats.ViewCount),
-		LikeCount:    int64(stats.LikeCount),
-		CommentCount: int64(stats.CommentCount),
+		ViewCount:     int64(stats.ViewCount),
+		LikeCount:     int64(stats.LikeCount),
+		CommentCount:  int64(stats.CommentCount),
+		FavoriteCount: optionalCount(stats.FavoriteCount),
 	}, nil
 }
 
+// optionalCount returns nil for a count YouTube did not report. The API client
+// decodes omitted counts as zero, so zero is treated as not reported.
+func optionalCount(n uint64) *int64 {
+	if n == 0 {
+		return nil
+	}
+	v := int64(n)
+	return &v
+}
+
 // GetChannelStats gets channel statistics
 func (c *client) GetChannelStats(ctx context.Context, ytChannelID valueobject.YouTubeChannelID) (*gateway.ChannelStats, error) {
 	call := c.service.Channels.List([]string{"statistics"}).Id(string(ytChannelID))
@@ -85,15 +98,16 @@ func (c *client) GetChannelStats(ctx context.Context, ytChannelID valueobject.Yo
 	stats := response.Items[0].Statistics
 	return &gateway.ChannelStats{
 		SubscriberCount: int64(stats.SubscriberCount),
+		ViewCount:       int64(stats.ViewCount),
 		VideoCount:      int64(stats.VideoCount),
 	}, nil
 }
 
-// ListMostPopular lists most popular videos
-func (c *client) ListMostPopular(ctx context.Context, categoryID valueobject.CategoryID, pageToken *string) (*gateway.TrendingVideos, error) {
-	call := c.service.Videos.List([]string{"snippet"}).
+// ListMostPopular lists most popular videos of a region
+func (c *client) ListMostPopular(ctx context.Context, regionCode string, categoryID valueobject.CategoryID, pageToken *string) (*gateway.TrendingVideos, error) {
+	call := c.service.Videos.List([]string{"snippet", "contentDetails"}).
 		Chart("mostPopular").
-		RegionCode("JP").
+		RegionCode(regionCode).
 		MaxResults(50)
 
 	if categoryID > 0 {
@@ -112,6 +126,10 @@ func (c *client) ListMostPopular(ctx context.Context, categoryID valueobject.Cat
 	videos := make([]gateway.VideoMeta, len(response.Items))
 	for i, item := range response.Items {
 		publishedAt, _ := time.Parse(time.RFC3339, item.Snippet.PublishedAt)
+		var duration time.Duration
+		if item.ContentDetails != nil {
+			duration, _ = parseDuration(item.ContentDetails.Duration)
+		}
 		videos[i] = gateway.VideoMeta{
 			ID:           valueobject.YouTubeVideoID(item.Id),
 			ChannelID:    valueobject.YouTubeChannelID(item.Snippet.ChannelId),
@@ -119,6 +137,7 @@ func (c *client) ListMostPopular(ctx context.Context, categoryID valueobject.Cat
 			Description:  item.Snippet.Description,
 			PublishedAt:  publishedAt,
 			CategoryID:   categoryID,
+			Duration:     duration,
 			ThumbnailURL: item.Snippet.Thumbnails.High.Url,
 		}
 	}
@@ -162,16 +181,29 @@ func (c *client) GetChannel(ctx context.Context, ytChannelID valueobject.YouTube
 	}, nil
 }
 
-// GetTrendingVideos gets trending videos
-func (c *client) GetTrendingVideos(ctx context.Context) ([]*gateway.VideoMeta, error) {
-	trending, err := c.ListMostPopular(ctx, 0, nil)
-	if err != nil {
-		return nil, err
+// GetTrendingVideos gets the first page of the most popular videos of the
+// region in each category. A video popular in several categories is listed once.
+func (c *client) GetTrendingVideos(ctx context.Context, regionCode string, categoryIDs []valueobject.CategoryID) ([]*gateway.VideoMeta, error) {
+	if len(categoryIDs) == 0 {
+		// Category 0 lists the chart across all categories
+		categoryIDs = []valueobject.CategoryID{0}
 	}
 
-	result := make([]*gateway.VideoMeta, len(trending.Videos))
-	for i, v := range trending.Videos {
-		result[i] = &v
+	var result []*gateway.VideoMeta
+	seen := make(map[valueobject.YouTubeVideoID]bool)
+	for _, categoryID := range categoryIDs {
+		trending, err := c.ListMostPopular(ctx, regionCode, categoryID, nil)
+		if err != nil {
+			return nil, err
+		}
+		for i := range trending.Videos {
+			v := &trending.Videos[i]
+			if seen[v.ID] {
+				continue
+			}
+			seen[v.ID] = true
+			result = append(result, v)
+		}
 	}
 	return result, nil
 }
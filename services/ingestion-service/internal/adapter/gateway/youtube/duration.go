@@ -0,0 +1,65 @@
+package youtube
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// parseDuration parses the ISO 8601 duration YouTube returns in
+// contentDetails.duration (e.g. PT1H2M3S, P1DT2H). Year and month designators
+// are not used for video lengths and are rejected.
+func parseDuration(s string) (time.Duration, error) {
+	if len(s) < 2 || s[0] != 'P' {
+		return 0, fmt.Errorf("invalid duration: %q", s)
+	}
+
+	var (
+		total  time.Duration
+		inTime bool
+		num    string
+	)
+	for _, r := range s[1:] {
+		switch {
+		case r >= '0' && r <= '9':
+			num += string(r)
+			continue
+		case r == 'T':
+			if inTime || num != "" {
+				return 0, fmt.Errorf("invalid duration: %q", s)
+			}
+			inTime = true
+			continue
+		}
+
+		if num == "" {
+			return 0, fmt.Errorf("invalid duration: %q", s)
+		}
+		n, err := strconv.Atoi(num)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration: %q", s)
+		}
+		num = ""
+
+		var unit time.Duration
+		switch {
+		case r == 'W' && !inTime:
+			unit = 7 * 24 * time.Hour
+		case r == 'D' && !inTime:
+			unit = 24 * time.Hour
+		case r == 'H' && inTime:
+			unit = time.Hour
+		case r == 'M' && inTime:
+			unit = time.Minute
+		case r == 'S' && inTime:
+			unit = time.Second
+		default:
+			return 0, fmt.Errorf("invalid duration: %q", s)
+		}
+		total += time.Duration(n) * unit
+	}
+	if num != "" {
+		return 0, fmt.Errorf("invalid duration: %q", s)
+	}
+	return total, nil
+}
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"fmt"
+	"time"
 	
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -154,6 +155,8 @@ func domainVideoToProto(video *domain.Video) *pb.Video {
 		PublishedAt:      timestamppb.New(video.PublishedAt),
 		CategoryId:       int32(video.CategoryID),
 		CreatedAt:        timestamppb.New(video.CreatedAt),
+		DurationSeconds:  int32(video.Duration / time.Second),
+		IsShort:          video.IsShort(),
 	}
 	if video.UpdatedAt != nil {
 		pbVideo.UpdatedAt = timestamppb.New(*video.UpdatedAt)
@@ -171,6 +174,9 @@ func domainSnapshotToProto(snapshot *domain.VideoSnapshot) *pb.VideoSnapshot {
 		LikesCount:        snapshot.LikesCount,
 		SubscriptionCount: snapshot.SubscriptionCount,
 		Source:            string(snapshot.Source),
+		DriftSeconds:      int64(snapshot.Drift / time.Second),
+		CommentsCount:     snapshot.CommentsCount,
+		Metrics:           snapshot.Metrics,
 		CreatedAt:         timestamppb.New(snapshot.CreatedAt),
 	}
 }
@@ -277,13 +283,14 @@ func domainGenreToProto(genre *domain.Genre) *pb.Genre {
 	}
 
 	return &pb.Genre{
-		Id:          string(genre.ID),
-		Code:        genre.Code,
-		Name:        genre.Name,
-		Language:    genre.Language,
-		RegionCode:  genre.RegionCode,
-		CategoryIds: categoryIDs,
-		Enabled:     genre.Enabled,
+		Id:                  string(genre.ID),
+		Code:                genre.Code,
+		Name:                genre.Name,
+		Language:            genre.Language,
+		RegionCode:          genre.RegionCode,
+		CategoryIds:         categoryIDs,
+		Enabled:             genre.Enabled,
+		CheckpointProfileId: string(genre.CheckpointProfileID),
 	}
 }
 
@@ -300,7 +307,33 @@ func domainVideoGenreToProto(vg *domain.VideoGenre) *pb.VideoGenre {
 		VideoId:   string(vg.VideoID),
 		GenreId:   string(vg.GenreID),
 		CreatedAt: timestamppb.New(vg.CreatedAt),
+		Match:     domainGenreMatchToProto(vg.Match),
+	}
+}
+
+func domainGenreMatchToProto(match *domain.GenreMatch) *pb.GenreMatch {
+	if match == nil {
+		return nil
+	}
+	proto := &pb.GenreMatch{
+		Result:      match.Result,
+		Matches:     make([]*pb.GenreKeywordMatch, len(match.Matches)),
+		EvaluatedAt: timestamppb.New(match.EvaluatedAt),
+	}
+	for i, m := range match.Matches {
+		proto.Matches[i] = &pb.GenreKeywordMatch{
+			KeywordGroupId: string(m.KeywordID),
+			KeywordGroup:   m.KeywordName,
+			FilterType:     string(m.FilterType),
+			Field:          m.Field,
+			MatchStart:     int32(m.Start),
+			MatchEnd:       int32(m.End),
+			MatchedText:    m.MatchedText,
+			Decisive:       m.Decisive,
+			Overridden:     m.Overridden,
+		}
 	}
+	return proto
 }
 
 func domainAuditLogToProto(log *domain.AuditLog) *pb.AuditLog {
@@ -339,11 +372,14 @@ func domainAuditLogToProto(log *domain.AuditLog) *pb.AuditLog {
 
 func domainBatchJobToProto(job *domain.BatchJob) *pb.BatchJob {
 	proto := &pb.BatchJob{
-		Id:           string(job.ID),
-		JobType:      string(job.JobType),
-		Status:       string(job.Status),
-		ErrorMessage: job.ErrorMessage,
-		CreatedAt:    timestamppb.New(job.CreatedAt),
+		Id:                string(job.ID),
+		JobType:           string(job.JobType),
+		Status:            string(job.Status),
+		ErrorMessage:      job.ErrorMessage,
+		CreatedAt:         timestamppb.New(job.CreatedAt),
+		ProgressProcessed: int32(job.Progress.Processed),
+		ProgressTotal:     int32(job.Progress.Total),
+		ProgressCurrent:   job.Progress.Current,
 	}
 
 	if job.StartedAt != nil {
@@ -354,6 +390,14 @@ func domainBatchJobToProto(job *domain.BatchJob) *pb.BatchJob {
 		proto.CompletedAt = timestamppb.New(*job.CompletedAt)
 	}
 
+	if job.CancelRequestedAt != nil {
+		proto.CancelRequestedAt = timestamppb.New(*job.CancelRequestedAt)
+	}
+
+	if job.RetryOf != nil {
+		proto.RetryOf = string(*job.RetryOf)
+	}
+
 	// Convert parameters
 	if job.Parameters != nil {
 		proto.Parameters = make(map[string]string)
@@ -13,6 +13,7 @@ type Config struct {
 	YouTube    YouTubeConfig
 	GCP        GCPConfig
 	CloudTasks CloudTasksConfig
+	Auth       AuthConfig
 }
 
 // ServerConfig holds server-related configuration
@@ -42,6 +43,15 @@ type CloudTasksConfig struct {
 	QueueName string
 }
 
+// AuthConfig holds ID token verification configuration
+type AuthConfig struct {
+	OIDCIssuer   string // e.g. https://securetoken.google.com/<PROJECT_ID>
+	OIDCAudience string // Usually the project ID
+	// InsecureDev skips token verification for local development. Callers
+	// are identified as a dev user without roles.
+	InsecureDev bool
+}
+
 // Load loads configuration from environment variables
 func Load() (*Config, error) {
 	cfg := &Config{}
@@ -96,5 +106,20 @@ func Load() (*Config, error) {
 		cfg.CloudTasks.QueueName = "ingestion-tasks" // Default queue name
 	}
 
+	// Auth configuration. Tokens are always verified unless local development
+	// mode is turned on explicitly.
+	cfg.Auth.OIDCIssuer = os.Getenv("OIDC_ISSUER")
+	cfg.Auth.OIDCAudience = os.Getenv("OIDC_AUDIENCE")
+	if insecureDev := os.Getenv("AUTH_INSECURE_DEV"); insecureDev != "" {
+		v, err := strconv.ParseBool(insecureDev)
+		if err != nil {
+			return nil, fmt.Errorf("invalid AUTH_INSECURE_DEV: %w", err)
+		}
+		cfg.Auth.InsecureDev = v
+	}
+	if !cfg.Auth.InsecureDev && (cfg.Auth.OIDCIssuer == "" || cfg.Auth.OIDCAudience == "") {
+		return nil, fmt.Errorf("OIDC_ISSUER and OIDC_AUDIENCE are required (set AUTH_INSECURE_DEV=true for local development)")
+	}
+
 	return cfg, nil
-}
\ No newline at end of file
+}
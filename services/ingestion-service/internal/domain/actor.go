@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"context"
+	"net"
+)
+
+// SystemActorID is the actor of changes made without a caller, such as by batch jobs
+const SystemActorID = "system"
+
+// Actor is the caller a change is attributed to in the audit log
+type Actor struct {
+	ID        string // Subject of the caller's ID token
+	Email     string
+	IPAddress net.IP
+	UserAgent string
+}
+
+type actorKey struct{}
+
+// WithActor returns a context carrying the actor of the request
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor of the request, or the system actor when
+// the context carries none
+func ActorFromContext(ctx context.Context) Actor {
+	if actor, ok := ctx.Value(actorKey{}).(Actor); ok {
+		return actor
+	}
+	return Actor{ID: SystemActorID}
+}
@@ -7,10 +7,35 @@ import (
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
 )
 
+// Audit log actions
+const (
+	AuditActionCreate  = "create"
+	AuditActionUpdate  = "update"
+	AuditActionEnable  = "enable"
+	AuditActionDisable = "disable"
+	AuditActionDelete  = "delete"
+	AuditActionAssign  = "assign"
+	AuditActionRemove  = "remove"
+	// Channel subscriptions
+	AuditActionSubscribe   = "subscribe"
+	AuditActionUnsubscribe = "unsubscribe"
+)
+
+// Audit log resource types
+const (
+	AuditResourceGenre           = "genre"
+	AuditResourceKeyword         = "keyword"
+	AuditResourceYouTubeCategory = "youtube_category"
+	AuditResourceVideoGenre      = "video_genre"
+	AuditResourceKeywordGroup    = "keyword_group"
+	AuditResourceKeywordSynonym  = "keyword_synonym"
+	AuditResourceChannel         = "channel"
+)
+
 // AuditLog represents an audit trail entry for administrative actions
 type AuditLog struct {
 	ID           valueobject.UUID
-	ActorID      valueobject.UUID
+	ActorID      string // Subject of the actor's ID token
 	ActorEmail   string
 	Action       string
 	ResourceType string
@@ -25,7 +50,7 @@ type AuditLog struct {
 // NewAuditLog creates a new audit log entry
 func NewAuditLog(
 	id valueobject.UUID,
-	actorID valueobject.UUID,
+	actorID string,
 	actorEmail string,
 	action string,
 	resourceType string,
@@ -61,4 +86,4 @@ func NewAuditLog(
 		UserAgent:    userAgent,
 		CreatedAt:    time.Now(),
 	}, nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,18 @@
+package domain
+
+import "time"
+
+// AuditLogOrder is the order audit logs are listed in: by created_at, then by
+// ID, newest first. Page tokens are issued for this order.
+const AuditLogOrder = "created_at desc"
+
+// AuditLogFilter narrows down an audit log listing. Zero fields do not filter.
+type AuditLogFilter struct {
+	ActorID       string
+	Action        string
+	ResourceType  string
+	ResourceID    string
+	ChangedField  string     // Field set or changed by the logged action, e.g. "category_ids"
+	CreatedAfter  *time.Time // Inclusive
+	CreatedBefore *time.Time // Exclusive
+}
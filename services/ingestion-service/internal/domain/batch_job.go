@@ -2,6 +2,7 @@ package domain
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
@@ -11,9 +12,13 @@ import (
 type JobType string
 
 const (
-	JobTypeCollectTrending      JobType = "collect_trending"
-	JobTypeRenewSubscriptions   JobType = "renew_subscriptions"
-	JobTypeCollectSnapshots     JobType = "collect_snapshots"
+	JobTypeCollectTrending    JobType = "collect_trending"
+	JobTypeRenewSubscriptions JobType = "renew_subscriptions"
+	JobTypeCollectSnapshots   JobType = "collect_snapshots"
+	JobTypeAuditSnapshots     JobType = "audit_snapshots"
+	JobTypeGenerateRankings   JobType = "generate_rankings"
+	JobTypeUpdateChannels     JobType = "update_channels"
+	JobTypePurgeAuditLogs     JobType = "purge_audit_logs"
 )
 
 // JobStatus represents the status of a batch job
@@ -24,24 +29,44 @@ const (
 	JobStatusRunning   JobStatus = "running"
 	JobStatusCompleted JobStatus = "completed"
 	JobStatusFailed    JobStatus = "failed"
+	// JobStatusSkippedOverlap marks a job that never ran because another job
+	// of the same type and scope was running
+	JobStatusSkippedOverlap JobStatus = "skipped_overlap"
+	JobStatusCancelled      JobStatus = "cancelled"
 )
 
 var (
 	ErrInvalidJobType   = errors.New("invalid job type")
 	ErrInvalidJobStatus = errors.New("invalid job status")
+	// ErrInvalidJobTransition is returned when a job is moved out of a status
+	// that does not allow it
+	ErrInvalidJobTransition = errors.New("invalid job status transition")
+	// ErrBatchJobCancelled is the cause of a run stopped by CancelBatchJob
+	ErrBatchJobCancelled = errors.New("batch job cancelled")
+	// ErrBatchJobShutdown is the cause of a run stopped because its server is shutting down
+	ErrBatchJobShutdown = errors.New("batch job stopped by server shutdown")
+	// ErrBatchJobNotRetryable is returned when a job type can only run from its batch command
+	ErrBatchJobNotRetryable = errors.New("batch job type cannot be retried")
+	// ErrBatchJobNotImplemented fails the runs of batch commands whose work is not implemented yet
+	ErrBatchJobNotImplemented = errors.New("batch job not implemented")
 )
 
 // BatchJob represents a batch job execution record
 type BatchJob struct {
-	ID            valueobject.UUID
-	JobType       JobType
-	Status        JobStatus
-	Parameters    map[string]interface{}
-	StartedAt     *time.Time
-	CompletedAt   *time.Time
-	ErrorMessage  string
-	Statistics    map[string]interface{}
-	CreatedAt     time.Time
+	ID           valueobject.UUID
+	JobType      JobType
+	Status       JobStatus
+	Parameters   map[string]interface{}
+	StartedAt    *time.Time
+	CompletedAt  *time.Time
+	ErrorMessage string
+	Statistics   map[string]interface{}
+	CreatedAt    time.Time
+	// CancelRequestedAt is set when cancellation of the running job was
+	// requested; the runner stops at its next check
+	CancelRequestedAt *time.Time
+	RetryOf           *valueobject.UUID // Job re-run by this job
+	Progress          BatchJobProgress
 }
 
 // NewBatchJob creates a new batch job
@@ -66,7 +91,7 @@ func NewBatchJob(
 // Start marks the job as started
 func (j *BatchJob) Start() error {
 	if j.Status != JobStatusPending {
-		return errors.New("job can only be started from pending status")
+		return fmt.Errorf("%w: job can only be started from pending status, got %s", ErrInvalidJobTransition, j.Status)
 	}
 
 	j.Status = JobStatusRunning
@@ -78,7 +103,7 @@ func (j *BatchJob) Start() error {
 // Complete marks the job as completed with statistics
 func (j *BatchJob) Complete(statistics map[string]interface{}) error {
 	if j.Status != JobStatusRunning {
-		return errors.New("job can only be completed from running status")
+		return fmt.Errorf("%w: job can only be completed from running status, got %s", ErrInvalidJobTransition, j.Status)
 	}
 
 	j.Status = JobStatusCompleted
@@ -88,10 +113,11 @@ func (j *BatchJob) Complete(statistics map[string]interface{}) error {
 	return nil
 }
 
-// Fail marks the job as failed with an error message
+// Fail marks the job as failed with an error message. A pending job fails
+// when it cannot be started, for example because its locks are unavailable.
 func (j *BatchJob) Fail(errorMessage string) error {
-	if j.Status != JobStatusRunning {
-		return errors.New("job can only be failed from running status")
+	if j.Status != JobStatusPending && j.Status != JobStatusRunning {
+		return fmt.Errorf("%w: job can only be failed from pending or running status, got %s", ErrInvalidJobTransition, j.Status)
 	}
 
 	j.Status = JobStatusFailed
@@ -101,11 +127,47 @@ func (j *BatchJob) Fail(errorMessage string) error {
 	return nil
 }
 
+// SkipOverlap marks the job as skipped because the given job holds its lock
+func (j *BatchJob) SkipOverlap(runningJobID valueobject.UUID) error {
+	if j.Status != JobStatusPending {
+		return fmt.Errorf("%w: job can only be skipped from pending status, got %s", ErrInvalidJobTransition, j.Status)
+	}
+
+	j.Status = JobStatusSkippedOverlap
+	now := time.Now()
+	j.CompletedAt = &now
+	j.ErrorMessage = fmt.Sprintf("overlaps running job %s", runningJobID)
+	return nil
+}
+
+// Cancel marks the job as cancelled
+func (j *BatchJob) Cancel() error {
+	if j.Status != JobStatusPending && j.Status != JobStatusRunning {
+		return fmt.Errorf("%w: job can only be cancelled from pending or running status, got %s", ErrInvalidJobTransition, j.Status)
+	}
+
+	j.Status = JobStatusCancelled
+	now := time.Now()
+	j.CompletedAt = &now
+	return nil
+}
+
+// IsFinished reports whether the job reached a final status
+func (j *BatchJob) IsFinished() bool {
+	switch j.Status {
+	case JobStatusCompleted, JobStatusFailed, JobStatusSkippedOverlap, JobStatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
 func isValidJobType(jobType JobType) bool {
 	switch jobType {
-	case JobTypeCollectTrending, JobTypeRenewSubscriptions, JobTypeCollectSnapshots:
+	case JobTypeCollectTrending, JobTypeRenewSubscriptions, JobTypeCollectSnapshots,
+		JobTypeAuditSnapshots, JobTypeGenerateRankings, JobTypeUpdateChannels, JobTypePurgeAuditLogs:
 		return true
 	default:
 		return false
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+)
+
+var (
+	// ErrBatchJobOverlap is returned when a batch job is skipped because
+	// another job of the same type and scope is still running
+	ErrBatchJobOverlap = errors.New("batch job overlaps a running job")
+	// ErrBatchJobLeaseLost is returned when a running job stops holding its lock,
+	// for example because heartbeats failed for longer than the lease
+	ErrBatchJobLeaseLost = errors.New("batch job lock lease lost")
+)
+
+// BatchJobLock is a lease held by the running job of a job type and scope.
+// The holder extends the lease with heartbeats; once it expires another job
+// may take it over.
+type BatchJobLock struct {
+	Key         string
+	JobID       valueobject.UUID
+	Holder      string // Host and process that run the job
+	AcquiredAt  time.Time
+	HeartbeatAt time.Time
+	ExpiresAt   time.Time
+}
+
+// BatchJobLockKey returns the lock key of a job type, optionally narrowed to a
+// scope such as a genre ID
+func BatchJobLockKey(jobType JobType, scope string) string {
+	if scope == "" {
+		return string(jobType)
+	}
+	return string(jobType) + ":" + scope
+}
+
+// IsStale reports whether the holder stopped heartbeating before now
+func (l *BatchJobLock) IsStale(now time.Time) bool {
+	return !l.ExpiresAt.After(now)
+}
@@ -0,0 +1,29 @@
+package domain
+
+import "context"
+
+// BatchJobProgress is the live progress of a running batch job
+type BatchJobProgress struct {
+	Processed int    // Items processed so far
+	Total     int    // Items to process; zero when unknown
+	Current   string // Item being processed, such as the genre being collected
+}
+
+// ProgressReporter receives the progress of a running batch job
+type ProgressReporter func(progress BatchJobProgress)
+
+type progressReporterKey struct{}
+
+// WithProgressReporter returns a context whose long-running loops report their
+// progress to reporter. A nil reporter silences reports, for example from
+// nested loops whose progress is already reported by the caller.
+func WithProgressReporter(ctx context.Context, reporter ProgressReporter) context.Context {
+	return context.WithValue(ctx, progressReporterKey{}, reporter)
+}
+
+// ReportProgress reports progress to the reporter of the context, if any
+func ReportProgress(ctx context.Context, progress BatchJobProgress) {
+	if reporter, ok := ctx.Value(progressReporterKey{}).(ProgressReporter); ok && reporter != nil {
+		reporter(progress)
+	}
+}
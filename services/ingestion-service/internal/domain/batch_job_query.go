@@ -0,0 +1,11 @@
+package domain
+
+// BatchJobOrder is the order batch jobs are listed in: by created_at, then by
+// ID, newest first. Page tokens are issued for this order.
+const BatchJobOrder = "created_at desc"
+
+// BatchJobFilter narrows down a batch job listing. Zero fields do not filter.
+type BatchJobFilter struct {
+	JobType JobType
+	Status  JobStatus
+}
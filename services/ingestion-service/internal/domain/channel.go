@@ -89,6 +89,15 @@ func (c *Channel) UpdateProfile(title string, thumbnailURL string, description s
 	c.UpdatedAt = &now
 }
 
+// UpdateStats updates the channel statistics to the latest captured counts
+func (c *Channel) UpdateStats(counts ChannelCounts) {
+	c.SubscriptionCount = counts.SubscriptionCount
+	c.ViewCount = counts.ViewCount
+	c.VideoCount = counts.VideoCount
+	now := time.Now()
+	c.UpdatedAt = &now
+}
+
 // Delete performs soft delete
 func (c *Channel) Delete() {
 	now := time.Now()
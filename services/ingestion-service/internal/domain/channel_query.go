@@ -0,0 +1,11 @@
+package domain
+
+// ChannelOrder is the order channels are listed in: by created_at, then by ID,
+// newest first. Page tokens are issued for this order.
+const ChannelOrder = "created_at desc"
+
+// ChannelFilter narrows down a channel listing. Zero fields do not filter.
+type ChannelFilter struct {
+	Subscribed *bool  // true lists only subscribed channels, false only unsubscribed ones
+	Query      string // Case-insensitive substring of the title
+}
@@ -1,17 +1,30 @@
 package domain
 
 import (
+	"sort"
 	"time"
 
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
 )
 
+// ChannelSnapshotInterval is how often channel statistics are captured
+const ChannelSnapshotInterval = 24 * time.Hour
+
+// ChannelCounts holds the channel statistics captured in a snapshot
+type ChannelCounts struct {
+	SubscriptionCount int64
+	ViewCount         int64
+	VideoCount        int64
+}
+
 // ChannelSnapshot represents a snapshot of channel statistics
 type ChannelSnapshot struct {
 	ID                valueobject.UUID
 	ChannelID         valueobject.UUID
 	MeasuredAt        time.Time
-	SubscriptionCount int
+	SubscriptionCount int64
+	ViewCount         int64
+	VideoCount        int64
 	CreatedAt         time.Time
 }
 
@@ -20,13 +33,63 @@ func NewChannelSnapshot(
 	id valueobject.UUID,
 	channelID valueobject.UUID,
 	measuredAt time.Time,
-	subscriptionCount int,
+	counts ChannelCounts,
 ) *ChannelSnapshot {
 	return &ChannelSnapshot{
 		ID:                id,
 		ChannelID:         channelID,
 		MeasuredAt:        measuredAt,
-		SubscriptionCount: subscriptionCount,
+		SubscriptionCount: counts.SubscriptionCount,
+		ViewCount:         counts.ViewCount,
+		VideoCount:        counts.VideoCount,
 		CreatedAt:         time.Now(),
 	}
-}
\ No newline at end of file
+}
+
+// ChannelSnapshotDue reports whether a new snapshot should be taken given the
+// latest one, which is nil when the channel has never been captured. Snapshots
+// are taken at most once per UTC day.
+func ChannelSnapshotDue(latest *ChannelSnapshot, now time.Time) bool {
+	if latest == nil {
+		return true
+	}
+	return now.UTC().Truncate(ChannelSnapshotInterval).After(latest.MeasuredAt.UTC())
+}
+
+// ChannelGrowthPoint is one snapshot of a channel growth series with the
+// change since the previous snapshot
+type ChannelGrowthPoint struct {
+	MeasuredAt             time.Time
+	SubscriptionCount      int64
+	ViewCount              int64
+	VideoCount             int64
+	SubscriptionCountDelta int64
+	ViewCountDelta         int64
+	VideoCountDelta        int64
+}
+
+// NewChannelGrowthSeries orders the snapshots oldest first and computes the
+// growth between consecutive snapshots. The first point has zero deltas.
+func NewChannelGrowthSeries(snapshots []*ChannelSnapshot) []*ChannelGrowthPoint {
+	sorted := make([]*ChannelSnapshot, len(snapshots))
+	copy(sorted, snapshots)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].MeasuredAt.Before(sorted[j].MeasuredAt) })
+
+	series := make([]*ChannelGrowthPoint, len(sorted))
+	for i, s := range sorted {
+		p := &ChannelGrowthPoint{
+			MeasuredAt:        s.MeasuredAt,
+			SubscriptionCount: s.SubscriptionCount,
+			ViewCount:         s.ViewCount,
+			VideoCount:        s.VideoCount,
+		}
+		if i > 0 {
+			prev := sorted[i-1]
+			p.SubscriptionCountDelta = s.SubscriptionCount - prev.SubscriptionCount
+			p.ViewCountDelta = s.ViewCount - prev.ViewCount
+			p.VideoCountDelta = s.VideoCount - prev.VideoCount
+		}
+		series[i] = p
+	}
+	return series
+}
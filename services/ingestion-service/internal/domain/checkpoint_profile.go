@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+)
+
+// DefaultCheckpointProfileCode is the profile used for videos whose genres have none
+const DefaultCheckpointProfileCode = "default"
+
+// CheckpointProfile is a named schedule of checkpoint hours that genres opt into
+type CheckpointProfile struct {
+	ID    valueobject.UUID
+	Code  string
+	Name  string
+	Hours []valueobject.CheckpointHour // ascending, always starting with the 0h baseline
+}
+
+// NewCheckpointProfile creates a new checkpoint profile. Hours are sorted and
+// de-duplicated, and the 0h baseline is added since every metric is relative to it.
+func NewCheckpointProfile(
+	id valueobject.UUID,
+	code, name string,
+	hours []valueobject.CheckpointHour,
+) (*CheckpointProfile, error) {
+	if code == "" {
+		return nil, ErrInvalidInput
+	}
+	for _, h := range hours {
+		if !h.IsValid() {
+			return nil, ErrInvalidCheckpoint
+		}
+	}
+
+	return &CheckpointProfile{
+		ID:    id,
+		Code:  code,
+		Name:  name,
+		Hours: normalizeCheckpointHours(hours),
+	}, nil
+}
+
+// DefaultCheckpointProfile returns the built-in profile used when none is stored
+func DefaultCheckpointProfile() *CheckpointProfile {
+	return &CheckpointProfile{
+		Code:  DefaultCheckpointProfileCode,
+		Name:  "Default",
+		Hours: valueobject.DefaultCheckpointHours(),
+	}
+}
+
+// HoursAfter returns the checkpoint hours later than the given hour
+func (p *CheckpointProfile) HoursAfter(hour valueobject.CheckpointHour) []valueobject.CheckpointHour {
+	i := sort.Search(len(p.Hours), func(i int) bool { return p.Hours[i] > hour })
+	return p.Hours[i:]
+}
+
+// Includes reports whether the profile schedules a snapshot at the given hour
+func (p *CheckpointProfile) Includes(hour valueobject.CheckpointHour) bool {
+	i := sort.Search(len(p.Hours), func(i int) bool { return p.Hours[i] >= hour })
+	return i < len(p.Hours) && p.Hours[i] == hour
+}
+
+// LastCheckpointHour returns the latest checkpoint of any of the profiles or
+// of the default profile
+func LastCheckpointHour(profiles []*CheckpointProfile) valueobject.CheckpointHour {
+	hours := DefaultCheckpointProfile().Hours
+	last := hours[len(hours)-1]
+	for _, profile := range profiles {
+		if n := len(profile.Hours); n > 0 && profile.Hours[n-1] > last {
+			last = profile.Hours[n-1]
+		}
+	}
+	return last
+}
+
+// MergeCheckpointProfiles combines the profiles of every genre a video belongs
+// to, so a video in several genres is captured at each of their checkpoints
+func MergeCheckpointProfiles(profiles []*CheckpointProfile) *CheckpointProfile {
+	switch len(profiles) {
+	case 0:
+		return DefaultCheckpointProfile()
+	case 1:
+		return profiles[0]
+	}
+
+	codes := make([]string, len(profiles))
+	var hours []valueobject.CheckpointHour
+	for i, p := range profiles {
+		codes[i] = p.Code
+		hours = append(hours, p.Hours...)
+	}
+
+	return &CheckpointProfile{
+		Code:  strings.Join(codes, "+"),
+		Name:  strings.Join(codes, " + "),
+		Hours: normalizeCheckpointHours(hours),
+	}
+}
+
+func normalizeCheckpointHours(hours []valueobject.CheckpointHour) []valueobject.CheckpointHour {
+	seen := map[valueobject.CheckpointHour]bool{valueobject.CheckpointHour0: true}
+	result := []valueobject.CheckpointHour{valueobject.CheckpointHour0}
+	for _, h := range hours {
+		if !seen[h] {
+			seen[h] = true
+			result = append(result, h)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result
+}
@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Actions of planned changes
+const (
+	PlannedInsert   = "insert"
+	PlannedUpdate   = "update"
+	PlannedDelete   = "delete"
+	PlannedSchedule = "schedule"
+	PlannedPublish  = "publish"
+)
+
+// PlannedChange is a change a dry run would have made
+type PlannedChange struct {
+	Action   string // One of the Planned* actions
+	Resource string // Kind of resource or event, e.g. video or snapshot_added
+	ID       string // Identifies the resource, when known
+	Detail   string // Optional human readable context
+}
+
+// String formats the change as "insert video <id> (<detail>)"
+func (c PlannedChange) String() string {
+	s := c.Action + " " + c.Resource
+	if c.ID != "" {
+		s += " " + c.ID
+	}
+	if c.Detail != "" {
+		s += " (" + c.Detail + ")"
+	}
+	return s
+}
+
+// DryRunReport collects the changes a dry run would have made. It is safe for
+// concurrent use.
+type DryRunReport struct {
+	mu      sync.Mutex
+	changes []PlannedChange
+}
+
+// Changes returns the planned changes in the order they were planned
+func (r *DryRunReport) Changes() []PlannedChange {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]PlannedChange(nil), r.changes...)
+}
+
+// Summary returns the number of planned changes per action and resource as
+// sorted lines such as "insert video: 3"
+func (r *DryRunReport) Summary() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	counts := make(map[string]int)
+	for _, c := range r.changes {
+		counts[c.Action+" "+c.Resource]++
+	}
+	lines := make([]string, 0, len(counts))
+	for key, n := range counts {
+		lines = append(lines, fmt.Sprintf("%s: %d", key, n))
+	}
+	sort.Strings(lines)
+	return lines
+}
+
+func (r *DryRunReport) add(change PlannedChange) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.changes = append(r.changes, change)
+}
+
+type dryRunKey struct{}
+
+// WithDryRun returns a context in which use cases validate and report changes
+// without persisting them
+func WithDryRun(ctx context.Context) context.Context {
+	return WithDryRunReport(ctx, &DryRunReport{})
+}
+
+// WithDryRunReport returns a dry run context whose planned changes are
+// collected in report
+func WithDryRunReport(ctx context.Context, report *DryRunReport) context.Context {
+	return context.WithValue(ctx, dryRunKey{}, report)
+}
+
+// IsDryRun reports whether changes made with the context must not be persisted
+func IsDryRun(ctx context.Context) bool {
+	_, ok := ctx.Value(dryRunKey{}).(*DryRunReport)
+	return ok
+}
+
+// PlanChange records a change skipped by a dry run in the report of the
+// context. It does nothing outside dry runs.
+func PlanChange(ctx context.Context, change PlannedChange) {
+	if report, ok := ctx.Value(dryRunKey{}).(*DryRunReport); ok && report != nil {
+		report.add(change)
+	}
+}
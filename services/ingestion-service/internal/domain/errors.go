@@ -9,6 +9,9 @@ var (
 	ErrChannelAlreadyExists = errors.New("channel already exists")
 	ErrInvalidChannelID     = errors.New("invalid channel ID")
 
+	// Channel snapshot errors
+	ErrChannelSnapshotNotFound = errors.New("channel snapshot not found")
+
 	// Video errors
 	ErrVideoNotFound      = errors.New("video not found")
 	ErrVideoAlreadyExists = errors.New("video already exists")
@@ -23,6 +26,9 @@ var (
 	ErrKeywordNotFound  = errors.New("keyword not found")
 	ErrKeywordDuplicate = errors.New("keyword already exists")
 
+	// Keyword group errors
+	ErrKeywordGroupNotFound = errors.New("keyword group not found")
+
 	// General errors
 	ErrInvalidInput = errors.New("invalid input")
 	ErrNotFound     = errors.New("not found")
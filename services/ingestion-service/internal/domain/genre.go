@@ -4,6 +4,10 @@ import (
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
 )
 
+// GenreOrder is the order genres are listed in. Codes are unique, so the code
+// alone is the keyset of a page token.
+const GenreOrder = "code asc"
+
 // Genre represents a collection target with region, categories, and language settings
 type Genre struct {
 	ID          valueobject.UUID
@@ -13,6 +17,8 @@ type Genre struct {
 	RegionCode  string                   // e.g., "JP", "US"
 	CategoryIDs []valueobject.CategoryID // e.g., [27, 28] for Education & Science
 	Enabled     bool
+	// CheckpointProfileID selects the snapshot schedule; empty uses the default profile
+	CheckpointProfileID valueobject.UUID
 }
 
 // NewGenre creates a new Genre
@@ -72,4 +78,14 @@ func (g *Genre) Update(name string, categoryIDs []valueobject.CategoryID) error
 	g.Name = name
 	g.CategoryIDs = categoryIDs
 	return nil
-}
\ No newline at end of file
+}
+
+// SetCheckpointProfile assigns the snapshot schedule; nil or the default
+// profile clears the assignment so the genre follows the default profile
+func (g *Genre) SetCheckpointProfile(profile *CheckpointProfile) {
+	if profile == nil || profile.Code == DefaultCheckpointProfileCode {
+		g.CheckpointProfileID = ""
+		return
+	}
+	g.CheckpointProfileID = profile.ID
+}
@@ -2,6 +2,8 @@ package domain
 
 import (
 	"errors"
+	"fmt"
+	"regexp"
 	"strings"
 	"time"
 
@@ -12,6 +14,7 @@ var (
 	ErrEmptyName        = errors.New("keyword name cannot be empty")
 	ErrEmptyPattern     = errors.New("keyword pattern cannot be empty")
 	ErrInvalidFilterType = errors.New("invalid filter type")
+	ErrInvalidPattern    = errors.New("invalid keyword pattern")
 )
 
 // Keyword represents a filter keyword entity
@@ -43,8 +46,8 @@ func NewKeyword(
 		return nil, ErrEmptyName
 	}
 
-	if strings.TrimSpace(pattern) == "" {
-		return nil, ErrEmptyPattern
+	if err := ValidatePattern(pattern); err != nil {
+		return nil, err
 	}
 
 	if !filterType.IsValid() {
@@ -85,8 +88,8 @@ func (k *Keyword) Update(name *string, filterType *valueobject.FilterType, patte
 	}
 
 	if pattern != nil {
-		if strings.TrimSpace(*pattern) == "" {
-			return ErrEmptyPattern
+		if err := ValidatePattern(*pattern); err != nil {
+			return err
 		}
 		k.Pattern = *pattern
 	}
@@ -101,6 +104,19 @@ func (k *Keyword) Update(name *string, filterType *valueobject.FilterType, patte
 	return nil
 }
 
+// ValidatePattern checks that a pattern is a regular expression the filter can
+// compile, so that invalid patterns are rejected when they are saved rather
+// than skipped when videos are filtered
+func ValidatePattern(pattern string) error {
+	if strings.TrimSpace(pattern) == "" {
+		return ErrEmptyPattern
+	}
+	if _, err := regexp.Compile(pattern); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidPattern, err)
+	}
+	return nil
+}
+
 // Enable enables the keyword
 func (k *Keyword) Enable() {
 	k.Enabled = true
@@ -9,10 +9,16 @@ import (
 	"github.com/google/uuid"
 )
 
+// KeywordGroupOrder is the order the keyword groups of a genre are listed in:
+// by name, then by ID. Page tokens are issued for this order.
+const KeywordGroupOrder = "name asc"
+
 var (
 	ErrEmptyGroupName    = errors.New("keyword group name cannot be empty")
 	ErrNoKeywordItems    = errors.New("keyword group must have at least one keyword item")
 	ErrDuplicateKeyword  = errors.New("duplicate keyword in group")
+	ErrEmptyKeyword      = errors.New("keyword cannot be empty")
+	ErrKeywordNotInGroup = errors.New("keyword not found in group")
 )
 
 // KeywordGroup represents a group of related keywords
@@ -25,10 +31,14 @@ type KeywordGroup struct {
 	TargetField string
 	Enabled     bool
 	Description *string
-	Items       []KeywordItem // Aggregate includes items
-	CreatedAt   time.Time
-	UpdatedAt   *time.Time
-	DeletedAt   *time.Time
+	// CrossLanguage applies cross-language synonyms, such as パイソン for Python
+	CrossLanguage bool
+	// Romaji also matches the romaji spellings of kana keywords, such as ramen for ラーメン
+	Romaji    bool
+	Items     []KeywordItem // Aggregate includes items
+	CreatedAt time.Time
+	UpdatedAt *time.Time
+	DeletedAt *time.Time
 }
 
 // KeywordItem represents an individual keyword within a group
@@ -119,8 +129,8 @@ func createKeywordItems(groupID valueobject.UUID, keywords []string) ([]KeywordI
 	return items, nil
 }
 
-// Update updates the keyword group
-func (kg *KeywordGroup) Update(name *string, filterType *valueobject.FilterType, description *string) error {
+// Update updates the keyword group. Nil fields are left unchanged.
+func (kg *KeywordGroup) Update(name *string, filterType *valueobject.FilterType, targetField *string, description *string) error {
 	if name != nil {
 		if strings.TrimSpace(*name) == "" {
 			return ErrEmptyGroupName
@@ -135,6 +145,13 @@ func (kg *KeywordGroup) Update(name *string, filterType *valueobject.FilterType,
 		kg.FilterType = *filterType
 	}
 
+	if targetField != nil {
+		if *targetField == "" {
+			return ErrInvalidInput
+		}
+		kg.TargetField = *targetField
+	}
+
 	if description != nil {
 		kg.Description = description
 	}
@@ -163,7 +180,7 @@ func (kg *KeywordGroup) UpdateKeywords(keywords []string) error {
 func (kg *KeywordGroup) AddKeyword(keyword string) error {
 	trimmed := strings.TrimSpace(keyword)
 	if trimmed == "" {
-		return errors.New("keyword cannot be empty")
+		return ErrEmptyKeyword
 	}
 
 	// Check for duplicates
@@ -203,7 +220,7 @@ func (kg *KeywordGroup) RemoveKeyword(keyword string) error {
 	}
 
 	if !found {
-		return errors.New("keyword not found in group")
+		return ErrKeywordNotInGroup
 	}
 
 	if len(newItems) == 0 {
@@ -226,6 +243,20 @@ func (kg *KeywordGroup) GetKeywords() []string {
 	return keywords
 }
 
+// SetCrossLanguage sets whether cross-language synonyms apply to the group
+func (kg *KeywordGroup) SetCrossLanguage(crossLanguage bool) {
+	kg.CrossLanguage = crossLanguage
+	now := time.Now()
+	kg.UpdatedAt = &now
+}
+
+// SetRomaji sets whether romaji spellings of kana keywords are matched
+func (kg *KeywordGroup) SetRomaji(romaji bool) {
+	kg.Romaji = romaji
+	now := time.Now()
+	kg.UpdatedAt = &now
+}
+
 // Enable enables the keyword group
 func (kg *KeywordGroup) Enable() {
 	kg.Enabled = true
@@ -250,4 +281,4 @@ func (kg *KeywordGroup) Delete() {
 // IsDeleted checks if the keyword group is deleted
 func (kg *KeywordGroup) IsDeleted() bool {
 	return kg.DeletedAt != nil
-}
\ No newline at end of file
+}
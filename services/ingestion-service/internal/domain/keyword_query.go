@@ -0,0 +1,14 @@
+package domain
+
+import "github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+
+// KeywordOrder is the order keywords are listed in: by name, then by ID. Page
+// tokens are issued for this order.
+const KeywordOrder = "name asc"
+
+// KeywordFilter narrows down a keyword listing. Zero fields do not filter.
+type KeywordFilter struct {
+	GenreID     valueobject.UUID
+	EnabledOnly bool
+	Query       string // Case-insensitive substring of the name
+}
@@ -0,0 +1,147 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+)
+
+var (
+	ErrKeywordSynonymNotFound  = errors.New("keyword synonym not found")
+	ErrDuplicateKeywordSynonym = errors.New("keyword synonym already exists")
+	ErrEmptySynonymTerm        = errors.New("synonym term and alias cannot be empty")
+	ErrSynonymSameAsTerm       = errors.New("synonym alias cannot equal its term")
+	ErrInvalidSynonymLanguage  = errors.New("invalid synonym language")
+)
+
+// Synonym languages. A keyword is Japanese when it contains kana or kanji and
+// English otherwise.
+const (
+	SynonymLanguageEnglish  = "en"
+	SynonymLanguageJapanese = "ja"
+)
+
+// KeywordSynonymOrder is the order synonym entries are listed in: by language,
+// then by term and alias ignoring case, then by ID. Page tokens are issued for
+// this order and keep the sort key returned by PageKey.
+const KeywordSynonymOrder = "language asc, term asc, alias asc"
+
+// keywordSynonymPageKeySeparator joins the fields of a page key. Text columns
+// cannot hold NUL, so no field contains it.
+const keywordSynonymPageKeySeparator = "\x00"
+
+// KeywordSynonym maps a keyword term to an alias the pattern generator also
+// matches. A bidirectional entry maps the alias back to the term as well. An
+// entry whose alias language differs from its language is cross-language and
+// only applies to keyword groups that opt in.
+type KeywordSynonym struct {
+	ID            valueobject.UUID
+	Language      string
+	Term          string
+	Alias         string
+	AliasLanguage string
+	Bidirectional bool
+	CreatedAt     time.Time
+	UpdatedAt     *time.Time
+}
+
+// NewKeywordSynonym creates a new synonym entry. The alias language defaults
+// to the language of the term.
+func NewKeywordSynonym(
+	id valueobject.UUID,
+	language string,
+	term string,
+	alias string,
+	aliasLanguage string,
+	bidirectional bool,
+) (*KeywordSynonym, error) {
+	if aliasLanguage == "" {
+		aliasLanguage = language
+	}
+	s := &KeywordSynonym{
+		ID:            id,
+		Language:      language,
+		Term:          strings.TrimSpace(term),
+		Alias:         strings.TrimSpace(alias),
+		AliasLanguage: aliasLanguage,
+		Bidirectional: bidirectional,
+		CreatedAt:     time.Now(),
+	}
+	if err := s.validate(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Update updates the synonym entry. Nil fields are left unchanged.
+func (s *KeywordSynonym) Update(term, alias, aliasLanguage *string, bidirectional *bool) error {
+	updated := *s
+	if term != nil {
+		updated.Term = strings.TrimSpace(*term)
+	}
+	if alias != nil {
+		updated.Alias = strings.TrimSpace(*alias)
+	}
+	if aliasLanguage != nil {
+		updated.AliasLanguage = *aliasLanguage
+	}
+	if bidirectional != nil {
+		updated.Bidirectional = *bidirectional
+	}
+	if err := updated.validate(); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	updated.UpdatedAt = &now
+	*s = updated
+	return nil
+}
+
+// IsCrossLanguage reports whether the alias is in another language than the term
+func (s *KeywordSynonym) IsCrossLanguage() bool {
+	return s.Language != s.AliasLanguage
+}
+
+// SameEntry reports whether both entries map the same term to the same alias,
+// compared case-insensitively
+func (s *KeywordSynonym) SameEntry(other *KeywordSynonym) bool {
+	return s.Language == other.Language &&
+		s.AliasLanguage == other.AliasLanguage &&
+		strings.EqualFold(s.Term, other.Term) &&
+		strings.EqualFold(s.Alias, other.Alias)
+}
+
+func (s *KeywordSynonym) validate() error {
+	if !IsSynonymLanguage(s.Language) || !IsSynonymLanguage(s.AliasLanguage) {
+		return ErrInvalidSynonymLanguage
+	}
+	if s.Term == "" || s.Alias == "" {
+		return ErrEmptySynonymTerm
+	}
+	if s.Language == s.AliasLanguage && strings.EqualFold(s.Term, s.Alias) {
+		return ErrSynonymSameAsTerm
+	}
+	return nil
+}
+
+// IsSynonymLanguage reports whether the language is supported by the synonym dictionary
+func IsSynonymLanguage(language string) bool {
+	return language == SynonymLanguageEnglish || language == SynonymLanguageJapanese
+}
+
+// PageKey returns the sort key of the entry in KeywordSynonymOrder
+func (s *KeywordSynonym) PageKey() string {
+	return strings.Join([]string{s.Language, s.Term, s.Alias}, keywordSynonymPageKeySeparator)
+}
+
+// ParseKeywordSynonymPageKey splits a sort key returned by PageKey
+func ParseKeywordSynonymPageKey(key string) (language, term, alias string, err error) {
+	fields := strings.Split(key, keywordSynonymPageKeySeparator)
+	if len(fields) != 3 {
+		return "", "", "", valueobject.ErrInvalidPageToken
+	}
+	return fields[0], fields[1], fields[2], nil
+}
@@ -0,0 +1,128 @@
+package service
+
+// ahoCorasick matches many literal strings against a text in a single pass.
+// It works on bytes; since both the literals and the text are UTF-8, every
+// match starts and ends on a character boundary.
+type ahoCorasick struct {
+	nodes    []acNode
+	literals []acLiteral
+}
+
+type acNode struct {
+	next    map[byte]int32
+	fail    int32   // Node of the longest proper suffix that is in the trie
+	dict    int32   // Nearest node on the fail chain that ends a literal, or -1
+	outputs []int32 // Literals ending at this node
+}
+
+// acLiteral is a literal alternative of a keyword pattern
+type acLiteral struct {
+	keyword     int // Index of the keyword the literal belongs to
+	alternative int // Position of the literal among the alternatives of the pattern
+	length      int
+}
+
+// acSpan is the byte range of the match of a keyword, with the alternative
+// that matched. A keyword without a match has start -1.
+type acSpan struct {
+	start, end  int
+	alternative int
+}
+
+// newAhoCorasick builds the automaton of the literal alternatives of each
+// keyword, indexed by keyword
+func newAhoCorasick(alternatives map[int][]string) *ahoCorasick {
+	ac := &ahoCorasick{nodes: []acNode{{fail: 0, dict: -1}}}
+	for keyword, literals := range alternatives {
+		for alternative, literal := range literals {
+			ac.add(literal, acLiteral{keyword: keyword, alternative: alternative, length: len(literal)})
+		}
+	}
+	ac.link()
+	return ac
+}
+
+func (ac *ahoCorasick) add(s string, literal acLiteral) {
+	node := int32(0)
+	for i := 0; i < len(s); i++ {
+		next, ok := ac.nodes[node].next[s[i]]
+		if !ok {
+			next = int32(len(ac.nodes))
+			ac.nodes = append(ac.nodes, acNode{dict: -1})
+			if ac.nodes[node].next == nil {
+				ac.nodes[node].next = make(map[byte]int32)
+			}
+			ac.nodes[node].next[s[i]] = next
+		}
+		node = next
+	}
+	ac.nodes[node].outputs = append(ac.nodes[node].outputs, int32(len(ac.literals)))
+	ac.literals = append(ac.literals, literal)
+}
+
+// link sets the fail and dictionary links breadth first
+func (ac *ahoCorasick) link() {
+	queue := make([]int32, 0, len(ac.nodes))
+	for _, child := range ac.nodes[0].next {
+		queue = append(queue, child)
+	}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		for b, child := range ac.nodes[node].next {
+			fail := ac.nodes[node].fail
+			for fail != 0 {
+				if _, ok := ac.nodes[fail].next[b]; ok {
+					break
+				}
+				fail = ac.nodes[fail].fail
+			}
+			if next, ok := ac.nodes[fail].next[b]; ok && next != child {
+				ac.nodes[child].fail = next
+			}
+			f := ac.nodes[child].fail
+			if len(ac.nodes[f].outputs) > 0 {
+				ac.nodes[child].dict = f
+			} else {
+				ac.nodes[child].dict = ac.nodes[f].dict
+			}
+			queue = append(queue, child)
+		}
+	}
+}
+
+// match returns, for each of n keywords, the leftmost match of its literals in
+// text. Of literals matching at the same position the earliest alternative
+// wins, as it would in the regular expression the literals came from.
+func (ac *ahoCorasick) match(text string, n int) []acSpan {
+	spans := make([]acSpan, n)
+	for i := range spans {
+		spans[i].start = -1
+	}
+
+	node := int32(0)
+	for i := 0; i < len(text); i++ {
+		b := text[i]
+		for node != 0 {
+			if _, ok := ac.nodes[node].next[b]; ok {
+				break
+			}
+			node = ac.nodes[node].fail
+		}
+		if next, ok := ac.nodes[node].next[b]; ok {
+			node = next
+		}
+
+		for out := node; out > 0; out = ac.nodes[out].dict {
+			for _, l := range ac.nodes[out].outputs {
+				literal := ac.literals[l]
+				start := i + 1 - literal.length
+				span := &spans[literal.keyword]
+				if span.start == -1 || start < span.start || (start == span.start && literal.alternative < span.alternative) {
+					*span = acSpan{start: start, end: i + 1, alternative: literal.alternative}
+				}
+			}
+		}
+	}
+	return spans
+}
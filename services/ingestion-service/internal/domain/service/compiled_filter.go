@@ -0,0 +1,318 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+)
+
+// FilterResult represents the result of filtering
+type FilterResult int
+
+const (
+	// FilterResultNeutral means no filter matched
+	FilterResultNeutral FilterResult = iota
+	// FilterResultInclude means include filter matched
+	FilterResultInclude
+	// FilterResultExclude means exclude filter matched
+	FilterResultExclude
+)
+
+// String returns the name of the result: neutral, include or exclude
+func (r FilterResult) String() string {
+	switch r {
+	case FilterResultInclude:
+		return "include"
+	case FilterResultExclude:
+		return "exclude"
+	default:
+		return "neutral"
+	}
+}
+
+// FilterMatch is the result of filtering with the keyword that decided it
+type FilterMatch struct {
+	Result  FilterResult
+	Keyword *domain.Keyword // Nil when no filter matched
+	Start   int             // Character offset of the matched span in the title
+	End     int             // Character offset just after the matched span
+}
+
+// CompiledFilter is a set of keyword filters compiled once to be matched
+// against many titles. Patterns that are case-insensitive alternatives of
+// literals, as generated from keyword groups, are matched together with
+// Aho-Corasick; other patterns are compiled regular expressions. It is safe
+// for concurrent use.
+type CompiledFilter struct {
+	exclude compiledFilterSet
+	include compiledFilterSet
+}
+
+// compiledFilterSet holds the enabled keywords of a filter type in order
+type compiledFilterSet struct {
+	keywords []*domain.Keyword
+	regexps  []*regexp.Regexp // Nil for keywords matched by literals
+	literals *ahoCorasick     // Nil when no keyword is literal
+}
+
+// CompileFilter compiles the enabled keywords into a filter. Invalid patterns
+// are an error wrapping domain.ErrInvalidPattern.
+func CompileFilter(keywords []*domain.Keyword) (*CompiledFilter, error) {
+	f := &CompiledFilter{}
+	var err error
+	if f.exclude, err = compileFilterSet(keywords, valueobject.FilterTypeExclude); err != nil {
+		return nil, err
+	}
+	if f.include, err = compileFilterSet(keywords, valueobject.FilterTypeInclude); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func compileFilterSet(keywords []*domain.Keyword, filterType valueobject.FilterType) (compiledFilterSet, error) {
+	var set compiledFilterSet
+	literals := make(map[int][]string)
+	for _, kw := range keywords {
+		if !kw.Enabled || kw.IsDeleted() || kw.FilterType != filterType {
+			continue
+		}
+
+		i := len(set.keywords)
+		set.keywords = append(set.keywords, kw)
+		if alternatives, ok := literalAlternatives(kw.Pattern); ok {
+			literals[i] = alternatives
+			set.regexps = append(set.regexps, nil)
+			continue
+		}
+		re, err := regexp.Compile(kw.Pattern)
+		if err != nil {
+			return compiledFilterSet{}, fmt.Errorf("keyword %q: %w: %v", kw.Name, domain.ErrInvalidPattern, err)
+		}
+		set.regexps = append(set.regexps, re)
+	}
+	if len(literals) > 0 {
+		set.literals = newAhoCorasick(literals)
+	}
+	return set, nil
+}
+
+// Filter applies the filter to a video title
+func (f *CompiledFilter) Filter(title string) FilterResult {
+	return f.Match(title).Result
+}
+
+// Match applies the filter to a video title. Exclude filters take precedence
+// over include filters, and within a filter type the first keyword that
+// matches decides.
+func (f *CompiledFilter) Match(title string) FilterMatch {
+	text := normalize(title, true)
+	if m, ok := f.exclude.match(text); ok {
+		m.Result = FilterResultExclude
+		return m
+	}
+	if m, ok := f.include.match(text); ok {
+		m.Result = FilterResultInclude
+		return m
+	}
+	return FilterMatch{Result: FilterResultNeutral}
+}
+
+// Admits reports whether the filter lets a video title in: no exclude keyword
+// matches it, and an include keyword does unless the filter has none
+func (f *CompiledFilter) Admits(title string) bool {
+	switch f.Filter(title) {
+	case FilterResultInclude:
+		return true
+	case FilterResultNeutral:
+		return len(f.include.keywords) == 0
+	default:
+		return false
+	}
+}
+
+// Explain applies the filter to the fields of a video, keyed by name such as
+// title, and returns every keyword that matched. The result and the decisive
+// match are those of Match when the only field is the title.
+func (f *CompiledFilter) Explain(fields map[string]string) FilterExplanation {
+	texts := newFieldTexts(fields)
+	return newFilterExplanation(f.exclude.matchAll(texts), f.include.matchAll(texts))
+}
+
+// FieldTitle is the video field keywords match unless they target another
+const FieldTitle = "title"
+
+// FilterExplanation is the result of filtering with every keyword that
+// matched, so that a result can be explained: which keywords matched, where,
+// and which exclusions overrode inclusions
+type FilterExplanation struct {
+	Result  FilterResult
+	Matches []KeywordMatch // Exclusions, then inclusions, each in keyword order
+}
+
+// KeywordMatch is the match of a keyword in a field of a video
+type KeywordMatch struct {
+	Keyword    *domain.Keyword
+	Field      string // Field the keyword matched, such as title
+	Start      int    // Character offset of the matched span in the field
+	End        int    // Character offset just after the matched span
+	Decisive   bool   // The match decided the result, as Match would return it
+	Overridden bool   // An inclusion overridden by a matching exclusion
+}
+
+// newFilterExplanation decides the result from the matches of each filter
+// type the way Match does: the first exclusion, else the first inclusion
+func newFilterExplanation(exclusions, inclusions []KeywordMatch) FilterExplanation {
+	e := FilterExplanation{Result: FilterResultNeutral}
+	switch {
+	case len(exclusions) > 0:
+		e.Result = FilterResultExclude
+		exclusions[0].Decisive = true
+		for i := range inclusions {
+			inclusions[i].Overridden = true
+		}
+	case len(inclusions) > 0:
+		e.Result = FilterResultInclude
+		inclusions[0].Decisive = true
+	}
+	e.Matches = append(exclusions, inclusions...)
+	return e
+}
+
+// fieldTexts holds the fields of a video, normalized as they are needed
+type fieldTexts struct {
+	fields     map[string]string
+	normalized map[string]*normalizedText
+}
+
+func newFieldTexts(fields map[string]string) *fieldTexts {
+	return &fieldTexts{fields: fields, normalized: make(map[string]*normalizedText)}
+}
+
+// get returns the field a keyword matches with its normalized text. Keywords
+// that target no field, or a field the video does not have, match the title
+// as they do in Match.
+func (t *fieldTexts) get(kw *domain.Keyword) (string, *normalizedText) {
+	field := strings.ToLower(kw.TargetField)
+	if _, ok := t.fields[field]; !ok {
+		field = FieldTitle
+	}
+	text, ok := t.normalized[field]
+	if !ok {
+		text = normalize(t.fields[field], true)
+		t.normalized[field] = text
+	}
+	return field, text
+}
+
+// match returns the match of the first keyword of the set that matches
+func (s *compiledFilterSet) match(text *normalizedText) (FilterMatch, bool) {
+	var spans []acSpan
+	if s.literals != nil {
+		spans = s.literals.match(text.text, len(s.keywords))
+	}
+
+	for i, kw := range s.keywords {
+		var start, end int
+		if re := s.regexps[i]; re != nil {
+			loc := re.FindStringIndex(text.text)
+			if loc == nil {
+				continue
+			}
+			start, end = loc[0], loc[1]
+		} else {
+			if spans[i].start < 0 {
+				continue
+			}
+			start, end = spans[i].start, spans[i].end
+		}
+
+		m := FilterMatch{Keyword: kw}
+		m.Start, m.End = text.span(start, end)
+		return m, true
+	}
+	return FilterMatch{}, false
+}
+
+// matchAll returns the match of every keyword of the set that matches its field
+func (s *compiledFilterSet) matchAll(texts *fieldTexts) []KeywordMatch {
+	// Literals are matched in one pass over each field, when first needed
+	spans := make(map[string][]acSpan)
+	var matches []KeywordMatch
+	for i, kw := range s.keywords {
+		field, text := texts.get(kw)
+		var start, end int
+		if re := s.regexps[i]; re != nil {
+			loc := re.FindStringIndex(text.text)
+			if loc == nil {
+				continue
+			}
+			start, end = loc[0], loc[1]
+		} else {
+			fieldSpans, ok := spans[field]
+			if !ok {
+				fieldSpans = s.literals.match(text.text, len(s.keywords))
+				spans[field] = fieldSpans
+			}
+			if fieldSpans[i].start < 0 {
+				continue
+			}
+			start, end = fieldSpans[i].start, fieldSpans[i].end
+		}
+
+		m := KeywordMatch{Keyword: kw, Field: field}
+		m.Start, m.End = text.span(start, end)
+		matches = append(matches, m)
+	}
+	return matches
+}
+
+// literalAlternatives returns the literals of a case-insensitive pattern that
+// only lists alternatives, such as (?i)(go|golang|next\.js). The literals are
+// lowercased to be matched against lowercased text.
+func literalAlternatives(pattern string) ([]string, bool) {
+	body, ok := strings.CutPrefix(pattern, "(?i)")
+	if !ok {
+		return nil, false
+	}
+	if strings.HasPrefix(body, "(") && strings.HasSuffix(body, ")") && !strings.HasSuffix(body, `\)`) {
+		body = body[1 : len(body)-1]
+	}
+
+	var alternatives []string
+	var b strings.Builder
+	for i := 0; i < len(body); i++ {
+		c := body[i]
+		switch {
+		case c == '\\':
+			// Only escaped metacharacters are literal; \d and the like are not
+			if i+1 == len(body) || !strings.ContainsRune(regexMetacharacters, rune(body[i+1])) {
+				return nil, false
+			}
+			i++
+			b.WriteByte(body[i])
+		case c == '|':
+			alternatives = append(alternatives, b.String())
+			b.Reset()
+		case strings.ContainsRune(regexMetacharacters, rune(c)):
+			return nil, false
+		default:
+			b.WriteByte(c)
+		}
+	}
+	alternatives = append(alternatives, b.String())
+
+	for i, a := range alternatives {
+		// An empty alternative matches everywhere; leave it to the regexp
+		if a == "" {
+			return nil, false
+		}
+		alternatives[i] = strings.ToLower(a)
+	}
+	return alternatives, true
+}
+
+// regexMetacharacters are the characters escapeRegex escapes
+const regexMetacharacters = `\.*+?^$()[]{}|`
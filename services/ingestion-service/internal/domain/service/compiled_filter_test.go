@@ -0,0 +1,395 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+)
+
+func TestCompiledFilter_Match(t *testing.T) {
+	include := &domain.Keyword{Name: "Go", FilterType: valueobject.FilterTypeInclude, Pattern: "(?i)(go|golang)", Enabled: true}
+	japanese := &domain.Keyword{Name: "プログラミング", FilterType: valueobject.FilterTypeInclude, Pattern: "(?i)(プログラミング)", Enabled: true}
+	exclude := &domain.Keyword{Name: "Pokemon", FilterType: valueobject.FilterTypeExclude, Pattern: "(?i)(pokemon go)", Enabled: true}
+	disabled := &domain.Keyword{Name: "Rust", FilterType: valueobject.FilterTypeInclude, Pattern: "(?i)(rust)", Enabled: false}
+	server := &domain.Keyword{Name: "サーバー", FilterType: valueobject.FilterTypeInclude, Pattern: NewKeywordPatternGenerator().GeneratePattern([]string{"サーバー"}), Enabled: true}
+
+	tests := []struct {
+		name      string
+		title     string
+		keywords  []*domain.Keyword
+		want      FilterResult
+		wantKw    *domain.Keyword
+		wantStart int
+		wantEnd   int
+	}{
+		{
+			name:      "Include match",
+			title:     "Learn Golang in 10 minutes",
+			keywords:  []*domain.Keyword{include},
+			want:      FilterResultInclude,
+			wantKw:    include,
+			wantStart: 6,
+			wantEnd:   8,
+		},
+		{
+			name:      "Exclude overrides include",
+			title:     "Pokemon GO tips",
+			keywords:  []*domain.Keyword{include, exclude},
+			want:      FilterResultExclude,
+			wantKw:    exclude,
+			wantStart: 0,
+			wantEnd:   10,
+		},
+		{
+			name:      "Offsets count characters, not bytes",
+			title:     "【初心者】プログラミング入門",
+			keywords:  []*domain.Keyword{japanese},
+			want:      FilterResultInclude,
+			wantKw:    japanese,
+			wantStart: 5,
+			wantEnd:   12,
+		},
+		{
+			name:     "Disabled keyword is ignored",
+			title:    "Rust for beginners",
+			keywords: []*domain.Keyword{disabled},
+			want:     FilterResultNeutral,
+		},
+		{
+			name:     "No match",
+			title:    "Cooking pasta",
+			keywords: []*domain.Keyword{include, exclude},
+			want:     FilterResultNeutral,
+		},
+		{
+			name:      "Full-width title is normalized",
+			title:     "ＧＯ言語入門",
+			keywords:  []*domain.Keyword{include},
+			want:      FilterResultInclude,
+			wantKw:    include,
+			wantStart: 0,
+			wantEnd:   2,
+		},
+		{
+			name:      "Half-width katakana offsets are those of the title",
+			title:     "【初心者】ﾌﾟﾛｸﾞﾗﾐﾝｸﾞ入門",
+			keywords:  []*domain.Keyword{japanese},
+			want:      FilterResultInclude,
+			wantKw:    japanese,
+			wantStart: 5,
+			wantEnd:   15,
+		},
+		{
+			name:      "Title without the long vowel mark",
+			title:     "【AWS】サーバ構築",
+			keywords:  []*domain.Keyword{server},
+			want:      FilterResultInclude,
+			wantKw:    server,
+			wantStart: 5,
+			wantEnd:   8,
+		},
+		{
+			name:      "Dash after katakana is the long vowel mark",
+			title:     "サーバ―構築",
+			keywords:  []*domain.Keyword{server},
+			want:      FilterResultInclude,
+			wantKw:    server,
+			wantStart: 0,
+			wantEnd:   4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compiled, err := CompileFilter(tt.keywords)
+			if err != nil {
+				t.Fatalf("CompileFilter() error = %v", err)
+			}
+			got := compiled.Match(tt.title)
+			if got.Result != tt.want {
+				t.Errorf("Result = %v, want %v", got.Result, tt.want)
+			}
+			if got.Keyword != tt.wantKw {
+				t.Errorf("Keyword = %v, want %v", got.Keyword, tt.wantKw)
+			}
+			if got.Start != tt.wantStart || got.End != tt.wantEnd {
+				t.Errorf("span = [%d, %d), want [%d, %d)", got.Start, got.End, tt.wantStart, tt.wantEnd)
+			}
+			if r := compiled.Filter(tt.title); r != got.Result {
+				t.Errorf("Filter() = %v, want %v", r, got.Result)
+			}
+		})
+	}
+}
+
+func TestCompiledFilter_MatchLiterals(t *testing.T) {
+	generator := NewKeywordPatternGenerator()
+	keywords := []*domain.Keyword{
+		{Name: "Go", FilterType: valueobject.FilterTypeInclude, Pattern: generator.GeneratePattern([]string{"Go", "Golang"}), Enabled: true},
+		{Name: "Frontend", FilterType: valueobject.FilterTypeInclude, Pattern: generator.GeneratePattern([]string{"React", "Next.js", "フロントエンド"}), Enabled: true},
+		{Name: "Server", FilterType: valueobject.FilterTypeInclude, Pattern: generator.GeneratePattern([]string{"サーバー"}), Enabled: true},
+		{Name: "Version", FilterType: valueobject.FilterTypeInclude, Pattern: `(?i)go\s*1\.\d+`, Enabled: true},
+		{Name: "Games", FilterType: valueobject.FilterTypeExclude, Pattern: generator.GeneratePattern([]string{"Pokemon GO", "ゲーム実況"}), Enabled: true},
+		{Name: "Disabled", FilterType: valueobject.FilterTypeExclude, Pattern: "(?i)(react)", Enabled: false},
+	}
+	compiled, err := CompileFilter(keywords)
+	if err != nil {
+		t.Fatalf("CompileFilter() error = %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		title     string
+		want      FilterResult
+		wantKw    string // Name of the deciding keyword
+		wantStart int
+		wantEnd   int
+	}{
+		{name: "Leftmost alternative of the first keyword", title: "Golang and React", want: FilterResultInclude, wantKw: "Go", wantStart: 0, wantEnd: 2},
+		{name: "Earlier keyword wins over an earlier position", title: "React with Go", want: FilterResultInclude, wantKw: "Go", wantStart: 11, wantEnd: 13},
+		{name: "Shorter alternative at the same position", title: "golang tips", want: FilterResultInclude, wantKw: "Go", wantStart: 0, wantEnd: 2},
+		{name: "Escaped literal", title: "Next.js 15 released", want: FilterResultInclude, wantKw: "Frontend", wantStart: 0, wantEnd: 7},
+		{name: "Literal without the dot", title: "nextjs app router", want: FilterResultInclude, wantKw: "Frontend", wantStart: 0, wantEnd: 6},
+		{name: "Regular expression keyword", title: "What's new in GO 1.23", want: FilterResultInclude, wantKw: "Go", wantStart: 14, wantEnd: 16},
+		{name: "Exclusion overrides inclusion", title: "Pokemon GO with Golang", want: FilterResultExclude, wantKw: "Games", wantStart: 0, wantEnd: 10},
+		{name: "Full-width title", title: "ＲＥＡＣＴ入門", want: FilterResultInclude, wantKw: "Frontend", wantStart: 0, wantEnd: 5},
+		{name: "Half-width katakana", title: "【初心者】ﾌﾛﾝﾄｴﾝﾄﾞ入門", want: FilterResultInclude, wantKw: "Frontend", wantStart: 5, wantEnd: 13},
+		{name: "Long vowel variant", title: "サーバ構築ゲーム実況", want: FilterResultExclude, wantKw: "Games", wantStart: 5, wantEnd: 10},
+		{name: "Disabled keyword", title: "react", want: FilterResultInclude, wantKw: "Frontend", wantStart: 0, wantEnd: 5},
+		{name: "No match", title: "Cooking pasta", want: FilterResultNeutral},
+		{name: "Empty title", title: "", want: FilterResultNeutral},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := compiled.Match(tt.title)
+			name := ""
+			if want.Keyword != nil {
+				name = want.Keyword.Name
+			}
+			if want.Result != tt.want || name != tt.wantKw || want.Start != tt.wantStart || want.End != tt.wantEnd {
+				t.Errorf("Match(%q) = %v %q [%d, %d), want %v %q [%d, %d)",
+					tt.title, want.Result, name, want.Start, want.End, tt.want, tt.wantKw, tt.wantStart, tt.wantEnd)
+			}
+			if r := compiled.Filter(tt.title); r != want.Result {
+				t.Errorf("Filter(%q) = %v, want %v", tt.title, r, want.Result)
+			}
+
+			// The decisive match of an explanation is the one Match returns
+			explanation := compiled.Explain(map[string]string{FieldTitle: tt.title})
+			if explanation.Result != want.Result {
+				t.Errorf("Explain(%q).Result = %v, want %v", tt.title, explanation.Result, want.Result)
+			}
+			for _, m := range explanation.Matches {
+				if m.Decisive && (m.Keyword != want.Keyword || m.Start != want.Start || m.End != want.End) {
+					t.Errorf("Explain(%q) decisive match = %+v, want %+v", tt.title, m, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCompiledFilter_Explain(t *testing.T) {
+	golang := &domain.Keyword{Name: "Go", FilterType: valueobject.FilterTypeInclude, Pattern: "(?i)(go|golang)", Enabled: true}
+	tips := &domain.Keyword{Name: "Tips", FilterType: valueobject.FilterTypeInclude, Pattern: "(?i)(tips)", Enabled: true}
+	pokemon := &domain.Keyword{Name: "Pokemon", FilterType: valueobject.FilterTypeExclude, Pattern: "(?i)(pokemon go)", Enabled: true}
+	games := &domain.Keyword{Name: "Games", FilterType: valueobject.FilterTypeExclude, Pattern: "(?i)(ゲーム)", Enabled: true}
+	described := &domain.Keyword{Name: "Tutorial", FilterType: valueobject.FilterTypeInclude, Pattern: "(?i)(tutorial)", TargetField: "DESCRIPTION", Enabled: true}
+
+	tests := []struct {
+		name     string
+		fields   map[string]string
+		keywords []*domain.Keyword
+		want     FilterExplanation
+	}{
+		{
+			name:     "Every inclusion is listed, the first decides",
+			fields:   map[string]string{FieldTitle: "Golang tips"},
+			keywords: []*domain.Keyword{golang, tips},
+			want: FilterExplanation{Result: FilterResultInclude, Matches: []KeywordMatch{
+				{Keyword: golang, Field: FieldTitle, Start: 0, End: 2, Decisive: true},
+				{Keyword: tips, Field: FieldTitle, Start: 7, End: 11},
+			}},
+		},
+		{
+			name:     "Exclusions override inclusions",
+			fields:   map[string]string{FieldTitle: "Pokemon GO ゲーム tips"},
+			keywords: []*domain.Keyword{golang, tips, pokemon, games},
+			want: FilterExplanation{Result: FilterResultExclude, Matches: []KeywordMatch{
+				{Keyword: pokemon, Field: FieldTitle, Start: 0, End: 10, Decisive: true},
+				{Keyword: games, Field: FieldTitle, Start: 11, End: 14},
+				{Keyword: golang, Field: FieldTitle, Start: 8, End: 10, Overridden: true},
+				{Keyword: tips, Field: FieldTitle, Start: 15, End: 19, Overridden: true},
+			}},
+		},
+		{
+			name:     "Keyword matches the field it targets",
+			fields:   map[string]string{FieldTitle: "Tutorial", "description": "A Go tutorial"},
+			keywords: []*domain.Keyword{described},
+			want: FilterExplanation{Result: FilterResultInclude, Matches: []KeywordMatch{
+				{Keyword: described, Field: "description", Start: 5, End: 13, Decisive: true},
+			}},
+		},
+		{
+			name:     "Keyword targeting a missing field matches the title",
+			fields:   map[string]string{FieldTitle: "Tutorial"},
+			keywords: []*domain.Keyword{described},
+			want: FilterExplanation{Result: FilterResultInclude, Matches: []KeywordMatch{
+				{Keyword: described, Field: FieldTitle, Start: 0, End: 8, Decisive: true},
+			}},
+		},
+		{
+			name:     "No match",
+			fields:   map[string]string{FieldTitle: "Cooking pasta"},
+			keywords: []*domain.Keyword{golang, pokemon},
+			want:     FilterExplanation{Result: FilterResultNeutral},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compiled, err := CompileFilter(tt.keywords)
+			if err != nil {
+				t.Fatalf("CompileFilter() error = %v", err)
+			}
+			got := compiled.Explain(tt.fields)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Explain() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompileFilter_InvalidPattern(t *testing.T) {
+	keywords := []*domain.Keyword{
+		{Name: "Broken", FilterType: valueobject.FilterTypeInclude, Pattern: "(?i)(go", Enabled: true},
+	}
+	if _, err := CompileFilter(keywords); !errors.Is(err, domain.ErrInvalidPattern) {
+		t.Errorf("CompileFilter() error = %v, want %v", err, domain.ErrInvalidPattern)
+	}
+
+	// Disabled keywords are not compiled
+	keywords[0].Enabled = false
+	if _, err := CompileFilter(keywords); err != nil {
+		t.Errorf("CompileFilter() error = %v, want nil", err)
+	}
+}
+
+func TestCompiledFilter_Admits(t *testing.T) {
+	generator := NewKeywordPatternGenerator()
+	include := &domain.Keyword{Name: "Go", FilterType: valueobject.FilterTypeInclude, Pattern: generator.GeneratePattern([]string{"Go", "Golang"}), Enabled: true}
+	exclude := &domain.Keyword{Name: "Games", FilterType: valueobject.FilterTypeExclude, Pattern: generator.GeneratePattern([]string{"ゲーム実況"}), Enabled: true}
+
+	tests := []struct {
+		name     string
+		keywords []*domain.Keyword
+		title    string
+		want     bool
+	}{
+		{name: "Included title", keywords: []*domain.Keyword{include, exclude}, title: "Golang tips", want: true},
+		{name: "Excluded title", keywords: []*domain.Keyword{include, exclude}, title: "Golang ゲーム実況", want: false},
+		{name: "Unmatched title with include keywords", keywords: []*domain.Keyword{include, exclude}, title: "Cooking pasta", want: false},
+		{name: "Unmatched title with only exclude keywords", keywords: []*domain.Keyword{exclude}, title: "Cooking pasta", want: true},
+		{name: "No keywords", title: "Cooking pasta", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compiled, err := CompileFilter(tt.keywords)
+			if err != nil {
+				t.Fatalf("CompileFilter() error = %v", err)
+			}
+			if got := compiled.Admits(tt.title); got != tt.want {
+				t.Errorf("Admits(%q) = %v, want %v", tt.title, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLiteralAlternatives(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    []string
+		wantOK  bool
+	}{
+		{pattern: "(?i)(go|golang)", want: []string{"go", "golang"}, wantOK: true},
+		{pattern: `(?i)(Next\.js|Nextjs|C\+\+)`, want: []string{"next.js", "nextjs", "c++"}, wantOK: true},
+		{pattern: "(?i)(プログラミング|ぷろぐらみんぐ)", want: []string{"プログラミング", "ぷろぐらみんぐ"}, wantOK: true},
+		{pattern: "(?i)react", want: []string{"react"}, wantOK: true},
+		{pattern: "(go|golang)", wantOK: false},
+		{pattern: `(?i)(go\s+1\.\d+)`, wantOK: false},
+		{pattern: "(?i)(go|)", wantOK: false},
+		{pattern: "(?i)(a)|(b)", wantOK: false},
+		{pattern: "(?i)(colou?r)", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			got, ok := literalAlternatives(tt.pattern)
+			if ok != tt.wantOK {
+				t.Fatalf("literalAlternatives(%q) ok = %v, want %v", tt.pattern, ok, tt.wantOK)
+			}
+			if ok && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("literalAlternatives(%q) = %q, want %q", tt.pattern, got, tt.want)
+			}
+		})
+	}
+}
+
+// benchmarkFilter returns hundreds of keyword groups of a genre and thousands
+// of titles, about one in ten of which matches
+func benchmarkFilter() ([]*domain.Keyword, []string) {
+	generator := NewKeywordPatternGenerator()
+	keywords := make([]*domain.Keyword, 0, 300)
+	for i := 0; i < 300; i++ {
+		filterType := valueobject.FilterTypeInclude
+		if i%10 == 0 {
+			filterType = valueobject.FilterTypeExclude
+		}
+		words := []string{fmt.Sprintf("topic%d", i), fmt.Sprintf("Topic %d guide", i), fmt.Sprintf("トピック%d", i), fmt.Sprintf("framework-%d", i)}
+		keywords = append(keywords, &domain.Keyword{
+			Name:       fmt.Sprintf("group %d", i),
+			FilterType: filterType,
+			Pattern:    generator.GeneratePattern(words),
+			Enabled:    true,
+		})
+	}
+
+	titles := make([]string, 5000)
+	for i := range titles {
+		if i%10 == 0 {
+			titles[i] = fmt.Sprintf("【入門】Learn topic%d in 10 minutes | 初心者向け解説 #%d", i%400, i)
+		} else {
+			titles[i] = fmt.Sprintf("今日のVlog: morning routine and coffee ☕ part %d (ｻｰﾊﾞｰ編)", i)
+		}
+	}
+	return keywords, titles
+}
+
+func BenchmarkCompiledFilter_Match(b *testing.B) {
+	keywords, titles := benchmarkFilter()
+	compiled, err := CompileFilter(keywords)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		compiled.Match(titles[i%len(titles)])
+	}
+}
+
+func BenchmarkCompileFilter(b *testing.B) {
+	keywords, _ := benchmarkFilter()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := CompileFilter(keywords); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+)
+
+// Fields reported by GenreManifestDiffer
+const (
+	GenreFieldName              = "name"
+	GenreFieldCategoryIDs       = "category_ids"
+	GenreFieldCheckpointProfile = "checkpoint_profile"
+	GenreFieldEnabled           = "enabled"
+
+	KeywordGroupFieldTargetField   = "target_field"
+	KeywordGroupFieldDescription   = "description"
+	KeywordGroupFieldCrossLanguage = "cross_language"
+	KeywordGroupFieldRomaji        = "romaji"
+	KeywordGroupFieldEnabled       = "enabled"
+)
+
+// DeclaredGenre is the state a genre manifest declares for an existing genre
+type DeclaredGenre struct {
+	Name        string
+	CategoryIDs []valueobject.CategoryID
+	// CheckpointProfileID is empty for the default profile
+	CheckpointProfileID valueobject.UUID
+	Enabled             bool
+}
+
+// DeclaredKeywordGroup is the state a genre manifest declares for an existing keyword group
+type DeclaredKeywordGroup struct {
+	TargetField   string
+	Description   string
+	CrossLanguage bool
+	Romaji        bool
+	Enabled       bool
+}
+
+// GenreManifestDiffer is a domain service comparing genres and keyword groups
+// with the state a genre manifest declares for them. Values that are saved the
+// same way compare equal, so once the reported differences are applied a
+// second comparison reports none.
+type GenreManifestDiffer interface {
+	// DiffGenre returns the fields of the genre that differ from the declared state
+	DiffGenre(genre *domain.Genre, declared DeclaredGenre) []string
+	// DiffKeywordGroup returns the settings of the group that differ from the
+	// declared state. Keywords are compared by DiffKeywords.
+	DiffKeywordGroup(group *domain.KeywordGroup, declared DeclaredKeywordGroup) []string
+	// DiffKeywords returns the keywords to add to and remove from current so it
+	// matches declared, each in sorted order
+	DiffKeywords(current, declared []string) (added, removed []string)
+}
+
+type genreManifestDiffer struct{}
+
+// NewGenreManifestDiffer creates a new genre manifest differ
+func NewGenreManifestDiffer() GenreManifestDiffer {
+	return &genreManifestDiffer{}
+}
+
+// DiffGenre compares categories as a set, since their order has no meaning
+func (d *genreManifestDiffer) DiffGenre(genre *domain.Genre, declared DeclaredGenre) []string {
+	var fields []string
+	if genre.Name != declared.Name {
+		fields = append(fields, GenreFieldName)
+	}
+	if !slices.Equal(sortedCategoryIDs(genre.CategoryIDs), sortedCategoryIDs(declared.CategoryIDs)) {
+		fields = append(fields, GenreFieldCategoryIDs)
+	}
+	if genre.CheckpointProfileID != declared.CheckpointProfileID {
+		fields = append(fields, GenreFieldCheckpointProfile)
+	}
+	if genre.Enabled != declared.Enabled {
+		fields = append(fields, GenreFieldEnabled)
+	}
+	return fields
+}
+
+// DiffKeywordGroup treats a missing description as an empty one
+func (d *genreManifestDiffer) DiffKeywordGroup(group *domain.KeywordGroup, declared DeclaredKeywordGroup) []string {
+	var fields []string
+	if group.TargetField != declared.TargetField {
+		fields = append(fields, KeywordGroupFieldTargetField)
+	}
+	description := ""
+	if group.Description != nil {
+		description = *group.Description
+	}
+	if description != declared.Description {
+		fields = append(fields, KeywordGroupFieldDescription)
+	}
+	if group.CrossLanguage != declared.CrossLanguage {
+		fields = append(fields, KeywordGroupFieldCrossLanguage)
+	}
+	if group.Romaji != declared.Romaji {
+		fields = append(fields, KeywordGroupFieldRomaji)
+	}
+	if group.Enabled != declared.Enabled {
+		fields = append(fields, KeywordGroupFieldEnabled)
+	}
+	return fields
+}
+
+// DiffKeywords trims declared keywords and skips empty and repeated ones, as
+// saving them does
+func (d *genreManifestDiffer) DiffKeywords(current, declared []string) (added, removed []string) {
+	have := make(map[string]bool, len(current))
+	for _, keyword := range current {
+		have[keyword] = true
+	}
+	want := make(map[string]bool, len(declared))
+	for _, keyword := range declared {
+		keyword = strings.TrimSpace(keyword)
+		if keyword == "" || want[keyword] {
+			continue
+		}
+		want[keyword] = true
+		if !have[keyword] {
+			added = append(added, keyword)
+		}
+	}
+	for keyword := range have {
+		if !want[keyword] {
+			removed = append(removed, keyword)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
+
+// sortedCategoryIDs returns a sorted copy of ids without duplicates
+func sortedCategoryIDs(ids []valueobject.CategoryID) []valueobject.CategoryID {
+	sorted := slices.Clone(ids)
+	slices.Sort(sorted)
+	return slices.Compact(sorted)
+}
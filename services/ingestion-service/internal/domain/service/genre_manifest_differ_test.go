@@ -0,0 +1,165 @@
+package service
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+)
+
+func TestGenreManifestDiffer_DiffGenre(t *testing.T) {
+	differ := NewGenreManifestDiffer()
+	profile, err := domain.NewCheckpointProfile("profile-1", "long_tail", "Long tail", []valueobject.CheckpointHour{24, 168, 720})
+	if err != nil {
+		t.Fatalf("NewCheckpointProfile() unexpected error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		declared DeclaredGenre
+		want     []string
+	}{
+		{
+			name:     "unchanged genre",
+			declared: DeclaredGenre{Name: "Engineering (JP)", CategoryIDs: []valueobject.CategoryID{27, 28}, Enabled: true},
+		},
+		{
+			name:     "categories in another order",
+			declared: DeclaredGenre{Name: "Engineering (JP)", CategoryIDs: []valueobject.CategoryID{28, 27}, Enabled: true},
+		},
+		{
+			name:     "changed categories",
+			declared: DeclaredGenre{Name: "Engineering (JP)", CategoryIDs: []valueobject.CategoryID{28}, Enabled: true},
+			want:     []string{GenreFieldCategoryIDs},
+		},
+		{
+			name: "every field changed",
+			declared: DeclaredGenre{
+				Name:                "Engineering",
+				CategoryIDs:         []valueobject.CategoryID{27, 28, 20},
+				CheckpointProfileID: profile.ID,
+			},
+			want: []string{GenreFieldName, GenreFieldCategoryIDs, GenreFieldCheckpointProfile, GenreFieldEnabled},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			genre, err := domain.NewGenre("genre-1", "engineering_jp", "Engineering (JP)", "ja", "JP", []valueobject.CategoryID{27, 28})
+			if err != nil {
+				t.Fatalf("NewGenre() unexpected error = %v", err)
+			}
+
+			got := differ.DiffGenre(genre, tt.declared)
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("DiffGenre() = %v, want %v", got, tt.want)
+			}
+
+			// Applying the differences leaves nothing to change
+			if slices.Contains(got, GenreFieldName) || slices.Contains(got, GenreFieldCategoryIDs) {
+				if err := genre.Update(tt.declared.Name, tt.declared.CategoryIDs); err != nil {
+					t.Fatalf("Update() unexpected error = %v", err)
+				}
+			}
+			if slices.Contains(got, GenreFieldCheckpointProfile) {
+				genre.SetCheckpointProfile(profile)
+			}
+			if slices.Contains(got, GenreFieldEnabled) {
+				genre.Disable()
+			}
+			if again := differ.DiffGenre(genre, tt.declared); len(again) > 0 {
+				t.Errorf("DiffGenre() after applying = %v, want none", again)
+			}
+		})
+	}
+}
+
+func TestGenreManifestDiffer_DiffKeywordGroup(t *testing.T) {
+	differ := NewGenreManifestDiffer()
+	description := "Programming languages"
+
+	tests := []struct {
+		name        string
+		declared    DeclaredKeywordGroup
+		keywords    []string
+		want        []string
+		wantAdded   []string
+		wantRemoved []string
+	}{
+		{
+			name:     "unchanged group",
+			declared: DeclaredKeywordGroup{TargetField: "title", Description: description, Enabled: true},
+			keywords: []string{"Go", "Rust"},
+		},
+		{
+			name:     "keywords in another order with surrounding spaces",
+			declared: DeclaredKeywordGroup{TargetField: "title", Description: description, Enabled: true},
+			keywords: []string{" Rust", "Go ", ""},
+		},
+		{
+			name:        "changed keywords",
+			declared:    DeclaredKeywordGroup{TargetField: "title", Description: description, Enabled: true},
+			keywords:    []string{"Go", "Zig", "Python"},
+			wantAdded:   []string{"Python", "Zig"},
+			wantRemoved: []string{"Rust"},
+		},
+		{
+			name:     "every setting changed",
+			declared: DeclaredKeywordGroup{TargetField: "description", CrossLanguage: true, Romaji: true},
+			keywords: []string{"Go", "Rust"},
+			want: []string{
+				KeywordGroupFieldTargetField,
+				KeywordGroupFieldDescription,
+				KeywordGroupFieldCrossLanguage,
+				KeywordGroupFieldRomaji,
+				KeywordGroupFieldEnabled,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			group, err := domain.NewKeywordGroup("group-1", "genre-1", "languages", valueobject.FilterTypeInclude, "title", &description, []string{"Go", "Rust"})
+			if err != nil {
+				t.Fatalf("NewKeywordGroup() unexpected error = %v", err)
+			}
+
+			got := differ.DiffKeywordGroup(group, tt.declared)
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("DiffKeywordGroup() = %v, want %v", got, tt.want)
+			}
+			added, removed := differ.DiffKeywords(group.GetKeywords(), tt.keywords)
+			if !slices.Equal(added, tt.wantAdded) || !slices.Equal(removed, tt.wantRemoved) {
+				t.Fatalf("DiffKeywords() = +%v -%v, want +%v -%v", added, removed, tt.wantAdded, tt.wantRemoved)
+			}
+
+			// Applying the differences leaves nothing to change
+			if slices.Contains(got, KeywordGroupFieldTargetField) || slices.Contains(got, KeywordGroupFieldDescription) {
+				if err := group.Update(nil, nil, &tt.declared.TargetField, &tt.declared.Description); err != nil {
+					t.Fatalf("Update() unexpected error = %v", err)
+				}
+			}
+			if slices.Contains(got, KeywordGroupFieldCrossLanguage) {
+				group.SetCrossLanguage(tt.declared.CrossLanguage)
+			}
+			if slices.Contains(got, KeywordGroupFieldRomaji) {
+				group.SetRomaji(tt.declared.Romaji)
+			}
+			if slices.Contains(got, KeywordGroupFieldEnabled) {
+				group.Disable()
+			}
+			if len(added)+len(removed) > 0 {
+				if err := group.UpdateKeywords(tt.keywords); err != nil {
+					t.Fatalf("UpdateKeywords() unexpected error = %v", err)
+				}
+			}
+			if again := differ.DiffKeywordGroup(group, tt.declared); len(again) > 0 {
+				t.Errorf("DiffKeywordGroup() after applying = %v, want none", again)
+			}
+			if added, removed := differ.DiffKeywords(group.GetKeywords(), tt.keywords); len(added)+len(removed) > 0 {
+				t.Errorf("DiffKeywords() after applying = +%v -%v, want none", added, removed)
+			}
+		})
+	}
+}
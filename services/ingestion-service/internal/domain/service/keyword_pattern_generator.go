@@ -3,36 +3,99 @@ package service
 import (
 	"fmt"
 	"strings"
+	"sync"
 	"unicode"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
 )
 
-// KeywordPatternGenerator generates regex patterns from keywords
-type KeywordPatternGenerator struct{}
+// maxCachedPatterns bounds the patterns a generator keeps; the cache is
+// cleared when it is full
+const maxCachedPatterns = 4096
+
+// KeywordPatternGenerator generates regex patterns from keywords. Aliases come
+// from its synonym dictionary, and generated patterns are cached for the
+// lifetime of the generator, so a generator is built per dictionary version.
+type KeywordPatternGenerator struct {
+	dictionary *SynonymDictionary
+
+	mu    sync.RWMutex
+	cache map[string]string
+}
+
+// PatternOptions are the options of a keyword group that change its pattern
+type PatternOptions struct {
+	// CrossLanguage adds aliases in other languages, such as パイソン for Python
+	CrossLanguage bool
+	// Romaji adds the romaji spellings of kana keywords, such as ramen for ラーメン
+	Romaji bool
+}
 
-// NewKeywordPatternGenerator creates a new pattern generator
+// NewKeywordPatternGenerator creates a new pattern generator with the built-in synonyms
 func NewKeywordPatternGenerator() *KeywordPatternGenerator {
-	return &KeywordPatternGenerator{}
+	return NewKeywordPatternGeneratorWithDictionary(DefaultSynonymDictionary())
+}
+
+// NewKeywordPatternGeneratorWithDictionary creates a new pattern generator with a synonym dictionary
+func NewKeywordPatternGeneratorWithDictionary(dictionary *SynonymDictionary) *KeywordPatternGenerator {
+	return &KeywordPatternGenerator{
+		dictionary: dictionary,
+		cache:      make(map[string]string),
+	}
+}
+
+// DictionaryVersion returns the version of the synonym dictionary the generator uses
+func (g *KeywordPatternGenerator) DictionaryVersion() int64 {
+	return g.dictionary.Version()
 }
 
 // GeneratePattern generates a regex pattern from keywords
 // It creates variations within the same language only
 func (g *KeywordPatternGenerator) GeneratePattern(keywords []string) string {
+	return g.GeneratePatternWithOptions(keywords, PatternOptions{})
+}
+
+// GeneratePatternWithOptions generates a regex pattern from keywords with the
+// options of their keyword group
+func (g *KeywordPatternGenerator) GeneratePatternWithOptions(keywords []string, opts PatternOptions) string {
 	if len(keywords) == 0 {
 		return ""
 	}
 
+	key := fmt.Sprintf("%t\x00%t\x00%s", opts.CrossLanguage, opts.Romaji, strings.Join(keywords, "\x00"))
+	g.mu.RLock()
+	pattern, ok := g.cache[key]
+	g.mu.RUnlock()
+	if ok {
+		return pattern
+	}
+
+	pattern = g.generate(keywords, opts)
+
+	g.mu.Lock()
+	if len(g.cache) >= maxCachedPatterns {
+		g.cache = make(map[string]string)
+	}
+	g.cache[key] = pattern
+	g.mu.Unlock()
+	return pattern
+}
+
+// generate builds the pattern of the keywords without the cache. Keywords are
+// normalized like the titles they are matched against.
+func (g *KeywordPatternGenerator) generate(keywords []string, opts PatternOptions) string {
 	var allVariations []string
 	for _, keyword := range keywords {
-		keyword = strings.TrimSpace(keyword)
+		keyword = strings.TrimSpace(NormalizeText(keyword))
 		if keyword == "" {
 			continue
 		}
 
 		// Detect if keyword is Japanese or English
 		if isJapanese(keyword) {
-			allVariations = append(allVariations, g.generateJapaneseVariations(keyword)...)
+			allVariations = append(allVariations, g.generateJapaneseVariations(keyword, opts)...)
 		} else {
-			allVariations = append(allVariations, g.generateEnglishVariations(keyword)...)
+			allVariations = append(allVariations, g.generateEnglishVariations(keyword, opts)...)
 		}
 	}
 
@@ -48,7 +111,7 @@ func (g *KeywordPatternGenerator) GeneratePattern(keywords []string) string {
 }
 
 // generateEnglishVariations generates variations for English keywords
-func (g *KeywordPatternGenerator) generateEnglishVariations(keyword string) []string {
+func (g *KeywordPatternGenerator) generateEnglishVariations(keyword string, opts PatternOptions) []string {
 	// Start with the escaped version
 	variations := []string{escapeRegex(keyword)}
 
@@ -64,31 +127,31 @@ func (g *KeywordPatternGenerator) generateEnglishVariations(keyword string) []st
 		// Add variations with hyphens
 		hyphenated := strings.ReplaceAll(keyword, " ", "-")
 		variations = append(variations, escapeRegex(hyphenated))
-		
+
 		// Add variations without spaces
 		noSpaces := strings.ReplaceAll(keyword, " ", "")
 		variations = append(variations, escapeRegex(noSpaces))
 	}
-	
+
 	if strings.Contains(keyword, "-") {
 		// Add variations with spaces
 		spaced := strings.ReplaceAll(keyword, "-", " ")
 		variations = append(variations, escapeRegex(spaced))
-		
+
 		// Add variations without hyphens
 		noHyphens := strings.ReplaceAll(keyword, "-", "")
 		variations = append(variations, escapeRegex(noHyphens))
 	}
 
-	// Handle common abbreviations
-	variations = append(variations, g.handleCommonAbbreviations(keyword)...)
+	// Add synonyms and abbreviations from the dictionary
+	variations = append(variations, g.synonymVariations(domain.SynonymLanguageEnglish, keyword, opts)...)
 
 	// Remove duplicates
 	return uniqueStrings(variations)
 }
 
 // generateJapaneseVariations generates variations for Japanese keywords
-func (g *KeywordPatternGenerator) generateJapaneseVariations(keyword string) []string {
+func (g *KeywordPatternGenerator) generateJapaneseVariations(keyword string, opts PatternOptions) []string {
 	variations := []string{escapeRegex(keyword)}
 
 	// Add katakana variations for hiragana
@@ -107,69 +170,32 @@ func (g *KeywordPatternGenerator) generateJapaneseVariations(keyword string) []s
 		}
 	}
 
-	// Handle common Japanese abbreviations
-	variations = append(variations, g.handleJapaneseAbbreviations(keyword)...)
-
-	return uniqueStrings(variations)
-}
+	// Add variations without the long vowel marks ending words (サーバー, サーバ)
+	for _, v := range variations {
+		if shorter := longVowelVariation(v); shorter != v {
+			variations = append(variations, shorter)
+		}
+	}
 
-// handleCommonAbbreviations handles common English abbreviations
-func (g *KeywordPatternGenerator) handleCommonAbbreviations(keyword string) []string {
-	var variations []string
+	// Add synonyms and abbreviations from the dictionary
+	variations = append(variations, g.synonymVariations(domain.SynonymLanguageJapanese, keyword, opts)...)
 
-	// Common technology abbreviations
-	abbreviations := map[string][]string{
-		"javascript": {"js"},
-		"typescript": {"ts"},
-		"kubernetes": {"k8s"},
-		"internationalization": {"i18n"},
-		"localization": {"l10n"},
-		"continuous integration": {"ci"},
-		"continuous deployment": {"cd"},
-		"continuous delivery": {"cd"},
-	}
-
-	for full, abbrevs := range abbreviations {
-		if strings.EqualFold(keyword, full) {
-			for _, abbrev := range abbrevs {
-				variations = append(variations, escapeRegex(abbrev))
-			}
-		}
-		// Also check reverse (abbreviation to full form)
-		for _, abbrev := range abbrevs {
-			if strings.EqualFold(keyword, abbrev) {
-				variations = append(variations, escapeRegex(full))
-			}
+	// Add romaji spellings when the group opts in
+	if opts.Romaji {
+		for _, romaji := range romajiVariations(keyword) {
+			variations = append(variations, escapeRegex(romaji))
 		}
 	}
 
-	return variations
+	return uniqueStrings(variations)
 }
 
-// handleJapaneseAbbreviations handles common Japanese abbreviations
-func (g *KeywordPatternGenerator) handleJapaneseAbbreviations(keyword string) []string {
+// synonymVariations returns the escaped aliases of a keyword from the dictionary
+func (g *KeywordPatternGenerator) synonymVariations(language, keyword string, opts PatternOptions) []string {
 	var variations []string
-
-	// Common Japanese tech abbreviations
-	abbreviations := map[string][]string{
-		"プログラミング": {"プログラム"},
-		"アプリケーション": {"アプリ"},
-		"インフラストラクチャー": {"インフラ"},
-		"フロントエンド": {"フロント"},
-		"バックエンド": {"バック"},
+	for _, alias := range g.dictionary.Aliases(language, keyword, opts.CrossLanguage) {
+		variations = append(variations, escapeRegex(alias))
 	}
-
-	for full, abbrevs := range abbreviations {
-		if keyword == full {
-			variations = append(variations, abbrevs...)
-		}
-		for _, abbrev := range abbrevs {
-			if keyword == abbrev {
-				variations = append(variations, full)
-			}
-		}
-	}
-
 	return variations
 }
 
@@ -241,4 +267,4 @@ func uniqueStrings(slice []string) []string {
 		}
 	}
 	return result
-}
\ No newline at end of file
+}
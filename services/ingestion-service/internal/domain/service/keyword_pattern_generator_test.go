@@ -3,6 +3,8 @@ package service
 import (
 	"strings"
 	"testing"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
 )
 
 func TestKeywordPatternGenerator_GeneratePattern(t *testing.T) {
@@ -142,4 +144,184 @@ func TestKeywordPatternGenerator_RegexEscaping(t *testing.T) {
 			t.Errorf("Pattern should contain properly escaped %q as %q, got: %s", original, escaped, pattern)
 		}
 	}
-}
\ No newline at end of file
+}
+func TestKeywordPatternGenerator_SynonymDictionary(t *testing.T) {
+	dictionary := NewSynonymDictionary(1, []*domain.KeywordSynonym{
+		{Language: "en", Term: "golang", Alias: "go", AliasLanguage: "en", Bidirectional: true},
+		{Language: "en", Term: "react native", Alias: "rn", AliasLanguage: "en", Bidirectional: false},
+		{Language: "ja", Term: "パイソン", Alias: "Python", AliasLanguage: "en", Bidirectional: true},
+		{Language: "ja", Term: "ゴー言語", Alias: "Go", AliasLanguage: "en", Bidirectional: false},
+	})
+	generator := NewKeywordPatternGeneratorWithDictionary(dictionary)
+
+	tests := []struct {
+		name     string
+		keywords []string
+		opts     PatternOptions
+		want     []string
+		notWant  []string
+	}{
+		{
+			name:     "bidirectional entry from the term",
+			keywords: []string{"Golang"},
+			want:     []string{"Golang", "go"},
+		},
+		{
+			name:     "bidirectional entry from the alias",
+			keywords: []string{"Go"},
+			want:     []string{"Go", "golang"},
+		},
+		{
+			name:     "one-way entry from the term",
+			keywords: []string{"React Native"},
+			want:     []string{"React Native", "rn"},
+		},
+		{
+			name:     "one-way entry is not applied from the alias",
+			keywords: []string{"RN"},
+			want:     []string{"RN"},
+			notWant:  []string{"react native"},
+		},
+		{
+			name:     "cross-language entry needs the group to opt in",
+			keywords: []string{"Python"},
+			want:     []string{"Python"},
+			notWant:  []string{"パイソン"},
+		},
+		{
+			name:     "cross-language entry from the alias",
+			keywords: []string{"Python"},
+			opts:     PatternOptions{CrossLanguage: true},
+			want:     []string{"Python", "パイソン"},
+		},
+		{
+			name:     "cross-language entry from the term",
+			keywords: []string{"パイソン"},
+			opts:     PatternOptions{CrossLanguage: true},
+			want:     []string{"パイソン", "ぱいそん", "Python"},
+		},
+		{
+			name:     "cross-language one-way entry is not applied from the alias",
+			keywords: []string{"Go"},
+			opts:     PatternOptions{CrossLanguage: true},
+			want:     []string{"Go", "golang"},
+			notWant:  []string{"ゴー言語"},
+		},
+		{
+			name:     "built-in synonyms are not in a loaded dictionary",
+			keywords: []string{"Kubernetes"},
+			want:     []string{"Kubernetes"},
+			notWant:  []string{"k8s"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := generator.GeneratePatternWithOptions(tt.keywords, tt.opts)
+			alternatives := strings.Split(strings.TrimSuffix(strings.TrimPrefix(got, "(?i)("), ")"), "|")
+
+			for _, want := range tt.want {
+				if !containsString(alternatives, want) {
+					t.Errorf("Pattern should contain %q, got: %s", want, got)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if containsString(alternatives, notWant) {
+					t.Errorf("Pattern should NOT contain %q, got: %s", notWant, got)
+				}
+			}
+		})
+	}
+}
+
+func TestKeywordPatternGenerator_Normalization(t *testing.T) {
+	generator := NewKeywordPatternGenerator()
+
+	tests := []struct {
+		name     string
+		keywords []string
+		opts     PatternOptions
+		want     []string
+		notWant  []string
+	}{
+		{
+			name:     "full-width keyword",
+			keywords: []string{"ＲＥＡＣＴ"},
+			want:     []string{"REACT"},
+			notWant:  []string{"ＲＥＡＣＴ"},
+		},
+		{
+			name:     "half-width katakana keyword",
+			keywords: []string{"ｻｰﾊﾞｰ"},
+			want:     []string{"サーバー", "さーばー", "サーバ"},
+			notWant:  []string{"ｻｰﾊﾞｰ"},
+		},
+		{
+			name:     "long vowel mark ending a word",
+			keywords: []string{"コンピューター"},
+			want:     []string{"コンピューター", "コンピュータ"},
+		},
+		{
+			name:     "long vowel mark inside a word is kept",
+			keywords: []string{"ラーメン"},
+			want:     []string{"ラーメン", "らーめん"},
+			notWant:  []string{"ラメン", "ramen"},
+		},
+		{
+			name:     "romaji of a kana keyword",
+			keywords: []string{"ラーメン", "とうきょう"},
+			opts:     PatternOptions{Romaji: true},
+			want:     []string{"ラーメン", "ramen", "toukyou", "tokyo"},
+		},
+		{
+			name:     "no romaji for a keyword with kanji",
+			keywords: []string{"東京タワー"},
+			opts:     PatternOptions{Romaji: true},
+			want:     []string{"東京タワー", "東京タワ"},
+			notWant:  []string{"tawa"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := generator.GeneratePatternWithOptions(tt.keywords, tt.opts)
+			alternatives := strings.Split(strings.TrimSuffix(strings.TrimPrefix(got, "(?i)("), ")"), "|")
+
+			for _, want := range tt.want {
+				if !containsString(alternatives, want) {
+					t.Errorf("Pattern should contain %q, got: %s", want, got)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if containsString(alternatives, notWant) {
+					t.Errorf("Pattern should NOT contain %q, got: %s", notWant, got)
+				}
+			}
+		})
+	}
+}
+
+func TestKeywordPatternGenerator_Cache(t *testing.T) {
+	generator := NewKeywordPatternGenerator()
+
+	keywords := []string{"JavaScript"}
+	first := generator.GeneratePattern(keywords)
+	if got := generator.GeneratePattern(keywords); got != first {
+		t.Errorf("Cached pattern = %s, want %s", got, first)
+	}
+	if got := generator.GeneratePatternWithOptions(keywords, PatternOptions{CrossLanguage: true}); got != first {
+		t.Errorf("Pattern without cross-language synonyms = %s, want %s", got, first)
+	}
+	if got := generator.DictionaryVersion(); got != 0 {
+		t.Errorf("DictionaryVersion() = %d, want 0 for the built-in dictionary", got)
+	}
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"strings"
+	"unicode"
+)
+
+// katakanaRomaji maps katakana syllables to Hepburn romaji. Two-character
+// syllables such as キャ are looked up before single characters.
+var katakanaRomaji = buildKatakanaRomaji()
+
+func buildKatakanaRomaji() map[string]string {
+	m := map[string]string{
+		"ア": "a", "イ": "i", "ウ": "u", "エ": "e", "オ": "o",
+		"カ": "ka", "キ": "ki", "ク": "ku", "ケ": "ke", "コ": "ko",
+		"ガ": "ga", "ギ": "gi", "グ": "gu", "ゲ": "ge", "ゴ": "go",
+		"サ": "sa", "シ": "shi", "ス": "su", "セ": "se", "ソ": "so",
+		"ザ": "za", "ジ": "ji", "ズ": "zu", "ゼ": "ze", "ゾ": "zo",
+		"タ": "ta", "チ": "chi", "ツ": "tsu", "テ": "te", "ト": "to",
+		"ダ": "da", "ヂ": "ji", "ヅ": "zu", "デ": "de", "ド": "do",
+		"ナ": "na", "ニ": "ni", "ヌ": "nu", "ネ": "ne", "ノ": "no",
+		"ハ": "ha", "ヒ": "hi", "フ": "fu", "ヘ": "he", "ホ": "ho",
+		"バ": "ba", "ビ": "bi", "ブ": "bu", "ベ": "be", "ボ": "bo",
+		"パ": "pa", "ピ": "pi", "プ": "pu", "ペ": "pe", "ポ": "po",
+		"マ": "ma", "ミ": "mi", "ム": "mu", "メ": "me", "モ": "mo",
+		"ヤ": "ya", "ユ": "yu", "ヨ": "yo",
+		"ラ": "ra", "リ": "ri", "ル": "ru", "レ": "re", "ロ": "ro",
+		"ワ": "wa", "ヰ": "i", "ヱ": "e", "ヲ": "o", "ン": "n", "ヴ": "vu",
+		"ァ": "a", "ィ": "i", "ゥ": "u", "ェ": "e", "ォ": "o",
+		"ャ": "ya", "ュ": "yu", "ョ": "yo", "ヮ": "wa",
+
+		// Syllables of loanwords
+		"ファ": "fa", "フィ": "fi", "フェ": "fe", "フォ": "fo",
+		"ティ": "ti", "ディ": "di", "トゥ": "tu", "ドゥ": "du",
+		"テュ": "tyu", "デュ": "dyu", "ツァ": "tsa",
+		"ウィ": "wi", "ウェ": "we", "ウォ": "wo",
+		"ヴァ": "va", "ヴィ": "vi", "ヴェ": "ve", "ヴォ": "vo",
+	}
+
+	// Contracted syllables: キャ kya, シャ sha, チェ che, ...
+	for kana, prefix := range map[string]string{
+		"キ": "ky", "ギ": "gy", "ニ": "ny", "ヒ": "hy", "ビ": "by", "ピ": "py", "ミ": "my", "リ": "ry",
+		"シ": "sh", "ジ": "j", "チ": "ch",
+	} {
+		m[kana+"ャ"] = prefix + "a"
+		m[kana+"ュ"] = prefix + "u"
+		m[kana+"ョ"] = prefix + "o"
+	}
+	m["シェ"], m["ジェ"], m["チェ"] = "she", "je", "che"
+	return m
+}
+
+// romajiVariations transliterates a kana keyword to Hepburn romaji. It
+// returns the spelling that keeps the vowel of う (toukyou) and the one that
+// drops it as a long vowel (tokyo); the long vowel mark is always dropped, so
+// ラーメン is ramen. Keywords with characters other than kana, ASCII and
+// spaces, such as kanji, have no romaji spelling and return nil.
+func romajiVariations(keyword string) []string {
+	runes := []rune(hiraganaToKatakana(keyword))
+	var full, short strings.Builder
+	geminate := false
+	lastVowel := byte(0)
+
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == 'ッ':
+			geminate = true
+			continue
+		case r == longVowelMark:
+			continue
+		case r <= unicode.MaxASCII:
+			full.WriteRune(unicode.ToLower(r))
+			short.WriteRune(unicode.ToLower(r))
+			geminate, lastVowel = false, 0
+			continue
+		}
+
+		syllable, ok := "", false
+		if i+1 < len(runes) {
+			if syllable, ok = katakanaRomaji[string(runes[i:i+2])]; ok {
+				i++
+			}
+		}
+		if !ok {
+			if syllable, ok = katakanaRomaji[string(r)]; !ok {
+				return nil
+			}
+		}
+
+		if geminate {
+			// ッ doubles the consonant that follows: マッチャ is matcha
+			switch {
+			case strings.HasPrefix(syllable, "ch"):
+				full.WriteByte('t')
+				short.WriteByte('t')
+			case !strings.ContainsRune("aiueon", rune(syllable[0])):
+				full.WriteByte(syllable[0])
+				short.WriteByte(syllable[0])
+			}
+			geminate = false
+		}
+
+		full.WriteString(syllable)
+		if syllable == "u" && (lastVowel == 'o' || lastVowel == 'u') {
+			// う lengthens the vowel before it: トウキョウ is tokyo
+			continue
+		}
+		short.WriteString(syllable)
+		lastVowel = syllable[len(syllable)-1]
+	}
+
+	if full.Len() == 0 {
+		return nil
+	}
+	return uniqueStrings([]string{full.String(), short.String()})
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"time"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+)
+
+// SnapshotAuditPolicy decides how late a snapshot may still be taken for a checkpoint
+type SnapshotAuditPolicy struct {
+	// MinTolerance is the smallest allowed delay for any checkpoint
+	MinTolerance time.Duration
+	// RelativeTolerance is the allowed delay as a fraction of the checkpoint hour
+	RelativeTolerance float64
+}
+
+// DefaultSnapshotAuditPolicy returns the default backfill tolerance
+func DefaultSnapshotAuditPolicy() SnapshotAuditPolicy {
+	return SnapshotAuditPolicy{
+		MinTolerance:      time.Hour,
+		RelativeTolerance: 0.1,
+	}
+}
+
+// Tolerance returns how long after it was due a checkpoint can still be captured
+func (p SnapshotAuditPolicy) Tolerance(cp valueobject.CheckpointHour) time.Duration {
+	relative := time.Duration(p.RelativeTolerance * float64(cp) * float64(time.Hour))
+	if relative > p.MinTolerance {
+		return relative
+	}
+	return p.MinTolerance
+}
+
+// AuditWindowMargin is how long after its tolerance ends a checkpoint stays in
+// the audit window, so a daily audit still sees it once to mark the gap
+const AuditWindowMargin = 24 * time.Hour
+
+// AuditWindow returns how far back videos must be audited for the last
+// checkpoint of any of the profiles, or of the default profile, to be seen
+// until it can no longer be backfilled
+func (p SnapshotAuditPolicy) AuditWindow(profiles []*domain.CheckpointProfile) time.Duration {
+	last := domain.LastCheckpointHour(profiles)
+	return time.Duration(last)*time.Hour + p.Tolerance(last) + AuditWindowMargin
+}
+
+// MissingCheckpoint represents a due checkpoint without a snapshot
+type MissingCheckpoint struct {
+	CheckpointHour valueobject.CheckpointHour
+	DueAt          time.Time
+	Recoverable    bool
+}
+
+// SnapshotAuditor finds checkpoints that were due but never captured
+type SnapshotAuditor interface {
+	DueCheckpoints(video *domain.Video, profile *domain.CheckpointProfile, now time.Time) []valueobject.CheckpointHour
+	FindMissing(video *domain.Video, profile *domain.CheckpointProfile, snapshots []*domain.VideoSnapshot, gaps []*domain.SnapshotGap, now time.Time) []MissingCheckpoint
+	// AuditWindow returns how far back published videos must be audited
+	AuditWindow(profiles []*domain.CheckpointProfile) time.Duration
+}
+
+type snapshotAuditor struct {
+	policy SnapshotAuditPolicy
+}
+
+// NewSnapshotAuditor creates a new snapshot auditor
+func NewSnapshotAuditor(policy SnapshotAuditPolicy) SnapshotAuditor {
+	return &snapshotAuditor{policy: policy}
+}
+
+// AuditWindow returns the audit window of the policy for the profiles
+func (a *snapshotAuditor) AuditWindow(profiles []*domain.CheckpointProfile) time.Duration {
+	return a.policy.AuditWindow(profiles)
+}
+
+// DueCheckpoints returns the checkpoints of the profile whose time has already come
+func (a *snapshotAuditor) DueCheckpoints(video *domain.Video, profile *domain.CheckpointProfile, now time.Time) []valueobject.CheckpointHour {
+	var due []valueobject.CheckpointHour
+	for _, cp := range profile.Hours {
+		if !dueAt(video, cp).After(now) {
+			due = append(due, cp)
+		}
+	}
+	return due
+}
+
+// FindMissing returns due checkpoints that have neither a snapshot nor a recorded gap
+func (a *snapshotAuditor) FindMissing(video *domain.Video, profile *domain.CheckpointProfile, snapshots []*domain.VideoSnapshot, gaps []*domain.SnapshotGap, now time.Time) []MissingCheckpoint {
+	known := make(map[valueobject.CheckpointHour]bool, len(snapshots)+len(gaps))
+	for _, s := range snapshots {
+		known[s.CheckpointHour] = true
+	}
+	for _, g := range gaps {
+		known[g.CheckpointHour] = true
+	}
+
+	var missing []MissingCheckpoint
+	for _, cp := range a.DueCheckpoints(video, profile, now) {
+		if known[cp] {
+			continue
+		}
+		due := dueAt(video, cp)
+		missing = append(missing, MissingCheckpoint{
+			CheckpointHour: cp,
+			DueAt:          due,
+			Recoverable:    !now.After(due.Add(a.policy.Tolerance(cp))),
+		})
+	}
+	return missing
+}
+
+func dueAt(video *domain.Video, cp valueobject.CheckpointHour) time.Time {
+	return video.PublishedAt.Add(time.Duration(cp) * time.Hour)
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+)
+
+func TestSnapshotAuditor_FindMissing(t *testing.T) {
+	auditor := NewSnapshotAuditor(DefaultSnapshotAuditPolicy())
+	video := &domain.Video{ID: "video-1", PublishedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	at := func(d time.Duration) time.Time { return video.PublishedAt.Add(d) }
+	fastMoving, err := domain.NewCheckpointProfile("profile-1", "fast_moving", "Fast moving", []valueobject.CheckpointHour{1, 2, 3, 6})
+	if err != nil {
+		t.Fatalf("NewCheckpointProfile() unexpected error = %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		profile   *domain.CheckpointProfile
+		now       time.Time
+		snapshots []valueobject.CheckpointHour
+		gaps      []valueobject.CheckpointHour
+		want      map[valueobject.CheckpointHour]bool // checkpoint -> recoverable
+	}{
+		{
+			name:      "nothing due yet beyond baseline",
+			now:       at(2 * time.Hour),
+			snapshots: []valueobject.CheckpointHour{0},
+			want:      map[valueobject.CheckpointHour]bool{},
+		},
+		{
+			name:      "short checkpoint within minimum tolerance",
+			now:       at(3*time.Hour + 30*time.Minute),
+			snapshots: []valueobject.CheckpointHour{0},
+			want:      map[valueobject.CheckpointHour]bool{3: true},
+		},
+		{
+			name:      "short checkpoint past minimum tolerance",
+			now:       at(5 * time.Hour),
+			snapshots: []valueobject.CheckpointHour{0},
+			want:      map[valueobject.CheckpointHour]bool{3: false},
+		},
+		{
+			name:      "long checkpoint uses relative tolerance",
+			now:       at(26 * time.Hour),
+			snapshots: []valueobject.CheckpointHour{0, 3, 6, 12},
+			want:      map[valueobject.CheckpointHour]bool{24: true},
+		},
+		{
+			name:      "profile adds early checkpoints",
+			profile:   fastMoving,
+			now:       at(3*time.Hour + 30*time.Minute),
+			snapshots: []valueobject.CheckpointHour{0, 2},
+			want:      map[valueobject.CheckpointHour]bool{1: false, 3: true},
+		},
+		{
+			name:      "recorded gaps are not reported again",
+			now:       at(26 * time.Hour),
+			snapshots: []valueobject.CheckpointHour{0, 3, 12},
+			gaps:      []valueobject.CheckpointHour{6},
+			want:      map[valueobject.CheckpointHour]bool{24: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var snapshots []*domain.VideoSnapshot
+			for _, cp := range tt.snapshots {
+				snapshots = append(snapshots, &domain.VideoSnapshot{VideoID: video.ID, CheckpointHour: cp})
+			}
+			var gaps []*domain.SnapshotGap
+			for _, cp := range tt.gaps {
+				gaps = append(gaps, &domain.SnapshotGap{VideoID: video.ID, CheckpointHour: cp})
+			}
+
+			profile := tt.profile
+			if profile == nil {
+				profile = domain.DefaultCheckpointProfile()
+			}
+
+			got := auditor.FindMissing(video, profile, snapshots, gaps, tt.now)
+			if len(got) != len(tt.want) {
+				t.Fatalf("FindMissing() = %+v, want %v", got, tt.want)
+			}
+			for _, m := range got {
+				recoverable, ok := tt.want[m.CheckpointHour]
+				if !ok {
+					t.Errorf("FindMissing() unexpected checkpoint %d", m.CheckpointHour)
+					continue
+				}
+				if m.Recoverable != recoverable {
+					t.Errorf("checkpoint %d Recoverable = %v, want %v", m.CheckpointHour, m.Recoverable, recoverable)
+				}
+			}
+		})
+	}
+}
+
+func TestSnapshotAuditPolicy_AuditWindow(t *testing.T) {
+	policy := DefaultSnapshotAuditPolicy()
+	longTail, err := domain.NewCheckpointProfile("profile-1", "long_tail", "Long tail", []valueobject.CheckpointHour{24, 168, 336, 720})
+	if err != nil {
+		t.Fatalf("NewCheckpointProfile() unexpected error = %v", err)
+	}
+	fastMoving, err := domain.NewCheckpointProfile("profile-2", "fast_moving", "Fast moving", []valueobject.CheckpointHour{1, 2, 3, 6})
+	if err != nil {
+		t.Fatalf("NewCheckpointProfile() unexpected error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		profiles []*domain.CheckpointProfile
+		want     time.Duration
+	}{
+		{
+			name: "default profile when none is active",
+			want: 168*time.Hour + 1008*time.Minute + AuditWindowMargin,
+		},
+		{
+			name:     "short profiles do not shorten the default window",
+			profiles: []*domain.CheckpointProfile{fastMoving},
+			want:     168*time.Hour + 1008*time.Minute + AuditWindowMargin,
+		},
+		{
+			name:     "longest checkpoint with its tolerance",
+			profiles: []*domain.CheckpointProfile{fastMoving, longTail},
+			want:     720*time.Hour + 72*time.Hour + AuditWindowMargin,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policy.AuditWindow(tt.profiles); got != tt.want {
+				t.Errorf("AuditWindow() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
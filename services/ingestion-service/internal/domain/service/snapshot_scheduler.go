@@ -9,8 +9,10 @@ import (
 
 // SnapshotScheduler is a domain service for scheduling video snapshots
 type SnapshotScheduler interface {
-	ScheduleSnapshots(video *domain.Video) ([]ScheduledSnapshot, error)
-	DetermineCheckpoints(video *domain.Video) []valueobject.CheckpointHour
+	ScheduleSnapshots(video *domain.Video, profile *domain.CheckpointProfile) ([]ScheduledSnapshot, error)
+	DetermineCheckpoints(video *domain.Video, profile *domain.CheckpointProfile) []valueobject.CheckpointHour
+	// ScheduleWindow returns how far back published videos can still have checkpoints ahead
+	ScheduleWindow(profiles []*domain.CheckpointProfile) time.Duration
 }
 
 // ScheduledSnapshot represents a scheduled snapshot task
@@ -28,13 +30,13 @@ func NewSnapshotScheduler() SnapshotScheduler {
 }
 
 // ScheduleSnapshots calculates the schedule for video snapshots
-// Returns snapshots for +3h, +6h, +12h, +24h, +48h, +72h, +168h from published time
-func (s *snapshotScheduler) ScheduleSnapshots(video *domain.Video) ([]ScheduledSnapshot, error) {
+// Returns snapshots for each checkpoint of the profile after D0 from published time
+func (s *snapshotScheduler) ScheduleSnapshots(video *domain.Video, profile *domain.CheckpointProfile) ([]ScheduledSnapshot, error) {
 	var scheduled []ScheduledSnapshot
 	now := time.Now()
 	
-	// Get all checkpoint hours after D0
-	checkpointHours := valueobject.GetCheckpointHoursAfter(valueobject.CheckpointHour0)
+	// Get profile checkpoint hours after D0
+	checkpointHours := profile.HoursAfter(valueobject.CheckpointHour0)
 	
 	for _, cpHour := range checkpointHours {
 		eta := video.PublishedAt.Add(time.Duration(cpHour) * time.Hour)
@@ -54,13 +56,13 @@ func (s *snapshotScheduler) ScheduleSnapshots(video *domain.Video) ([]ScheduledS
 	return scheduled, nil
 }
 
-// DetermineCheckpoints determines which checkpoint hours are needed for a video
-func (s *snapshotScheduler) DetermineCheckpoints(video *domain.Video) []valueobject.CheckpointHour {
+// DetermineCheckpoints determines which checkpoint hours of the profile are still needed for a video
+func (s *snapshotScheduler) DetermineCheckpoints(video *domain.Video, profile *domain.CheckpointProfile) []valueobject.CheckpointHour {
 	now := time.Now()
 	var checkpoints []valueobject.CheckpointHour
 	
-	// Get all checkpoint hours after D0
-	allCheckpoints := valueobject.GetCheckpointHoursAfter(valueobject.CheckpointHour0)
+	// Get profile checkpoint hours after D0
+	allCheckpoints := profile.HoursAfter(valueobject.CheckpointHour0)
 	
 	for _, cp := range allCheckpoints {
 		eta := video.PublishedAt.Add(time.Duration(cp) * time.Hour)
@@ -72,4 +74,10 @@ func (s *snapshotScheduler) DetermineCheckpoints(video *domain.Video) []valueobj
 	}
 	
 	return checkpoints
-}
\ No newline at end of file
+}
+
+// ScheduleWindow returns the last checkpoint of any of the profiles, or of the
+// default profile. Videos published longer ago have no checkpoints left to schedule.
+func (s *snapshotScheduler) ScheduleWindow(profiles []*domain.CheckpointProfile) time.Duration {
+	return time.Duration(domain.LastCheckpointHour(profiles)) * time.Hour
+}
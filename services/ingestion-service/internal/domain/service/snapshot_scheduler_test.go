@@ -0,0 +1,50 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+)
+
+func TestSnapshotScheduler_ScheduleWindow(t *testing.T) {
+	scheduler := NewSnapshotScheduler()
+	longTail, err := domain.NewCheckpointProfile("profile-1", "long_tail", "Long tail", []valueobject.CheckpointHour{24, 168, 336, 720})
+	if err != nil {
+		t.Fatalf("NewCheckpointProfile() unexpected error = %v", err)
+	}
+	fastMoving, err := domain.NewCheckpointProfile("profile-2", "fast_moving", "Fast moving", []valueobject.CheckpointHour{1, 2, 3, 6})
+	if err != nil {
+		t.Fatalf("NewCheckpointProfile() unexpected error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		profiles []*domain.CheckpointProfile
+		want     time.Duration
+	}{
+		{
+			name: "default profile when none is active",
+			want: 168 * time.Hour,
+		},
+		{
+			name:     "short profiles do not shorten the default window",
+			profiles: []*domain.CheckpointProfile{fastMoving},
+			want:     168 * time.Hour,
+		},
+		{
+			name:     "last checkpoint of the longest profile",
+			profiles: []*domain.CheckpointProfile{fastMoving, longTail},
+			want:     720 * time.Hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := scheduler.ScheduleWindow(tt.profiles); got != tt.want {
+				t.Errorf("ScheduleWindow() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+)
+
+// SynonymDictionary looks up the aliases of keywords for pattern generation.
+// It is built from the synonym entries once and is safe for concurrent use.
+type SynonymDictionary struct {
+	version int64
+	aliases map[string][]synonymAlias
+}
+
+// synonymAlias is an alias a keyword also matches
+type synonymAlias struct {
+	alias         string
+	crossLanguage bool
+}
+
+// NewSynonymDictionary builds a dictionary of the given version from synonym
+// entries. Bidirectional entries are also indexed from the alias to the term.
+// Terms and aliases are normalized like keywords.
+func NewSynonymDictionary(version int64, synonyms []*domain.KeywordSynonym) *SynonymDictionary {
+	d := &SynonymDictionary{
+		version: version,
+		aliases: make(map[string][]synonymAlias),
+	}
+	for _, s := range synonyms {
+		cross := s.IsCrossLanguage()
+		term, alias := NormalizeText(s.Term), NormalizeText(s.Alias)
+		d.add(s.Language, term, synonymAlias{alias: alias, crossLanguage: cross})
+		if s.Bidirectional {
+			d.add(s.AliasLanguage, alias, synonymAlias{alias: term, crossLanguage: cross})
+		}
+	}
+	return d
+}
+
+// DefaultSynonymDictionary returns the dictionary of the built-in synonyms,
+// used when no dictionary has been loaded. The same entries are seeded into
+// the keyword_synonyms table.
+func DefaultSynonymDictionary() *SynonymDictionary {
+	return NewSynonymDictionary(0, DefaultKeywordSynonyms())
+}
+
+// DefaultKeywordSynonyms returns the built-in synonym entries
+func DefaultKeywordSynonyms() []*domain.KeywordSynonym {
+	entries := []struct {
+		language, term, alias string
+	}{
+		{domain.SynonymLanguageEnglish, "javascript", "js"},
+		{domain.SynonymLanguageEnglish, "typescript", "ts"},
+		{domain.SynonymLanguageEnglish, "kubernetes", "k8s"},
+		{domain.SynonymLanguageEnglish, "internationalization", "i18n"},
+		{domain.SynonymLanguageEnglish, "localization", "l10n"},
+		{domain.SynonymLanguageEnglish, "continuous integration", "ci"},
+		{domain.SynonymLanguageEnglish, "continuous deployment", "cd"},
+		{domain.SynonymLanguageEnglish, "continuous delivery", "cd"},
+		{domain.SynonymLanguageJapanese, "プログラミング", "プログラム"},
+		{domain.SynonymLanguageJapanese, "アプリケーション", "アプリ"},
+		{domain.SynonymLanguageJapanese, "インフラストラクチャー", "インフラ"},
+		{domain.SynonymLanguageJapanese, "フロントエンド", "フロント"},
+		{domain.SynonymLanguageJapanese, "バックエンド", "バック"},
+	}
+
+	synonyms := make([]*domain.KeywordSynonym, len(entries))
+	for i, e := range entries {
+		synonyms[i] = &domain.KeywordSynonym{
+			Language:      e.language,
+			Term:          e.term,
+			Alias:         e.alias,
+			AliasLanguage: e.language,
+			Bidirectional: true,
+		}
+	}
+	return synonyms
+}
+
+// Version returns the version of the dictionary the entries were loaded at
+func (d *SynonymDictionary) Version() int64 {
+	return d.version
+}
+
+// Aliases returns the aliases of a keyword in the given language, matched
+// case-insensitively after normalization. Cross-language aliases are only returned when
+// crossLanguage is set.
+func (d *SynonymDictionary) Aliases(language, keyword string, crossLanguage bool) []string {
+	var aliases []string
+	for _, a := range d.aliases[synonymKey(language, keyword)] {
+		if a.crossLanguage && !crossLanguage {
+			continue
+		}
+		aliases = append(aliases, a.alias)
+	}
+	return aliases
+}
+
+func (d *SynonymDictionary) add(language, term string, alias synonymAlias) {
+	key := synonymKey(language, term)
+	d.aliases[key] = append(d.aliases[key], alias)
+}
+
+func synonymKey(language, term string) string {
+	return language + "\x00" + strings.ToLower(strings.TrimSpace(NormalizeText(term)))
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// longVowelMark is the katakana-hiragana prolonged sound mark (ー)
+const longVowelMark = 'ー'
+
+// NormalizeText normalizes text for keyword matching. It applies Unicode NFKC,
+// which folds full-width ASCII (ＲＥＡＣＴ) to ASCII and half-width katakana
+// (ｻｰﾊﾞｰ) to katakana, and folds dashes written after katakana (サーバ―) into
+// the long vowel mark. Keywords are normalized before patterns are generated,
+// and titles before they are matched, so both sides agree.
+func NormalizeText(s string) string {
+	return normalize(s, false).text
+}
+
+// normalizedText is normalized text with the span of the original text each of
+// its characters came from
+type normalizedText struct {
+	text string
+	// starts and ends hold, for each character of text, the character offsets
+	// in the original text of the segment it was normalized from
+	starts []int
+	ends   []int
+}
+
+// span converts a byte range of the normalized text into the character range
+// of the original text it was normalized from
+func (n *normalizedText) span(start, end int) (int, int) {
+	i := utf8.RuneCountInString(n.text[:start])
+	j := utf8.RuneCountInString(n.text[:end])
+	if i == len(n.starts) || i == j {
+		origin := n.origin(i)
+		return origin, origin
+	}
+	return n.starts[i], n.ends[j-1]
+}
+
+// origin returns the original offset of the character at i, or the length of
+// the original text when i is at the end
+func (n *normalizedText) origin(i int) int {
+	if i < len(n.starts) {
+		return n.starts[i]
+	}
+	if len(n.ends) == 0 {
+		return 0
+	}
+	return n.ends[len(n.ends)-1]
+}
+
+// normalize applies NormalizeText, lowercasing as well when lower is set, and
+// keeps track of where each normalized character came from
+func normalize(s string, lower bool) *normalizedText {
+	n := &normalizedText{}
+	var b strings.Builder
+	b.Grow(len(s))
+
+	offset := 0
+	prev := rune(0)
+	for pos := 0; pos < len(s); {
+		// Normalize the text segment by segment, each segment a character
+		// with the marks that combine with it
+		size := norm.NFKC.NextBoundaryInString(s[pos:], true)
+		if size <= 0 {
+			size = len(s) - pos
+		}
+		consumed := utf8.RuneCountInString(s[pos : pos+size])
+		segment := norm.NFKC.String(s[pos : pos+size])
+		pos += size
+		if lower {
+			segment = strings.ToLower(segment)
+		}
+
+		// Segments usually map character for character; when they do not,
+		// such as ｶﾞ to ガ, each character spans the whole segment
+		oneToOne := utf8.RuneCountInString(segment) == consumed
+		i := 0
+		for _, r := range segment {
+			if isLongVowelDash(r) && isKatakanaOrLongVowel(prev) {
+				r = longVowelMark
+			}
+			b.WriteRune(r)
+			if oneToOne {
+				n.starts = append(n.starts, offset+i)
+				n.ends = append(n.ends, offset+i+1)
+			} else {
+				n.starts = append(n.starts, offset)
+				n.ends = append(n.ends, offset+consumed)
+			}
+			prev = r
+			i++
+		}
+		offset += consumed
+	}
+	n.text = b.String()
+	return n
+}
+
+// isLongVowelDash reports whether r is a dash that stands for the long vowel
+// mark when written after katakana
+func isLongVowelDash(r rune) bool {
+	switch r {
+	case '‐', '‑', '‒', '–', '—', '―', '−', '─':
+		return true
+	}
+	return false
+}
+
+func isKatakanaOrLongVowel(r rune) bool {
+	return r == longVowelMark || unicode.In(r, unicode.Katakana)
+}
+
+// longVowelVariation returns s without the long vowel marks that end a
+// katakana word, so サーバー also matches サーバ and コンピューター matches
+// コンピュータ. A title spelled with the mark still matches the shorter form.
+func longVowelVariation(s string) string {
+	runes := []rune(s)
+	out := make([]rune, 0, len(runes))
+	for i, r := range runes {
+		if r == longVowelMark && i > 0 && isKatakanaOrLongVowel(runes[i-1]) &&
+			(i == len(runes)-1 || !isKatakanaOrLongVowel(runes[i+1])) {
+			// Drop the whole run of marks ending the word
+			for len(out) > 0 && out[len(out)-1] == longVowelMark {
+				out = out[:len(out)-1]
+			}
+			continue
+		}
+		out = append(out, r)
+	}
+	return string(out)
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeText(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "Full-width ASCII",
+			input: "ＲＥＡＣＴ　入門",
+			want:  "REACT 入門",
+		},
+		{
+			name:  "Half-width katakana with voiced marks",
+			input: "ｻｰﾊﾞｰ ﾌﾟﾛｸﾞﾗﾐﾝｸﾞ",
+			want:  "サーバー プログラミング",
+		},
+		{
+			name:  "Dash after katakana",
+			input: "サーバ― コンピュータ−",
+			want:  "サーバー コンピューター",
+		},
+		{
+			name:  "Dash elsewhere is kept",
+			input: "2024―2025",
+			want:  "2024―2025",
+		},
+		{
+			name:  "Hiragana and kanji are unchanged",
+			input: "ぷろぐらみんぐ入門",
+			want:  "ぷろぐらみんぐ入門",
+		},
+		{
+			name:  "Compatibility characters",
+			input: "①㈱ｺﾝﾋﾟｭｰﾀ",
+			want:  "1(株)コンピュータ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeText(tt.input); got != tt.want {
+				t.Errorf("NormalizeText(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizedText_Span(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		match     string // Substring of the normalized text
+		wantStart int
+		wantEnd   int
+	}{
+		{
+			name:      "Unchanged text",
+			input:     "Learn Go",
+			match:     "go",
+			wantStart: 6,
+			wantEnd:   8,
+		},
+		{
+			name:      "Full-width text",
+			input:     "ＧＯ言語",
+			match:     "言語",
+			wantStart: 2,
+			wantEnd:   4,
+		},
+		{
+			name:      "Half-width katakana shrinks",
+			input:     "ｶﾞｲﾄﾞ本",
+			match:     "本",
+			wantStart: 5,
+			wantEnd:   6,
+		},
+		{
+			name:      "Part of an expanded character spans all of it",
+			input:     "㈱テスト",
+			match:     "株",
+			wantStart: 0,
+			wantEnd:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := normalize(tt.input, true)
+			i := indexOf(n.text, tt.match)
+			if i < 0 {
+				t.Fatalf("normalized text %q does not contain %q", n.text, tt.match)
+			}
+			start, end := n.span(i, i+len(tt.match))
+			if start != tt.wantStart || end != tt.wantEnd {
+				t.Errorf("span = [%d, %d), want [%d, %d)", start, end, tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestLongVowelVariation(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "サーバー", want: "サーバ"},
+		{input: "コンピューター", want: "コンピュータ"},
+		{input: "サーバー管理", want: "サーバ管理"},
+		{input: "ユーザー インターフェース", want: "ユーザ インターフェース"},
+		{input: "ラーメン", want: "ラーメン"},
+		{input: "すーぱー", want: "すーぱー"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := longVowelVariation(tt.input); got != tt.want {
+				t.Errorf("longVowelVariation(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRomajiVariations(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []string
+	}{
+		{input: "すし", want: []string{"sushi"}},
+		{input: "ラーメン", want: []string{"ramen"}},
+		{input: "まっちゃ", want: []string{"matcha"}},
+		{input: "とうきょう", want: []string{"toukyou", "tokyo"}},
+		{input: "きっぷ", want: []string{"kippu"}},
+		{input: "ジャズ", want: []string{"jazu"}},
+		{input: "フィギュア", want: []string{"figyua"}},
+		{input: "ポケモン GO", want: []string{"pokemon go"}},
+		{input: "東京", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := romajiVariations(tt.input); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("romajiVariations(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func indexOf(s, substr string) int {
+	for i := 0; i+len(substr) <= len(s); i++ {
+		if s[i:i+len(substr)] == substr {
+			return i
+		}
+	}
+	return -1
+}
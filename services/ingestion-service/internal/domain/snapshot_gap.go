@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+)
+
+// GapReason represents why a checkpoint could not be captured
+type GapReason string
+
+const (
+	// GapReasonExpired means the checkpoint was missed beyond the backfill tolerance
+	GapReasonExpired GapReason = "expired"
+	// GapReasonVideoUnavailable means the video can no longer be fetched from YouTube
+	GapReasonVideoUnavailable GapReason = "video_unavailable"
+)
+
+// SnapshotGap records a checkpoint that is permanently missing for a video
+type SnapshotGap struct {
+	VideoID        valueobject.UUID
+	CheckpointHour valueobject.CheckpointHour
+	DueAt          time.Time
+	Reason         GapReason
+	DetectedAt     time.Time
+}
+
+// NewSnapshotGap creates a new snapshot gap
+func NewSnapshotGap(
+	videoID valueobject.UUID,
+	checkpointHour valueobject.CheckpointHour,
+	dueAt time.Time,
+	reason GapReason,
+) (*SnapshotGap, error) {
+	if videoID == "" {
+		return nil, ErrInvalidInput
+	}
+	if !checkpointHour.IsValid() {
+		return nil, ErrInvalidCheckpointHour
+	}
+
+	return &SnapshotGap{
+		VideoID:        videoID,
+		CheckpointHour: checkpointHour,
+		DueAt:          dueAt,
+		Reason:         reason,
+		DetectedAt:     time.Now(),
+	}, nil
+}
@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+)
+
+// SnapshotOrder is the only order snapshots are listed in: by measured_at, then
+// by ID, oldest first. Page tokens are issued for this order.
+const SnapshotOrder = "measured_at asc"
+
+// SnapshotFilter narrows down a snapshot listing across videos. Zero fields do
+// not filter.
+type SnapshotFilter struct {
+	VideoID        valueobject.UUID
+	GenreID        valueobject.UUID
+	CheckpointHour *valueobject.CheckpointHour
+	MeasuredAfter  *time.Time // Inclusive
+	MeasuredBefore *time.Time // Exclusive
+}
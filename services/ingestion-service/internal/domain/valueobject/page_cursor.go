@@ -0,0 +1,87 @@
+package valueobject
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrInvalidPageToken is returned for a page token that was not issued for the request
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// PageCursor is the keyset position of the last item of a page. Clients receive
+// it as an opaque page token and pass it back to fetch the next page.
+type PageCursor struct {
+	Order  string    `json:"o"`           // Sort order the cursor was issued for
+	Filter string    `json:"f"`           // FilterDigest of the filter the cursor was issued for
+	Key    time.Time `json:"k"`           // Sort key of the last item, for orders by time
+	Text   string    `json:"t,omitempty"` // Sort key of the last item, for orders by text
+	ID     string    `json:"i"`           // ID of the last item, breaking ties on the sort key
+}
+
+// Token encodes the cursor as an opaque page token
+func (c PageCursor) Token() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// FilterDigest returns a short digest of the filter parameters of a listing.
+// A page token only continues the listing whose filter has the same digest.
+func FilterDigest(filter ...interface{}) string {
+	b, _ := json.Marshal(filter)
+	sum := sha256.Sum256(b)
+	return base64.RawURLEncoding.EncodeToString(sum[:12])
+}
+
+// ParsePageToken decodes a page token issued for the given sort order and
+// filter digest, whose last item has a UUID. An empty token means the first
+// page and returns nil.
+func ParsePageToken(token, order, filter string) (*PageCursor, error) {
+	c, err := decodePageToken(token, order, filter)
+	if c == nil || err != nil {
+		return nil, err
+	}
+	if _, err := uuid.Parse(c.ID); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	return c, nil
+}
+
+// ParseIntPageToken is ParsePageToken for listings whose items have integer
+// IDs, such as YouTube categories
+func ParseIntPageToken(token, order, filter string) (*PageCursor, error) {
+	c, err := decodePageToken(token, order, filter)
+	if c == nil || err != nil {
+		return nil, err
+	}
+	if _, err := strconv.Atoi(c.ID); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	return c, nil
+}
+
+// decodePageToken decodes a page token and checks that it was issued for the
+// sort order and filter digest
+func decodePageToken(token, order, filter string) (*PageCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	var c PageCursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	if c.Order != order || c.Filter != filter {
+		return nil, ErrInvalidPageToken
+	}
+	return &c, nil
+}
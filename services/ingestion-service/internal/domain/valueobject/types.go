@@ -14,10 +14,11 @@ type YouTubeChannelID string
 // CategoryID represents a YouTube video category ID
 type CategoryID int
 
-// CheckpointHour represents a checkpoint hour (0,3,6,12,24,48,72,168)
+// CheckpointHour represents hours after publication at which a snapshot is taken.
+// Which hours apply to a video is decided by its genres' checkpoint profiles.
 type CheckpointHour int
 
-// Valid checkpoint hours
+// Checkpoint hours of the default profile
 const (
 	CheckpointHour0   CheckpointHour = 0
 	CheckpointHour3   CheckpointHour = 3
@@ -29,19 +30,14 @@ const (
 	CheckpointHour168 CheckpointHour = 168
 )
 
-// IsValid checks if the checkpoint hour is valid
+// IsValid checks if the checkpoint hour is valid. The allowed set is kept in
+// the ingestion.checkpoint_hours reference table.
 func (c CheckpointHour) IsValid() bool {
-	switch c {
-	case CheckpointHour0, CheckpointHour3, CheckpointHour6, CheckpointHour12,
-		CheckpointHour24, CheckpointHour48, CheckpointHour72, CheckpointHour168:
-		return true
-	default:
-		return false
-	}
+	return c >= CheckpointHour0
 }
 
-// AllCheckpointHours returns all valid checkpoint hours
-func AllCheckpointHours() []CheckpointHour {
+// DefaultCheckpointHours returns the checkpoint hours of the default profile
+func DefaultCheckpointHours() []CheckpointHour {
 	return []CheckpointHour{
 		CheckpointHour0,
 		CheckpointHour3,
@@ -54,20 +50,6 @@ func AllCheckpointHours() []CheckpointHour {
 	}
 }
 
-// GetCheckpointHoursAfter returns checkpoint hours after the given hour
-func GetCheckpointHoursAfter(hour CheckpointHour) []CheckpointHour {
-	var result []CheckpointHour
-	allHours := AllCheckpointHours()
-	
-	for _, h := range allHours {
-		if h > hour {
-			result = append(result, h)
-		}
-	}
-	
-	return result
-}
-
 // FilterType represents the type of filter (include/exclude)
 type FilterType string
 
@@ -93,12 +75,14 @@ const (
 	SourceWebSub Source = "websub"
 	SourceTask   Source = "task"
 	SourceManual Source = "manual"
+	// SourceBackfill marks a late snapshot taken by the snapshot audit
+	SourceBackfill Source = "backfill"
 )
 
 // IsValid checks if the source is valid
 func (s Source) IsValid() bool {
 	switch s {
-	case SourceWebSub, SourceTask, SourceManual:
+	case SourceWebSub, SourceTask, SourceManual, SourceBackfill:
 		return true
 	default:
 		return false
@@ -13,6 +13,9 @@ var (
 	ErrInvalidVideoPublishedAt = errors.New("published at must be in the past")
 )
 
+// ShortsMaxDuration is the longest a video can run and still count as a Short
+const ShortsMaxDuration = 3 * time.Minute
+
 // Video represents a YouTube video entity
 type Video struct {
 	ID               valueobject.UUID
@@ -22,6 +25,7 @@ type Video struct {
 	Title            string
 	PublishedAt      time.Time
 	CategoryID       valueobject.CategoryID
+	Duration         time.Duration // Zero when unknown
 	CreatedAt        time.Time
 	UpdatedAt        *time.Time
 	DeletedAt        *time.Time
@@ -78,6 +82,12 @@ func (v *Video) Delete() {
 	v.UpdatedAt = &now
 }
 
+// IsShort reports whether the video is a Short. Videos of unknown duration are
+// not treated as Shorts.
+func (v *Video) IsShort() bool {
+	return v.Duration > 0 && v.Duration <= ShortsMaxDuration
+}
+
 // IsDeleted checks if the video is deleted
 func (v *Video) IsDeleted() bool {
 	return v.DeletedAt != nil
@@ -94,6 +104,7 @@ func (v *Video) AddSnapshot(snapshot *VideoSnapshot) error {
 	if err := snapshot.ValidateWithVideo(v); err != nil {
 		return err
 	}
+	snapshot.Drift = snapshot.DriftFrom(v.PublishedAt)
 	
 	// Add to new snapshots list
 	v.newSnapshots = append(v.newSnapshots, snapshot)
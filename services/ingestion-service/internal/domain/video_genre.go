@@ -6,12 +6,39 @@ import (
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
 )
 
+// VideoGenreOrder is the order the genres of a video are listed in: by
+// created_at, then by ID, oldest first. Page tokens are issued for this order.
+const VideoGenreOrder = "created_at asc"
+
 // VideoGenre represents the many-to-many relationship between videos and genres
 type VideoGenre struct {
 	ID        valueobject.UUID
 	VideoID   valueobject.UUID
 	GenreID   valueobject.UUID
 	CreatedAt time.Time
+	Match     *GenreMatch // Why the video is in the genre; nil when no match was recorded
+}
+
+// GenreMatch is the result of the keyword filters of a genre for a video,
+// evaluated when the video was assigned to the genre. A neutral or exclude
+// result means the video was assigned although its keywords do not include it.
+type GenreMatch struct {
+	Result      string // include, exclude or neutral
+	Matches     []GenreKeywordMatch
+	EvaluatedAt time.Time
+}
+
+// GenreKeywordMatch is the match of a keyword group in a field of a video
+type GenreKeywordMatch struct {
+	KeywordID   valueobject.UUID // ID of the keyword group
+	KeywordName string
+	FilterType  valueobject.FilterType
+	Field       string // Field of the video matched, such as title
+	Start       int    // Character offsets of the match in the field
+	End         int
+	MatchedText string
+	Decisive    bool // The match decided the result
+	Overridden  bool // An inclusion overridden by a matching exclusion
 }
 
 // NewVideoGenre creates a new video-genre association
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+)
+
+// VideoFilter narrows down a video listing. Zero fields do not filter.
+type VideoFilter struct {
+	ChannelID       valueobject.UUID
+	GenreID         valueobject.UUID
+	CategoryID      *valueobject.CategoryID
+	PublishedAfter  *time.Time // Inclusive
+	PublishedBefore *time.Time // Exclusive
+	Shorts          *bool      // true lists only Shorts, false excludes them
+	Query           string     // Case-insensitive substring of the title
+}
+
+// GenreVideoScope selects the stored videos the filters of a genre apply to.
+// Videos carry no language or region, so besides the members of the genre it
+// takes the videos of its categories that were assigned to another genre of the
+// same language and region, or whose channel is based in the region.
+type GenreVideoScope struct {
+	GenreID        valueobject.UUID
+	Language       string
+	RegionCode     string
+	CategoryIDs    []valueobject.CategoryID
+	PublishedAfter time.Time // Inclusive
+}
+
+// NewGenreVideoScope returns the scope of the videos of a genre published since a time
+func NewGenreVideoScope(g *Genre, since time.Time) GenreVideoScope {
+	return GenreVideoScope{
+		GenreID:        g.ID,
+		Language:       g.Language,
+		RegionCode:     g.RegionCode,
+		CategoryIDs:    g.CategoryIDs,
+		PublishedAfter: since,
+	}
+}
+
+// VideoSortField is the key a video listing is sorted by
+type VideoSortField string
+
+const (
+	VideoSortPublishedAt VideoSortField = "published_at"
+	VideoSortCreatedAt   VideoSortField = "created_at"
+)
+
+// VideoOrder is the sort order of a video listing. Ties are broken by video ID
+// in the same direction.
+type VideoOrder struct {
+	Field     VideoSortField
+	Ascending bool
+}
+
+// DefaultVideoOrder lists the most recently published videos first
+var DefaultVideoOrder = VideoOrder{Field: VideoSortPublishedAt}
+
+// ParseVideoOrder parses an order such as "published_at" or "created_at desc".
+// The direction defaults to descending and an empty string to DefaultVideoOrder.
+func ParseVideoOrder(s string) (VideoOrder, error) {
+	fields := strings.Fields(strings.ToLower(s))
+	if len(fields) == 0 {
+		return DefaultVideoOrder, nil
+	}
+	if len(fields) > 2 {
+		return VideoOrder{}, ErrInvalidInput
+	}
+
+	order := VideoOrder{Field: VideoSortField(fields[0])}
+	switch order.Field {
+	case VideoSortPublishedAt, VideoSortCreatedAt:
+	default:
+		return VideoOrder{}, ErrInvalidInput
+	}
+	if len(fields) == 2 {
+		switch fields[1] {
+		case "asc":
+			order.Ascending = true
+		case "desc":
+		default:
+			return VideoOrder{}, ErrInvalidInput
+		}
+	}
+	return order, nil
+}
+
+// String returns the order in the form accepted by ParseVideoOrder
+func (o VideoOrder) String() string {
+	if o.Ascending {
+		return string(o.Field) + " asc"
+	}
+	return string(o.Field) + " desc"
+}
+
+// SortKey returns the value of the video the order sorts by
+func (o VideoOrder) SortKey(v *Video) time.Time {
+	if o.Field == VideoSortCreatedAt {
+		return v.CreatedAt
+	}
+	return v.PublishedAt
+}
@@ -14,6 +14,11 @@ var (
 	ErrMeasuredAtBeforePublished = errors.New("measured at cannot be before video published at")
 )
 
+// Names of the optional counts kept in VideoSnapshot.Metrics
+const (
+	SnapshotMetricFavorites = "favorite_count"
+)
+
 // VideoSnapshot represents a snapshot of video statistics at a checkpoint
 type VideoSnapshot struct {
 	ID                valueobject.UUID
@@ -23,8 +28,16 @@ type VideoSnapshot struct {
 	ViewsCount        int64
 	LikesCount        int64
 	SubscriptionCount int64
-	Source            valueobject.Source
-	CreatedAt         time.Time
+	// CommentsCount is nil for snapshots taken before comments were recorded
+	CommentsCount *int64
+	// Metrics holds counts that are not available for every snapshot, keyed by
+	// metric name (see the SnapshotMetric constants)
+	Metrics map[string]int64
+	Source  valueobject.Source
+	// Drift is how far MeasuredAt is from the exact checkpoint time
+	// (published_at + checkpoint_hour); positive when measured late
+	Drift     time.Duration
+	CreatedAt time.Time
 }
 
 // SnapshotCounts holds the counts for a snapshot
@@ -32,6 +45,8 @@ type SnapshotCounts struct {
 	ViewsCount        int64
 	LikesCount        int64
 	SubscriptionCount int64
+	CommentsCount     int64
+	Metrics           map[string]int64
 }
 
 // NewVideoSnapshot creates a new video snapshot
@@ -63,6 +78,8 @@ func NewVideoSnapshot(
 		ViewsCount:        counts.ViewsCount,
 		LikesCount:        counts.LikesCount,
 		SubscriptionCount: counts.SubscriptionCount,
+		CommentsCount:     &counts.CommentsCount,
+		Metrics:           counts.Metrics,
 		Source:            source,
 		CreatedAt:         time.Now(),
 	}, nil
@@ -74,4 +91,10 @@ func (s *VideoSnapshot) ValidateWithVideo(video *Video) error {
 		return ErrMeasuredAtBeforePublished
 	}
 	return nil
+}
+
+// DriftFrom returns the offset of MeasuredAt from the exact checkpoint time of
+// a video published at publishedAt
+func (s *VideoSnapshot) DriftFrom(publishedAt time.Time) time.Duration {
+	return s.MeasuredAt.Sub(publishedAt.Add(time.Duration(s.CheckpointHour) * time.Hour))
 }
\ No newline at end of file
@@ -4,6 +4,9 @@ import (
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
 )
 
+// YouTubeCategoryOrder is the order YouTube categories are listed in
+const YouTubeCategoryOrder = "id asc"
+
 // YouTubeCategory represents YouTube category reference data
 type YouTubeCategory struct {
 	ID         valueobject.CategoryID // YouTube's category ID (e.g., 27, 28)
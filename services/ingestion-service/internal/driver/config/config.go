@@ -38,6 +38,10 @@ type Config struct {
 	
 	// Pub/Sub configuration
 	PubSubProjectID string
+	
+	// Audit log retention configuration
+	AuditLogRetentionDays int    // Audit logs older than this are purged
+	AuditLogArchiveDir    string // Directory or gs://bucket/prefix purged audit logs are archived to as JSON Lines; empty disables archiving
 }
 
 // Load loads configuration from environment variables
@@ -74,6 +78,10 @@ func Load() *Config {
 		
 		// Pub/Sub
 		PubSubProjectID: getEnv("PUBSUB_PROJECT_ID", ""),
+		
+		// Audit log retention
+		AuditLogRetentionDays: getEnvAsInt("AUDIT_LOG_RETENTION_DAYS", 365),
+		AuditLogArchiveDir:    getEnv("AUDIT_LOG_ARCHIVE_DIR", ""),
 	}
 }
 
@@ -0,0 +1,7 @@
+-- Down migration: drop snapshot gap tracking
+DROP TABLE IF EXISTS ingestion.snapshot_gaps;
+
+UPDATE ingestion.video_snapshots SET source = 'task' WHERE source = 'backfill';
+ALTER TABLE ingestion.video_snapshots DROP CONSTRAINT IF EXISTS video_snapshots_source_check;
+ALTER TABLE ingestion.video_snapshots
+  ADD CONSTRAINT video_snapshots_source_check CHECK (source IN ('websub', 'task', 'manual'));
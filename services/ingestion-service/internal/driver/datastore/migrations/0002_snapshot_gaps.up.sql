@@ -0,0 +1,17 @@
+-- Up migration: snapshot gap tracking and backfilled snapshots
+
+-- Allow late snapshots taken by the snapshot audit
+ALTER TABLE ingestion.video_snapshots DROP CONSTRAINT IF EXISTS video_snapshots_source_check;
+ALTER TABLE ingestion.video_snapshots
+  ADD CONSTRAINT video_snapshots_source_check CHECK (source IN ('websub', 'task', 'manual', 'backfill'));
+
+-- Snapshot gaps table (checkpoints that can no longer be captured)
+CREATE TABLE IF NOT EXISTS ingestion.snapshot_gaps (
+  video_id         uuid NOT NULL REFERENCES ingestion.videos(id) ON DELETE CASCADE,
+  checkpoint_hour  integer NOT NULL,
+  due_at           timestamptz NOT NULL,
+  reason           varchar(50) NOT NULL,
+  detected_at      timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY (video_id, checkpoint_hour)
+);
+CREATE INDEX IF NOT EXISTS snapshot_gaps_due_at_idx ON ingestion.snapshot_gaps(due_at DESC);
@@ -0,0 +1,3 @@
+-- Down migration: drop snapshot drift
+
+ALTER TABLE ingestion.video_snapshots DROP COLUMN IF EXISTS drift_seconds;
@@ -0,0 +1,9 @@
+-- Up migration: record how far each snapshot was measured from its exact checkpoint time
+
+ALTER TABLE ingestion.video_snapshots ADD COLUMN IF NOT EXISTS drift_seconds integer NOT NULL DEFAULT 0;
+
+-- Backfill existing snapshots (measured_at - (published_at + checkpoint_hour))
+UPDATE ingestion.video_snapshots s
+SET drift_seconds = EXTRACT(EPOCH FROM s.measured_at - (v.published_at + s.checkpoint_hour * interval '1 hour'))::integer
+FROM ingestion.videos v
+WHERE v.id = s.video_id;
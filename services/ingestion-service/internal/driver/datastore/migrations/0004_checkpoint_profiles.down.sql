@@ -0,0 +1,21 @@
+-- Down migration: drop checkpoint profiles and restore the fixed checkpoint set
+
+ALTER TABLE ingestion.snapshot_gaps DROP CONSTRAINT IF EXISTS snapshot_gaps_checkpoint_hour_fkey;
+ALTER TABLE ingestion.snapshot_tasks DROP CONSTRAINT IF EXISTS snapshot_tasks_checkpoint_hour_fkey;
+ALTER TABLE ingestion.video_snapshots DROP CONSTRAINT IF EXISTS video_snapshots_checkpoint_hour_fkey;
+
+-- Rows at checkpoints outside the fixed set cannot satisfy the restored constraints
+DELETE FROM ingestion.snapshot_gaps WHERE checkpoint_hour NOT IN (0, 3, 6, 12, 24, 48, 72, 168);
+DELETE FROM ingestion.snapshot_tasks WHERE checkpoint_hour NOT IN (0, 3, 6, 12, 24, 48, 72, 168);
+DELETE FROM ingestion.video_snapshots WHERE checkpoint_hour NOT IN (0, 3, 6, 12, 24, 48, 72, 168);
+
+ALTER TABLE ingestion.snapshot_tasks
+  ADD CONSTRAINT snapshot_tasks_checkpoint_hour_check CHECK (checkpoint_hour IN (0, 3, 6, 12, 24, 48, 72, 168));
+ALTER TABLE ingestion.video_snapshots
+  ADD CONSTRAINT video_snapshots_checkpoint_hour_check CHECK (checkpoint_hour IN (0, 3, 6, 12, 24, 48, 72, 168));
+
+ALTER TABLE ingestion.genres DROP COLUMN IF EXISTS checkpoint_profile_id;
+
+DROP TABLE IF EXISTS ingestion.checkpoint_profile_hours;
+DROP TABLE IF EXISTS ingestion.checkpoint_profiles;
+DROP TABLE IF EXISTS ingestion.checkpoint_hours;
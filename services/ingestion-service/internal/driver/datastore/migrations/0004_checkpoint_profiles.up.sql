@@ -0,0 +1,60 @@
+-- Up migration: per-genre checkpoint profiles
+
+-- Checkpoint hours reference table (replaces the hard-coded CHECK constraints)
+CREATE TABLE IF NOT EXISTS ingestion.checkpoint_hours (
+  hour        integer PRIMARY KEY CHECK (hour >= 0),
+  created_at  timestamptz NOT NULL DEFAULT now()
+);
+INSERT INTO ingestion.checkpoint_hours (hour)
+VALUES (0), (1), (2), (3), (6), (12), (24), (48), (72), (168), (336), (720)
+ON CONFLICT (hour) DO NOTHING;
+
+-- Checkpoint profiles (named schedules that genres opt into)
+CREATE TABLE IF NOT EXISTS ingestion.checkpoint_profiles (
+  id          uuid PRIMARY KEY,
+  code        varchar(50) UNIQUE NOT NULL,
+  name        varchar(100) NOT NULL,
+  created_at  timestamptz NOT NULL DEFAULT now(),
+  updated_at  timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS ingestion.checkpoint_profile_hours (
+  profile_id       uuid NOT NULL REFERENCES ingestion.checkpoint_profiles(id) ON DELETE CASCADE,
+  checkpoint_hour  integer NOT NULL REFERENCES ingestion.checkpoint_hours(hour),
+  PRIMARY KEY (profile_id, checkpoint_hour)
+);
+
+INSERT INTO ingestion.checkpoint_profiles (id, code, name) VALUES
+  ('0198c000-0000-7000-8000-000000000001', 'default', 'Default (0h-7d)'),
+  ('0198c000-0000-7000-8000-000000000002', 'fast_moving', 'Fast moving (1h-7d)'),
+  ('0198c000-0000-7000-8000-000000000003', 'evergreen', 'Evergreen (0h-30d)')
+ON CONFLICT (code) DO NOTHING;
+
+INSERT INTO ingestion.checkpoint_profile_hours (profile_id, checkpoint_hour)
+SELECT p.id, h.hour
+FROM ingestion.checkpoint_profiles p
+CROSS JOIN LATERAL unnest(CASE p.code
+  WHEN 'default'     THEN ARRAY[0, 3, 6, 12, 24, 48, 72, 168]
+  WHEN 'fast_moving' THEN ARRAY[0, 1, 2, 3, 6, 12, 24, 48, 72, 168]
+  WHEN 'evergreen'   THEN ARRAY[0, 3, 6, 12, 24, 48, 72, 168, 336, 720]
+END) AS h(hour)
+ON CONFLICT DO NOTHING;
+
+-- Genres without a profile use the default profile
+ALTER TABLE ingestion.genres
+  ADD COLUMN IF NOT EXISTS checkpoint_profile_id uuid REFERENCES ingestion.checkpoint_profiles(id) ON DELETE SET NULL;
+
+-- Replace hard-coded checkpoint CHECK constraints with the reference table
+ALTER TABLE ingestion.video_snapshots DROP CONSTRAINT IF EXISTS video_snapshots_checkpoint_hour_check;
+ALTER TABLE ingestion.video_snapshots
+  ADD CONSTRAINT video_snapshots_checkpoint_hour_fkey
+  FOREIGN KEY (checkpoint_hour) REFERENCES ingestion.checkpoint_hours(hour);
+
+ALTER TABLE ingestion.snapshot_tasks DROP CONSTRAINT IF EXISTS snapshot_tasks_checkpoint_hour_check;
+ALTER TABLE ingestion.snapshot_tasks
+  ADD CONSTRAINT snapshot_tasks_checkpoint_hour_fkey
+  FOREIGN KEY (checkpoint_hour) REFERENCES ingestion.checkpoint_hours(hour);
+
+ALTER TABLE ingestion.snapshot_gaps
+  ADD CONSTRAINT snapshot_gaps_checkpoint_hour_fkey
+  FOREIGN KEY (checkpoint_hour) REFERENCES ingestion.checkpoint_hours(hour);
@@ -0,0 +1,5 @@
+-- Down migration: drop comment counts and per-snapshot metrics
+
+ALTER TABLE ingestion.video_snapshots
+  DROP COLUMN IF EXISTS metrics,
+  DROP COLUMN IF EXISTS comment_count;
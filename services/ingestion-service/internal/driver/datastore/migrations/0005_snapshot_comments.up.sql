@@ -0,0 +1,10 @@
+-- Up migration: record comment counts and optional per-snapshot metrics
+
+-- comment_count is NULL for snapshots taken before comments were recorded. Those
+-- rows stored the comment count in subscription_count and cannot be told apart
+-- reliably, so they are left unknown rather than guessed.
+-- metrics holds counts not available for every snapshot, keyed by metric name
+-- (e.g. favorite_count).
+ALTER TABLE ingestion.video_snapshots
+  ADD COLUMN IF NOT EXISTS comment_count bigint,
+  ADD COLUMN IF NOT EXISTS metrics       jsonb NOT NULL DEFAULT '{}'::jsonb;
@@ -0,0 +1,6 @@
+-- Down migration: drop video duration and listing indexes
+
+DROP INDEX IF EXISTS ingestion.videos_created_at_id_idx;
+DROP INDEX IF EXISTS ingestion.videos_published_at_id_idx;
+
+ALTER TABLE ingestion.videos DROP COLUMN IF EXISTS duration_seconds;
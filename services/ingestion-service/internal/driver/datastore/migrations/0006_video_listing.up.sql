@@ -0,0 +1,10 @@
+-- Up migration: record video duration and support keyset listing of videos
+
+-- duration_seconds is NULL for videos discovered before durations were recorded
+-- and for videos found through channel search, which does not return one.
+ALTER TABLE ingestion.videos ADD COLUMN IF NOT EXISTS duration_seconds integer;
+
+-- ListVideos pages by (published_at, id) so that videos sharing a publish time are
+-- neither skipped nor repeated
+CREATE INDEX IF NOT EXISTS videos_published_at_id_idx ON ingestion.videos(published_at DESC, id DESC) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS videos_created_at_id_idx ON ingestion.videos(created_at DESC, id DESC) WHERE deleted_at IS NULL;
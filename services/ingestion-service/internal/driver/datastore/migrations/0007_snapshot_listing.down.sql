@@ -0,0 +1,3 @@
+-- Down migration: drop snapshot listing index
+
+DROP INDEX IF EXISTS ingestion.video_snapshots_measured_at_id_idx;
@@ -0,0 +1,4 @@
+-- Up migration: support keyset listing of snapshots across videos
+
+-- ListSnapshots pages by (measured_at, id), oldest first
+CREATE INDEX IF NOT EXISTS video_snapshots_measured_at_id_idx ON ingestion.video_snapshots(measured_at, id);
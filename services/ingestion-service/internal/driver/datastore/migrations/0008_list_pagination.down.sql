@@ -0,0 +1,5 @@
+-- Down migration: drop channel, audit log and batch job listing indexes
+
+DROP INDEX IF EXISTS ingestion.batch_jobs_created_at_id_idx;
+DROP INDEX IF EXISTS ingestion.audit_logs_created_at_id_idx;
+DROP INDEX IF EXISTS ingestion.channels_created_at_id_idx;
@@ -0,0 +1,7 @@
+-- Up migration: support keyset listing of channels, audit logs and batch jobs
+
+-- Listings page by (created_at, id) so that rows sharing a creation time are
+-- neither skipped nor repeated
+CREATE INDEX IF NOT EXISTS channels_created_at_id_idx ON ingestion.channels(created_at DESC, id DESC) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS audit_logs_created_at_id_idx ON ingestion.audit_logs(created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS batch_jobs_created_at_id_idx ON ingestion.batch_jobs(created_at DESC, id DESC);
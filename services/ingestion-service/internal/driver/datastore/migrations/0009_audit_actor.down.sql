@@ -0,0 +1,16 @@
+-- Down migration: restore UUID audit log actors
+
+-- Audit logs are never deleted, so the rollback is refused while logs of
+-- actors whose subject is not a UUID exist
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM ingestion.audit_logs
+        WHERE actor_id !~ '^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$'
+    ) THEN
+        RAISE EXCEPTION 'audit_logs has actors that are not UUIDs; cannot restore the uuid actor_id column';
+    END IF;
+END
+$$;
+
+ALTER TABLE ingestion.audit_logs ALTER COLUMN actor_id TYPE uuid USING actor_id::uuid;
@@ -0,0 +1,5 @@
+-- Up migration: identify audit log actors by ID token subject
+
+-- Actors are the subjects of verified ID tokens, which are provider-issued
+-- strings such as Identity Platform UIDs rather than UUIDs
+ALTER TABLE ingestion.audit_logs ALTER COLUMN actor_id TYPE varchar(128) USING actor_id::text;
@@ -0,0 +1,6 @@
+-- Down migration: drop single-flight leases for batch jobs
+
+-- Jobs skipped because of an overlap are recorded as failed
+UPDATE ingestion.batch_jobs SET status = 'failed' WHERE status = 'skipped_overlap';
+
+DROP TABLE IF EXISTS ingestion.batch_job_locks;
@@ -0,0 +1,15 @@
+-- Up migration: single-flight leases for batch jobs
+
+-- A lease is held by the running job of a job type and scope (such as a genre).
+-- The holder extends expires_at with heartbeats; an expired lease may be taken
+-- over by the next job.
+CREATE TABLE IF NOT EXISTS ingestion.batch_job_locks (
+  lock_key      varchar(255) PRIMARY KEY,
+  job_id        uuid         NOT NULL REFERENCES ingestion.batch_jobs(id) ON DELETE CASCADE,
+  holder        varchar(255) NOT NULL,
+  acquired_at   timestamptz  NOT NULL,
+  heartbeat_at  timestamptz  NOT NULL,
+  expires_at    timestamptz  NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_batch_job_locks_job_id ON ingestion.batch_job_locks(job_id);
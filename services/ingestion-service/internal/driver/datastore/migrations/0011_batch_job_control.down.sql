@@ -0,0 +1,11 @@
+-- Down migration: drop cancellation, retries and progress of batch jobs
+
+-- Cancelled jobs are recorded as failed
+UPDATE ingestion.batch_jobs SET status = 'failed' WHERE status = 'cancelled';
+
+ALTER TABLE ingestion.batch_jobs
+  DROP COLUMN IF EXISTS progress_current,
+  DROP COLUMN IF EXISTS progress_total,
+  DROP COLUMN IF EXISTS progress_processed,
+  DROP COLUMN IF EXISTS retry_of,
+  DROP COLUMN IF EXISTS cancel_requested_at;
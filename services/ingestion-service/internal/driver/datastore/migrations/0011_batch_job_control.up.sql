@@ -0,0 +1,10 @@
+-- Up migration: cancellation, retries and progress of batch jobs
+
+-- cancel_requested_at is set by CancelBatchJob; the runner observes it and
+-- stops the job as cancelled. retry_of links a re-run to the job it repeats.
+ALTER TABLE ingestion.batch_jobs
+  ADD COLUMN IF NOT EXISTS cancel_requested_at timestamptz,
+  ADD COLUMN IF NOT EXISTS retry_of            uuid REFERENCES ingestion.batch_jobs(id) ON DELETE SET NULL,
+  ADD COLUMN IF NOT EXISTS progress_processed  integer NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS progress_total      integer NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS progress_current    varchar(255);
@@ -0,0 +1,12 @@
+-- Down migration: drop deprecation comments of legacy keywords
+
+COMMENT ON TABLE ingestion.keyword_items IS NULL;
+COMMENT ON TABLE ingestion.keyword_groups IS NULL;
+
+DO $$
+BEGIN
+  IF to_regclass('ingestion.keywords') IS NOT NULL THEN
+    COMMENT ON TABLE ingestion.keywords IS NULL;
+  END IF;
+END
+$$;
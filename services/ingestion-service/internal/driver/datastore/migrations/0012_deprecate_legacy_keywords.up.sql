@@ -0,0 +1,15 @@
+-- Up migration: deprecate legacy single-regex keywords
+
+-- Keyword groups (keyword_groups and keyword_items) replace the legacy
+-- ingestion.keywords table, whose RPCs are deprecated. Older databases may
+-- still have the table, so it is kept and only marked deprecated.
+DO $$
+BEGIN
+  IF to_regclass('ingestion.keywords') IS NOT NULL THEN
+    COMMENT ON TABLE ingestion.keywords IS 'Deprecated: replaced by keyword_groups and keyword_items';
+  END IF;
+END
+$$;
+
+COMMENT ON TABLE ingestion.keyword_groups IS 'Named keyword sets of a genre; an include or exclude filter matched against target_field';
+COMMENT ON TABLE ingestion.keyword_items IS 'Keywords of a keyword group';
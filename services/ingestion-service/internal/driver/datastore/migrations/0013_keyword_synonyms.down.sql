@@ -0,0 +1,9 @@
+-- Down migration: synonym dictionary for keyword pattern generation
+
+ALTER TABLE ingestion.keyword_groups
+  DROP COLUMN IF EXISTS cross_language;
+
+DROP TRIGGER IF EXISTS keyword_synonyms_version ON ingestion.keyword_synonyms;
+DROP FUNCTION IF EXISTS ingestion.bump_keyword_synonym_version();
+DROP TABLE IF EXISTS ingestion.keyword_synonym_version;
+DROP TABLE IF EXISTS ingestion.keyword_synonyms;
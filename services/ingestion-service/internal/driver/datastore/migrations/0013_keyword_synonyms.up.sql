@@ -0,0 +1,69 @@
+-- Up migration: synonym dictionary for keyword pattern generation
+
+-- Each entry maps a term to an alias the generated pattern also matches.
+-- Bidirectional entries also map the alias back to the term. Entries whose
+-- alias_language differs from language are cross-language and only apply to
+-- keyword groups with cross_language set.
+CREATE TABLE IF NOT EXISTS ingestion.keyword_synonyms (
+  id             uuid PRIMARY KEY,
+  language       varchar(8)   NOT NULL,
+  term           varchar(255) NOT NULL,
+  alias          varchar(255) NOT NULL,
+  alias_language varchar(8)   NOT NULL,
+  bidirectional  boolean      NOT NULL DEFAULT true,
+  created_at     timestamptz  NOT NULL DEFAULT now(),
+  updated_at     timestamptz
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS keyword_synonyms_entry_uniq
+  ON ingestion.keyword_synonyms (language, lower(term), alias_language, lower(alias));
+
+-- The dictionary version is bumped by every change to keyword_synonyms, so
+-- cached patterns are regenerated when the dictionary changes
+CREATE TABLE IF NOT EXISTS ingestion.keyword_synonym_version (
+  singleton  boolean PRIMARY KEY DEFAULT true CHECK (singleton),
+  version    bigint      NOT NULL DEFAULT 0,
+  updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+INSERT INTO ingestion.keyword_synonym_version (singleton, version)
+VALUES (true, 0)
+ON CONFLICT (singleton) DO NOTHING;
+
+CREATE OR REPLACE FUNCTION ingestion.bump_keyword_synonym_version() RETURNS trigger AS $$
+BEGIN
+  UPDATE ingestion.keyword_synonym_version
+  SET version = version + 1, updated_at = now()
+  WHERE singleton;
+  RETURN NULL;
+END
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS keyword_synonyms_version ON ingestion.keyword_synonyms;
+CREATE TRIGGER keyword_synonyms_version
+  AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON ingestion.keyword_synonyms
+  FOR EACH STATEMENT EXECUTE FUNCTION ingestion.bump_keyword_synonym_version();
+
+ALTER TABLE ingestion.keyword_groups
+  ADD COLUMN IF NOT EXISTS cross_language boolean NOT NULL DEFAULT false;
+
+-- The synonyms previously built into the pattern generator
+INSERT INTO ingestion.keyword_synonyms (id, language, term, alias, alias_language, bidirectional)
+VALUES
+  (gen_random_uuid(), 'en', 'javascript',             'js',         'en', true),
+  (gen_random_uuid(), 'en', 'typescript',             'ts',         'en', true),
+  (gen_random_uuid(), 'en', 'kubernetes',             'k8s',        'en', true),
+  (gen_random_uuid(), 'en', 'internationalization',   'i18n',       'en', true),
+  (gen_random_uuid(), 'en', 'localization',           'l10n',       'en', true),
+  (gen_random_uuid(), 'en', 'continuous integration', 'ci',         'en', true),
+  (gen_random_uuid(), 'en', 'continuous deployment',  'cd',         'en', true),
+  (gen_random_uuid(), 'en', 'continuous delivery',    'cd',         'en', true),
+  (gen_random_uuid(), 'ja', 'プログラミング',           'プログラム',   'ja', true),
+  (gen_random_uuid(), 'ja', 'アプリケーション',         'アプリ',       'ja', true),
+  (gen_random_uuid(), 'ja', 'インフラストラクチャー',   'インフラ',     'ja', true),
+  (gen_random_uuid(), 'ja', 'フロントエンド',           'フロント',     'ja', true),
+  (gen_random_uuid(), 'ja', 'バックエンド',             'バック',       'ja', true)
+ON CONFLICT DO NOTHING;
+
+COMMENT ON TABLE ingestion.keyword_synonyms IS 'Synonym and alias dictionary used to generate keyword group patterns';
+COMMENT ON COLUMN ingestion.keyword_groups.cross_language IS 'Apply cross-language synonyms, such as パイソン for Python';
@@ -0,0 +1,4 @@
+-- Down migration: romaji spellings for keyword groups
+
+ALTER TABLE ingestion.keyword_groups
+  DROP COLUMN IF EXISTS romaji;
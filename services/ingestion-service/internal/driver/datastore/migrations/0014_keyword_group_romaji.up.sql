@@ -0,0 +1,10 @@
+-- Romaji spellings for keyword groups
+--
+-- Titles and keywords are normalized (NFKC, long vowel marks) before they are
+-- matched, which needs no schema change. Matching the romaji spellings of kana
+-- keywords, such as ramen for ラーメン, can match unrelated English words, so
+-- groups opt in.
+ALTER TABLE ingestion.keyword_groups
+  ADD COLUMN IF NOT EXISTS romaji boolean NOT NULL DEFAULT false;
+
+COMMENT ON COLUMN ingestion.keyword_groups.romaji IS 'Also match romaji spellings of kana keywords, such as ramen for ラーメン';
@@ -0,0 +1,4 @@
+-- Down migration: match provenance for video-genre assignments
+
+ALTER TABLE ingestion.video_genres
+  DROP COLUMN IF EXISTS match_provenance;
@@ -0,0 +1,10 @@
+-- Match provenance for video-genre assignments
+--
+-- Why a video was put in a genre: the result of the keyword filters of the
+-- genre when the video was assigned, with every keyword group that matched,
+-- the field and offsets of each match, and the inclusions that exclusions
+-- overrode. Assignments made before this migration have none.
+ALTER TABLE ingestion.video_genres
+  ADD COLUMN IF NOT EXISTS match_provenance jsonb;
+
+COMMENT ON COLUMN ingestion.video_genres.match_provenance IS 'Keyword filter result and matches when the video was assigned to the genre';
@@ -0,0 +1,4 @@
+-- Down migration: indexes for filtering audit logs by changed field
+
+DROP INDEX IF EXISTS ingestion.audit_logs_new_values_idx;
+DROP INDEX IF EXISTS ingestion.audit_logs_old_values_idx;
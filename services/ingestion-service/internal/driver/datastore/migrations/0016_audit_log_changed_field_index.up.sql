@@ -0,0 +1,11 @@
+-- Indexes for filtering audit logs by changed field
+--
+-- The changed_field filter of ListAuditLogs and ExportAuditLogs tests for a
+-- key in old_values or new_values with the jsonb ? operator, which the default
+-- GIN operator class supports. Each side has its own index so the two tests
+-- are combined with a bitmap OR.
+--
+-- The indexes depend only on ingestion.audit_logs from 0001 and 0009, so this
+-- migration may apply before or after 0010-0015 with the same result.
+CREATE INDEX IF NOT EXISTS audit_logs_old_values_idx ON ingestion.audit_logs USING gin (old_values);
+CREATE INDEX IF NOT EXISTS audit_logs_new_values_idx ON ingestion.audit_logs USING gin (new_values);
@@ -0,0 +1,101 @@
+package grpc
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	pb "github.com/YukiOnishi1129/youtube-analytics/services/pkg/pb/ingestion/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+)
+
+// DryRunHeader is the metadata key of calls whose changes must only be
+// validated and reported, not persisted
+const DryRunHeader = "x-dry-run"
+
+// DryRunChangesHeader is the response header listing the number of changes a
+// dry run planned per action and resource, e.g. "insert video: 3"
+const DryRunChangesHeader = "x-dry-run-changes"
+
+// dryRunMethods are the RPCs whose use cases honor dry runs. A dry run of any
+// other RPC is rejected rather than applied for real.
+var dryRunMethods = map[string]bool{
+	pb.IngestionService_SubscribeChannel_FullMethodName:           true,
+	pb.IngestionService_UnsubscribeChannel_FullMethodName:         true,
+	pb.IngestionService_CreateGenre_FullMethodName:                true,
+	pb.IngestionService_UpdateGenre_FullMethodName:                true,
+	pb.IngestionService_EnableGenre_FullMethodName:                true,
+	pb.IngestionService_DisableGenre_FullMethodName:               true,
+	pb.IngestionService_CreateKeyword_FullMethodName:              true,
+	pb.IngestionService_UpdateKeyword_FullMethodName:              true,
+	pb.IngestionService_EnableKeyword_FullMethodName:              true,
+	pb.IngestionService_DisableKeyword_FullMethodName:             true,
+	pb.IngestionService_DeleteKeyword_FullMethodName:              true,
+	pb.IngestionService_CreateKeywordGroup_FullMethodName:         true,
+	pb.IngestionService_UpdateKeywordGroup_FullMethodName:         true,
+	pb.IngestionService_UpdateKeywordGroupKeywords_FullMethodName: true,
+	pb.IngestionService_AddKeywordGroupItem_FullMethodName:        true,
+	pb.IngestionService_RemoveKeywordGroupItem_FullMethodName:     true,
+	pb.IngestionService_EnableKeywordGroup_FullMethodName:         true,
+	pb.IngestionService_DisableKeywordGroup_FullMethodName:        true,
+	pb.IngestionService_DeleteKeywordGroup_FullMethodName:         true,
+	pb.IngestionService_CreateKeywordSynonym_FullMethodName:       true,
+	pb.IngestionService_UpdateKeywordSynonym_FullMethodName:       true,
+	pb.IngestionService_DeleteKeywordSynonym_FullMethodName:       true,
+	// Collection still reads YouTube but only plans its writes and events
+	pb.IngestionService_CollectTrending_FullMethodName:        true,
+	pb.IngestionService_CollectTrendingByGenre_FullMethodName: true,
+	pb.IngestionService_CollectAllTrending_FullMethodName:     true,
+	pb.IngestionService_CollectSubscriptions_FullMethodName:   true,
+	pb.IngestionService_UpdateChannels_FullMethodName:         true,
+	pb.IngestionService_ScheduleSnapshots_FullMethodName:      true,
+	pb.IngestionService_CreateSnapshot_FullMethodName:         true,
+}
+
+// UnaryDryRunInterceptor marks the context of calls sent with the x-dry-run
+// header as a dry run and returns the summary of the planned changes in the
+// x-dry-run-changes header
+func UnaryDryRunInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		dryRun, err := dryRunRequested(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !dryRun {
+			return handler(ctx, req)
+		}
+		if !dryRunMethods[info.FullMethod] {
+			return nil, status.Errorf(codes.InvalidArgument, "%s does not support dry runs", info.FullMethod)
+		}
+		report := &domain.DryRunReport{}
+		resp, err := handler(domain.WithDryRunReport(ctx, report), req)
+		if summary := report.Summary(); len(summary) > 0 {
+			if herr := grpc.SetHeader(ctx, metadata.MD{DryRunChangesHeader: summary}); herr != nil {
+				log.Printf("Failed to send planned changes of %s: %v", info.FullMethod, herr)
+			}
+		}
+		return resp, err
+	}
+}
+
+// dryRunRequested reports whether the call carries a true x-dry-run header
+func dryRunRequested(ctx context.Context) (bool, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false, nil
+	}
+	vals := md.Get(DryRunHeader)
+	if len(vals) == 0 {
+		return false, nil
+	}
+	dryRun, err := strconv.ParseBool(vals[0])
+	if err != nil {
+		return false, status.Errorf(codes.InvalidArgument, "invalid %s header %q", DryRunHeader, vals[0])
+	}
+	return dryRun, nil
+}
@@ -2,15 +2,18 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net"
+	"time"
 
 	pb "github.com/YukiOnishi1129/youtube-analytics/services/pkg/pb/ingestion/v1"
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/input"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -23,12 +26,15 @@ type Server struct {
 	channelUseCase         input.ChannelInputPort
 	videoUseCase           input.VideoInputPort
 	systemUseCase          input.SystemInputPort
-	keywordUseCase         input.KeywordInputPort         // Optional keyword use case
-	genreUseCase           input.GenreInputPort           // Genre use case
-	youtubeCategoryUseCase input.YouTubeCategoryInputPort // YouTube category use case
-	videoGenreUseCase      input.VideoGenreInputPort      // Video-Genre use case
-	auditLogUseCase        input.AuditLogInputPort        // Audit log use case
-	batchJobUseCase        input.BatchJobInputPort        // Batch job use case
+	keywordUseCase         input.KeywordInputPort            // Optional keyword use case
+	keywordGroupUseCase    input.KeywordGroupInputPort       // Keyword group use case
+	keywordGroupTester     input.KeywordGroupTesterInputPort // Keyword group tester use case
+	keywordSynonymUseCase  input.KeywordSynonymInputPort     // Synonym dictionary use case
+	genreUseCase           input.GenreInputPort              // Genre use case
+	youtubeCategoryUseCase input.YouTubeCategoryInputPort    // YouTube category use case
+	videoGenreUseCase      input.VideoGenreInputPort         // Video-Genre use case
+	auditLogUseCase        input.AuditLogInputPort           // Audit log use case
+	batchJobUseCase        input.BatchJobInputPort           // Batch job use case
 }
 
 func NewServer(
@@ -64,6 +70,9 @@ func NewServerWithAllUseCases(
 	videoUseCase input.VideoInputPort,
 	systemUseCase input.SystemInputPort,
 	keywordUseCase input.KeywordInputPort,
+	keywordGroupUseCase input.KeywordGroupInputPort,
+	keywordGroupTester input.KeywordGroupTesterInputPort,
+	keywordSynonymUseCase input.KeywordSynonymInputPort,
 	genreUseCase input.GenreInputPort,
 	youtubeCategoryUseCase input.YouTubeCategoryInputPort,
 	videoGenreUseCase input.VideoGenreInputPort,
@@ -75,6 +84,9 @@ func NewServerWithAllUseCases(
 		videoUseCase:           videoUseCase,
 		systemUseCase:          systemUseCase,
 		keywordUseCase:         keywordUseCase,
+		keywordGroupUseCase:    keywordGroupUseCase,
+		keywordGroupTester:     keywordGroupTester,
+		keywordSynonymUseCase:  keywordSynonymUseCase,
 		genreUseCase:           genreUseCase,
 		youtubeCategoryUseCase: youtubeCategoryUseCase,
 		videoGenreUseCase:      videoGenreUseCase,
@@ -122,18 +134,62 @@ func (s *Server) GetChannel(ctx context.Context, req *pb.GetChannelRequest) (*pb
 }
 
 func (s *Server) ListChannels(ctx context.Context, req *pb.ListChannelsRequest) (*pb.ListChannelsResponse, error) {
-	channels, err := s.channelUseCase.ListChannels(ctx, req.SubscribedOnly)
+	filter := domain.ChannelFilter{Query: req.Query}
+	if req.SubscribedOnly {
+		subscribed := true
+		filter.Subscribed = &subscribed
+	}
+
+	result, err := s.channelUseCase.ListChannels(ctx, &input.ListChannelsInput{
+		Filter:    filter,
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+	})
 	if err != nil {
+		if err == valueobject.ErrInvalidPageToken {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to list channels: %v", err))
 	}
 
-	protoChannels := make([]*pb.Channel, len(channels))
-	for i, channel := range channels {
+	protoChannels := make([]*pb.Channel, len(result.Channels))
+	for i, channel := range result.Channels {
 		protoChannels[i] = domainChannelToProto(channel)
 	}
 
 	return &pb.ListChannelsResponse{
-		Channels: protoChannels,
+		Channels:      protoChannels,
+		NextPageToken: result.NextPageToken,
+		TotalCount:    int32(result.TotalCount),
+	}, nil
+}
+
+// GetChannelGrowth returns the daily growth series of a channel
+func (s *Server) GetChannelGrowth(ctx context.Context, req *pb.GetChannelGrowthRequest) (*pb.GetChannelGrowthResponse, error) {
+	if req.ChannelId == "" {
+		return nil, status.Error(codes.InvalidArgument, "channel_id is required")
+	}
+
+	channelID, err := uuid.Parse(req.ChannelId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid channel_id format")
+	}
+
+	points, err := s.channelUseCase.GetChannelGrowth(ctx, channelID, int(req.Limit))
+	if err != nil {
+		if err == domain.ErrChannelNotFound {
+			return nil, status.Error(codes.NotFound, "channel not found")
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get channel growth: %v", err))
+	}
+
+	protoPoints := make([]*pb.ChannelGrowthPoint, len(points))
+	for i, point := range points {
+		protoPoints[i] = domainChannelGrowthPointToProto(point)
+	}
+
+	return &pb.GetChannelGrowthResponse{
+		Points: protoPoints,
 	}, nil
 }
 
@@ -145,6 +201,9 @@ func (s *Server) CollectTrending(ctx context.Context, req *pb.CollectTrendingReq
 
 	result, err := s.videoUseCase.CollectTrending(ctx, genreID)
 	if err != nil {
+		if errors.Is(err, domain.ErrBatchJobOverlap) {
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to collect trending videos: %v", err))
 	}
 
@@ -185,8 +244,11 @@ func (s *Server) CreateSnapshot(ctx context.Context, req *pb.CreateSnapshotReque
 		CheckpointHour: int(req.CheckpointHour),
 	})
 	if err != nil {
-		if err == domain.ErrVideoNotFound {
+		switch err {
+		case domain.ErrVideoNotFound:
 			return nil, status.Error(codes.NotFound, "video not found")
+		case domain.ErrSnapshotAlreadyExists:
+			return nil, status.Error(codes.AlreadyExists, "snapshot already exists")
 		}
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to create snapshot: %v", err))
 	}
@@ -196,9 +258,146 @@ func (s *Server) CreateSnapshot(ctx context.Context, req *pb.CreateSnapshotReque
 	}, nil
 }
 
+// GetSnapshot returns the snapshot of a video at a checkpoint
+func (s *Server) GetSnapshot(ctx context.Context, req *pb.GetSnapshotRequest) (*pb.GetSnapshotResponse, error) {
+	if req.VideoId == "" {
+		return nil, status.Error(codes.InvalidArgument, "video_id is required")
+	}
+
+	videoID, err := uuid.Parse(req.VideoId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid video_id format")
+	}
+
+	snapshot, err := s.systemUseCase.GetSnapshot(ctx, videoID, int(req.CheckpointHour))
+	if err != nil {
+		switch err {
+		case domain.ErrInvalidCheckpoint:
+			return nil, status.Error(codes.InvalidArgument, "invalid checkpoint_hour")
+		case domain.ErrSnapshotNotFound:
+			return nil, status.Error(codes.NotFound, "snapshot not found")
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get snapshot: %v", err))
+	}
+
+	return &pb.GetSnapshotResponse{
+		Snapshot: domainSnapshotToProto(snapshot),
+	}, nil
+}
+
+// ListSnapshots lists snapshots across videos, oldest measurement first
+func (s *Server) ListSnapshots(ctx context.Context, req *pb.ListSnapshotsRequest) (*pb.ListSnapshotsResponse, error) {
+	filter, err := snapshotFilterFromProto(req.VideoId, req.GenreId, req.CheckpointHour, req.MeasuredAfter, req.MeasuredBefore)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.systemUseCase.ListSnapshots(ctx, &input.ListSnapshotsInput{
+		Filter:    filter,
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+	})
+	if err != nil {
+		if err == valueobject.ErrInvalidPageToken {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to list snapshots: %v", err))
+	}
+
+	snapshots := make([]*pb.VideoSnapshot, len(result.Snapshots))
+	for i, snapshot := range result.Snapshots {
+		snapshots[i] = domainSnapshotToProto(snapshot)
+	}
+
+	return &pb.ListSnapshotsResponse{
+		Snapshots:     snapshots,
+		NextPageToken: result.NextPageToken,
+	}, nil
+}
+
+// StreamSnapshots streams snapshots across videos in batches, oldest measurement
+// first. Send blocks while the client's flow-control window is full, which holds
+// back reading the next batch from the database.
+func (s *Server) StreamSnapshots(req *pb.StreamSnapshotsRequest, stream pb.IngestionService_StreamSnapshotsServer) error {
+	filter, err := snapshotFilterFromProto(req.VideoId, req.GenreId, req.CheckpointHour, req.MeasuredAfter, req.MeasuredBefore)
+	if err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	err = s.systemUseCase.StreamSnapshots(ctx, &input.ListSnapshotsInput{
+		Filter:    filter,
+		PageSize:  int(req.BatchSize),
+		PageToken: req.ResumeToken,
+	}, func(batch *input.SnapshotBatch) error {
+		snapshots := make([]*pb.VideoSnapshot, len(batch.Snapshots))
+		for i, snapshot := range batch.Snapshots {
+			snapshots[i] = domainSnapshotToProto(snapshot)
+		}
+		return stream.Send(&pb.StreamSnapshotsResponse{
+			Snapshots:   snapshots,
+			ResumeToken: batch.ResumeToken,
+		})
+	})
+	if err != nil {
+		if err == valueobject.ErrInvalidPageToken {
+			return status.Error(codes.InvalidArgument, "invalid resume_token")
+		}
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return status.Error(codes.Internal, fmt.Sprintf("failed to stream snapshots: %v", err))
+	}
+	return nil
+}
+
+// snapshotFilterFromProto builds a snapshot filter from request fields, returning
+// an InvalidArgument status for malformed values
+func snapshotFilterFromProto(videoID, genreID string, checkpointHour *int32, measuredAfter, measuredBefore *timestamppb.Timestamp) (domain.SnapshotFilter, error) {
+	var filter domain.SnapshotFilter
+	if videoID != "" {
+		id, err := uuid.Parse(videoID)
+		if err != nil {
+			return filter, status.Error(codes.InvalidArgument, "invalid video_id format")
+		}
+		filter.VideoID = valueobject.UUID(id.String())
+	}
+	if genreID != "" {
+		id, err := uuid.Parse(genreID)
+		if err != nil {
+			return filter, status.Error(codes.InvalidArgument, "invalid genre_id format")
+		}
+		filter.GenreID = valueobject.UUID(id.String())
+	}
+	if checkpointHour != nil {
+		cp := valueobject.CheckpointHour(*checkpointHour)
+		if !cp.IsValid() {
+			return filter, status.Error(codes.InvalidArgument, "invalid checkpoint_hour")
+		}
+		filter.CheckpointHour = &cp
+	}
+	if measuredAfter != nil {
+		after := measuredAfter.AsTime()
+		filter.MeasuredAfter = &after
+	}
+	if measuredBefore != nil {
+		before := measuredBefore.AsTime()
+		filter.MeasuredBefore = &before
+	}
+	return filter, nil
+}
+
 func (s *Server) ScheduleSnapshots(ctx context.Context, req *pb.ScheduleSnapshotsRequest) (*pb.ScheduleSnapshotsResponse, error) {
-	result, err := s.systemUseCase.ScheduleSnapshots(ctx)
+	result, err := s.systemUseCase.ScheduleSnapshots(ctx, &input.ScheduleSnapshotsInput{
+		Window: time.Duration(req.Hours) * time.Hour,
+	})
 	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to schedule snapshots: %v", err))
 	}
 
@@ -206,6 +405,7 @@ func (s *Server) ScheduleSnapshots(ctx context.Context, req *pb.ScheduleSnapshot
 		VideosProcessed: int32(result.VideosProcessed),
 		TasksScheduled:  int32(result.TasksScheduled),
 		DurationMs:      result.Duration.Milliseconds(),
+		PublishedSince:  timestamppb.New(result.PublishedSince),
 	}, nil
 }
 
@@ -219,32 +419,153 @@ func (s *Server) UpdateChannels(ctx context.Context, req *pb.UpdateChannelsReque
 		ChannelsProcessed: int32(result.ChannelsProcessed),
 		ChannelsUpdated:   int32(result.ChannelsUpdated),
 		DurationMs:        result.Duration.Milliseconds(),
+		SnapshotsTaken:    int32(result.SnapshotsTaken),
 	}, nil
 }
 
 // Unimplemented methods
+// SubscribeChannel subscribes to a channel by its YouTube ID
 func (s *Server) SubscribeChannel(ctx context.Context, req *pb.SubscribeChannelRequest) (*pb.SubscribeChannelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubscribeChannel not implemented")
+	if req.YoutubeChannelId == "" {
+		return nil, status.Error(codes.InvalidArgument, "youtube channel id is required")
+	}
+
+	channel, err := s.channelUseCase.SubscribeChannel(ctx, req.YoutubeChannelId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to subscribe channel: %v", err))
+	}
+
+	return &pb.SubscribeChannelResponse{
+		Channel: domainChannelToProto(channel),
+	}, nil
 }
 
+// UnsubscribeChannel unsubscribes from a channel
 func (s *Server) UnsubscribeChannel(ctx context.Context, req *pb.UnsubscribeChannelRequest) (*pb.UnsubscribeChannelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeChannel not implemented")
+	channelID, err := uuid.Parse(req.ChannelId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid channel id format")
+	}
+
+	channel, err := s.channelUseCase.UnsubscribeChannel(ctx, channelID)
+	if err != nil {
+		if err == domain.ErrChannelNotFound {
+			return nil, status.Error(codes.NotFound, "channel not found")
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to unsubscribe channel: %v", err))
+	}
+
+	return &pb.UnsubscribeChannelResponse{
+		Channel: domainChannelToProto(channel),
+	}, nil
 }
 
+// Video operations
+
+// GetVideo returns a video with its channel, genres and latest snapshot
 func (s *Server) GetVideo(ctx context.Context, req *pb.GetVideoRequest) (*pb.GetVideoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVideo not implemented")
+	if s.videoUseCase == nil {
+		return nil, status.Error(codes.Unimplemented, "video use case not available")
+	}
+
+	videoID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid video ID")
+	}
+
+	detail, err := s.videoUseCase.GetVideo(ctx, videoID)
+	if err != nil {
+		if err == domain.ErrVideoNotFound {
+			return nil, status.Error(codes.NotFound, "video not found")
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get video: %v", err))
+	}
+
+	video := domainVideoToProto(detail.Video)
+	genres := make([]*pb.Genre, len(detail.Genres))
+	for i, genre := range detail.Genres {
+		genres[i] = domainGenreToProto(genre)
+		video.GenreIds = append(video.GenreIds, string(genre.ID))
+	}
+
+	resp := &pb.GetVideoResponse{
+		Video:  video,
+		Genres: genres,
+	}
+	if detail.Channel != nil {
+		resp.Channel = domainChannelToProto(detail.Channel)
+	}
+	if detail.LatestSnapshot != nil {
+		resp.LatestSnapshot = domainSnapshotToProto(detail.LatestSnapshot)
+	}
+	return resp, nil
 }
 
+// ListVideos lists videos matching the request filters, one page at a time
 func (s *Server) ListVideos(ctx context.Context, req *pb.ListVideosRequest) (*pb.ListVideosResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListVideos not implemented")
-}
+	if s.videoUseCase == nil {
+		return nil, status.Error(codes.Unimplemented, "video use case not available")
+	}
 
-func (s *Server) GetSnapshot(ctx context.Context, req *pb.GetSnapshotRequest) (*pb.GetSnapshotResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSnapshot not implemented")
-}
+	order, err := domain.ParseVideoOrder(req.OrderBy)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid order_by")
+	}
 
-func (s *Server) ListSnapshots(ctx context.Context, req *pb.ListSnapshotsRequest) (*pb.ListSnapshotsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
+	filter := domain.VideoFilter{
+		Shorts: req.Shorts,
+		Query:  req.Query,
+	}
+	if req.ChannelId != "" {
+		channelID, err := uuid.Parse(req.ChannelId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid channel ID")
+		}
+		filter.ChannelID = valueobject.UUID(channelID.String())
+	}
+	if req.GenreId != "" {
+		genreID, err := uuid.Parse(req.GenreId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid genre ID")
+		}
+		filter.GenreID = valueobject.UUID(genreID.String())
+	}
+	if req.CategoryId != nil {
+		categoryID := valueobject.CategoryID(*req.CategoryId)
+		filter.CategoryID = &categoryID
+	}
+	if req.PublishedAfter != nil {
+		after := req.PublishedAfter.AsTime()
+		filter.PublishedAfter = &after
+	}
+	if req.PublishedBefore != nil {
+		before := req.PublishedBefore.AsTime()
+		filter.PublishedBefore = &before
+	}
+
+	result, err := s.videoUseCase.ListVideos(ctx, &input.ListVideosInput{
+		Filter:    filter,
+		Order:     order,
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+	})
+	if err != nil {
+		if err == valueobject.ErrInvalidPageToken {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to list videos: %v", err))
+	}
+
+	videos := make([]*pb.Video, len(result.Videos))
+	for i, video := range result.Videos {
+		videos[i] = domainVideoToProto(video)
+	}
+
+	return &pb.ListVideosResponse{
+		Videos:        videos,
+		NextPageToken: result.NextPageToken,
+		TotalCount:    int32(result.TotalCount),
+	}, nil
 }
 
 // Genre operations
@@ -254,27 +575,27 @@ func (s *Server) ListGenres(ctx context.Context, req *pb.ListGenresRequest) (*pb
 		return nil, status.Error(codes.Unimplemented, "genre use case not available")
 	}
 
-	var genres []*domain.Genre
-	var err error
-
-	if req.EnabledOnly {
-		genres, err = s.genreUseCase.ListEnabledGenres(ctx)
-	} else {
-		genres, err = s.genreUseCase.ListGenres(ctx)
-	}
-
+	result, err := s.genreUseCase.ListGenres(ctx, &input.ListGenresInput{
+		EnabledOnly: req.EnabledOnly,
+		PageSize:    int(req.PageSize),
+		PageToken:   req.PageToken,
+	})
 	if err != nil {
+		if err == valueobject.ErrInvalidPageToken {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	protoGenres := make([]*pb.Genre, len(genres))
-	for i, genre := range genres {
+	protoGenres := make([]*pb.Genre, len(result.Genres))
+	for i, genre := range result.Genres {
 		protoGenres[i] = domainGenreToProto(genre)
 	}
 
 	return &pb.ListGenresResponse{
-		Genres:     protoGenres,
-		TotalCount: int32(len(genres)),
+		Genres:        protoGenres,
+		NextPageToken: result.NextPageToken,
+		TotalCount:    int32(result.TotalCount),
 	}, nil
 }
 
@@ -331,14 +652,15 @@ func (s *Server) CreateGenre(ctx context.Context, req *pb.CreateGenreRequest) (*
 	}
 
 	genre, err := s.genreUseCase.CreateGenre(ctx, &input.CreateGenreInput{
-		Code:        req.Code,
-		Name:        req.Name,
-		Language:    req.Language,
-		RegionCode:  req.RegionCode,
-		CategoryIDs: categoryIDs,
+		Code:              req.Code,
+		Name:              req.Name,
+		Language:          req.Language,
+		RegionCode:        req.RegionCode,
+		CategoryIDs:       categoryIDs,
+		CheckpointProfile: req.CheckpointProfile,
 	})
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, genreError(err)
 	}
 
 	return &pb.CreateGenreResponse{
@@ -363,15 +685,13 @@ func (s *Server) UpdateGenre(ctx context.Context, req *pb.UpdateGenreRequest) (*
 	}
 
 	genre, err := s.genreUseCase.UpdateGenre(ctx, &input.UpdateGenreInput{
-		GenreID:     genreID,
-		Name:        req.Name,
-		CategoryIDs: categoryIDs,
+		GenreID:           genreID,
+		Name:              req.Name,
+		CategoryIDs:       categoryIDs,
+		CheckpointProfile: req.CheckpointProfile,
 	})
 	if err != nil {
-		if err == domain.ErrNotFound {
-			return nil, status.Error(codes.NotFound, "genre not found")
-		}
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, genreError(err)
 	}
 
 	return &pb.UpdateGenreResponse{
@@ -379,6 +699,18 @@ func (s *Server) UpdateGenre(ctx context.Context, req *pb.UpdateGenreRequest) (*
 	}, nil
 }
 
+// genreError maps genre use case errors to gRPC status errors
+func genreError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return status.Error(codes.NotFound, "genre not found")
+	case errors.Is(err, domain.ErrInvalidInput):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
 func (s *Server) EnableGenre(ctx context.Context, req *pb.EnableGenreRequest) (*pb.EnableGenreResponse, error) {
 	if s.genreUseCase == nil {
 		return nil, status.Error(codes.Unimplemented, "genre use case not available")
@@ -432,27 +764,27 @@ func (s *Server) ListYouTubeCategories(ctx context.Context, req *pb.ListYouTubeC
 		return nil, status.Error(codes.Unimplemented, "youtube category use case not available")
 	}
 
-	var categories []*domain.YouTubeCategory
-	var err error
-
-	if req.AssignableOnly {
-		categories, err = s.youtubeCategoryUseCase.ListAssignableYouTubeCategories(ctx)
-	} else {
-		categories, err = s.youtubeCategoryUseCase.ListYouTubeCategories(ctx)
-	}
-
+	result, err := s.youtubeCategoryUseCase.ListYouTubeCategories(ctx, &input.ListYouTubeCategoriesInput{
+		AssignableOnly: req.AssignableOnly,
+		PageSize:       int(req.PageSize),
+		PageToken:      req.PageToken,
+	})
 	if err != nil {
+		if err == valueobject.ErrInvalidPageToken {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	protoCategories := make([]*pb.YouTubeCategory, len(categories))
-	for i, category := range categories {
+	protoCategories := make([]*pb.YouTubeCategory, len(result.Categories))
+	for i, category := range result.Categories {
 		protoCategories[i] = domainYouTubeCategoryToProto(category)
 	}
 
 	return &pb.ListYouTubeCategoriesResponse{
-		Categories: protoCategories,
-		TotalCount: int32(len(categories)),
+		Categories:    protoCategories,
+		NextPageToken: result.NextPageToken,
+		TotalCount:    int32(result.TotalCount),
 	}, nil
 }
 
@@ -526,30 +858,30 @@ func (s *Server) ListKeywords(ctx context.Context, req *pb.ListKeywordsRequest)
 		return nil, status.Error(codes.Unimplemented, "keyword use case not available")
 	}
 
-	keywords, err := s.keywordUseCase.ListKeywords(ctx)
+	result, err := s.keywordUseCase.ListKeywords(ctx, &input.ListKeywordsInput{
+		Filter: domain.KeywordFilter{
+			EnabledOnly: req.EnabledOnly,
+			Query:       req.Query,
+		},
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+	})
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-
-	// Filter enabled only if requested
-	filteredKeywords := keywords
-	if req.EnabledOnly {
-		filteredKeywords = make([]*domain.Keyword, 0)
-		for _, k := range keywords {
-			if k.Enabled {
-				filteredKeywords = append(filteredKeywords, k)
-			}
+		if err == valueobject.ErrInvalidPageToken {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
 		}
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	protoKeywords := make([]*pb.Keyword, len(filteredKeywords))
-	for i, keyword := range filteredKeywords {
+	protoKeywords := make([]*pb.Keyword, len(result.Keywords))
+	for i, keyword := range result.Keywords {
 		protoKeywords[i] = domainKeywordToProto(keyword)
 	}
 
 	return &pb.ListKeywordsResponse{
-		Keywords:   protoKeywords,
-		TotalCount: int32(len(filteredKeywords)),
+		Keywords:      protoKeywords,
+		NextPageToken: result.NextPageToken,
+		TotalCount:    int32(result.TotalCount),
 	}, nil
 }
 
@@ -563,30 +895,30 @@ func (s *Server) ListKeywordsByGenre(ctx context.Context, req *pb.ListKeywordsBy
 		return nil, status.Error(codes.InvalidArgument, "invalid genre ID")
 	}
 
-	keywords, err := s.keywordUseCase.ListKeywordsByGenre(ctx, genreID)
+	result, err := s.keywordUseCase.ListKeywords(ctx, &input.ListKeywordsInput{
+		Filter: domain.KeywordFilter{
+			GenreID:     valueobject.UUID(genreID.String()),
+			EnabledOnly: req.EnabledOnly,
+		},
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+	})
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-
-	// Filter enabled only if requested
-	filteredKeywords := keywords
-	if req.EnabledOnly {
-		filteredKeywords = make([]*domain.Keyword, 0)
-		for _, k := range keywords {
-			if k.Enabled {
-				filteredKeywords = append(filteredKeywords, k)
-			}
+		if err == valueobject.ErrInvalidPageToken {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
 		}
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	protoKeywords := make([]*pb.Keyword, len(filteredKeywords))
-	for i, keyword := range filteredKeywords {
+	protoKeywords := make([]*pb.Keyword, len(result.Keywords))
+	for i, keyword := range result.Keywords {
 		protoKeywords[i] = domainKeywordToProto(keyword)
 	}
 
 	return &pb.ListKeywordsByGenreResponse{
-		Keywords:   protoKeywords,
-		TotalCount: int32(len(filteredKeywords)),
+		Keywords:      protoKeywords,
+		NextPageToken: result.NextPageToken,
+		TotalCount:    int32(result.TotalCount),
 	}, nil
 }
 
@@ -609,6 +941,9 @@ func (s *Server) CreateKeyword(ctx context.Context, req *pb.CreateKeywordRequest
 		Description: &req.Description,
 	})
 	if err != nil {
+		if isInvalidKeyword(err) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -640,6 +975,9 @@ func (s *Server) UpdateKeyword(ctx context.Context, req *pb.UpdateKeywordRequest
 		if err == domain.ErrNotFound {
 			return nil, status.Error(codes.NotFound, "keyword not found")
 		}
+		if isInvalidKeyword(err) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -714,90 +1052,66 @@ func (s *Server) DeleteKeyword(ctx context.Context, req *pb.DeleteKeywordRequest
 	return &pb.DeleteKeywordResponse{}, nil
 }
 
-// Helper functions to convert between domain and proto types
+// Keyword group operations
 
-func domainChannelToProto(channel *domain.Channel) *pb.Channel {
-	proto := &pb.Channel{
-		Id:                string(channel.ID),
-		YoutubeChannelId:  string(channel.YouTubeChannelID),
-		Title:             channel.Title,
-		ThumbnailUrl:      channel.ThumbnailURL,
-		Description:       channel.Description,
-		Country:           channel.Country,
-		ViewCount:         channel.ViewCount,
-		SubscriptionCount: channel.SubscriptionCount,
-		VideoCount:        channel.VideoCount,
-		Subscribed:        channel.Subscribed,
-		CreatedAt:         timestamppb.New(channel.CreatedAt),
+func (s *Server) GetKeywordGroup(ctx context.Context, req *pb.GetKeywordGroupRequest) (*pb.GetKeywordGroupResponse, error) {
+	if s.keywordGroupUseCase == nil {
+		return nil, status.Error(codes.Unimplemented, "keyword group use case not available")
 	}
 
-	if channel.UpdatedAt != nil {
-		proto.UpdatedAt = timestamppb.New(*channel.UpdatedAt)
-	}
-	if channel.DeletedAt != nil {
-		proto.DeletedAt = timestamppb.New(*channel.DeletedAt)
+	groupID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid keyword group ID")
 	}
 
-	return proto
-}
-
-func domainSnapshotToProto(snapshot *domain.VideoSnapshot) *pb.VideoSnapshot {
-	proto := &pb.VideoSnapshot{
-		Id:                string(snapshot.ID),
-		VideoId:           string(snapshot.VideoID),
-		CheckpointHour:    int32(snapshot.CheckpointHour),
-		MeasuredAt:        timestamppb.New(snapshot.MeasuredAt),
-		ViewsCount:        snapshot.ViewsCount,
-		LikesCount:        snapshot.LikesCount,
-		SubscriptionCount: snapshot.SubscriptionCount,
-		Source:            string(snapshot.Source),
+	group, err := s.keywordGroupUseCase.GetKeywordGroup(ctx, groupID)
+	if err != nil {
+		return nil, keywordGroupError(err)
 	}
 
-	// CreatedAt is not a pointer
-	proto.CreatedAt = timestamppb.New(snapshot.CreatedAt)
-
-	return proto
+	return &pb.GetKeywordGroupResponse{
+		KeywordGroup: domainKeywordGroupToProto(group),
+	}, nil
 }
 
-// Video-Genre operations
-
-func (s *Server) ListVideoGenres(ctx context.Context, req *pb.ListVideoGenresRequest) (*pb.ListVideoGenresResponse, error) {
-	if s.videoGenreUseCase == nil {
-		return nil, status.Error(codes.Unimplemented, "video-genre use case not available")
+func (s *Server) ListKeywordGroups(ctx context.Context, req *pb.ListKeywordGroupsRequest) (*pb.ListKeywordGroupsResponse, error) {
+	if s.keywordGroupUseCase == nil {
+		return nil, status.Error(codes.Unimplemented, "keyword group use case not available")
 	}
 
-	videoID, err := uuid.Parse(req.VideoId)
+	genreID, err := uuid.Parse(req.GenreId)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid video ID")
+		return nil, status.Error(codes.InvalidArgument, "invalid genre ID")
 	}
 
-	videoGenres, err := s.videoGenreUseCase.GetVideoGenres(ctx, videoID)
+	result, err := s.keywordGroupUseCase.ListKeywordGroups(ctx, &input.ListKeywordGroupsInput{
+		GenreID:     genreID,
+		EnabledOnly: req.EnabledOnly,
+		PageSize:    int(req.PageSize),
+		PageToken:   req.PageToken,
+	})
 	if err != nil {
+		if err == valueobject.ErrInvalidPageToken {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	protoVideoGenres := make([]*pb.VideoGenre, len(videoGenres))
-	for i, vg := range videoGenres {
-		protoVideoGenres[i] = &pb.VideoGenre{
-			VideoId:   string(vg.VideoID),
-			GenreId:   string(vg.GenreID),
-			CreatedAt: timestamppb.New(vg.CreatedAt),
-		}
+	protoGroups := make([]*pb.KeywordGroup, len(result.KeywordGroups))
+	for i, group := range result.KeywordGroups {
+		protoGroups[i] = domainKeywordGroupToProto(group)
 	}
 
-	return &pb.ListVideoGenresResponse{
-		VideoGenres: protoVideoGenres,
+	return &pb.ListKeywordGroupsResponse{
+		KeywordGroups: protoGroups,
+		NextPageToken: result.NextPageToken,
+		TotalCount:    int32(result.TotalCount),
 	}, nil
 }
 
-func (s *Server) AssignVideoToGenre(ctx context.Context, req *pb.AssignVideoToGenreRequest) (*pb.AssignVideoToGenreResponse, error) {
-	if s.videoGenreUseCase == nil {
-		return nil, status.Error(codes.Unimplemented, "video-genre use case not available")
-	}
-
-	videoID, err := uuid.Parse(req.VideoId)
-	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid video ID")
+func (s *Server) CreateKeywordGroup(ctx context.Context, req *pb.CreateKeywordGroupRequest) (*pb.CreateKeywordGroupResponse, error) {
+	if s.keywordGroupUseCase == nil {
+		return nil, status.Error(codes.Unimplemented, "keyword group use case not available")
 	}
 
 	genreID, err := uuid.Parse(req.GenreId)
@@ -805,20 +1119,574 @@ func (s *Server) AssignVideoToGenre(ctx context.Context, req *pb.AssignVideoToGe
 		return nil, status.Error(codes.InvalidArgument, "invalid genre ID")
 	}
 
-	videoGenre, err := s.videoGenreUseCase.AssociateVideoWithGenre(ctx, videoID, genreID)
+	in := input.CreateKeywordGroupInput{
+		GenreID:       genreID,
+		Name:          req.Name,
+		Keywords:      req.Keywords,
+		FilterType:    valueobject.FilterType(req.FilterType),
+		TargetField:   req.TargetField,
+		CrossLanguage: req.CrossLanguage,
+		Romaji:        req.Romaji,
+	}
+	if req.Description != "" {
+		in.Description = &req.Description
+	}
+
+	group, err := s.keywordGroupUseCase.CreateKeywordGroup(ctx, in)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, keywordGroupError(err)
 	}
 
-	return &pb.AssignVideoToGenreResponse{
-		VideoGenre: &pb.VideoGenre{
-			VideoId:   string(videoGenre.VideoID),
+	return &pb.CreateKeywordGroupResponse{
+		KeywordGroup: domainKeywordGroupToProto(group),
+	}, nil
+}
+
+func (s *Server) UpdateKeywordGroup(ctx context.Context, req *pb.UpdateKeywordGroupRequest) (*pb.UpdateKeywordGroupResponse, error) {
+	if s.keywordGroupUseCase == nil {
+		return nil, status.Error(codes.Unimplemented, "keyword group use case not available")
+	}
+
+	groupID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid keyword group ID")
+	}
+
+	in := input.UpdateKeywordGroupInput{
+		Name:          req.Name,
+		TargetField:   req.TargetField,
+		Description:   req.Description,
+		CrossLanguage: req.CrossLanguage,
+		Romaji:        req.Romaji,
+	}
+	if req.FilterType != nil {
+		filterType := valueobject.FilterType(*req.FilterType)
+		in.FilterType = &filterType
+	}
+
+	group, err := s.keywordGroupUseCase.UpdateKeywordGroup(ctx, groupID, in)
+	if err != nil {
+		return nil, keywordGroupError(err)
+	}
+
+	return &pb.UpdateKeywordGroupResponse{
+		KeywordGroup: domainKeywordGroupToProto(group),
+	}, nil
+}
+
+func (s *Server) UpdateKeywordGroupKeywords(ctx context.Context, req *pb.UpdateKeywordGroupKeywordsRequest) (*pb.UpdateKeywordGroupKeywordsResponse, error) {
+	if s.keywordGroupUseCase == nil {
+		return nil, status.Error(codes.Unimplemented, "keyword group use case not available")
+	}
+
+	groupID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid keyword group ID")
+	}
+
+	group, err := s.keywordGroupUseCase.UpdateKeywords(ctx, groupID, req.Keywords)
+	if err != nil {
+		return nil, keywordGroupError(err)
+	}
+
+	return &pb.UpdateKeywordGroupKeywordsResponse{
+		KeywordGroup: domainKeywordGroupToProto(group),
+	}, nil
+}
+
+func (s *Server) AddKeywordGroupItem(ctx context.Context, req *pb.AddKeywordGroupItemRequest) (*pb.AddKeywordGroupItemResponse, error) {
+	if s.keywordGroupUseCase == nil {
+		return nil, status.Error(codes.Unimplemented, "keyword group use case not available")
+	}
+
+	groupID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid keyword group ID")
+	}
+
+	group, err := s.keywordGroupUseCase.AddKeyword(ctx, groupID, req.Keyword)
+	if err != nil {
+		return nil, keywordGroupError(err)
+	}
+
+	return &pb.AddKeywordGroupItemResponse{
+		KeywordGroup: domainKeywordGroupToProto(group),
+	}, nil
+}
+
+func (s *Server) RemoveKeywordGroupItem(ctx context.Context, req *pb.RemoveKeywordGroupItemRequest) (*pb.RemoveKeywordGroupItemResponse, error) {
+	if s.keywordGroupUseCase == nil {
+		return nil, status.Error(codes.Unimplemented, "keyword group use case not available")
+	}
+
+	groupID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid keyword group ID")
+	}
+
+	group, err := s.keywordGroupUseCase.RemoveKeyword(ctx, groupID, req.Keyword)
+	if err != nil {
+		return nil, keywordGroupError(err)
+	}
+
+	return &pb.RemoveKeywordGroupItemResponse{
+		KeywordGroup: domainKeywordGroupToProto(group),
+	}, nil
+}
+
+func (s *Server) EnableKeywordGroup(ctx context.Context, req *pb.EnableKeywordGroupRequest) (*pb.EnableKeywordGroupResponse, error) {
+	if s.keywordGroupUseCase == nil {
+		return nil, status.Error(codes.Unimplemented, "keyword group use case not available")
+	}
+
+	groupID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid keyword group ID")
+	}
+
+	group, err := s.keywordGroupUseCase.EnableKeywordGroup(ctx, groupID)
+	if err != nil {
+		return nil, keywordGroupError(err)
+	}
+
+	return &pb.EnableKeywordGroupResponse{
+		KeywordGroup: domainKeywordGroupToProto(group),
+	}, nil
+}
+
+func (s *Server) DisableKeywordGroup(ctx context.Context, req *pb.DisableKeywordGroupRequest) (*pb.DisableKeywordGroupResponse, error) {
+	if s.keywordGroupUseCase == nil {
+		return nil, status.Error(codes.Unimplemented, "keyword group use case not available")
+	}
+
+	groupID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid keyword group ID")
+	}
+
+	group, err := s.keywordGroupUseCase.DisableKeywordGroup(ctx, groupID)
+	if err != nil {
+		return nil, keywordGroupError(err)
+	}
+
+	return &pb.DisableKeywordGroupResponse{
+		KeywordGroup: domainKeywordGroupToProto(group),
+	}, nil
+}
+
+func (s *Server) DeleteKeywordGroup(ctx context.Context, req *pb.DeleteKeywordGroupRequest) (*pb.DeleteKeywordGroupResponse, error) {
+	if s.keywordGroupUseCase == nil {
+		return nil, status.Error(codes.Unimplemented, "keyword group use case not available")
+	}
+
+	groupID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid keyword group ID")
+	}
+
+	if err := s.keywordGroupUseCase.DeleteKeywordGroup(ctx, groupID); err != nil {
+		return nil, keywordGroupError(err)
+	}
+
+	return &pb.DeleteKeywordGroupResponse{}, nil
+}
+
+func (s *Server) GetKeywordGroupPattern(ctx context.Context, req *pb.GetKeywordGroupPatternRequest) (*pb.GetKeywordGroupPatternResponse, error) {
+	if s.keywordGroupUseCase == nil {
+		return nil, status.Error(codes.Unimplemented, "keyword group use case not available")
+	}
+
+	groupID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid keyword group ID")
+	}
+
+	pattern, err := s.keywordGroupUseCase.GeneratePatternForGroup(ctx, groupID)
+	if err != nil {
+		return nil, keywordGroupError(err)
+	}
+
+	return &pb.GetKeywordGroupPatternResponse{
+		Pattern: pattern,
+	}, nil
+}
+
+func (s *Server) TestKeywordGroup(ctx context.Context, req *pb.TestKeywordGroupRequest) (*pb.TestKeywordGroupResponse, error) {
+	if s.keywordGroupTester == nil {
+		return nil, status.Error(codes.Unimplemented, "keyword group tester use case not available")
+	}
+
+	in := input.TestKeywordGroupInput{
+		Keywords:      req.Keywords,
+		CrossLanguage: req.CrossLanguage,
+		Romaji:        req.Romaji,
+		Enabled:       req.Enabled,
+		Days:          int(req.Days),
+	}
+	if req.Id != "" {
+		groupID, err := uuid.Parse(req.Id)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid keyword group ID")
+		}
+		in.GroupID = &groupID
+	} else {
+		genreID, err := uuid.Parse(req.GenreId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid genre ID")
+		}
+		in.GenreID = genreID
+	}
+	if req.FilterType != nil {
+		filterType := valueobject.FilterType(*req.FilterType)
+		in.FilterType = &filterType
+	}
+
+	result, err := s.keywordGroupTester.TestKeywordGroup(ctx, in)
+	if err != nil {
+		return nil, keywordGroupError(err)
+	}
+
+	return &pb.TestKeywordGroupResponse{
+		Pattern:         result.Pattern,
+		Enabled:         result.Enabled,
+		VideosEvaluated: int32(result.VideosEvaluated),
+		Truncated:       result.Truncated,
+		NewlyIncluded:   keywordGroupTestMatchesToProto(result.NewlyIncluded),
+		NewlyExcluded:   keywordGroupTestMatchesToProto(result.NewlyExcluded),
+	}, nil
+}
+
+// keywordGroupError maps errors of keyword group commands to gRPC status errors
+// isInvalidKeyword reports whether a keyword was rejected by validation
+func isInvalidKeyword(err error) bool {
+	return errors.Is(err, domain.ErrEmptyName) || errors.Is(err, domain.ErrEmptyPattern) ||
+		errors.Is(err, domain.ErrInvalidPattern) || errors.Is(err, domain.ErrInvalidFilterType)
+}
+
+func keywordGroupError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrKeywordGroupNotFound):
+		return status.Error(codes.NotFound, "keyword group not found")
+	case errors.Is(err, domain.ErrKeywordNotInGroup):
+		return status.Error(codes.NotFound, domain.ErrKeywordNotInGroup.Error())
+	case errors.Is(err, domain.ErrDuplicateKeyword):
+		return status.Error(codes.AlreadyExists, domain.ErrDuplicateKeyword.Error())
+	case errors.Is(err, domain.ErrEmptyGroupName), errors.Is(err, domain.ErrNoKeywordItems), errors.Is(err, domain.ErrEmptyKeyword),
+		errors.Is(err, domain.ErrInvalidFilterType), errors.Is(err, domain.ErrInvalidPattern), errors.Is(err, domain.ErrInvalidInput):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// Synonym dictionary handlers
+
+func (s *Server) ListKeywordSynonyms(ctx context.Context, req *pb.ListKeywordSynonymsRequest) (*pb.ListKeywordSynonymsResponse, error) {
+	if s.keywordSynonymUseCase == nil {
+		return nil, status.Error(codes.Unimplemented, "keyword synonym use case not available")
+	}
+
+	result, err := s.keywordSynonymUseCase.ListKeywordSynonyms(ctx, &input.ListKeywordSynonymsInput{
+		Language:  req.Language,
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+	})
+	if err != nil {
+		return nil, keywordSynonymError(err)
+	}
+
+	protoSynonyms := make([]*pb.KeywordSynonym, len(result.KeywordSynonyms))
+	for i, synonym := range result.KeywordSynonyms {
+		protoSynonyms[i] = domainKeywordSynonymToProto(synonym)
+	}
+
+	return &pb.ListKeywordSynonymsResponse{
+		KeywordSynonyms: protoSynonyms,
+		NextPageToken:   result.NextPageToken,
+		TotalCount:      int32(result.TotalCount),
+	}, nil
+}
+
+func (s *Server) GetKeywordSynonym(ctx context.Context, req *pb.GetKeywordSynonymRequest) (*pb.GetKeywordSynonymResponse, error) {
+	if s.keywordSynonymUseCase == nil {
+		return nil, status.Error(codes.Unimplemented, "keyword synonym use case not available")
+	}
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid keyword synonym ID")
+	}
+
+	synonym, err := s.keywordSynonymUseCase.GetKeywordSynonym(ctx, id)
+	if err != nil {
+		return nil, keywordSynonymError(err)
+	}
+
+	return &pb.GetKeywordSynonymResponse{
+		KeywordSynonym: domainKeywordSynonymToProto(synonym),
+	}, nil
+}
+
+func (s *Server) CreateKeywordSynonym(ctx context.Context, req *pb.CreateKeywordSynonymRequest) (*pb.CreateKeywordSynonymResponse, error) {
+	if s.keywordSynonymUseCase == nil {
+		return nil, status.Error(codes.Unimplemented, "keyword synonym use case not available")
+	}
+
+	synonym, err := s.keywordSynonymUseCase.CreateKeywordSynonym(ctx, input.CreateKeywordSynonymInput{
+		Language:      req.Language,
+		Term:          req.Term,
+		Alias:         req.Alias,
+		AliasLanguage: req.AliasLanguage,
+		Bidirectional: req.Bidirectional,
+	})
+	if err != nil {
+		return nil, keywordSynonymError(err)
+	}
+
+	return &pb.CreateKeywordSynonymResponse{
+		KeywordSynonym: domainKeywordSynonymToProto(synonym),
+	}, nil
+}
+
+func (s *Server) UpdateKeywordSynonym(ctx context.Context, req *pb.UpdateKeywordSynonymRequest) (*pb.UpdateKeywordSynonymResponse, error) {
+	if s.keywordSynonymUseCase == nil {
+		return nil, status.Error(codes.Unimplemented, "keyword synonym use case not available")
+	}
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid keyword synonym ID")
+	}
+
+	synonym, err := s.keywordSynonymUseCase.UpdateKeywordSynonym(ctx, id, input.UpdateKeywordSynonymInput{
+		Term:          req.Term,
+		Alias:         req.Alias,
+		AliasLanguage: req.AliasLanguage,
+		Bidirectional: req.Bidirectional,
+	})
+	if err != nil {
+		return nil, keywordSynonymError(err)
+	}
+
+	return &pb.UpdateKeywordSynonymResponse{
+		KeywordSynonym: domainKeywordSynonymToProto(synonym),
+	}, nil
+}
+
+func (s *Server) DeleteKeywordSynonym(ctx context.Context, req *pb.DeleteKeywordSynonymRequest) (*pb.DeleteKeywordSynonymResponse, error) {
+	if s.keywordSynonymUseCase == nil {
+		return nil, status.Error(codes.Unimplemented, "keyword synonym use case not available")
+	}
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid keyword synonym ID")
+	}
+
+	if err := s.keywordSynonymUseCase.DeleteKeywordSynonym(ctx, id); err != nil {
+		return nil, keywordSynonymError(err)
+	}
+
+	return &pb.DeleteKeywordSynonymResponse{}, nil
+}
+
+// keywordSynonymError maps a synonym dictionary use case error to a gRPC status
+func keywordSynonymError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrKeywordSynonymNotFound):
+		return status.Error(codes.NotFound, "keyword synonym not found")
+	case errors.Is(err, domain.ErrDuplicateKeywordSynonym):
+		return status.Error(codes.AlreadyExists, domain.ErrDuplicateKeywordSynonym.Error())
+	case errors.Is(err, domain.ErrEmptySynonymTerm), errors.Is(err, domain.ErrSynonymSameAsTerm),
+		errors.Is(err, domain.ErrInvalidSynonymLanguage):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, valueobject.ErrInvalidPageToken):
+		return status.Error(codes.InvalidArgument, "invalid page_token")
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// Helper functions to convert between domain and proto types
+
+func domainChannelToProto(channel *domain.Channel) *pb.Channel {
+	proto := &pb.Channel{
+		Id:                string(channel.ID),
+		YoutubeChannelId:  string(channel.YouTubeChannelID),
+		Title:             channel.Title,
+		ThumbnailUrl:      channel.ThumbnailURL,
+		Description:       channel.Description,
+		Country:           channel.Country,
+		ViewCount:         channel.ViewCount,
+		SubscriptionCount: channel.SubscriptionCount,
+		VideoCount:        channel.VideoCount,
+		Subscribed:        channel.Subscribed,
+		CreatedAt:         timestamppb.New(channel.CreatedAt),
+	}
+
+	if channel.UpdatedAt != nil {
+		proto.UpdatedAt = timestamppb.New(*channel.UpdatedAt)
+	}
+	if channel.DeletedAt != nil {
+		proto.DeletedAt = timestamppb.New(*channel.DeletedAt)
+	}
+
+	return proto
+}
+
+func domainVideoToProto(video *domain.Video) *pb.Video {
+	proto := &pb.Video{
+		Id:               string(video.ID),
+		YoutubeVideoId:   string(video.YouTubeVideoID),
+		YoutubeChannelId: string(video.YouTubeChannelID),
+		Title:            video.Title,
+		PublishedAt:      timestamppb.New(video.PublishedAt),
+		CategoryId:       int32(video.CategoryID),
+		CreatedAt:        timestamppb.New(video.CreatedAt),
+		DurationSeconds:  int32(video.Duration / time.Second),
+		IsShort:          video.IsShort(),
+	}
+
+	if video.UpdatedAt != nil {
+		proto.UpdatedAt = timestamppb.New(*video.UpdatedAt)
+	}
+	if video.DeletedAt != nil {
+		proto.DeletedAt = timestamppb.New(*video.DeletedAt)
+	}
+
+	return proto
+}
+
+func domainChannelGrowthPointToProto(point *domain.ChannelGrowthPoint) *pb.ChannelGrowthPoint {
+	return &pb.ChannelGrowthPoint{
+		MeasuredAt:             timestamppb.New(point.MeasuredAt),
+		SubscriptionCount:      point.SubscriptionCount,
+		ViewCount:              point.ViewCount,
+		VideoCount:             point.VideoCount,
+		SubscriptionCountDelta: point.SubscriptionCountDelta,
+		ViewCountDelta:         point.ViewCountDelta,
+		VideoCountDelta:        point.VideoCountDelta,
+	}
+}
+
+func domainSnapshotToProto(snapshot *domain.VideoSnapshot) *pb.VideoSnapshot {
+	proto := &pb.VideoSnapshot{
+		Id:                string(snapshot.ID),
+		VideoId:           string(snapshot.VideoID),
+		CheckpointHour:    int32(snapshot.CheckpointHour),
+		MeasuredAt:        timestamppb.New(snapshot.MeasuredAt),
+		ViewsCount:        snapshot.ViewsCount,
+		LikesCount:        snapshot.LikesCount,
+		SubscriptionCount: snapshot.SubscriptionCount,
+		Source:            string(snapshot.Source),
+		DriftSeconds:      int64(snapshot.Drift / time.Second),
+		CommentsCount:     snapshot.CommentsCount,
+		Metrics:           snapshot.Metrics,
+	}
+
+	// CreatedAt is not a pointer
+	proto.CreatedAt = timestamppb.New(snapshot.CreatedAt)
+
+	return proto
+}
+
+// Video-Genre operations
+
+func (s *Server) ListVideoGenres(ctx context.Context, req *pb.ListVideoGenresRequest) (*pb.ListVideoGenresResponse, error) {
+	if s.videoGenreUseCase == nil {
+		return nil, status.Error(codes.Unimplemented, "video-genre use case not available")
+	}
+
+	videoID, err := uuid.Parse(req.VideoId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid video ID")
+	}
+
+	result, err := s.videoGenreUseCase.ListVideoGenres(ctx, &input.ListVideoGenresInput{
+		VideoID:   videoID,
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+	})
+	if err != nil {
+		if err == valueobject.ErrInvalidPageToken {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	protoVideoGenres := make([]*pb.VideoGenre, len(result.VideoGenres))
+	for i, vg := range result.VideoGenres {
+		protoVideoGenres[i] = &pb.VideoGenre{
+			VideoId:   string(vg.VideoID),
+			GenreId:   string(vg.GenreID),
+			CreatedAt: timestamppb.New(vg.CreatedAt),
+			Match:     genreMatchToProto(vg.Match),
+		}
+	}
+
+	return &pb.ListVideoGenresResponse{
+		VideoGenres:   protoVideoGenres,
+		NextPageToken: result.NextPageToken,
+		TotalCount:    int32(result.TotalCount),
+	}, nil
+}
+
+func (s *Server) AssignVideoToGenre(ctx context.Context, req *pb.AssignVideoToGenreRequest) (*pb.AssignVideoToGenreResponse, error) {
+	if s.videoGenreUseCase == nil {
+		return nil, status.Error(codes.Unimplemented, "video-genre use case not available")
+	}
+
+	videoID, err := uuid.Parse(req.VideoId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid video ID")
+	}
+
+	genreID, err := uuid.Parse(req.GenreId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid genre ID")
+	}
+
+	videoGenre, err := s.videoGenreUseCase.AssociateVideoWithGenre(ctx, videoID, genreID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.AssignVideoToGenreResponse{
+		VideoGenre: &pb.VideoGenre{
+			VideoId:   string(videoGenre.VideoID),
 			GenreId:   string(videoGenre.GenreID),
 			CreatedAt: timestamppb.New(videoGenre.CreatedAt),
+			Match:     genreMatchToProto(videoGenre.Match),
 		},
 	}, nil
 }
 
+// genreMatchToProto converts the match recorded with a genre assignment
+func genreMatchToProto(match *domain.GenreMatch) *pb.GenreMatch {
+	if match == nil {
+		return nil
+	}
+	protoMatch := &pb.GenreMatch{
+		Result:      match.Result,
+		Matches:     make([]*pb.GenreKeywordMatch, len(match.Matches)),
+		EvaluatedAt: timestamppb.New(match.EvaluatedAt),
+	}
+	for i, m := range match.Matches {
+		protoMatch.Matches[i] = &pb.GenreKeywordMatch{
+			KeywordGroupId: string(m.KeywordID),
+			KeywordGroup:   m.KeywordName,
+			FilterType:     string(m.FilterType),
+			Field:          m.Field,
+			MatchStart:     int32(m.Start),
+			MatchEnd:       int32(m.End),
+			MatchedText:    m.MatchedText,
+			Decisive:       m.Decisive,
+			Overridden:     m.Overridden,
+		}
+	}
+	return protoMatch
+}
+
 func (s *Server) RemoveVideoFromGenre(ctx context.Context, req *pb.RemoveVideoFromGenreRequest) (*pb.RemoveVideoFromGenreResponse, error) {
 	if s.videoGenreUseCase == nil {
 		return nil, status.Error(codes.Unimplemented, "video-genre use case not available")
@@ -848,80 +1716,139 @@ func (s *Server) ListAuditLogs(ctx context.Context, req *pb.ListAuditLogsRequest
 		return nil, status.Error(codes.Unimplemented, "audit log use case not available")
 	}
 
-	// TODO: Implement pagination
-	pageSize := int(req.PageSize)
-	if pageSize == 0 {
-		pageSize = 100
-	}
-
-	var logs []*domain.AuditLog
-	var err error
-
-	if req.ActorId != "" {
-		actorID, err := uuid.Parse(req.ActorId)
-		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, "invalid actor ID")
-		}
-		logs, err = s.auditLogUseCase.ListAuditLogsByActor(ctx, actorID, pageSize, 0)
-	} else if req.ResourceType != "" && req.ResourceId != "" {
-		logs, err = s.auditLogUseCase.ListAuditLogsByResource(ctx, req.ResourceType, req.ResourceId, pageSize, 0)
-	} else {
-		logs, err = s.auditLogUseCase.ListRecentAuditLogs(ctx, pageSize)
-	}
+	filter := auditLogFilterFromProto(req.ActorId, req.Action, req.ResourceType, req.ResourceId, req.ChangedField, req.CreatedAfter, req.CreatedBefore)
 
+	result, err := s.auditLogUseCase.ListAuditLogs(ctx, &input.ListAuditLogsInput{
+		Filter:    filter,
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+	})
 	if err != nil {
+		if err == valueobject.ErrInvalidPageToken {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	protoLogs := make([]*pb.AuditLog, len(logs))
-	for i, log := range logs {
+	protoLogs := make([]*pb.AuditLog, len(result.AuditLogs))
+	for i, log := range result.AuditLogs {
 		protoLogs[i] = domainAuditLogToProto(log)
 	}
 
 	return &pb.ListAuditLogsResponse{
-		AuditLogs:  protoLogs,
-		TotalCount: int32(len(logs)),
+		AuditLogs:     protoLogs,
+		NextPageToken: result.NextPageToken,
+		TotalCount:    int32(result.TotalCount),
 	}, nil
 }
 
 func (s *Server) GetAuditLog(ctx context.Context, req *pb.GetAuditLogRequest) (*pb.GetAuditLogResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLog not implemented")
+	if s.auditLogUseCase == nil {
+		return nil, status.Error(codes.Unimplemented, "audit log use case not available")
+	}
+
+	auditLogID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid audit log ID")
+	}
+
+	auditLog, err := s.auditLogUseCase.GetAuditLog(ctx, auditLogID)
+	if err != nil {
+		if err == domain.ErrNotFound {
+			return nil, status.Error(codes.NotFound, "audit log not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.GetAuditLogResponse{
+		AuditLog: domainAuditLogToProto(auditLog),
+	}, nil
 }
 
-// Batch job operations
+// ExportAuditLogs streams the audit logs matching the filters as JSON Lines
+func (s *Server) ExportAuditLogs(req *pb.ExportAuditLogsRequest, stream pb.IngestionService_ExportAuditLogsServer) error {
+	if s.auditLogUseCase == nil {
+		return status.Error(codes.Unimplemented, "audit log use case not available")
+	}
 
-func (s *Server) ListBatchJobs(ctx context.Context, req *pb.ListBatchJobsRequest) (*pb.ListBatchJobsResponse, error) {
-	if s.batchJobUseCase == nil {
-		return nil, status.Error(codes.Unimplemented, "batch job use case not available")
+	filter := auditLogFilterFromProto(req.ActorId, req.Action, req.ResourceType, req.ResourceId, req.ChangedField, req.CreatedAfter, req.CreatedBefore)
+	ctx := stream.Context()
+	if _, err := s.auditLogUseCase.ExportAuditLogs(ctx, filter, &exportStreamWriter{stream: stream}); err != nil {
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		return status.Error(codes.Internal, fmt.Sprintf("failed to export audit logs: %v", err))
 	}
+	return nil
+}
 
-	// TODO: Implement pagination and filtering
-	pageSize := 100
+// exportStreamWriter sends each write as one ExportAuditLogs message. The
+// exporter writes whole lines, so messages never split a line.
+type exportStreamWriter struct {
+	stream pb.IngestionService_ExportAuditLogsServer
+}
 
-	var jobType *domain.JobType
-	if req.JobType != "" {
-		jt := domain.JobType(req.JobType)
-		jobType = &jt
+// Write sends p as one message
+func (w *exportStreamWriter) Write(p []byte) (int, error) {
+	data := make([]byte, len(p))
+	copy(data, p)
+	if err := w.stream.Send(&pb.ExportAuditLogsResponse{Data: data}); err != nil {
+		return 0, err
 	}
+	return len(p), nil
+}
 
-	var jobTypeStr *string
-	if jobType != nil {
-		jt := string(*jobType)
-		jobTypeStr = &jt
+// auditLogFilterFromProto builds an audit log filter from request fields
+func auditLogFilterFromProto(actorID, action, resourceType, resourceID, changedField string, createdAfter, createdBefore *timestamppb.Timestamp) domain.AuditLogFilter {
+	filter := domain.AuditLogFilter{
+		ActorID:      actorID,
+		Action:       action,
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		ChangedField: changedField,
 	}
-	jobs, err := s.batchJobUseCase.ListRecentBatchJobs(ctx, jobTypeStr, pageSize)
+	if createdAfter != nil {
+		after := createdAfter.AsTime()
+		filter.CreatedAfter = &after
+	}
+	if createdBefore != nil {
+		before := createdBefore.AsTime()
+		filter.CreatedBefore = &before
+	}
+	return filter
+}
+
+// Batch job operations
+
+func (s *Server) ListBatchJobs(ctx context.Context, req *pb.ListBatchJobsRequest) (*pb.ListBatchJobsResponse, error) {
+	if s.batchJobUseCase == nil {
+		return nil, status.Error(codes.Unimplemented, "batch job use case not available")
+	}
+
+	result, err := s.batchJobUseCase.ListBatchJobs(ctx, &input.ListBatchJobsInput{
+		Filter: domain.BatchJobFilter{
+			JobType: domain.JobType(req.JobType),
+			Status:  domain.JobStatus(req.Status),
+		},
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+	})
 	if err != nil {
+		if err == valueobject.ErrInvalidPageToken {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	protoJobs := make([]*pb.BatchJob, len(jobs))
-	for i, job := range jobs {
+	protoJobs := make([]*pb.BatchJob, len(result.BatchJobs))
+	for i, job := range result.BatchJobs {
 		protoJobs[i] = domainBatchJobToProto(job)
 	}
 
 	return &pb.ListBatchJobsResponse{
-		BatchJobs:  protoJobs,
-		TotalCount: int32(len(jobs)),
+		BatchJobs:     protoJobs,
+		NextPageToken: result.NextPageToken,
+		TotalCount:    int32(result.TotalCount),
 	}, nil
 }
 
@@ -948,6 +1875,62 @@ func (s *Server) GetBatchJob(ctx context.Context, req *pb.GetBatchJobRequest) (*
 	}, nil
 }
 
+func (s *Server) CancelBatchJob(ctx context.Context, req *pb.CancelBatchJobRequest) (*pb.CancelBatchJobResponse, error) {
+	if s.batchJobUseCase == nil {
+		return nil, status.Error(codes.Unimplemented, "batch job use case not available")
+	}
+
+	jobID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid job ID")
+	}
+
+	job, err := s.batchJobUseCase.CancelBatchJob(ctx, jobID)
+	if err != nil {
+		return nil, batchJobError(err)
+	}
+
+	return &pb.CancelBatchJobResponse{
+		BatchJob: domainBatchJobToProto(job),
+	}, nil
+}
+
+func (s *Server) RetryBatchJob(ctx context.Context, req *pb.RetryBatchJobRequest) (*pb.RetryBatchJobResponse, error) {
+	if s.batchJobUseCase == nil {
+		return nil, status.Error(codes.Unimplemented, "batch job use case not available")
+	}
+
+	jobID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid job ID")
+	}
+
+	job, err := s.batchJobUseCase.RetryBatchJob(ctx, jobID)
+	if err != nil {
+		return nil, batchJobError(err)
+	}
+
+	return &pb.RetryBatchJobResponse{
+		BatchJob: domainBatchJobToProto(job),
+	}, nil
+}
+
+// batchJobError maps errors of batch job commands to gRPC status errors
+func batchJobError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return status.Error(codes.NotFound, "batch job not found")
+	case errors.Is(err, domain.ErrInvalidJobTransition), errors.Is(err, domain.ErrBatchJobNotRetryable):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrBatchJobOverlap):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, domain.ErrBatchJobShutdown):
+		return status.Error(codes.Unavailable, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
 // System operations
 
 func (s *Server) CollectTrendingByGenre(ctx context.Context, req *pb.CollectTrendingByGenreRequest) (*pb.CollectTrendingByGenreResponse, error) {
@@ -957,6 +1940,9 @@ func (s *Server) CollectTrendingByGenre(ctx context.Context, req *pb.CollectTren
 
 	result, err := s.videoUseCase.CollectTrendingByGenre(ctx, req.GenreId)
 	if err != nil {
+		if errors.Is(err, domain.ErrBatchJobOverlap) {
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -975,6 +1961,9 @@ func (s *Server) CollectAllTrending(ctx context.Context, req *pb.CollectAllTrend
 
 	result, err := s.videoUseCase.CollectAllTrending(ctx)
 	if err != nil {
+		if errors.Is(err, domain.ErrBatchJobOverlap) {
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -1006,13 +1995,14 @@ func domainGenreToProto(genre *domain.Genre) *pb.Genre {
 	}
 
 	return &pb.Genre{
-		Id:          string(genre.ID),
-		Code:        genre.Code,
-		Name:        genre.Name,
-		Language:    genre.Language,
-		RegionCode:  genre.RegionCode,
-		CategoryIds: categoryIDs,
-		Enabled:     genre.Enabled,
+		Id:                  string(genre.ID),
+		Code:                genre.Code,
+		Name:                genre.Name,
+		Language:            genre.Language,
+		RegionCode:          genre.RegionCode,
+		CategoryIds:         categoryIDs,
+		Enabled:             genre.Enabled,
+		CheckpointProfileId: string(genre.CheckpointProfileID),
 	}
 }
 
@@ -1051,6 +2041,70 @@ func domainKeywordToProto(keyword *domain.Keyword) *pb.Keyword {
 	return proto
 }
 
+func domainKeywordGroupToProto(group *domain.KeywordGroup) *pb.KeywordGroup {
+	proto := &pb.KeywordGroup{
+		Id:            string(group.ID),
+		GenreId:       string(group.GenreID),
+		Name:          group.Name,
+		FilterType:    string(group.FilterType),
+		TargetField:   group.TargetField,
+		Enabled:       group.Enabled,
+		Keywords:      group.GetKeywords(),
+		CreatedAt:     timestamppb.New(group.CreatedAt),
+		CrossLanguage: group.CrossLanguage,
+		Romaji:        group.Romaji,
+	}
+
+	if group.Description != nil {
+		proto.Description = *group.Description
+	}
+
+	if group.UpdatedAt != nil {
+		proto.UpdatedAt = timestamppb.New(*group.UpdatedAt)
+	}
+
+	return proto
+}
+
+func domainKeywordSynonymToProto(synonym *domain.KeywordSynonym) *pb.KeywordSynonym {
+	proto := &pb.KeywordSynonym{
+		Id:            string(synonym.ID),
+		Language:      synonym.Language,
+		Term:          synonym.Term,
+		Alias:         synonym.Alias,
+		AliasLanguage: synonym.AliasLanguage,
+		Bidirectional: synonym.Bidirectional,
+		CreatedAt:     timestamppb.New(synonym.CreatedAt),
+	}
+
+	if synonym.UpdatedAt != nil {
+		proto.UpdatedAt = timestamppb.New(*synonym.UpdatedAt)
+	}
+
+	return proto
+}
+
+func keywordGroupTestMatchesToProto(matches []*input.KeywordGroupTestMatch) []*pb.KeywordGroupTestMatch {
+	protoMatches := make([]*pb.KeywordGroupTestMatch, len(matches))
+	for i, m := range matches {
+		title := []rune(m.Video.Title)
+		protoMatches[i] = &pb.KeywordGroupTestMatch{
+			VideoId:          string(m.Video.ID),
+			YoutubeVideoId:   string(m.Video.YouTubeVideoID),
+			Title:            m.Video.Title,
+			PublishedAt:      timestamppb.New(m.Video.PublishedAt),
+			Before:           m.Before.String(),
+			After:            m.After.String(),
+			KeywordGroup:     m.KeywordGroup,
+			MatchStart:       int32(m.MatchStart),
+			MatchEnd:         int32(m.MatchEnd),
+			MatchedText:      string(title[m.MatchStart:m.MatchEnd]),
+			HighlightedTitle: string(title[:m.MatchStart]) + "[[" + string(title[m.MatchStart:m.MatchEnd]) + "]]" + string(title[m.MatchEnd:]),
+		}
+	}
+	return protoMatches
+}
+
 func domainAuditLogToProto(log *domain.AuditLog) *pb.AuditLog {
 	proto := &pb.AuditLog{
 		Id:           string(log.ID),
@@ -1087,11 +2141,14 @@ func domainAuditLogToProto(log *domain.AuditLog) *pb.AuditLog {
 
 func domainBatchJobToProto(job *domain.BatchJob) *pb.BatchJob {
 	proto := &pb.BatchJob{
-		Id:           string(job.ID),
-		JobType:      string(job.JobType),
-		Status:       string(job.Status),
-		ErrorMessage: job.ErrorMessage,
-		CreatedAt:    timestamppb.New(job.CreatedAt),
+		Id:                string(job.ID),
+		JobType:           string(job.JobType),
+		Status:            string(job.Status),
+		ErrorMessage:      job.ErrorMessage,
+		CreatedAt:         timestamppb.New(job.CreatedAt),
+		ProgressProcessed: int32(job.Progress.Processed),
+		ProgressTotal:     int32(job.Progress.Total),
+		ProgressCurrent:   job.Progress.Current,
 	}
 
 	if job.StartedAt != nil {
@@ -1102,6 +2159,14 @@ func domainBatchJobToProto(job *domain.BatchJob) *pb.BatchJob {
 		proto.CompletedAt = timestamppb.New(*job.CompletedAt)
 	}
 
+	if job.CancelRequestedAt != nil {
+		proto.CancelRequestedAt = timestamppb.New(*job.CancelRequestedAt)
+	}
+
+	if job.RetryOf != nil {
+		proto.RetryOf = string(*job.RetryOf)
+	}
+
 	// Convert parameters
 	if job.Parameters != nil {
 		proto.Parameters = make(map[string]string)
@@ -0,0 +1,108 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/driver/http/generated"
+	"github.com/gin-gonic/gin"
+)
+
+// DryRunHeader is the request header of calls whose changes must only be
+// validated and reported, not persisted. It matches the gRPC metadata key.
+const DryRunHeader = "X-Dry-Run"
+
+// DryRunChangesHeader is the response header listing the number of changes a
+// dry run planned per action and resource, e.g. "update keyword_group: 1"
+const DryRunChangesHeader = "X-Dry-Run-Changes"
+
+// dryRunRoutes are the routes whose use cases honor dry runs. A dry run of any
+// other route is rejected rather than applied for real.
+var dryRunRoutes = map[string]bool{
+	"POST /admin/keyword-groups":                true,
+	"PATCH /admin/keyword-groups/:id":           true,
+	"PUT /admin/keyword-groups/:id/keywords":    true,
+	"POST /admin/keyword-groups/:id/keywords":   true,
+	"DELETE /admin/keyword-groups/:id/keywords": true,
+	"POST /admin/keyword-groups/:id/enable":     true,
+	"POST /admin/keyword-groups/:id/disable":    true,
+	"DELETE /admin/keyword-groups/:id":          true,
+}
+
+// DryRunMiddleware is the HTTP counterpart of the gRPC UnaryDryRunInterceptor.
+// It marks the context of requests sent with the X-Dry-Run header as a dry run
+// and returns the summary of the planned changes in the X-Dry-Run-Changes header.
+func DryRunMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		value := c.GetHeader(DryRunHeader)
+		if value == "" {
+			c.Next()
+			return
+		}
+		dryRun, err := strconv.ParseBool(value)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, generated.Error{
+				Code:    "INVALID_REQUEST",
+				Message: fmt.Sprintf("invalid %s header %q", DryRunHeader, value),
+			})
+			return
+		}
+		if !dryRun {
+			c.Next()
+			return
+		}
+		route := c.Request.Method + " " + c.FullPath()
+		if !dryRunRoutes[route] {
+			c.AbortWithStatusJSON(http.StatusBadRequest, generated.Error{
+				Code:    "INVALID_REQUEST",
+				Message: fmt.Sprintf("%s does not support dry runs", route),
+			})
+			return
+		}
+
+		report := &domain.DryRunReport{}
+		c.Request = c.Request.WithContext(domain.WithDryRunReport(c.Request.Context(), report))
+		c.Writer = &dryRunWriter{ResponseWriter: c.Writer, report: report}
+		c.Next()
+	}
+}
+
+// dryRunWriter adds the planned changes to the response headers before the
+// handler's response is written
+type dryRunWriter struct {
+	gin.ResponseWriter
+	report  *domain.DryRunReport
+	flushed bool
+}
+
+// writeChanges sets the X-Dry-Run-Changes header once, while headers can still
+// be changed
+func (w *dryRunWriter) writeChanges() {
+	if w.flushed || w.ResponseWriter.Written() {
+		return
+	}
+	w.flushed = true
+	for _, line := range w.report.Summary() {
+		w.Header().Add(DryRunChangesHeader, line)
+	}
+}
+
+// WriteHeaderNow writes the planned changes with the response headers
+func (w *dryRunWriter) WriteHeaderNow() {
+	w.writeChanges()
+	w.ResponseWriter.WriteHeaderNow()
+}
+
+// Write writes the planned changes with the response headers, then the body
+func (w *dryRunWriter) Write(data []byte) (int, error) {
+	w.writeChanges()
+	return w.ResponseWriter.Write(data)
+}
+
+// WriteString writes the planned changes with the response headers, then the body
+func (w *dryRunWriter) WriteString(s string) (int, error) {
+	w.writeChanges()
+	return w.ResponseWriter.WriteString(s)
+}
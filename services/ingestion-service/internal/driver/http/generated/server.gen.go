@@ -26,6 +26,39 @@ type ServerInterface interface {
 	// (POST /admin/update-channels)
 	AdminUpdateChannels(c *gin.Context, params AdminUpdateChannelsParams)
 
+	// (POST /admin/keyword-groups/{id}/keywords)
+	KeywordGroupsAddKeyword(c *gin.Context, id string)
+
+	// (POST /admin/keyword-groups)
+	KeywordGroupsCreate(c *gin.Context)
+
+	// (DELETE /admin/keyword-groups/{id})
+	KeywordGroupsDelete(c *gin.Context, id string)
+
+	// (POST /admin/keyword-groups/{id}/disable)
+	KeywordGroupsDisable(c *gin.Context, id string)
+
+	// (POST /admin/keyword-groups/{id}/enable)
+	KeywordGroupsEnable(c *gin.Context, id string)
+
+	// (GET /admin/keyword-groups/{id})
+	KeywordGroupsGet(c *gin.Context, id string)
+
+	// (GET /admin/keyword-groups/{id}/pattern)
+	KeywordGroupsGetPattern(c *gin.Context, id string)
+
+	// (GET /admin/keyword-groups)
+	KeywordGroupsList(c *gin.Context, params KeywordGroupsListParams)
+
+	// (DELETE /admin/keyword-groups/{id}/keywords)
+	KeywordGroupsRemoveKeyword(c *gin.Context, id string, params KeywordGroupsRemoveKeywordParams)
+
+	// (PUT /admin/keyword-groups/{id}/keywords)
+	KeywordGroupsReplaceKeywords(c *gin.Context, id string)
+
+	// (PATCH /admin/keyword-groups/{id})
+	KeywordGroupsUpdate(c *gin.Context, id string)
+
 	// (POST /tasks/snapshot)
 	TasksCreateSnapshot(c *gin.Context, params TasksCreateSnapshotParams)
 
@@ -301,6 +334,294 @@ func (siw *ServerInterfaceWrapper) AdminUpdateChannels(c *gin.Context) {
 	siw.Handler.AdminUpdateChannels(c, params)
 }
 
+// KeywordGroupsAddKeyword operation middleware
+func (siw *ServerInterfaceWrapper) KeywordGroupsAddKeyword(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.KeywordGroupsAddKeyword(c, id)
+}
+
+// KeywordGroupsCreate operation middleware
+func (siw *ServerInterfaceWrapper) KeywordGroupsCreate(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.KeywordGroupsCreate(c)
+}
+
+// KeywordGroupsDelete operation middleware
+func (siw *ServerInterfaceWrapper) KeywordGroupsDelete(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.KeywordGroupsDelete(c, id)
+}
+
+// KeywordGroupsDisable operation middleware
+func (siw *ServerInterfaceWrapper) KeywordGroupsDisable(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.KeywordGroupsDisable(c, id)
+}
+
+// KeywordGroupsEnable operation middleware
+func (siw *ServerInterfaceWrapper) KeywordGroupsEnable(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.KeywordGroupsEnable(c, id)
+}
+
+// KeywordGroupsGet operation middleware
+func (siw *ServerInterfaceWrapper) KeywordGroupsGet(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.KeywordGroupsGet(c, id)
+}
+
+// KeywordGroupsGetPattern operation middleware
+func (siw *ServerInterfaceWrapper) KeywordGroupsGetPattern(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.KeywordGroupsGetPattern(c, id)
+}
+
+// KeywordGroupsList operation middleware
+func (siw *ServerInterfaceWrapper) KeywordGroupsList(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params KeywordGroupsListParams
+
+	// ------------- Required query parameter "genreId" -------------
+
+	if paramValue := c.Query("genreId"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument genreId is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", false, true, "genreId", c.Request.URL.Query(), &params.GenreId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter genreId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "enabledOnly" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "enabledOnly", c.Request.URL.Query(), &params.EnabledOnly)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter enabledOnly: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.KeywordGroupsList(c, params)
+}
+
+// KeywordGroupsRemoveKeyword operation middleware
+func (siw *ServerInterfaceWrapper) KeywordGroupsRemoveKeyword(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params KeywordGroupsRemoveKeywordParams
+
+	// ------------- Required query parameter "keyword" -------------
+
+	if paramValue := c.Query("keyword"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument keyword is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", false, true, "keyword", c.Request.URL.Query(), &params.Keyword)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter keyword: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.KeywordGroupsRemoveKeyword(c, id, params)
+}
+
+// KeywordGroupsReplaceKeywords operation middleware
+func (siw *ServerInterfaceWrapper) KeywordGroupsReplaceKeywords(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.KeywordGroupsReplaceKeywords(c, id)
+}
+
+// KeywordGroupsUpdate operation middleware
+func (siw *ServerInterfaceWrapper) KeywordGroupsUpdate(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.KeywordGroupsUpdate(c, id)
+}
+
 // TasksCreateSnapshot operation middleware
 func (siw *ServerInterfaceWrapper) TasksCreateSnapshot(c *gin.Context) {
 
@@ -575,6 +896,17 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.POST(options.BaseURL+"/admin/collect-trending", wrapper.AdminCollectTrending)
 	router.POST(options.BaseURL+"/admin/schedule-snapshots", wrapper.AdminScheduleSnapshots)
 	router.POST(options.BaseURL+"/admin/update-channels", wrapper.AdminUpdateChannels)
+	router.POST(options.BaseURL+"/admin/keyword-groups/:id/keywords", wrapper.KeywordGroupsAddKeyword)
+	router.POST(options.BaseURL+"/admin/keyword-groups", wrapper.KeywordGroupsCreate)
+	router.DELETE(options.BaseURL+"/admin/keyword-groups/:id", wrapper.KeywordGroupsDelete)
+	router.POST(options.BaseURL+"/admin/keyword-groups/:id/disable", wrapper.KeywordGroupsDisable)
+	router.POST(options.BaseURL+"/admin/keyword-groups/:id/enable", wrapper.KeywordGroupsEnable)
+	router.GET(options.BaseURL+"/admin/keyword-groups/:id", wrapper.KeywordGroupsGet)
+	router.GET(options.BaseURL+"/admin/keyword-groups/:id/pattern", wrapper.KeywordGroupsGetPattern)
+	router.GET(options.BaseURL+"/admin/keyword-groups", wrapper.KeywordGroupsList)
+	router.DELETE(options.BaseURL+"/admin/keyword-groups/:id/keywords", wrapper.KeywordGroupsRemoveKeyword)
+	router.PUT(options.BaseURL+"/admin/keyword-groups/:id/keywords", wrapper.KeywordGroupsReplaceKeywords)
+	router.PATCH(options.BaseURL+"/admin/keyword-groups/:id", wrapper.KeywordGroupsUpdate)
 	router.POST(options.BaseURL+"/tasks/snapshot", wrapper.TasksCreateSnapshot)
 	router.POST(options.BaseURL+"/websub/youtube/notify", wrapper.WebSubNotify)
 	router.GET(options.BaseURL+"/websub/youtube/verify", wrapper.WebSubVerify)
@@ -3,16 +3,8 @@
 // Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.0 DO NOT EDIT.
 package generated
 
-// Defines values for CreateSnapshotRequestCheckpointHour.
-const (
-	N0   CreateSnapshotRequestCheckpointHour = 0
-	N12  CreateSnapshotRequestCheckpointHour = 12
-	N168 CreateSnapshotRequestCheckpointHour = 168
-	N24  CreateSnapshotRequestCheckpointHour = 24
-	N3   CreateSnapshotRequestCheckpointHour = 3
-	N48  CreateSnapshotRequestCheckpointHour = 48
-	N6   CreateSnapshotRequestCheckpointHour = 6
-	N72  CreateSnapshotRequestCheckpointHour = 72
+import (
+	"time"
 )
 
 // Defines values for WebSubVerifyParamsHubMode.
@@ -21,6 +13,11 @@ const (
 	Unsubscribe WebSubVerifyParamsHubMode = "unsubscribe"
 )
 
+// AddKeywordRequest defines model for AddKeywordRequest.
+type AddKeywordRequest struct {
+	Keyword string `json:"keyword"`
+}
+
 // CollectSubscriptionsResponse defines model for CollectSubscriptionsResponse.
 type CollectSubscriptionsResponse struct {
 	ChannelsProcessed int32  `json:"channelsProcessed"`
@@ -37,21 +34,75 @@ type CollectTrendingResponse struct {
 	VideosUpdated   int32  `json:"videosUpdated"`
 }
 
+// CreateKeywordGroupRequest defines model for CreateKeywordGroupRequest.
+type CreateKeywordGroupRequest struct {
+	// CrossLanguage Apply cross-language synonyms, such as パイソン for Python
+	CrossLanguage *bool   `json:"crossLanguage,omitempty"`
+	Description   *string `json:"description,omitempty"`
+
+	// FilterType include or exclude
+	FilterType string   `json:"filterType"`
+	GenreId    string   `json:"genreId"`
+	Keywords   []string `json:"keywords"`
+	Name       string   `json:"name"`
+
+	// Romaji Also match romaji spellings of kana keywords, such as ramen for ラーメン
+	Romaji *bool `json:"romaji,omitempty"`
+
+	// TargetField Defaults to title
+	TargetField *string `json:"targetField,omitempty"`
+}
+
 // CreateSnapshotRequest defines model for CreateSnapshotRequest.
 type CreateSnapshotRequest struct {
-	CheckpointHour CreateSnapshotRequestCheckpointHour `json:"checkpointHour"`
-	VideoId        string                              `json:"videoId"`
+	// CheckpointHour Hours after publication; must be a checkpoint registered in ingestion.checkpoint_hours
+	CheckpointHour int32  `json:"checkpointHour"`
+	VideoId        string `json:"videoId"`
 }
 
-// CreateSnapshotRequestCheckpointHour defines model for CreateSnapshotRequest.CheckpointHour.
-type CreateSnapshotRequestCheckpointHour float32
-
 // Error defines model for Error.
 type Error struct {
 	Code    string `json:"code"`
 	Message string `json:"message"`
 }
 
+// KeywordGroup defines model for KeywordGroup.
+type KeywordGroup struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// CrossLanguage Cross-language synonyms apply, such as パイソン for Python
+	CrossLanguage bool    `json:"crossLanguage"`
+	Description   *string `json:"description,omitempty"`
+	Enabled       bool    `json:"enabled"`
+
+	// FilterType include or exclude
+	FilterType string   `json:"filterType"`
+	GenreId    string   `json:"genreId"`
+	Id         string   `json:"id"`
+	Keywords   []string `json:"keywords"`
+	Name       string   `json:"name"`
+
+	// Romaji Romaji spellings of kana keywords match, such as ramen for ラーメン
+	Romaji      bool       `json:"romaji"`
+	TargetField string     `json:"targetField"`
+	UpdatedAt   *time.Time `json:"updatedAt,omitempty"`
+}
+
+// KeywordGroupPatternResponse defines model for KeywordGroupPatternResponse.
+type KeywordGroupPatternResponse struct {
+	Pattern string `json:"pattern"`
+}
+
+// ListKeywordGroupsResponse defines model for ListKeywordGroupsResponse.
+type ListKeywordGroupsResponse struct {
+	KeywordGroups []KeywordGroup `json:"keywordGroups"`
+}
+
+// ReplaceKeywordsRequest defines model for ReplaceKeywordsRequest.
+type ReplaceKeywordsRequest struct {
+	Keywords []string `json:"keywords"`
+}
+
 // ScheduleSnapshotsResponse defines model for ScheduleSnapshotsResponse.
 type ScheduleSnapshotsResponse struct {
 	Duration        string `json:"duration"`
@@ -64,6 +115,19 @@ type UpdateChannelsResponse struct {
 	ChannelsProcessed int32  `json:"channelsProcessed"`
 	ChannelsUpdated   int32  `json:"channelsUpdated"`
 	Duration          string `json:"duration"`
+
+	// SnapshotsTaken Channels that got their daily statistics snapshot
+	SnapshotsTaken int32 `json:"snapshotsTaken"`
+}
+
+// UpdateKeywordGroupRequest Omitted fields are left unchanged
+type UpdateKeywordGroupRequest struct {
+	CrossLanguage *bool   `json:"crossLanguage,omitempty"`
+	Description   *string `json:"description,omitempty"`
+	FilterType    *string `json:"filterType,omitempty"`
+	Name          *string `json:"name,omitempty"`
+	Romaji        *bool   `json:"romaji,omitempty"`
+	TargetField   *string `json:"targetField,omitempty"`
 }
 
 // AdminCollectSubscriptionsParams defines parameters for AdminCollectSubscriptions.
@@ -90,6 +154,17 @@ type AdminUpdateChannelsParams struct {
 	XCloudSchedulerScheduleTime string `json:"X-CloudScheduler-ScheduleTime"`
 }
 
+// KeywordGroupsListParams defines parameters for KeywordGroupsList.
+type KeywordGroupsListParams struct {
+	GenreId     string `form:"genreId" json:"genreId"`
+	EnabledOnly *bool  `form:"enabledOnly,omitempty" json:"enabledOnly,omitempty"`
+}
+
+// KeywordGroupsRemoveKeywordParams defines parameters for KeywordGroupsRemoveKeyword.
+type KeywordGroupsRemoveKeywordParams struct {
+	Keyword string `form:"keyword" json:"keyword"`
+}
+
 // TasksCreateSnapshotParams defines parameters for TasksCreateSnapshot.
 type TasksCreateSnapshotParams struct {
 	XCloudTasksTaskName           string `json:"X-CloudTasks-TaskName"`
@@ -115,5 +190,17 @@ type WebSubVerifyParams struct {
 // WebSubVerifyParamsHubMode defines parameters for WebSubVerify.
 type WebSubVerifyParamsHubMode string
 
+// KeywordGroupsAddKeywordJSONRequestBody defines body for KeywordGroupsAddKeyword for application/json ContentType.
+type KeywordGroupsAddKeywordJSONRequestBody = AddKeywordRequest
+
+// KeywordGroupsCreateJSONRequestBody defines body for KeywordGroupsCreate for application/json ContentType.
+type KeywordGroupsCreateJSONRequestBody = CreateKeywordGroupRequest
+
+// KeywordGroupsReplaceKeywordsJSONRequestBody defines body for KeywordGroupsReplaceKeywords for application/json ContentType.
+type KeywordGroupsReplaceKeywordsJSONRequestBody = ReplaceKeywordsRequest
+
+// KeywordGroupsUpdateJSONRequestBody defines body for KeywordGroupsUpdate for application/json ContentType.
+type KeywordGroupsUpdateJSONRequestBody = UpdateKeywordGroupRequest
+
 // TasksCreateSnapshotJSONRequestBody defines body for TasksCreateSnapshot for application/json ContentType.
 type TasksCreateSnapshotJSONRequestBody = CreateSnapshotRequest
@@ -1,9 +1,11 @@
 package http
 
 import (
+	"errors"
 	"net/http"
 	"time"
 
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/driver/http/generated"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/input"
 	"github.com/gin-gonic/gin"
@@ -11,10 +13,11 @@ import (
 )
 
 type Server struct {
-	channelUseCase input.ChannelInputPort
-	videoUseCase   input.VideoInputPort
-	systemUseCase  input.SystemInputPort
-	keywordUseCase input.KeywordInputPort
+	channelUseCase      input.ChannelInputPort
+	videoUseCase        input.VideoInputPort
+	systemUseCase       input.SystemInputPort
+	keywordUseCase      input.KeywordInputPort
+	keywordGroupUseCase input.KeywordGroupInputPort
 }
 
 func NewServer(
@@ -44,6 +47,23 @@ func NewServerWithKeyword(
 	}
 }
 
+// NewServerWithKeywordGroup creates a new server with keyword and keyword group support
+func NewServerWithKeywordGroup(
+	channelUseCase input.ChannelInputPort,
+	videoUseCase input.VideoInputPort,
+	systemUseCase input.SystemInputPort,
+	keywordUseCase input.KeywordInputPort,
+	keywordGroupUseCase input.KeywordGroupInputPort,
+) *Server {
+	return &Server{
+		channelUseCase:      channelUseCase,
+		videoUseCase:        videoUseCase,
+		systemUseCase:       systemUseCase,
+		keywordUseCase:      keywordUseCase,
+		keywordGroupUseCase: keywordGroupUseCase,
+	}
+}
+
 func (s *Server) AdminCollectSubscriptions(c *gin.Context, params generated.AdminCollectSubscriptionsParams) {
 	start := time.Now()
 
@@ -89,7 +109,7 @@ func (s *Server) AdminCollectTrending(c *gin.Context, params generated.AdminColl
 func (s *Server) AdminScheduleSnapshots(c *gin.Context, params generated.AdminScheduleSnapshotsParams) {
 	start := time.Now()
 
-	result, err := s.systemUseCase.ScheduleSnapshots(c.Request.Context())
+	result, err := s.systemUseCase.ScheduleSnapshots(c.Request.Context(), &input.ScheduleSnapshotsInput{})
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, generated.Error{
 			Code:    "INTERNAL_ERROR",
@@ -120,6 +140,7 @@ func (s *Server) AdminUpdateChannels(c *gin.Context, params generated.AdminUpdat
 	c.JSON(http.StatusOK, generated.UpdateChannelsResponse{
 		ChannelsProcessed: int32(result.ChannelsProcessed),
 		ChannelsUpdated:   int32(result.ChannelsUpdated),
+		SnapshotsTaken:    int32(result.SnapshotsTaken),
 		Duration:          time.Since(start).String(),
 	})
 }
@@ -149,6 +170,11 @@ func (s *Server) TasksCreateSnapshot(c *gin.Context, params generated.TasksCreat
 		VideoID:        videoID,
 		CheckpointHour: int(req.CheckpointHour),
 	})
+	if errors.Is(err, domain.ErrSnapshotAlreadyExists) {
+		// A retried task: the snapshot is saved and has been announced again
+		c.Status(http.StatusOK)
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, generated.Error{
 			Code:    "INTERNAL_ERROR",
@@ -0,0 +1,344 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/driver/http/generated"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/input"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+func (s *Server) KeywordGroupsList(c *gin.Context, params generated.KeywordGroupsListParams) {
+	if !s.keywordGroupsAvailable(c) {
+		return
+	}
+
+	genreID, err := uuid.Parse(params.GenreId)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{
+			Code:    "INVALID_GENRE_ID",
+			Message: "Invalid genre ID format",
+		})
+		return
+	}
+
+	groups, err := s.keywordGroupUseCase.ListKeywordGroupsByGenre(c.Request.Context(), genreID)
+	if err != nil {
+		keywordGroupError(c, err)
+		return
+	}
+
+	enabledOnly := params.EnabledOnly != nil && *params.EnabledOnly
+	response := generated.ListKeywordGroupsResponse{
+		KeywordGroups: make([]generated.KeywordGroup, 0, len(groups)),
+	}
+	for _, group := range groups {
+		if enabledOnly && !group.Enabled {
+			continue
+		}
+		response.KeywordGroups = append(response.KeywordGroups, toKeywordGroupResponse(group))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (s *Server) KeywordGroupsCreate(c *gin.Context) {
+	if !s.keywordGroupsAvailable(c) {
+		return
+	}
+
+	var req generated.CreateKeywordGroupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{
+			Code:    "INVALID_REQUEST",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	genreID, err := uuid.Parse(req.GenreId)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{
+			Code:    "INVALID_GENRE_ID",
+			Message: "Invalid genre ID format",
+		})
+		return
+	}
+
+	in := input.CreateKeywordGroupInput{
+		GenreID:     genreID,
+		Name:        req.Name,
+		Keywords:    req.Keywords,
+		FilterType:  valueobject.FilterType(req.FilterType),
+		Description: req.Description,
+	}
+	if req.TargetField != nil {
+		in.TargetField = *req.TargetField
+	}
+	if req.CrossLanguage != nil {
+		in.CrossLanguage = *req.CrossLanguage
+	}
+	if req.Romaji != nil {
+		in.Romaji = *req.Romaji
+	}
+
+	group, err := s.keywordGroupUseCase.CreateKeywordGroup(c.Request.Context(), in)
+	if err != nil {
+		keywordGroupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toKeywordGroupResponse(group))
+}
+
+func (s *Server) KeywordGroupsGet(c *gin.Context, id string) {
+	groupID, ok := s.keywordGroupID(c, id)
+	if !ok {
+		return
+	}
+
+	group, err := s.keywordGroupUseCase.GetKeywordGroup(c.Request.Context(), groupID)
+	if err != nil {
+		keywordGroupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toKeywordGroupResponse(group))
+}
+
+func (s *Server) KeywordGroupsUpdate(c *gin.Context, id string) {
+	groupID, ok := s.keywordGroupID(c, id)
+	if !ok {
+		return
+	}
+
+	var req generated.UpdateKeywordGroupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{
+			Code:    "INVALID_REQUEST",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	in := input.UpdateKeywordGroupInput{
+		Name:          req.Name,
+		TargetField:   req.TargetField,
+		Description:   req.Description,
+		CrossLanguage: req.CrossLanguage,
+		Romaji:        req.Romaji,
+	}
+	if req.FilterType != nil {
+		filterType := valueobject.FilterType(*req.FilterType)
+		in.FilterType = &filterType
+	}
+
+	group, err := s.keywordGroupUseCase.UpdateKeywordGroup(c.Request.Context(), groupID, in)
+	if err != nil {
+		keywordGroupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toKeywordGroupResponse(group))
+}
+
+func (s *Server) KeywordGroupsDelete(c *gin.Context, id string) {
+	groupID, ok := s.keywordGroupID(c, id)
+	if !ok {
+		return
+	}
+
+	if err := s.keywordGroupUseCase.DeleteKeywordGroup(c.Request.Context(), groupID); err != nil {
+		keywordGroupError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (s *Server) KeywordGroupsReplaceKeywords(c *gin.Context, id string) {
+	groupID, ok := s.keywordGroupID(c, id)
+	if !ok {
+		return
+	}
+
+	var req generated.ReplaceKeywordsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{
+			Code:    "INVALID_REQUEST",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	group, err := s.keywordGroupUseCase.UpdateKeywords(c.Request.Context(), groupID, req.Keywords)
+	if err != nil {
+		keywordGroupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toKeywordGroupResponse(group))
+}
+
+func (s *Server) KeywordGroupsAddKeyword(c *gin.Context, id string) {
+	groupID, ok := s.keywordGroupID(c, id)
+	if !ok {
+		return
+	}
+
+	var req generated.AddKeywordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{
+			Code:    "INVALID_REQUEST",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	group, err := s.keywordGroupUseCase.AddKeyword(c.Request.Context(), groupID, req.Keyword)
+	if err != nil {
+		keywordGroupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toKeywordGroupResponse(group))
+}
+
+func (s *Server) KeywordGroupsRemoveKeyword(c *gin.Context, id string, params generated.KeywordGroupsRemoveKeywordParams) {
+	groupID, ok := s.keywordGroupID(c, id)
+	if !ok {
+		return
+	}
+
+	group, err := s.keywordGroupUseCase.RemoveKeyword(c.Request.Context(), groupID, params.Keyword)
+	if err != nil {
+		keywordGroupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toKeywordGroupResponse(group))
+}
+
+func (s *Server) KeywordGroupsEnable(c *gin.Context, id string) {
+	groupID, ok := s.keywordGroupID(c, id)
+	if !ok {
+		return
+	}
+
+	group, err := s.keywordGroupUseCase.EnableKeywordGroup(c.Request.Context(), groupID)
+	if err != nil {
+		keywordGroupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toKeywordGroupResponse(group))
+}
+
+func (s *Server) KeywordGroupsDisable(c *gin.Context, id string) {
+	groupID, ok := s.keywordGroupID(c, id)
+	if !ok {
+		return
+	}
+
+	group, err := s.keywordGroupUseCase.DisableKeywordGroup(c.Request.Context(), groupID)
+	if err != nil {
+		keywordGroupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toKeywordGroupResponse(group))
+}
+
+func (s *Server) KeywordGroupsGetPattern(c *gin.Context, id string) {
+	groupID, ok := s.keywordGroupID(c, id)
+	if !ok {
+		return
+	}
+
+	pattern, err := s.keywordGroupUseCase.GeneratePatternForGroup(c.Request.Context(), groupID)
+	if err != nil {
+		keywordGroupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.KeywordGroupPatternResponse{
+		Pattern: pattern,
+	})
+}
+
+// keywordGroupsAvailable writes 501 when the server was built without keyword group support
+func (s *Server) keywordGroupsAvailable(c *gin.Context) bool {
+	if s.keywordGroupUseCase == nil {
+		c.JSON(http.StatusNotImplemented, generated.Error{
+			Code:    "NOT_IMPLEMENTED",
+			Message: "keyword group use case not available",
+		})
+		return false
+	}
+	return true
+}
+
+// keywordGroupID parses the keyword group ID of the path, writing the error response on failure
+func (s *Server) keywordGroupID(c *gin.Context, id string) (uuid.UUID, bool) {
+	if !s.keywordGroupsAvailable(c) {
+		return uuid.Nil, false
+	}
+
+	groupID, err := uuid.Parse(id)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{
+			Code:    "INVALID_KEYWORD_GROUP_ID",
+			Message: "Invalid keyword group ID format",
+		})
+		return uuid.Nil, false
+	}
+	return groupID, true
+}
+
+// keywordGroupError writes the error response of a failed keyword group command
+func keywordGroupError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domain.ErrKeywordGroupNotFound), errors.Is(err, domain.ErrKeywordNotInGroup):
+		c.JSON(http.StatusNotFound, generated.Error{
+			Code:    "NOT_FOUND",
+			Message: err.Error(),
+		})
+	case errors.Is(err, domain.ErrDuplicateKeyword):
+		c.JSON(http.StatusConflict, generated.Error{
+			Code:    "ALREADY_EXISTS",
+			Message: err.Error(),
+		})
+	case errors.Is(err, domain.ErrEmptyGroupName), errors.Is(err, domain.ErrNoKeywordItems), errors.Is(err, domain.ErrEmptyKeyword),
+		errors.Is(err, domain.ErrInvalidFilterType), errors.Is(err, domain.ErrInvalidPattern), errors.Is(err, domain.ErrInvalidInput):
+		c.JSON(http.StatusBadRequest, generated.Error{
+			Code:    "INVALID_REQUEST",
+			Message: err.Error(),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, generated.Error{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		})
+	}
+}
+
+func toKeywordGroupResponse(group *domain.KeywordGroup) generated.KeywordGroup {
+	return generated.KeywordGroup{
+		Id:            string(group.ID),
+		GenreId:       string(group.GenreID),
+		Name:          group.Name,
+		FilterType:    string(group.FilterType),
+		TargetField:   group.TargetField,
+		Enabled:       group.Enabled,
+		Description:   group.Description,
+		Keywords:      group.GetKeywords(),
+		CrossLanguage: group.CrossLanguage,
+		Romaji:        group.Romaji,
+		CreatedAt:     group.CreatedAt,
+		UpdatedAt:     group.UpdatedAt,
+	}
+}
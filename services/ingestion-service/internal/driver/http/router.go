@@ -2,7 +2,9 @@ package http
 
 import (
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/driver/http/generated"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/driver/security"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/input"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
 	"github.com/gin-gonic/gin"
 )
 
@@ -43,6 +45,34 @@ func SetupRouterWithKeyword(
 	return router
 }
 
+// SetupRouterWithKeywordGroup creates a new router with keyword and keyword group support.
+// Keyword group routes require a bearer token with the role of the matching
+// RPC, and their changes honor dry runs.
+func SetupRouterWithKeywordGroup(
+	channelUseCase input.ChannelInputPort,
+	videoUseCase input.VideoInputPort,
+	systemUseCase input.SystemInputPort,
+	keywordUseCase input.KeywordInputPort,
+	keywordGroupUseCase input.KeywordGroupInputPort,
+	tokenVerifier gateway.TokenVerifier,
+) *gin.Engine {
+	router := gin.Default()
+	router.Use(security.HTTPAuthMiddleware(tokenVerifier), DryRunMiddleware())
+
+	server := NewServerWithKeywordGroup(
+		channelUseCase,
+		videoUseCase,
+		systemUseCase,
+		keywordUseCase,
+		keywordGroupUseCase,
+	)
+
+	// Register handlers with generated server interface
+	generated.RegisterHandlers(router, server)
+
+	return router
+}
+
 // SetupRouterWithPresenters creates a new router with presenters for response formatting
 func SetupRouterWithPresenters(
 	channelUseCase input.ChannelInputPort,
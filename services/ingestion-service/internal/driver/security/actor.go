@@ -0,0 +1,55 @@
+package security
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/pkg/identityauth"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// actor returns the audited caller of the request with the verified claims
+func actor(ctx context.Context, claims identityauth.Claims) domain.Actor {
+	a := domain.Actor{
+		ID:        claims.Subject,
+		Email:     claims.Email,
+		IPAddress: clientIP(ctx),
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get("user-agent"); len(vals) > 0 {
+			a.UserAgent = vals[0]
+		}
+	}
+	return a
+}
+
+// clientIP returns the address of the client. Behind the load balancer the
+// last X-Forwarded-For entry is the one it appended for the connecting client;
+// entries before it are supplied by the client and cannot be trusted.
+// Otherwise it is the connection's peer.
+func clientIP(ctx context.Context) net.IP {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get("x-forwarded-for"); len(vals) > 0 {
+			entries := strings.Split(vals[len(vals)-1], ",")
+			if ip := net.ParseIP(strings.TrimSpace(entries[len(entries)-1])); ip != nil {
+				return ip
+			}
+		}
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return nil
+	}
+	if addr, ok := p.Addr.(*net.TCPAddr); ok {
+		return addr.IP
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return nil
+	}
+	return net.ParseIP(host)
+}
@@ -0,0 +1,60 @@
+package security
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestClientIP(t *testing.T) {
+	peerAddr := &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 443}
+
+	tests := []struct {
+		name         string
+		forwardedFor []string
+		want         string
+	}{
+		{
+			name: "peer address without a proxy",
+			want: "10.0.0.1",
+		},
+		{
+			name:         "single forwarded entry",
+			forwardedFor: []string{"203.0.113.7"},
+			want:         "203.0.113.7",
+		},
+		{
+			name:         "entries supplied by the client are ignored",
+			forwardedFor: []string{"198.51.100.1, 203.0.113.7"},
+			want:         "203.0.113.7",
+		},
+		{
+			name:         "last header value is the proxy's",
+			forwardedFor: []string{"198.51.100.1", "203.0.113.7"},
+			want:         "203.0.113.7",
+		},
+		{
+			name:         "unparsable entry falls back to the peer",
+			forwardedFor: []string{"203.0.113.7, unknown"},
+			want:         "10.0.0.1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: peerAddr})
+			if len(tt.forwardedFor) > 0 {
+				md := metadata.MD{}
+				md.Append("x-forwarded-for", tt.forwardedFor...)
+				ctx = metadata.NewIncomingContext(ctx, md)
+			}
+
+			if got := clientIP(ctx); got.String() != tt.want {
+				t.Errorf("clientIP() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
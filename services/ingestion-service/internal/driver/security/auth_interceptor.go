@@ -0,0 +1,95 @@
+package security
+
+import (
+	"context"
+	"strings"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
+	"github.com/YukiOnishi1129/youtube-analytics/services/pkg/identityauth"
+	pb "github.com/YukiOnishi1129/youtube-analytics/services/pkg/pb/ingestion/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// servicePrefix is the method prefix of IngestionService RPCs. Other services on
+// the server, such as reflection, are not authenticated.
+var servicePrefix = "/" + pb.IngestionService_ServiceDesc.ServiceName + "/"
+
+// UnaryAuthInterceptor verifies the Authorization: Bearer <token> header with the
+// verifier, enforces the role the RPC requires and injects the claims into the
+// context for handlers via identityauth.FromContext. The caller is also
+// attached as the domain.Actor that audit logs are attributed to.
+func UnaryAuthInterceptor(verifier gateway.TokenVerifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authenticate(ctx, verifier, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuthInterceptor is the streaming counterpart of UnaryAuthInterceptor
+func StreamAuthInterceptor(verifier gateway.TokenVerifier) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), verifier, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authenticate verifies the bearer token of the call and checks its roles
+// against the RPC's requirement
+func authenticate(ctx context.Context, verifier gateway.TokenVerifier, fullMethod string) (context.Context, error) {
+	if !strings.HasPrefix(fullMethod, servicePrefix) {
+		return ctx, nil
+	}
+
+	token := bearerToken(ctx)
+	if token == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+	claims, err := verifier.Verify(ctx, token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid bearer token")
+	}
+	if !authorize(fullMethod, claims.Roles) {
+		return nil, status.Error(codes.PermissionDenied, "insufficient role")
+	}
+	ctx = identityauth.WithClaims(ctx, claims)
+	return domain.WithActor(ctx, actor(ctx, claims)), nil
+}
+
+// bearerToken returns the token of the authorization metadata, or "" if absent
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	vals := md.Get("authorization")
+	if len(vals) == 0 {
+		return ""
+	}
+	authz := vals[0]
+	if !strings.HasPrefix(strings.ToLower(authz), "bearer ") {
+		return ""
+	}
+	return strings.TrimSpace(authz[len("bearer "):])
+}
+
+// authenticatedStream overrides the context of a server stream with one
+// carrying the verified claims
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context carrying the verified claims
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,123 @@
+package security
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/pkg/identityauth"
+	pb "github.com/YukiOnishi1129/youtube-analytics/services/pkg/pb/ingestion/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// fakeVerifier accepts the tokens it knows and rejects the rest
+type fakeVerifier map[string]identityauth.Claims
+
+func (v fakeVerifier) Verify(ctx context.Context, idToken string) (identityauth.Claims, error) {
+	claims, ok := v[idToken]
+	if !ok {
+		return identityauth.Claims{}, errors.New("unknown token")
+	}
+	return claims, nil
+}
+
+func TestUnaryAuthInterceptor(t *testing.T) {
+	verifier := fakeVerifier{
+		"user-token":    {Subject: "user-1", Email: "user@example.com", Roles: []string{RoleUser}},
+		"admin-token":   {Subject: "admin-1", Email: "admin@example.com", Roles: []string{RoleAdmin}},
+		"no-role-token": {Subject: "dev-user"},
+	}
+	interceptor := UnaryAuthInterceptor(verifier)
+
+	tests := []struct {
+		name          string
+		method        string
+		authorization string
+		wantCode      codes.Code
+		wantActor     string // Subject attached as the audited actor when the call passes
+	}{
+		{
+			name:          "valid token with the required role",
+			method:        pb.IngestionService_ListGenres_FullMethodName,
+			authorization: "Bearer user-token",
+			wantCode:      codes.OK,
+			wantActor:     "user-1",
+		},
+		{
+			name:          "scheme is case insensitive",
+			method:        pb.IngestionService_ListGenres_FullMethodName,
+			authorization: "bearer user-token",
+			wantCode:      codes.OK,
+			wantActor:     "user-1",
+		},
+		{
+			name:          "admin calls mutations",
+			method:        pb.IngestionService_CreateGenre_FullMethodName,
+			authorization: "Bearer admin-token",
+			wantCode:      codes.OK,
+			wantActor:     "admin-1",
+		},
+		{
+			name:     "missing token",
+			method:   pb.IngestionService_ListGenres_FullMethodName,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:          "not a bearer token",
+			method:        pb.IngestionService_ListGenres_FullMethodName,
+			authorization: "Basic dXNlcjpwYXNz",
+			wantCode:      codes.Unauthenticated,
+		},
+		{
+			name:          "invalid token",
+			method:        pb.IngestionService_ListGenres_FullMethodName,
+			authorization: "Bearer forged-token",
+			wantCode:      codes.Unauthenticated,
+		},
+		{
+			name:          "role does not allow the RPC",
+			method:        pb.IngestionService_CreateGenre_FullMethodName,
+			authorization: "Bearer user-token",
+			wantCode:      codes.PermissionDenied,
+		},
+		{
+			name:          "token without roles is denied",
+			method:        pb.IngestionService_ListGenres_FullMethodName,
+			authorization: "Bearer no-role-token",
+			wantCode:      codes.PermissionDenied,
+		},
+		{
+			name:      "other services are not authenticated",
+			method:    "/grpc.reflection.v1.ServerReflection/ServerReflectionInfo",
+			wantCode:  codes.OK,
+			wantActor: domain.SystemActorID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.authorization != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tt.authorization))
+			}
+
+			var gotActor string
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				gotActor = domain.ActorFromContext(ctx).ID
+				return "ok", nil
+			}
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("interceptor() code = %v, want %v (err = %v)", code, tt.wantCode, err)
+			}
+			if gotActor != tt.wantActor {
+				t.Errorf("actor = %q, want %q", gotActor, tt.wantActor)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package security
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/driver/http/generated"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
+	"github.com/YukiOnishi1129/youtube-analytics/services/pkg/identityauth"
+	pb "github.com/YukiOnishi1129/youtube-analytics/services/pkg/pb/ingestion/v1"
+	"github.com/gin-gonic/gin"
+)
+
+// routeMethods maps the HTTP routes that manage keyword groups to the RPC of
+// the same operation, so both transports require the roles of methodRoles.
+// Other routes are not authenticated.
+var routeMethods = map[string]string{
+	"GET /admin/keyword-groups":                 pb.IngestionService_ListKeywordGroups_FullMethodName,
+	"GET /admin/keyword-groups/:id":             pb.IngestionService_GetKeywordGroup_FullMethodName,
+	"GET /admin/keyword-groups/:id/pattern":     pb.IngestionService_GetKeywordGroupPattern_FullMethodName,
+	"POST /admin/keyword-groups":                pb.IngestionService_CreateKeywordGroup_FullMethodName,
+	"PATCH /admin/keyword-groups/:id":           pb.IngestionService_UpdateKeywordGroup_FullMethodName,
+	"PUT /admin/keyword-groups/:id/keywords":    pb.IngestionService_UpdateKeywordGroupKeywords_FullMethodName,
+	"POST /admin/keyword-groups/:id/keywords":   pb.IngestionService_AddKeywordGroupItem_FullMethodName,
+	"DELETE /admin/keyword-groups/:id/keywords": pb.IngestionService_RemoveKeywordGroupItem_FullMethodName,
+	"POST /admin/keyword-groups/:id/enable":     pb.IngestionService_EnableKeywordGroup_FullMethodName,
+	"POST /admin/keyword-groups/:id/disable":    pb.IngestionService_DisableKeywordGroup_FullMethodName,
+	"DELETE /admin/keyword-groups/:id":          pb.IngestionService_DeleteKeywordGroup_FullMethodName,
+}
+
+// HTTPAuthMiddleware is the HTTP counterpart of UnaryAuthInterceptor. It
+// verifies the Authorization: Bearer <token> header of the routes in
+// routeMethods, enforces the role of their RPC and attaches the claims and the
+// audited domain.Actor to the request context.
+func HTTPAuthMiddleware(verifier gateway.TokenVerifier) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		fullMethod, ok := routeMethods[c.Request.Method+" "+c.FullPath()]
+		if !ok {
+			c.Next()
+			return
+		}
+
+		token := httpBearerToken(c.Request)
+		if token == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, generated.Error{Code: "UNAUTHENTICATED", Message: "missing bearer token"})
+			return
+		}
+		ctx := c.Request.Context()
+		claims, err := verifier.Verify(ctx, token)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, generated.Error{Code: "UNAUTHENTICATED", Message: "invalid bearer token"})
+			return
+		}
+		if !authorize(fullMethod, claims.Roles) {
+			c.AbortWithStatusJSON(http.StatusForbidden, generated.Error{Code: "PERMISSION_DENIED", Message: "insufficient role"})
+			return
+		}
+
+		ctx = identityauth.WithClaims(ctx, claims)
+		ctx = domain.WithActor(ctx, httpActor(c.Request, claims))
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
+
+// httpBearerToken returns the token of the Authorization header, or "" if absent
+func httpBearerToken(r *http.Request) string {
+	authz := r.Header.Get("Authorization")
+	if !strings.HasPrefix(strings.ToLower(authz), "bearer ") {
+		return ""
+	}
+	return strings.TrimSpace(authz[len("bearer "):])
+}
+
+// httpActor returns the audited caller of an HTTP request with the verified claims
+func httpActor(r *http.Request, claims identityauth.Claims) domain.Actor {
+	return domain.Actor{
+		ID:        claims.Subject,
+		Email:     claims.Email,
+		IPAddress: httpClientIP(r),
+		UserAgent: r.UserAgent(),
+	}
+}
+
+// httpClientIP returns the address of the client, trusting only the last
+// X-Forwarded-For entry like clientIP
+func httpClientIP(r *http.Request) net.IP {
+	if vals := r.Header.Values("X-Forwarded-For"); len(vals) > 0 {
+		entries := strings.Split(vals[len(vals)-1], ",")
+		if ip := net.ParseIP(strings.TrimSpace(entries[len(entries)-1])); ip != nil {
+			return ip
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return nil
+	}
+	return net.ParseIP(host)
+}
@@ -0,0 +1,115 @@
+package security
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/pkg/identityauth"
+	"github.com/gin-gonic/gin"
+)
+
+func TestHTTPAuthMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	verifier := fakeVerifier{
+		"user-token":  {Subject: "user-1", Email: "user@example.com", Roles: []string{RoleUser}},
+		"admin-token": {Subject: "admin-1", Email: "admin@example.com", Roles: []string{RoleAdmin}},
+	}
+
+	var gotActor domain.Actor
+	var gotClaims bool
+	router := gin.New()
+	router.Use(HTTPAuthMiddleware(verifier))
+	handler := func(c *gin.Context) {
+		gotActor = domain.ActorFromContext(c.Request.Context())
+		_, gotClaims = identityauth.FromContext(c.Request.Context())
+		c.Status(http.StatusOK)
+	}
+	router.GET("/admin/keyword-groups/:id", handler)
+	router.POST("/admin/keyword-groups", handler)
+	router.DELETE("/admin/keyword-groups/:id", handler)
+	router.POST("/websub/youtube/notify", handler)
+
+	tests := []struct {
+		name          string
+		method        string
+		path          string
+		authorization string
+		wantStatus    int
+		wantActor     string // Subject attached as the audited actor when the request passes
+	}{
+		{
+			name:          "user reads a keyword group",
+			method:        http.MethodGet,
+			path:          "/admin/keyword-groups/0b6e6a8e-6b5e-4a43-9d0e-2f0c3c1b9a10",
+			authorization: "Bearer user-token",
+			wantStatus:    http.StatusOK,
+			wantActor:     "user-1",
+		},
+		{
+			name:          "admin creates a keyword group",
+			method:        http.MethodPost,
+			path:          "/admin/keyword-groups",
+			authorization: "Bearer admin-token",
+			wantStatus:    http.StatusOK,
+			wantActor:     "admin-1",
+		},
+		{
+			name:          "user cannot delete a keyword group",
+			method:        http.MethodDelete,
+			path:          "/admin/keyword-groups/0b6e6a8e-6b5e-4a43-9d0e-2f0c3c1b9a10",
+			authorization: "Bearer user-token",
+			wantStatus:    http.StatusForbidden,
+		},
+		{
+			name:       "missing token",
+			method:     http.MethodPost,
+			path:       "/admin/keyword-groups",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:          "invalid token",
+			method:        http.MethodPost,
+			path:          "/admin/keyword-groups",
+			authorization: "Bearer forged-token",
+			wantStatus:    http.StatusUnauthorized,
+		},
+		{
+			name:       "other routes are not authenticated",
+			method:     http.MethodPost,
+			path:       "/websub/youtube/notify",
+			wantStatus: http.StatusOK,
+			wantActor:  domain.SystemActorID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotActor, gotClaims = domain.Actor{}, false
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.RemoteAddr = "10.0.0.1:443"
+			req.Header.Set("User-Agent", "curl/8.0")
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body = %s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if gotActor.ID != tt.wantActor {
+				t.Errorf("actor = %q, want %q", gotActor.ID, tt.wantActor)
+			}
+			if tt.wantActor != "" && tt.wantActor != domain.SystemActorID {
+				if !gotClaims {
+					t.Error("claims missing from the request context")
+				}
+				if gotActor.IPAddress.String() != "10.0.0.1" || gotActor.UserAgent != "curl/8.0" {
+					t.Errorf("actor = %+v, want IP 10.0.0.1 and user agent curl/8.0", gotActor)
+				}
+			}
+		})
+	}
+}
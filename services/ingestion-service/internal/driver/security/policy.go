@@ -0,0 +1,116 @@
+package security
+
+import (
+	pb "github.com/YukiOnishi1129/youtube-analytics/services/pkg/pb/ingestion/v1"
+)
+
+// Roles granted through the "roles" claim of the ID token
+const (
+	RoleAdmin   = "admin"   // Manages genres, keywords, categories and subscriptions
+	RoleUser    = "user"    // Reads channels, videos, snapshots and master data
+	RoleService = "service" // Runs scheduled collection and snapshot tasks
+)
+
+// methodRoles is the role each IngestionService RPC requires. Admins may call
+// every RPC. RPCs missing from the table are denied.
+var methodRoles = map[string]string{
+	// Channels
+	pb.IngestionService_GetChannel_FullMethodName:         RoleUser,
+	pb.IngestionService_ListChannels_FullMethodName:       RoleUser,
+	pb.IngestionService_GetChannelGrowth_FullMethodName:   RoleUser,
+	pb.IngestionService_SubscribeChannel_FullMethodName:   RoleAdmin,
+	pb.IngestionService_UnsubscribeChannel_FullMethodName: RoleAdmin,
+
+	// Videos
+	pb.IngestionService_GetVideo_FullMethodName:             RoleUser,
+	pb.IngestionService_ListVideos_FullMethodName:           RoleUser,
+	pb.IngestionService_CollectTrending_FullMethodName:      RoleService,
+	pb.IngestionService_CollectSubscriptions_FullMethodName: RoleService,
+
+	// Snapshots
+	pb.IngestionService_GetSnapshot_FullMethodName:     RoleUser,
+	pb.IngestionService_ListSnapshots_FullMethodName:   RoleUser,
+	pb.IngestionService_StreamSnapshots_FullMethodName: RoleUser,
+	pb.IngestionService_CreateSnapshot_FullMethodName:  RoleService,
+
+	// Genres
+	pb.IngestionService_ListGenres_FullMethodName:     RoleUser,
+	pb.IngestionService_GetGenre_FullMethodName:       RoleUser,
+	pb.IngestionService_GetGenreByCode_FullMethodName: RoleUser,
+	pb.IngestionService_CreateGenre_FullMethodName:    RoleAdmin,
+	pb.IngestionService_UpdateGenre_FullMethodName:    RoleAdmin,
+	pb.IngestionService_EnableGenre_FullMethodName:    RoleAdmin,
+	pb.IngestionService_DisableGenre_FullMethodName:   RoleAdmin,
+
+	// YouTube categories
+	pb.IngestionService_ListYouTubeCategories_FullMethodName: RoleUser,
+	pb.IngestionService_GetYouTubeCategory_FullMethodName:    RoleUser,
+	pb.IngestionService_UpdateYouTubeCategory_FullMethodName: RoleAdmin,
+
+	// Keywords
+	pb.IngestionService_GetKeyword_FullMethodName:          RoleUser,
+	pb.IngestionService_ListKeywords_FullMethodName:        RoleUser,
+	pb.IngestionService_ListKeywordsByGenre_FullMethodName: RoleUser,
+	pb.IngestionService_CreateKeyword_FullMethodName:       RoleAdmin,
+	pb.IngestionService_UpdateKeyword_FullMethodName:       RoleAdmin,
+	pb.IngestionService_EnableKeyword_FullMethodName:       RoleAdmin,
+	pb.IngestionService_DisableKeyword_FullMethodName:      RoleAdmin,
+	pb.IngestionService_DeleteKeyword_FullMethodName:       RoleAdmin,
+
+	// Keyword groups
+	pb.IngestionService_GetKeywordGroup_FullMethodName:            RoleUser,
+	pb.IngestionService_ListKeywordGroups_FullMethodName:          RoleUser,
+	pb.IngestionService_GetKeywordGroupPattern_FullMethodName:     RoleUser,
+	pb.IngestionService_TestKeywordGroup_FullMethodName:           RoleUser,
+	pb.IngestionService_CreateKeywordGroup_FullMethodName:         RoleAdmin,
+	pb.IngestionService_UpdateKeywordGroup_FullMethodName:         RoleAdmin,
+	pb.IngestionService_UpdateKeywordGroupKeywords_FullMethodName: RoleAdmin,
+	pb.IngestionService_AddKeywordGroupItem_FullMethodName:        RoleAdmin,
+	pb.IngestionService_RemoveKeywordGroupItem_FullMethodName:     RoleAdmin,
+	pb.IngestionService_EnableKeywordGroup_FullMethodName:         RoleAdmin,
+	pb.IngestionService_DisableKeywordGroup_FullMethodName:        RoleAdmin,
+	pb.IngestionService_DeleteKeywordGroup_FullMethodName:         RoleAdmin,
+
+	// Keyword synonyms
+	pb.IngestionService_ListKeywordSynonyms_FullMethodName:  RoleUser,
+	pb.IngestionService_GetKeywordSynonym_FullMethodName:    RoleUser,
+	pb.IngestionService_CreateKeywordSynonym_FullMethodName: RoleAdmin,
+	pb.IngestionService_UpdateKeywordSynonym_FullMethodName: RoleAdmin,
+	pb.IngestionService_DeleteKeywordSynonym_FullMethodName: RoleAdmin,
+
+	// Video genres
+	pb.IngestionService_ListVideoGenres_FullMethodName:      RoleUser,
+	pb.IngestionService_AssignVideoToGenre_FullMethodName:   RoleAdmin,
+	pb.IngestionService_RemoveVideoFromGenre_FullMethodName: RoleAdmin,
+
+	// Audit logs record who changed what, so only admins may read them
+	pb.IngestionService_ListAuditLogs_FullMethodName:   RoleAdmin,
+	pb.IngestionService_GetAuditLog_FullMethodName:     RoleAdmin,
+	pb.IngestionService_ExportAuditLogs_FullMethodName: RoleAdmin,
+
+	// Batch jobs
+	pb.IngestionService_ListBatchJobs_FullMethodName:  RoleUser,
+	pb.IngestionService_GetBatchJob_FullMethodName:    RoleUser,
+	pb.IngestionService_CancelBatchJob_FullMethodName: RoleAdmin,
+	pb.IngestionService_RetryBatchJob_FullMethodName:  RoleAdmin,
+
+	// Tasks
+	pb.IngestionService_ScheduleSnapshots_FullMethodName:      RoleService,
+	pb.IngestionService_UpdateChannels_FullMethodName:         RoleService,
+	pb.IngestionService_CollectTrendingByGenre_FullMethodName: RoleService,
+	pb.IngestionService_CollectAllTrending_FullMethodName:     RoleService,
+}
+
+// authorize reports whether the claims' roles allow calling the RPC
+func authorize(fullMethod string, roles []string) bool {
+	required, ok := methodRoles[fullMethod]
+	if !ok {
+		return false
+	}
+	for _, r := range roles {
+		if r == required || r == RoleAdmin {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,101 @@
+package security
+
+import (
+	"testing"
+
+	pb "github.com/YukiOnishi1129/youtube-analytics/services/pkg/pb/ingestion/v1"
+)
+
+func TestAuthorize(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		roles  []string
+		want   bool
+	}{
+		{
+			name:   "user reads",
+			method: pb.IngestionService_ListVideos_FullMethodName,
+			roles:  []string{RoleUser},
+			want:   true,
+		},
+		{
+			name:   "user cannot mutate",
+			method: pb.IngestionService_CreateGenre_FullMethodName,
+			roles:  []string{RoleUser},
+			want:   false,
+		},
+		{
+			name:   "user cannot read audit logs",
+			method: pb.IngestionService_ListAuditLogs_FullMethodName,
+			roles:  []string{RoleUser},
+			want:   false,
+		},
+		{
+			name:   "service runs tasks",
+			method: pb.IngestionService_CreateSnapshot_FullMethodName,
+			roles:  []string{RoleService},
+			want:   true,
+		},
+		{
+			name:   "service cannot read",
+			method: pb.IngestionService_GetChannel_FullMethodName,
+			roles:  []string{RoleService},
+			want:   false,
+		},
+		{
+			name:   "admin may call every mapped RPC",
+			method: pb.IngestionService_ScheduleSnapshots_FullMethodName,
+			roles:  []string{RoleAdmin},
+			want:   true,
+		},
+		{
+			name:   "any matching role is enough",
+			method: pb.IngestionService_UpdateChannels_FullMethodName,
+			roles:  []string{RoleUser, RoleService},
+			want:   true,
+		},
+		{
+			name:   "no roles are denied",
+			method: pb.IngestionService_ListGenres_FullMethodName,
+			want:   false,
+		},
+		{
+			name:   "unknown roles are denied",
+			method: pb.IngestionService_ListGenres_FullMethodName,
+			roles:  []string{"owner"},
+			want:   false,
+		},
+		{
+			name:   "unmapped RPC is denied even to admins",
+			method: "/ingestion.v1.IngestionService/DropEverything",
+			roles:  []string{RoleAdmin},
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := authorize(tt.method, tt.roles); got != tt.want {
+				t.Errorf("authorize(%s, %v) = %v, want %v", tt.method, tt.roles, got, tt.want)
+			}
+		})
+	}
+}
+
+// Every RPC must be mapped, otherwise it is silently denied to everyone
+func TestMethodRoles_CoverService(t *testing.T) {
+	var methods []string
+	for _, m := range pb.IngestionService_ServiceDesc.Methods {
+		methods = append(methods, servicePrefix+m.MethodName)
+	}
+	for _, s := range pb.IngestionService_ServiceDesc.Streams {
+		methods = append(methods, servicePrefix+s.StreamName)
+	}
+
+	for _, method := range methods {
+		if _, ok := methodRoles[method]; !ok {
+			t.Errorf("methodRoles has no role for %s", method)
+		}
+	}
+}
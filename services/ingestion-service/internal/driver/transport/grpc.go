@@ -1,19 +1,29 @@
 package transport
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/service"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/driver/grpc"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/driver/security"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/repository"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/usecase"
 	pb "github.com/YukiOnishi1129/youtube-analytics/services/pkg/pb/ingestion/v1"
 	googlegrpc "google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
 
+// shutdownTimeout bounds how long a stopping server waits for background batch job retries
+const shutdownTimeout = 10 * time.Second
+
 // BootstrapGRPC wires everything and starts gRPC server
 func BootstrapGRPC(
 	addr string,
@@ -21,22 +31,31 @@ func BootstrapGRPC(
 	channelSnapshotRepo gateway.ChannelSnapshotRepository,
 	videoRepo gateway.VideoRepository,
 	videoSnapshotRepo gateway.VideoSnapshotRepository,
+	checkpointProfileRepo gateway.CheckpointProfileRepository,
+	videoGenreRepo gateway.VideoGenreRepository,
+	genreRepo gateway.GenreRepository,
 	keywordRepo gateway.KeywordRepository,
 	youtubeClient gateway.YouTubeClient,
 	taskScheduler gateway.TaskScheduler,
 	eventPublisher gateway.EventPublisher,
+	tokenVerifier gateway.TokenVerifier,
 ) error {
 	// Initialize use cases
 	channelUseCase := usecase.NewChannelUseCase(
 		channelRepo,
+		channelSnapshotRepo,
 		youtubeClient,
 	)
 
 	videoUseCase := usecase.NewVideoUseCase(
 		videoRepo,
 		channelRepo,
+		videoSnapshotRepo,
+		videoGenreRepo,
+		genreRepo,
 		youtubeClient,
 		eventPublisher,
+		nil, // Keyword groups are not wired here, so trending videos stay unassigned
 	)
 
 	// Create snapshot scheduler
@@ -47,7 +66,10 @@ func BootstrapGRPC(
 		videoSnapshotRepo,
 		taskScheduler,
 		snapshotScheduler,
+		checkpointProfileRepo,
+		channelSnapshotRepo,
 		youtubeClient,
+		eventPublisher,
 	)
 
 	// Create gRPC server handler
@@ -57,11 +79,8 @@ func BootstrapGRPC(
 		systemUseCase,
 	)
 
-	// Create gRPC server with options
-	grpcServer := googlegrpc.NewServer(
-		// Add interceptors here if needed
-		// googlegrpc.UnaryInterceptor(someInterceptor),
-	)
+	// Create gRPC server with auth interceptors
+	grpcServer := newServer(tokenVerifier)
 
 	// Register service
 	pb.RegisterIngestionServiceServer(grpcServer, handler)
@@ -86,22 +105,31 @@ func BootstrapGRPCWithKeyword(
 	channelSnapshotRepo gateway.ChannelSnapshotRepository,
 	videoRepo gateway.VideoRepository,
 	videoSnapshotRepo gateway.VideoSnapshotRepository,
+	checkpointProfileRepo gateway.CheckpointProfileRepository,
+	videoGenreRepo gateway.VideoGenreRepository,
+	genreRepo gateway.GenreRepository,
 	keywordRepo gateway.KeywordRepository,
 	youtubeClient gateway.YouTubeClient,
 	taskScheduler gateway.TaskScheduler,
 	eventPublisher gateway.EventPublisher,
+	tokenVerifier gateway.TokenVerifier,
 ) error {
 	// Initialize use cases
 	channelUseCase := usecase.NewChannelUseCase(
 		channelRepo,
+		channelSnapshotRepo,
 		youtubeClient,
 	)
 
 	videoUseCase := usecase.NewVideoUseCase(
 		videoRepo,
 		channelRepo,
+		videoSnapshotRepo,
+		videoGenreRepo,
+		genreRepo,
 		youtubeClient,
 		eventPublisher,
+		nil, // Keyword groups are not wired here, so trending videos stay unassigned
 	)
 
 	// Create snapshot scheduler
@@ -112,7 +140,10 @@ func BootstrapGRPCWithKeyword(
 		videoSnapshotRepo,
 		taskScheduler,
 		snapshotScheduler,
+		checkpointProfileRepo,
+		channelSnapshotRepo,
 		youtubeClient,
+		eventPublisher,
 	)
 
 	keywordUseCase := usecase.NewKeywordUseCase(
@@ -127,8 +158,8 @@ func BootstrapGRPCWithKeyword(
 		keywordUseCase,
 	)
 
-	// Create gRPC server
-	grpcServer := googlegrpc.NewServer()
+	// Create gRPC server with auth interceptors
+	grpcServer := newServer(tokenVerifier)
 
 	// Register service
 	pb.RegisterIngestionServiceServer(grpcServer, handler)
@@ -146,3 +177,182 @@ func BootstrapGRPCWithKeyword(
 	return grpcServer.Serve(lis)
 }
 
+// newServer creates a gRPC server that authenticates and authorizes every
+// IngestionService call and honors dry runs
+func newServer(tokenVerifier gateway.TokenVerifier) *googlegrpc.Server {
+	return googlegrpc.NewServer(
+		googlegrpc.ChainUnaryInterceptor(
+			security.UnaryAuthInterceptor(tokenVerifier),
+			grpc.UnaryDryRunInterceptor(),
+		),
+		googlegrpc.StreamInterceptor(security.StreamAuthInterceptor(tokenVerifier)),
+	)
+}
+
+// BootstrapGRPCWithAllUseCases wires every use case and starts gRPC server.
+// Changes to master data made through it are recorded in the audit log.
+func BootstrapGRPCWithAllUseCases(
+	addr string,
+	channelRepo gateway.ChannelRepository,
+	channelSnapshotRepo gateway.ChannelSnapshotRepository,
+	videoRepo gateway.VideoRepository,
+	videoSnapshotRepo gateway.VideoSnapshotRepository,
+	checkpointProfileRepo gateway.CheckpointProfileRepository,
+	videoGenreRepo gateway.VideoGenreRepository,
+	genreRepo gateway.GenreRepository,
+	keywordRepo gateway.KeywordRepository,
+	keywordGroupRepo repository.KeywordGroupRepository,
+	keywordSynonymRepo repository.KeywordSynonymRepository,
+	youtubeCategoryRepo gateway.YouTubeCategoryRepository,
+	auditLogRepo gateway.AuditLogRepository,
+	batchJobRepo gateway.BatchJobRepository,
+	batchJobLockRepo gateway.BatchJobLockRepository,
+	txManager gateway.TransactionManager,
+	youtubeClient gateway.YouTubeClient,
+	taskScheduler gateway.TaskScheduler,
+	eventPublisher gateway.EventPublisher,
+	tokenVerifier gateway.TokenVerifier,
+) error {
+	// Initialize use cases
+	channelUseCase := usecase.NewChannelUseCase(
+		channelRepo,
+		channelSnapshotRepo,
+		youtubeClient,
+	)
+
+	// Create snapshot scheduler
+	snapshotScheduler := service.NewSnapshotScheduler()
+
+	systemUseCase := usecase.NewSystemUseCase(
+		videoRepo,
+		videoSnapshotRepo,
+		taskScheduler,
+		snapshotScheduler,
+		checkpointProfileRepo,
+		channelSnapshotRepo,
+		youtubeClient,
+		eventPublisher,
+	)
+
+	auditLogUseCase := usecase.NewAuditLogUseCase(auditLogRepo)
+
+	videoGenreUseCase := usecase.NewAuditedVideoGenreUseCase(
+		usecase.NewVideoGenreUseCase(videoGenreRepo, videoRepo, keywordGroupRepo, keywordSynonymRepo),
+		auditLogUseCase,
+		txManager,
+	)
+
+	// Trending videos collected for a genre are filtered by its keyword groups
+	// and assigned to it
+	collectionUseCase := usecase.NewVideoUseCase(
+		videoRepo,
+		channelRepo,
+		videoSnapshotRepo,
+		videoGenreRepo,
+		genreRepo,
+		youtubeClient,
+		eventPublisher,
+		videoGenreUseCase,
+	)
+
+	// Finished collection, snapshot and channel jobs can be retried from the API
+	batchJobUseCase := usecase.NewBatchJobUseCase(
+		batchJobRepo,
+		batchJobLockRepo,
+		usecase.NewBatchJobRunners(collectionUseCase, channelUseCase, systemUseCase, genreRepo),
+	)
+
+	// Trending collection runs as batch jobs that never overlap with the
+	// scheduled batches
+	videoUseCase := usecase.NewSingleFlightVideoUseCase(
+		collectionUseCase,
+		batchJobUseCase,
+		genreRepo,
+	)
+
+	// Master data use cases and channel subscriptions audit every change in
+	// the same transaction
+	auditedChannelUseCase := usecase.NewAuditedChannelUseCase(
+		channelUseCase,
+		auditLogUseCase,
+		txManager,
+	)
+	keywordUseCase := usecase.NewAuditedKeywordUseCase(
+		usecase.NewKeywordUseCase(keywordRepo),
+		auditLogUseCase,
+		txManager,
+	)
+	keywordGroupUseCase := usecase.NewAuditedKeywordGroupUseCase(
+		usecase.NewKeywordGroupManagementUseCase(keywordGroupRepo, keywordSynonymRepo),
+		auditLogUseCase,
+		txManager,
+	)
+	keywordGroupTester := usecase.NewKeywordGroupTesterUseCase(keywordGroupRepo, genreRepo, videoRepo, keywordSynonymRepo)
+	keywordSynonymUseCase := usecase.NewAuditedKeywordSynonymUseCase(
+		usecase.NewKeywordSynonymUseCase(keywordSynonymRepo),
+		auditLogUseCase,
+		txManager,
+	)
+	genreUseCase := usecase.NewAuditedGenreUseCase(
+		usecase.NewGenreUseCase(genreRepo, checkpointProfileRepo),
+		auditLogUseCase,
+		txManager,
+	)
+	youtubeCategoryUseCase := usecase.NewAuditedYouTubeCategoryUseCase(
+		usecase.NewYouTubeCategoryUseCase(youtubeCategoryRepo),
+		auditLogUseCase,
+		txManager,
+	)
+	// Create gRPC server handler with all use cases
+	handler := grpc.NewServerWithAllUseCases(
+		auditedChannelUseCase,
+		videoUseCase,
+		systemUseCase,
+		keywordUseCase,
+		keywordGroupUseCase,
+		keywordGroupTester,
+		keywordSynonymUseCase,
+		genreUseCase,
+		youtubeCategoryUseCase,
+		videoGenreUseCase,
+		auditLogUseCase,
+		batchJobUseCase,
+	)
+
+	// Create gRPC server with auth interceptors
+	grpcServer := newServer(tokenVerifier)
+
+	// Register service
+	pb.RegisterIngestionServiceServer(grpcServer, handler)
+
+	// Register reflection service
+	reflection.Register(grpcServer)
+
+	// Start listening
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen: %w", err)
+	}
+
+	log.Printf("ingestion-service gRPC listening on %s", addr)
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- grpcServer.Serve(lis)
+	}()
+
+	// On SIGINT or SIGTERM, finish the RPCs in flight, then stop the batch
+	// job retries running in the background and wait for them to be recorded
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	select {
+	case err := <-serveErr:
+		return err
+	case <-sigCh:
+	}
+	log.Println("Shutting down...")
+	grpcServer.GracefulStop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	return batchJobUseCase.Shutdown(ctx)
+}
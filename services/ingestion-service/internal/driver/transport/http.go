@@ -8,10 +8,12 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
 	cloudtasksgw "github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/cloudtasks"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/insecure"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/postgres"
 	pubsubgw "github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/pubsub"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/adapter/gateway/youtube"
@@ -19,6 +21,8 @@ import (
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/usecase"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/driver/datastore"
 	httpdriver "github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/driver/http"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
+	"github.com/YukiOnishi1129/youtube-analytics/services/pkg/identityauth"
 )
 
 // BootstrapHTTP bootstraps HTTP server with all dependencies
@@ -30,6 +34,7 @@ func BootstrapHTTP(
 	region string,
 	taskQueue string,
 	eventTopic string,
+	tokenVerifier gateway.TokenVerifier,
 ) error {
 	// Note: HTTP handlers currently handle their own response formatting
 	// The HTTPPresenter interface is available for future use
@@ -39,9 +44,15 @@ func BootstrapHTTP(
 
 	// Initialize repositories
 	channelRepo := postgres.NewChannelRepository(repo)
+	channelSnapshotRepo := postgres.NewChannelSnapshotRepository(repo)
 	videoRepo := postgres.NewVideoRepository(repo)
 	videoSnapshotRepo := postgres.NewVideoSnapshotRepository(repo)
+	checkpointProfileRepo := postgres.NewCheckpointProfileRepository(repo)
+	videoGenreRepo := postgres.NewVideoGenreRepository(repo)
+	genreRepo := postgres.NewGenreRepository(repo)
 	keywordRepo := postgres.NewKeywordRepository(repo)
+	keywordGroupRepo := postgres.NewKeywordGroupRepository(repo)
+	auditLogRepo := postgres.NewAuditLogRepository(repo)
 
 	// Initialize external service clients
 	youtubeClient, err := youtube.NewClient(youtubeAPIKey)
@@ -70,14 +81,29 @@ func BootstrapHTTP(
 	// Initialize use cases
 	channelUseCase := usecase.NewChannelUseCase(
 		channelRepo,
+		channelSnapshotRepo,
 		youtubeClient,
 	)
 
+	keywordSynonymRepo := postgres.NewKeywordSynonymRepository(repo)
+	auditLogUseCase := usecase.NewAuditLogUseCase(auditLogRepo)
+	txManager := postgres.NewTransactionManager(db)
+
+	// Trending videos collected for a genre are assigned to it, audited like
+	// assignments made over gRPC
 	videoUseCase := usecase.NewVideoUseCase(
 		videoRepo,
 		channelRepo,
+		videoSnapshotRepo,
+		videoGenreRepo,
+		genreRepo,
 		youtubeClient,
 		eventPublisher,
+		usecase.NewAuditedVideoGenreUseCase(
+			usecase.NewVideoGenreUseCase(videoGenreRepo, videoRepo, keywordGroupRepo, keywordSynonymRepo),
+			auditLogUseCase,
+			txManager,
+		),
 	)
 
 	// Create snapshot scheduler service
@@ -88,19 +114,31 @@ func BootstrapHTTP(
 		videoSnapshotRepo,
 		taskScheduler,
 		snapshotScheduler,
+		checkpointProfileRepo,
+		channelSnapshotRepo,
 		youtubeClient,
+		eventPublisher,
 	)
 
 	keywordUseCase := usecase.NewKeywordUseCase(
 		keywordRepo,
 	)
 
+	// Keyword group changes are audited like those made over gRPC
+	keywordGroupUseCase := usecase.NewAuditedKeywordGroupUseCase(
+		usecase.NewKeywordGroupManagementUseCase(keywordGroupRepo, keywordSynonymRepo),
+		auditLogUseCase,
+		txManager,
+	)
+
 	// Create router
-	router := httpdriver.SetupRouterWithKeyword(
+	router := httpdriver.SetupRouterWithKeywordGroup(
 		channelUseCase,
 		videoUseCase,
 		systemUseCase,
 		keywordUseCase,
+		keywordGroupUseCase,
+		tokenVerifier,
 	)
 
 	// Create HTTP server
@@ -155,6 +193,28 @@ func BootstrapHTTPWithDefaults(addr string) error {
 	taskQueue := getEnvOrDefault("TASK_QUEUE", "video-snapshots")
 	eventTopic := getEnvOrDefault("EVENT_TOPIC", "ingestion-events")
 
+	// Keyword group routes verify tokens like the gRPC server (no-op only when
+	// local dev mode is explicit)
+	insecureDev, err := strconv.ParseBool(getEnvOrDefault("AUTH_INSECURE_DEV", "false"))
+	if err != nil {
+		return fmt.Errorf("invalid AUTH_INSECURE_DEV: %w", err)
+	}
+	var tokenVerifier gateway.TokenVerifier
+	if insecureDev {
+		log.Printf("[WARN] AUTH_INSECURE_DEV set; accepting any token as a dev user without roles")
+		tokenVerifier = insecure.NoopVerifier{}
+	} else {
+		issuer, audience := os.Getenv("OIDC_ISSUER"), os.Getenv("OIDC_AUDIENCE")
+		if issuer == "" || audience == "" {
+			return fmt.Errorf("OIDC_ISSUER and OIDC_AUDIENCE environment variables are required (set AUTH_INSECURE_DEV=true for local development)")
+		}
+		verifier, err := identityauth.NewOIDCVerifier(context.Background(), issuer, audience)
+		if err != nil {
+			return fmt.Errorf("failed to create token verifier: %w", err)
+		}
+		tokenVerifier = verifier
+	}
+
 	// Initialize database
 	db, err := datastore.OpenPostgres("")
 	if err != nil {
@@ -162,7 +222,7 @@ func BootstrapHTTPWithDefaults(addr string) error {
 	}
 	defer db.Close()
 
-	return BootstrapHTTP(addr, db, projectID, youtubeAPIKey, region, taskQueue, eventTopic)
+	return BootstrapHTTP(addr, db, projectID, youtubeAPIKey, region, taskQueue, eventTopic, tokenVerifier)
 }
 
 // getEnvOrDefault returns environment variable value or default
@@ -2,23 +2,29 @@ package input
 
 import (
 	"context"
+	"io"
 	"net"
+	"time"
 
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
 	"github.com/google/uuid"
 )
 
 // AuditLogInputPort is the interface for audit log use cases
 type AuditLogInputPort interface {
 	CreateAuditLog(ctx context.Context, input *CreateAuditLogInput) (*domain.AuditLog, error)
-	ListAuditLogsByActor(ctx context.Context, actorID uuid.UUID, limit, offset int) ([]*domain.AuditLog, error)
-	ListAuditLogsByResource(ctx context.Context, resourceType, resourceID string, limit, offset int) ([]*domain.AuditLog, error)
-	ListRecentAuditLogs(ctx context.Context, limit int) ([]*domain.AuditLog, error)
+	GetAuditLog(ctx context.Context, auditLogID uuid.UUID) (*domain.AuditLog, error)
+	ListAuditLogs(ctx context.Context, input *ListAuditLogsInput) (*ListAuditLogsResult, error)
+	// ExportAuditLogs writes every audit log matching the filter to w as JSON
+	// Lines, newest first, and returns how many were written
+	ExportAuditLogs(ctx context.Context, filter domain.AuditLogFilter, w io.Writer) (int, error)
+	PurgeAuditLogs(ctx context.Context, input *PurgeAuditLogsInput) (*PurgeAuditLogsResult, error)
 }
 
 // CreateAuditLogInput represents the input for creating an audit log
 type CreateAuditLogInput struct {
-	ActorID      uuid.UUID
+	ActorID      string // Subject of the actor's ID token
 	ActorEmail   string
 	Action       string
 	ResourceType string
@@ -27,4 +33,32 @@ type CreateAuditLogInput struct {
 	NewValues    map[string]interface{}
 	IPAddress    net.IP
 	UserAgent    string
-}
\ No newline at end of file
+}
+
+// ListAuditLogsInput represents input for listing audit logs
+type ListAuditLogsInput struct {
+	Filter    domain.AuditLogFilter
+	PageSize  int
+	PageToken string // Empty for the first page
+}
+
+// ListAuditLogsResult represents one page of audit logs
+type ListAuditLogsResult struct {
+	AuditLogs     []*domain.AuditLog
+	NextPageToken string // Empty on the last page
+	TotalCount    int    // Audit logs matching the filter across all pages
+}
+
+// PurgeAuditLogsInput represents input for deleting expired audit logs
+type PurgeAuditLogsInput struct {
+	Before  time.Time             // Audit logs created before this are deleted
+	Archive gateway.ArchiveWriter // Receives the deleted logs as JSON Lines and is committed before they are deleted; nil deletes without archiving
+	DryRun  bool                  // Only count the expired logs
+}
+
+// PurgeAuditLogsResult represents the result of deleting expired audit logs
+type PurgeAuditLogsResult struct {
+	Expired  int // Audit logs created before the cutoff
+	Archived int
+	Deleted  int
+}
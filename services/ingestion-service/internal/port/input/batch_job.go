@@ -13,14 +13,52 @@ type BatchJobInputPort interface {
 	StartBatchJob(ctx context.Context, jobID uuid.UUID) (*domain.BatchJob, error)
 	CompleteBatchJob(ctx context.Context, jobID uuid.UUID, statistics map[string]interface{}) (*domain.BatchJob, error)
 	FailBatchJob(ctx context.Context, jobID uuid.UUID, errorMessage string) (*domain.BatchJob, error)
+	CancelBatchJob(ctx context.Context, jobID uuid.UUID) (*domain.BatchJob, error)
+	RetryBatchJob(ctx context.Context, jobID uuid.UUID) (*domain.BatchJob, error)
+	RunBatchJob(ctx context.Context, input *RunBatchJobInput, run BatchJobFunc) (*domain.BatchJob, error)
 	GetBatchJob(ctx context.Context, jobID uuid.UUID) (*domain.BatchJob, error)
 	ListBatchJobsByType(ctx context.Context, jobType string, status string) ([]*domain.BatchJob, error)
-	ListRecentBatchJobs(ctx context.Context, jobType *string, limit int) ([]*domain.BatchJob, error)
+	ListBatchJobs(ctx context.Context, input *ListBatchJobsInput) (*ListBatchJobsResult, error)
 	GetRunningBatchJobs(ctx context.Context) ([]*domain.BatchJob, error)
+	// Shutdown stops the retries running in the background and waits until
+	// they have recorded their outcome or ctx is done
+	Shutdown(ctx context.Context) error
 }
 
 // CreateBatchJobInput represents the input for creating a batch job
 type CreateBatchJobInput struct {
 	JobType    string
 	Parameters map[string]interface{}
-}
\ No newline at end of file
+	RetryOf    *uuid.UUID // Job re-run by this job
+}
+
+// RunBatchJobInput represents the input for running a tracked batch job
+type RunBatchJobInput struct {
+	JobType    string
+	Parameters map[string]interface{}
+	// LockKeys are the leases held while the job runs (see domain.BatchJobLockKey).
+	// A job that cannot take all of them is skipped. Defaults to the job type.
+	LockKeys []string
+	RetryOf  *uuid.UUID // Job re-run by this job
+}
+
+// BatchJobFunc runs the work of a batch job and returns its statistics
+type BatchJobFunc func(ctx context.Context) (map[string]interface{}, error)
+
+// BatchJobRunner rebuilds the run of a job type from the parameters of an
+// earlier job so that it can be retried
+type BatchJobRunner func(ctx context.Context, parameters map[string]interface{}) (*RunBatchJobInput, BatchJobFunc, error)
+
+// ListBatchJobsInput represents input for listing batch jobs
+type ListBatchJobsInput struct {
+	Filter    domain.BatchJobFilter
+	PageSize  int
+	PageToken string // Empty for the first page
+}
+
+// ListBatchJobsResult represents one page of batch jobs
+type ListBatchJobsResult struct {
+	BatchJobs     []*domain.BatchJob
+	NextPageToken string // Empty on the last page
+	TotalCount    int    // Batch jobs matching the filter across all pages
+}
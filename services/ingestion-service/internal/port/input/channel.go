@@ -12,12 +12,32 @@ import (
 type ChannelInputPort interface {
 	UpdateChannels(ctx context.Context) (*UpdateChannelsResult, error)
 	GetChannel(ctx context.Context, channelID uuid.UUID) (*domain.Channel, error)
-	ListChannels(ctx context.Context, onlySubscribed bool) ([]*domain.Channel, error)
+	// GetChannelByYouTubeID returns domain.ErrChannelNotFound when the channel is not stored
+	GetChannelByYouTubeID(ctx context.Context, youtubeChannelID string) (*domain.Channel, error)
+	ListChannels(ctx context.Context, input *ListChannelsInput) (*ListChannelsResult, error)
+	GetChannelGrowth(ctx context.Context, channelID uuid.UUID, limit int) ([]*domain.ChannelGrowthPoint, error)
+	SubscribeChannel(ctx context.Context, youtubeChannelID string) (*domain.Channel, error)
+	UnsubscribeChannel(ctx context.Context, channelID uuid.UUID) (*domain.Channel, error)
 }
 
 // UpdateChannelsResult represents the result of updating channels
 type UpdateChannelsResult struct {
 	ChannelsProcessed int
 	ChannelsUpdated   int
+	SnapshotsTaken    int
 	Duration          time.Duration
-}
\ No newline at end of file
+}
+
+// ListChannelsInput represents input for listing channels
+type ListChannelsInput struct {
+	Filter    domain.ChannelFilter
+	PageSize  int
+	PageToken string // Empty for the first page
+}
+
+// ListChannelsResult represents one page of channels
+type ListChannelsResult struct {
+	Channels      []*domain.Channel
+	NextPageToken string // Empty on the last page
+	TotalCount    int    // Channels matching the filter across all pages
+}
@@ -9,7 +9,7 @@ import (
 
 // GenreInputPort is the interface for genre use cases
 type GenreInputPort interface {
-	ListGenres(ctx context.Context) ([]*domain.Genre, error)
+	ListGenres(ctx context.Context, input *ListGenresInput) (*ListGenresResult, error)
 	ListEnabledGenres(ctx context.Context) ([]*domain.Genre, error)
 	GetGenre(ctx context.Context, genreID uuid.UUID) (*domain.Genre, error)
 	GetGenreByCode(ctx context.Context, code string) (*domain.Genre, error)
@@ -26,6 +26,8 @@ type CreateGenreInput struct {
 	Language    string
 	RegionCode  string
 	CategoryIDs []int
+	// CheckpointProfile is the code of the snapshot schedule; empty uses the default profile
+	CheckpointProfile string
 }
 
 // UpdateGenreInput represents the input for updating a genre
@@ -33,4 +35,20 @@ type UpdateGenreInput struct {
 	GenreID     uuid.UUID
 	Name        string
 	CategoryIDs []int
-}
\ No newline at end of file
+	// CheckpointProfile changes the snapshot schedule when set; empty selects the default profile
+	CheckpointProfile *string
+}
+
+// ListGenresInput represents input for listing genres
+type ListGenresInput struct {
+	EnabledOnly bool
+	PageSize    int
+	PageToken   string // Empty for the first page
+}
+
+// ListGenresResult represents one page of genres
+type ListGenresResult struct {
+	Genres        []*domain.Genre
+	NextPageToken string // Empty on the last page
+	TotalCount    int
+}
@@ -0,0 +1,53 @@
+package input
+
+import (
+	"context"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+)
+
+// GenreManifestInputPort is the interface for reconciling genres and their
+// keyword groups with a declarative manifest
+type GenreManifestInputPort interface {
+	// ExportManifest returns a manifest describing all current genres and keyword groups
+	ExportManifest(ctx context.Context) (*GenreManifest, error)
+	// ApplyManifest changes the genres of the manifest and their keyword groups
+	// to match it. In a dry run the changes are only planned.
+	ApplyManifest(ctx context.Context, manifest *GenreManifest) (*ApplyManifestResult, error)
+}
+
+// GenreManifest declares genres and their keyword groups
+type GenreManifest struct {
+	Genres []GenreSpec `json:"genres" yaml:"genres"`
+}
+
+// GenreSpec declares a genre. Genres are matched by code.
+type GenreSpec struct {
+	Code              string             `json:"code" yaml:"code"`
+	Name              string             `json:"name" yaml:"name"`
+	Language          string             `json:"language" yaml:"language"`
+	RegionCode        string             `json:"region_code" yaml:"region_code"`
+	CategoryIDs       []int              `json:"category_ids" yaml:"category_ids,flow"`
+	Enabled           *bool              `json:"enabled,omitempty" yaml:"enabled,omitempty"`                       // Defaults to true
+	CheckpointProfile string             `json:"checkpoint_profile,omitempty" yaml:"checkpoint_profile,omitempty"` // Profile code; defaults to the default profile
+	KeywordGroups     []KeywordGroupSpec `json:"keyword_groups,omitempty" yaml:"keyword_groups,omitempty"`
+}
+
+// KeywordGroupSpec declares a keyword group of a genre. Groups are matched by
+// name and filter type within their genre.
+type KeywordGroupSpec struct {
+	Name          string   `json:"name" yaml:"name"`
+	FilterType    string   `json:"filter_type" yaml:"filter_type"`
+	TargetField   string   `json:"target_field,omitempty" yaml:"target_field,omitempty"` // Defaults to title
+	Description   string   `json:"description,omitempty" yaml:"description,omitempty"`
+	Enabled       *bool    `json:"enabled,omitempty" yaml:"enabled,omitempty"`               // Defaults to true
+	CrossLanguage bool     `json:"cross_language,omitempty" yaml:"cross_language,omitempty"` // Applies cross-language synonyms
+	Romaji        bool     `json:"romaji,omitempty" yaml:"romaji,omitempty"`                 // Matches romaji spellings of kana keywords
+	Keywords      []string `json:"keywords" yaml:"keywords"`
+}
+
+// ApplyManifestResult represents the result of applying a manifest
+type ApplyManifestResult struct {
+	Changes        []domain.PlannedChange // Changes made, or planned in a dry run, in order
+	UnmanagedCodes []string               // Codes of existing genres missing from the manifest, left untouched
+}
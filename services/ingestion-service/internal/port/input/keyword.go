@@ -9,8 +9,7 @@ import (
 
 // KeywordInputPort is the interface for keyword use cases
 type KeywordInputPort interface {
-	ListKeywords(ctx context.Context) ([]*domain.Keyword, error)
-	ListKeywordsByGenre(ctx context.Context, genreID uuid.UUID) ([]*domain.Keyword, error)
+	ListKeywords(ctx context.Context, input *ListKeywordsInput) (*ListKeywordsResult, error)
 	CreateKeyword(ctx context.Context, input *CreateKeywordInput) (*domain.Keyword, error)
 	GetKeyword(ctx context.Context, keywordID uuid.UUID) (*domain.Keyword, error)
 	UpdateKeyword(ctx context.Context, input *UpdateKeywordInput) (*domain.Keyword, error)
@@ -39,3 +38,17 @@ type UpdateKeywordInput struct {
 	Enabled     bool
 	Description *string
 }
+
+// ListKeywordsInput represents input for listing keywords
+type ListKeywordsInput struct {
+	Filter    domain.KeywordFilter
+	PageSize  int
+	PageToken string // Empty for the first page
+}
+
+// ListKeywordsResult represents one page of keywords
+type ListKeywordsResult struct {
+	Keywords      []*domain.Keyword
+	NextPageToken string // Empty on the last page
+	TotalCount    int    // Keywords matching the filter across all pages
+}
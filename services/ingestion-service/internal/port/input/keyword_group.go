@@ -0,0 +1,65 @@
+package input
+
+import (
+	"context"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// KeywordGroupInputPort is the interface for keyword group use cases
+type KeywordGroupInputPort interface {
+	CreateKeywordGroup(ctx context.Context, input CreateKeywordGroupInput) (*domain.KeywordGroup, error)
+	UpdateKeywordGroup(ctx context.Context, groupID uuid.UUID, input UpdateKeywordGroupInput) (*domain.KeywordGroup, error)
+	UpdateKeywords(ctx context.Context, groupID uuid.UUID, keywords []string) (*domain.KeywordGroup, error)
+	AddKeyword(ctx context.Context, groupID uuid.UUID, keyword string) (*domain.KeywordGroup, error)
+	RemoveKeyword(ctx context.Context, groupID uuid.UUID, keyword string) (*domain.KeywordGroup, error)
+	EnableKeywordGroup(ctx context.Context, groupID uuid.UUID) (*domain.KeywordGroup, error)
+	DisableKeywordGroup(ctx context.Context, groupID uuid.UUID) (*domain.KeywordGroup, error)
+	DeleteKeywordGroup(ctx context.Context, groupID uuid.UUID) error
+	GetKeywordGroup(ctx context.Context, groupID uuid.UUID) (*domain.KeywordGroup, error)
+	ListKeywordGroupsByGenre(ctx context.Context, genreID uuid.UUID) ([]*domain.KeywordGroup, error)
+	ListKeywordGroups(ctx context.Context, input *ListKeywordGroupsInput) (*ListKeywordGroupsResult, error)
+	GeneratePatternForGroup(ctx context.Context, groupID uuid.UUID) (string, error)
+}
+
+// ListKeywordGroupsInput represents input for listing the keyword groups of a genre
+type ListKeywordGroupsInput struct {
+	GenreID     uuid.UUID
+	EnabledOnly bool
+	PageSize    int
+	PageToken   string // Empty for the first page
+}
+
+// ListKeywordGroupsResult represents one page of the keyword groups of a genre
+type ListKeywordGroupsResult struct {
+	KeywordGroups []*domain.KeywordGroup
+	NextPageToken string // Empty on the last page
+	TotalCount    int
+}
+
+// CreateKeywordGroupInput represents the input for creating a keyword group
+type CreateKeywordGroupInput struct {
+	GenreID     uuid.UUID
+	Name        string
+	Keywords    []string
+	FilterType  valueobject.FilterType
+	TargetField string // Defaults to title
+	Description *string
+	// CrossLanguage applies cross-language synonyms to the group's pattern
+	CrossLanguage bool
+	// Romaji also matches the romaji spellings of kana keywords
+	Romaji bool
+}
+
+// UpdateKeywordGroupInput represents the input for updating a keyword group.
+// Nil fields are left unchanged.
+type UpdateKeywordGroupInput struct {
+	Name          *string
+	FilterType    *valueobject.FilterType
+	TargetField   *string
+	Description   *string
+	CrossLanguage *bool
+	Romaji        *bool
+}
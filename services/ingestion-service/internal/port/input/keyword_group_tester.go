@@ -0,0 +1,52 @@
+package input
+
+import (
+	"context"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/service"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// KeywordGroupTesterInputPort is the interface for trying keyword group edits
+// against stored videos before saving them
+type KeywordGroupTesterInputPort interface {
+	TestKeywordGroup(ctx context.Context, in TestKeywordGroupInput) (*TestKeywordGroupResult, error)
+}
+
+// TestKeywordGroupInput represents a proposed keyword group, either new or an
+// edit of an existing group
+type TestKeywordGroupInput struct {
+	GenreID       uuid.UUID               // Genre of a new group; ignored when GroupID is set
+	GroupID       *uuid.UUID              // Group the edits apply to; nil tests a new group
+	Keywords      []string                // Proposed keywords; empty keeps those of the group
+	FilterType    *valueobject.FilterType // Proposed filter type; nil keeps that of the group, or include
+	CrossLanguage *bool                   // Proposed cross-language synonyms setting; nil keeps that of the group, or off
+	Romaji        *bool                   // Proposed romaji setting; nil keeps that of the group, or off
+	Enabled       *bool                   // Proposed enabled state; nil keeps that of the group, or on
+	Days          int                     // Videos published in the last days; defaults to 30
+}
+
+// TestKeywordGroupResult lists the videos whose filter result the proposed
+// group changes
+type TestKeywordGroupResult struct {
+	Pattern         string // Pattern generated from the proposed keywords
+	Enabled         bool   // A disabled group changes the result of no video it matches
+	VideosEvaluated int
+	Truncated       bool // More videos were published in the period than were evaluated
+	NewlyIncluded   []*KeywordGroupTestMatch
+	NewlyExcluded   []*KeywordGroupTestMatch
+}
+
+// KeywordGroupTestMatch is a video whose filter result changes, with the match
+// that decided it: the new match for included videos and excluded videos, or
+// the match that no longer applies for videos that become neutral
+type KeywordGroupTestMatch struct {
+	Video        *domain.Video
+	Before       service.FilterResult
+	After        service.FilterResult
+	KeywordGroup string // Name of the group of the deciding match
+	MatchStart   int    // Character offsets of the match in the title
+	MatchEnd     int
+}
@@ -0,0 +1,50 @@
+package input
+
+import (
+	"context"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/google/uuid"
+)
+
+// KeywordSynonymInputPort is the interface for synonym dictionary use cases
+type KeywordSynonymInputPort interface {
+	// ListKeywordSynonyms lists a page of the entries of a language, or of all languages when language is empty
+	ListKeywordSynonyms(ctx context.Context, input *ListKeywordSynonymsInput) (*ListKeywordSynonymsResult, error)
+	GetKeywordSynonym(ctx context.Context, id uuid.UUID) (*domain.KeywordSynonym, error)
+	CreateKeywordSynonym(ctx context.Context, input CreateKeywordSynonymInput) (*domain.KeywordSynonym, error)
+	UpdateKeywordSynonym(ctx context.Context, id uuid.UUID, input UpdateKeywordSynonymInput) (*domain.KeywordSynonym, error)
+	DeleteKeywordSynonym(ctx context.Context, id uuid.UUID) error
+}
+
+// ListKeywordSynonymsInput represents input for listing synonym entries
+type ListKeywordSynonymsInput struct {
+	Language  string // Empty for all languages
+	PageSize  int
+	PageToken string // Empty for the first page
+}
+
+// ListKeywordSynonymsResult represents one page of synonym entries
+type ListKeywordSynonymsResult struct {
+	KeywordSynonyms []*domain.KeywordSynonym
+	NextPageToken   string // Empty on the last page
+	TotalCount      int
+}
+
+// CreateKeywordSynonymInput represents the input for creating a synonym entry
+type CreateKeywordSynonymInput struct {
+	Language      string
+	Term          string
+	Alias         string
+	AliasLanguage string // Defaults to Language
+	Bidirectional bool
+}
+
+// UpdateKeywordSynonymInput represents the input for updating a synonym entry.
+// Nil fields are left unchanged.
+type UpdateKeywordSynonymInput struct {
+	Term          *string
+	Alias         *string
+	AliasLanguage *string
+	Bidirectional *bool
+}
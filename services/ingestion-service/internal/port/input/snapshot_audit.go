@@ -0,0 +1,55 @@
+package input
+
+import (
+	"context"
+	"time"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+)
+
+// SnapshotAuditInputPort is the interface for snapshot audit use cases
+type SnapshotAuditInputPort interface {
+	AuditSnapshots(ctx context.Context, input *AuditSnapshotsInput) (*AuditSnapshotsResult, error)
+}
+
+// AuditSnapshotsInput represents the input for auditing snapshot coverage
+type AuditSnapshotsInput struct {
+	// PublishedSince limits the audit to videos published after this time.
+	// When zero, it is derived from the longest checkpoint of the profiles of
+	// enabled genres plus its tolerance.
+	PublishedSince time.Time
+	// DryRun reports what would be backfilled or marked without writing anything
+	DryRun bool
+}
+
+// AuditSnapshotsResult represents the result of a snapshot audit
+type AuditSnapshotsResult struct {
+	PublishedSince time.Time // Start of the window audited
+	VideosAudited  int
+	Missing        int
+	Backfilled     int
+	BackfillFailed int
+	GapsMarked     int
+	Coverage       []*CheckpointCoverage
+	Duration       time.Duration
+}
+
+// CheckpointCoverage reports snapshot coverage of one genre at one checkpoint.
+// Videos without a genre are reported with an empty GenreID.
+type CheckpointCoverage struct {
+	GenreID        valueobject.UUID
+	GenreCode      string
+	CheckpointHour valueobject.CheckpointHour
+	Due            int
+	Captured       int
+	Backfilled     int
+	Gaps           int
+}
+
+// CoverageRate returns the captured share of due checkpoints
+func (c *CheckpointCoverage) CoverageRate() float64 {
+	if c.Due == 0 {
+		return 0
+	}
+	return float64(c.Captured) / float64(c.Due)
+}
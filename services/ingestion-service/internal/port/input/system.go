@@ -10,9 +10,15 @@ import (
 
 // SystemInputPort is the interface for system use cases
 type SystemInputPort interface {
-	ScheduleSnapshots(ctx context.Context) (*ScheduleSnapshotsResult, error)
+	ScheduleSnapshots(ctx context.Context, input *ScheduleSnapshotsInput) (*ScheduleSnapshotsResult, error)
 	CreateSnapshot(ctx context.Context, input *CreateSnapshotInput) (*domain.VideoSnapshot, error)
 	GetVideoSnapshots(ctx context.Context, videoID uuid.UUID) ([]*domain.VideoSnapshot, error)
+	GetSnapshot(ctx context.Context, videoID uuid.UUID, checkpointHour int) (*domain.VideoSnapshot, error)
+	ListSnapshots(ctx context.Context, input *ListSnapshotsInput) (*ListSnapshotsResult, error)
+	// StreamSnapshots hands the snapshots matching the filter to send batch by
+	// batch. The next batch is read only after send returns, and the first send
+	// error ends the stream.
+	StreamSnapshots(ctx context.Context, input *ListSnapshotsInput, send func(*SnapshotBatch) error) error
 }
 
 // CreateSnapshotInput represents the input for creating a video snapshot
@@ -21,9 +27,36 @@ type CreateSnapshotInput struct {
 	CheckpointHour int
 }
 
+// ScheduleSnapshotsInput represents input for scheduling snapshots
+type ScheduleSnapshotsInput struct {
+	// Window schedules videos published within it; zero uses the last
+	// checkpoint of the longest active checkpoint profile
+	Window time.Duration
+}
+
 // ScheduleSnapshotsResult represents the result of scheduling snapshots
 type ScheduleSnapshotsResult struct {
 	VideosProcessed int
 	TasksScheduled  int
+	PublishedSince  time.Time // Start of the publish window videos were scheduled from
 	Duration        time.Duration
 }
+
+// ListSnapshotsInput represents input for listing snapshots across videos
+type ListSnapshotsInput struct {
+	Filter    domain.SnapshotFilter
+	PageSize  int
+	PageToken string // Empty for the first page
+}
+
+// ListSnapshotsResult represents one page of snapshots
+type ListSnapshotsResult struct {
+	Snapshots     []*domain.VideoSnapshot
+	NextPageToken string // Empty on the last page
+}
+
+// SnapshotBatch represents one batch of a snapshot stream
+type SnapshotBatch struct {
+	Snapshots   []*domain.VideoSnapshot
+	ResumeToken string // Page token that resumes after the last snapshot of the batch
+}
@@ -3,6 +3,9 @@ package input
 import (
 	"context"
 	"time"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/google/uuid"
 )
 
 // VideoInputPort is the interface for video use cases
@@ -11,6 +14,31 @@ type VideoInputPort interface {
 	CollectTrendingByGenre(ctx context.Context, genreID string) (*CollectTrendingResult, error)
 	CollectAllTrending(ctx context.Context) (*CollectAllTrendingResult, error)
 	CollectSubscriptions(ctx context.Context) (*CollectSubscriptionsResult, error)
+	GetVideo(ctx context.Context, videoID uuid.UUID) (*VideoDetail, error)
+	ListVideos(ctx context.Context, input *ListVideosInput) (*ListVideosResult, error)
+}
+
+// VideoDetail represents a video with its channel, genres and latest snapshot
+type VideoDetail struct {
+	Video          *domain.Video
+	Channel        *domain.Channel       // Nil when the channel is not stored
+	Genres         []*domain.Genre
+	LatestSnapshot *domain.VideoSnapshot // Nil before the first snapshot
+}
+
+// ListVideosInput represents input for listing videos
+type ListVideosInput struct {
+	Filter    domain.VideoFilter
+	Order     domain.VideoOrder
+	PageSize  int
+	PageToken string // Empty for the first page
+}
+
+// ListVideosResult represents one page of videos
+type ListVideosResult struct {
+	Videos        []*domain.Video
+	NextPageToken string // Empty on the last page
+	TotalCount    int    // Videos matching the filter across all pages
 }
 
 // CollectTrendingResult represents the result of collecting trending videos
@@ -19,15 +47,18 @@ type CollectTrendingResult struct {
 	VideosCollected int // Total videos fetched from YouTube API
 	VideosCreated   int // New videos added to database
 	VideosUpdated   int // Existing videos updated
+	VideosFiltered  int // Videos the genre's keyword groups did not let in
 	Duration        time.Duration
 }
 
 // CollectAllTrendingResult represents the result of collecting trending videos for all genres
 type CollectAllTrendingResult struct {
 	GenresProcessed int
+	GenresFailed    int // Genres skipped because their collection failed
 	TotalCollected  int // Total videos collected from all genres
 	TotalCreated    int // Total new videos created
 	TotalUpdated    int // Total videos updated
+	TotalFiltered   int // Total videos the genres' keyword groups did not let in
 	GenreResults    []*CollectTrendingResult
 	Duration        time.Duration
 }
@@ -11,8 +11,26 @@ import (
 type VideoGenreInputPort interface {
 	AssociateVideoWithGenre(ctx context.Context, videoID, genreID uuid.UUID) (*domain.VideoGenre, error)
 	AssociateVideoWithGenres(ctx context.Context, videoID uuid.UUID, genreIDs []uuid.UUID) ([]*domain.VideoGenre, error)
+	// AdmitsVideo reports whether the enabled keyword groups of the genre let
+	// the video in. The video need not be saved yet.
+	AdmitsVideo(ctx context.Context, video *domain.Video, genreID uuid.UUID) (bool, error)
 	GetVideoGenres(ctx context.Context, videoID uuid.UUID) ([]*domain.VideoGenre, error)
+	ListVideoGenres(ctx context.Context, input *ListVideoGenresInput) (*ListVideoGenresResult, error)
 	GetGenreVideos(ctx context.Context, genreID uuid.UUID) ([]*domain.VideoGenre, error)
 	DisassociateVideoFromGenre(ctx context.Context, videoID, genreID uuid.UUID) error
 	DisassociateVideoFromAllGenres(ctx context.Context, videoID uuid.UUID) error
-}
\ No newline at end of file
+}
+
+// ListVideoGenresInput represents input for listing the genres of a video
+type ListVideoGenresInput struct {
+	VideoID   uuid.UUID
+	PageSize  int
+	PageToken string // Empty for the first page
+}
+
+// ListVideoGenresResult represents one page of the genres of a video
+type ListVideoGenresResult struct {
+	VideoGenres   []*domain.VideoGenre
+	NextPageToken string // Empty on the last page
+	TotalCount    int
+}
@@ -8,8 +8,7 @@ import (
 
 // YouTubeCategoryInputPort is the interface for YouTube category use cases
 type YouTubeCategoryInputPort interface {
-	ListYouTubeCategories(ctx context.Context) ([]*domain.YouTubeCategory, error)
-	ListAssignableYouTubeCategories(ctx context.Context) ([]*domain.YouTubeCategory, error)
+	ListYouTubeCategories(ctx context.Context, input *ListYouTubeCategoriesInput) (*ListYouTubeCategoriesResult, error)
 	GetYouTubeCategory(ctx context.Context, categoryID int) (*domain.YouTubeCategory, error)
 	CreateYouTubeCategory(ctx context.Context, input *CreateYouTubeCategoryInput) (*domain.YouTubeCategory, error)
 	UpdateYouTubeCategory(ctx context.Context, input *UpdateYouTubeCategoryInput) (*domain.YouTubeCategory, error)
@@ -27,4 +26,18 @@ type UpdateYouTubeCategoryInput struct {
 	CategoryID int
 	Name       string
 	Assignable bool
-}
\ No newline at end of file
+}
+
+// ListYouTubeCategoriesInput represents input for listing YouTube categories
+type ListYouTubeCategoriesInput struct {
+	AssignableOnly bool
+	PageSize       int
+	PageToken      string // Empty for the first page
+}
+
+// ListYouTubeCategoriesResult represents one page of YouTube categories
+type ListYouTubeCategoriesResult struct {
+	Categories    []*domain.YouTubeCategory
+	NextPageToken string // Empty on the last page
+	TotalCount    int
+}
@@ -0,0 +1,22 @@
+package gateway
+
+import (
+	"context"
+	"io"
+)
+
+// Archiver is the gateway interface for storing archives of deleted data
+type Archiver interface {
+	// Create starts a new archive with the given name. It fails rather than
+	// overwrite an existing archive.
+	Create(ctx context.Context, name string) (ArchiveWriter, error)
+}
+
+// ArchiveWriter writes one archive. Nothing is visible until Commit returns,
+// and only then is the archive durably stored. Abort discards an archive that
+// was not committed and does nothing after Commit.
+type ArchiveWriter interface {
+	io.Writer
+	Commit() error
+	Abort() error
+}
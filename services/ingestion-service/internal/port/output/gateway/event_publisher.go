@@ -2,6 +2,7 @@ package gateway
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
@@ -14,9 +15,36 @@ type EventPublisher interface {
 	PublishVideoDiscovered(ctx context.Context, video *domain.Video) error
 }
 
-// SnapshotAddedEvent represents a domain event for snapshot creation
+// SnapshotAddedEvent represents a domain event for snapshot creation.
+// EventID is stable for a given snapshot so that consumers can deduplicate
+// redeliveries and republishes. The event carries the snapshot's counts and
+// the video's publish time, so analytics keeps its own copy of its inputs
+// rather than reading ingestion's tables.
 type SnapshotAddedEvent struct {
-	VideoID        valueobject.UUID
-	CheckpointHour valueobject.CheckpointHour
-	MeasuredAt     time.Time
-}
\ No newline at end of file
+	EventID           string                     `json:"eventId"`
+	VideoID           valueobject.UUID           `json:"videoId"`
+	CheckpointHour    valueobject.CheckpointHour `json:"checkpointHour"`
+	MeasuredAt        time.Time                  `json:"measuredAt"`
+	PublishedAt       time.Time                  `json:"publishedAt"`
+	ViewsCount        int64                      `json:"viewCount"`
+	LikesCount        int64                      `json:"likeCount"`
+	SubscriptionCount int64                      `json:"subscriptionCount"`
+	CommentsCount     *int64                     `json:"commentCount,omitempty"` // nil when comments were not recorded
+	DriftSeconds      int64                      `json:"driftSeconds"`
+}
+
+// NewSnapshotAddedEvent creates a snapshot added event for a snapshot of the video
+func NewSnapshotAddedEvent(video *domain.Video, snapshot *domain.VideoSnapshot) SnapshotAddedEvent {
+	return SnapshotAddedEvent{
+		EventID:           fmt.Sprintf("snapshot-added:%s:%d:%d", snapshot.VideoID, snapshot.CheckpointHour, snapshot.MeasuredAt.UnixNano()),
+		VideoID:           snapshot.VideoID,
+		CheckpointHour:    snapshot.CheckpointHour,
+		MeasuredAt:        snapshot.MeasuredAt,
+		PublishedAt:       video.PublishedAt,
+		ViewsCount:        snapshot.ViewsCount,
+		LikesCount:        snapshot.LikesCount,
+		SubscriptionCount: snapshot.SubscriptionCount,
+		CommentsCount:     snapshot.CommentsCount,
+		DriftSeconds:      int64(snapshot.Drift / time.Second),
+	}
+}
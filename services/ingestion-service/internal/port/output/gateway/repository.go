@@ -17,6 +17,10 @@ type KeywordRepository interface {
 	FindByGenre(ctx context.Context, genreID valueobject.UUID, enabledOnly bool) ([]*domain.Keyword, error)
 	FindByGenreAndType(ctx context.Context, genreID valueobject.UUID, filterType valueobject.FilterType, enabledOnly bool) ([]*domain.Keyword, error)
 	SoftDelete(ctx context.Context, id valueobject.UUID) error
+	// Search lists up to limit keywords matching the filter in domain.KeywordOrder,
+	// starting after the cursor position (from the beginning when nil)
+	Search(ctx context.Context, filter domain.KeywordFilter, after *valueobject.PageCursor, limit int) ([]*domain.Keyword, error)
+	CountSearch(ctx context.Context, filter domain.KeywordFilter) (int, error)
 }
 
 // ChannelRepository is the repository interface for Channel aggregate
@@ -28,8 +32,10 @@ type ChannelRepository interface {
 	FindByID(ctx context.Context, id valueobject.UUID) (*domain.Channel, error)
 	FindByYouTubeID(ctx context.Context, ytID valueobject.YouTubeChannelID) (*domain.Channel, error)
 	FindByYouTubeChannelID(ctx context.Context, youtubeChannelID valueobject.YouTubeChannelID) (*domain.Channel, error)
-	List(ctx context.Context, subscribed *bool, q *string, sort string, limit, offset int) ([]*domain.Channel, error)
-	Count(ctx context.Context, subscribed *bool, q *string) (int, error)
+	// Search lists up to limit channels matching the filter in domain.ChannelOrder,
+	// starting after the cursor position (from the beginning when nil)
+	Search(ctx context.Context, filter domain.ChannelFilter, after *valueobject.PageCursor, limit int) ([]*domain.Channel, error)
+	CountSearch(ctx context.Context, filter domain.ChannelFilter) (int, error)
 	ListActive(ctx context.Context) ([]*domain.Channel, error)
 	ListSubscribed(ctx context.Context) ([]*domain.Channel, error)
 }
@@ -37,6 +43,7 @@ type ChannelRepository interface {
 // ChannelSnapshotRepository is the repository interface for ChannelSnapshot (read-only)
 type ChannelSnapshotRepository interface {
 	Latest(ctx context.Context, channelID valueobject.UUID) (*domain.ChannelSnapshot, error)
+	Nearest(ctx context.Context, channelID valueobject.UUID, at time.Time) (*domain.ChannelSnapshot, error) // Snapshot measured closest to at
 	ListByChannel(ctx context.Context, channelID valueobject.UUID, limit int) ([]*domain.ChannelSnapshot, error)
 }
 
@@ -51,6 +58,13 @@ type VideoRepository interface {
 	ListByChannel(ctx context.Context, channelID valueobject.UUID, limit, offset int) ([]*domain.Video, error)
 	CountByChannel(ctx context.Context, channelID valueobject.UUID) (int, error)
 	ListActive(ctx context.Context, since time.Time) ([]*domain.Video, error)
+	// Search lists up to limit videos matching the filter in the given order,
+	// starting after the cursor position (from the beginning when nil)
+	Search(ctx context.Context, filter domain.VideoFilter, order domain.VideoOrder, after *valueobject.PageCursor, limit int) ([]*domain.Video, error)
+	CountSearch(ctx context.Context, filter domain.VideoFilter) (int, error)
+	// SearchGenreScope lists up to limit videos of the genre scope, most recently
+	// published first, starting after the cursor position (from the beginning when nil)
+	SearchGenreScope(ctx context.Context, scope domain.GenreVideoScope, after *valueobject.PageCursor, limit int) ([]*domain.Video, error)
 }
 
 // VideoSnapshotRepository is the repository interface for VideoSnapshot (read-only)
@@ -59,6 +73,15 @@ type VideoSnapshotRepository interface {
 	FindByVideoAndCP(ctx context.Context, videoID valueobject.UUID, cp valueobject.CheckpointHour) (*domain.VideoSnapshot, error)
 	ListByVideo(ctx context.Context, videoID valueobject.UUID) ([]*domain.VideoSnapshot, error)
 	ListByVideoID(ctx context.Context, videoID valueobject.UUID) ([]*domain.VideoSnapshot, error)
+	// Search lists up to limit snapshots matching the filter in domain.SnapshotOrder,
+	// starting after the cursor position (from the beginning when nil)
+	Search(ctx context.Context, filter domain.SnapshotFilter, after *valueobject.PageCursor, limit int) ([]*domain.VideoSnapshot, error)
+}
+
+// SnapshotGapRepository is the repository interface for SnapshotGap
+type SnapshotGapRepository interface {
+	Save(ctx context.Context, gap *domain.SnapshotGap) error
+	ListByVideo(ctx context.Context, videoID valueobject.UUID) ([]*domain.SnapshotGap, error)
 }
 
 // GenreRepository is the repository interface for Genre aggregate
@@ -69,6 +92,20 @@ type GenreRepository interface {
 	FindByCode(ctx context.Context, code string) (*domain.Genre, error)
 	FindAll(ctx context.Context) ([]*domain.Genre, error)
 	FindEnabled(ctx context.Context) ([]*domain.Genre, error)
+	// List lists up to limit genres in domain.GenreOrder, starting after the
+	// cursor position (from the beginning when nil)
+	List(ctx context.Context, enabledOnly bool, after *valueobject.PageCursor, limit int) ([]*domain.Genre, error)
+	Count(ctx context.Context, enabledOnly bool) (int, error)
+}
+
+// CheckpointProfileRepository is the repository interface for CheckpointProfile
+type CheckpointProfileRepository interface {
+	FindByID(ctx context.Context, id valueobject.UUID) (*domain.CheckpointProfile, error)
+	FindByCode(ctx context.Context, code string) (*domain.CheckpointProfile, error)
+	// FindByVideo returns the profiles assigned to the genres of the video
+	FindByVideo(ctx context.Context, videoID valueobject.UUID) ([]*domain.CheckpointProfile, error)
+	// ListActive returns the profiles assigned to enabled genres
+	ListActive(ctx context.Context) ([]*domain.CheckpointProfile, error)
 }
 
 // YouTubeCategoryRepository is the repository interface for YouTubeCategory aggregate
@@ -78,6 +115,10 @@ type YouTubeCategoryRepository interface {
 	FindByID(ctx context.Context, id valueobject.CategoryID) (*domain.YouTubeCategory, error)
 	FindAll(ctx context.Context) ([]*domain.YouTubeCategory, error)
 	FindAssignable(ctx context.Context) ([]*domain.YouTubeCategory, error)
+	// List lists up to limit categories in domain.YouTubeCategoryOrder, starting
+	// after the cursor position (from the beginning when nil)
+	List(ctx context.Context, assignableOnly bool, after *valueobject.PageCursor, limit int) ([]*domain.YouTubeCategory, error)
+	Count(ctx context.Context, assignableOnly bool) (int, error)
 }
 
 // VideoGenreRepository is the repository interface for VideoGenre relationships
@@ -85,6 +126,10 @@ type VideoGenreRepository interface {
 	Save(ctx context.Context, vg *domain.VideoGenre) error
 	SaveBatch(ctx context.Context, vgs []*domain.VideoGenre) error
 	FindByVideo(ctx context.Context, videoID valueobject.UUID) ([]*domain.VideoGenre, error)
+	// ListByVideo lists up to limit genre assignments of a video in
+	// domain.VideoGenreOrder, starting after the cursor position (from the beginning when nil)
+	ListByVideo(ctx context.Context, videoID valueobject.UUID, after *valueobject.PageCursor, limit int) ([]*domain.VideoGenre, error)
+	CountByVideo(ctx context.Context, videoID valueobject.UUID) (int, error)
 	FindByGenre(ctx context.Context, genreID valueobject.UUID) ([]*domain.VideoGenre, error)
 	ExistsByVideoAndGenre(ctx context.Context, videoID, genreID valueobject.UUID) (bool, error)
 	DeleteByVideo(ctx context.Context, videoID valueobject.UUID) error
@@ -94,9 +139,14 @@ type VideoGenreRepository interface {
 // AuditLogRepository is the repository interface for AuditLog
 type AuditLogRepository interface {
 	Save(ctx context.Context, log *domain.AuditLog) error
-	FindByActor(ctx context.Context, actorID valueobject.UUID, limit, offset int) ([]*domain.AuditLog, error)
-	FindByResource(ctx context.Context, resourceType, resourceID string, limit, offset int) ([]*domain.AuditLog, error)
-	FindRecent(ctx context.Context, limit int) ([]*domain.AuditLog, error)
+	FindByID(ctx context.Context, id valueobject.UUID) (*domain.AuditLog, error)
+	// Search lists up to limit audit logs matching the filter in domain.AuditLogOrder,
+	// starting after the cursor position (from the beginning when nil)
+	Search(ctx context.Context, filter domain.AuditLogFilter, after *valueobject.PageCursor, limit int) ([]*domain.AuditLog, error)
+	CountSearch(ctx context.Context, filter domain.AuditLogFilter) (int, error)
+	// DeleteBefore deletes up to limit of the oldest audit logs created before
+	// the cutoff and returns how many were deleted
+	DeleteBefore(ctx context.Context, before time.Time, limit int) (int, error)
 }
 
 // BatchJobRepository is the repository interface for BatchJob
@@ -105,6 +155,28 @@ type BatchJobRepository interface {
 	Update(ctx context.Context, job *domain.BatchJob) error
 	FindByID(ctx context.Context, id valueobject.UUID) (*domain.BatchJob, error)
 	FindByTypeAndStatus(ctx context.Context, jobType domain.JobType, status domain.JobStatus) ([]*domain.BatchJob, error)
-	FindRecent(ctx context.Context, jobType *domain.JobType, limit int) ([]*domain.BatchJob, error)
+	// Search lists up to limit batch jobs matching the filter in domain.BatchJobOrder,
+	// starting after the cursor position (from the beginning when nil)
+	Search(ctx context.Context, filter domain.BatchJobFilter, after *valueobject.PageCursor, limit int) ([]*domain.BatchJob, error)
+	CountSearch(ctx context.Context, filter domain.BatchJobFilter) (int, error)
 	GetRunningJobs(ctx context.Context) ([]*domain.BatchJob, error)
+	// RequestCancel records that cancellation of the job was requested
+	RequestCancel(ctx context.Context, id valueobject.UUID) error
+	// SaveProgress records the progress of a running job and reports whether
+	// cancellation of the job was requested
+	SaveProgress(ctx context.Context, id valueobject.UUID, progress domain.BatchJobProgress) (bool, error)
+}
+
+// BatchJobLockRepository is the repository interface for BatchJobLock leases
+type BatchJobLockRepository interface {
+	// Acquire takes the lease for the job when it is free or expired and
+	// reports whether the job now holds it
+	Acquire(ctx context.Context, key string, jobID valueobject.UUID, holder string, ttl time.Duration) (bool, error)
+	// Heartbeat extends the lease and reports whether the job still holds it
+	Heartbeat(ctx context.Context, key string, jobID valueobject.UUID, ttl time.Duration) (bool, error)
+	// Release gives up the lease if the job still holds it
+	Release(ctx context.Context, key string, jobID valueobject.UUID) error
+	FindByKey(ctx context.Context, key string) (*domain.BatchJobLock, error)
+	// FindByJob finds the leases recorded for the job, expired or not
+	FindByJob(ctx context.Context, jobID valueobject.UUID) ([]*domain.BatchJobLock, error)
 }
\ No newline at end of file
@@ -0,0 +1,12 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/pkg/identityauth"
+)
+
+// TokenVerifier verifies a bearer ID token issued by the identity provider
+type TokenVerifier interface {
+	Verify(ctx context.Context, idToken string) (identityauth.Claims, error)
+}
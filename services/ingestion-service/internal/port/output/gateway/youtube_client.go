@@ -12,10 +12,12 @@ type YouTubeClient interface {
 	GetVideoStats(ctx context.Context, ytVideoID valueobject.YouTubeVideoID) (*VideoStats, error)
 	GetVideoStatistics(ctx context.Context, ytVideoID string) (*VideoStats, error)
 	GetChannelStats(ctx context.Context, ytChannelID valueobject.YouTubeChannelID) (*ChannelStats, error)
-	ListMostPopular(ctx context.Context, categoryID valueobject.CategoryID, pageToken *string) (*TrendingVideos, error)
+	ListMostPopular(ctx context.Context, regionCode string, categoryID valueobject.CategoryID, pageToken *string) (*TrendingVideos, error)
 	GetVideo(ctx context.Context, ytVideoID valueobject.YouTubeVideoID) (*VideoMeta, error)
 	GetChannel(ctx context.Context, ytChannelID valueobject.YouTubeChannelID) (*ChannelMeta, error)
-	GetTrendingVideos(ctx context.Context) ([]*VideoMeta, error)
+	// GetTrendingVideos lists the most popular videos of the region in each of
+	// the categories, or across all categories when none are given
+	GetTrendingVideos(ctx context.Context, regionCode string, categoryIDs []valueobject.CategoryID) ([]*VideoMeta, error)
 	GetChannelVideos(ctx context.Context, channelID valueobject.YouTubeChannelID) ([]*VideoMeta, error)
 }
 
@@ -24,11 +26,15 @@ type VideoStats struct {
 	ViewCount    int64
 	LikeCount    int64
 	CommentCount int64
+	// FavoriteCount is nil when YouTube does not report it; the field is
+	// deprecated and omitted for most videos
+	FavoriteCount *int64
 }
 
 // ChannelStats represents channel statistics from YouTube API
 type ChannelStats struct {
 	SubscriberCount int64
+	ViewCount       int64
 	VideoCount      int64
 }
 
@@ -40,6 +46,7 @@ type VideoMeta struct {
 	Description  string
 	PublishedAt  time.Time
 	CategoryID   valueobject.CategoryID
+	Duration     time.Duration // Zero when the API call did not return content details
 	Thumbnails   Thumbnails
 	ThumbnailURL string
 }
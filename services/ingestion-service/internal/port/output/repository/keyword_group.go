@@ -27,6 +27,14 @@ type KeywordGroupRepository interface {
 	// FindByGenreID finds all keyword groups for a genre
 	FindByGenreID(ctx context.Context, genreID valueobject.UUID) ([]*domain.KeywordGroup, error)
 
+	// ListByGenre lists up to limit keyword groups of a genre in
+	// domain.KeywordGroupOrder, starting after the cursor position (from the
+	// beginning when nil)
+	ListByGenre(ctx context.Context, genreID valueobject.UUID, enabledOnly bool, after *valueobject.PageCursor, limit int) ([]*domain.KeywordGroup, error)
+
+	// CountByGenre counts the keyword groups of a genre
+	CountByGenre(ctx context.Context, genreID valueobject.UUID, enabledOnly bool) (int, error)
+
 	// List lists keyword groups with pagination
 	List(ctx context.Context, limit, offset int) ([]*domain.KeywordGroup, error)
 
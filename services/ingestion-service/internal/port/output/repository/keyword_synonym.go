@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+)
+
+// KeywordSynonymRepository interface for synonym dictionary persistence
+type KeywordSynonymRepository interface {
+	// Create creates a new synonym entry
+	Create(ctx context.Context, synonym *domain.KeywordSynonym) error
+
+	// Update updates an existing synonym entry
+	Update(ctx context.Context, synonym *domain.KeywordSynonym) error
+
+	// Delete deletes a synonym entry
+	Delete(ctx context.Context, id valueobject.UUID) error
+
+	// FindByID finds a synonym entry by ID
+	FindByID(ctx context.Context, id valueobject.UUID) (*domain.KeywordSynonym, error)
+
+	// List lists the synonym entries of a language, or of all languages when
+	// language is empty
+	List(ctx context.Context, language string) ([]*domain.KeywordSynonym, error)
+
+	// ListPage lists up to limit entries of a language, or of all languages
+	// when language is empty, in domain.KeywordSynonymOrder, starting after the
+	// cursor position (from the beginning when nil)
+	ListPage(ctx context.Context, language string, after *valueobject.PageCursor, limit int) ([]*domain.KeywordSynonym, error)
+
+	// Count counts the entries of a language, or of all languages when language is empty
+	Count(ctx context.Context, language string) (int, error)
+
+	// Version returns the dictionary version, which changes whenever an entry
+	// is created, updated or deleted
+	Version(ctx context.Context) (int64, error)
+}
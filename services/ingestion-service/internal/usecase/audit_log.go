@@ -2,6 +2,10 @@ package usecase
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
 
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
@@ -10,6 +14,16 @@ import (
 	"github.com/google/uuid"
 )
 
+// Page sizes for ListAuditLogs
+const (
+	defaultAuditLogPageSize = 100
+	maxAuditLogPageSize     = 1000
+)
+
+// auditLogBatchSize is how many audit logs are read or deleted at a time when
+// exporting or purging
+const auditLogBatchSize = 1000
+
 // auditLogUseCase implements the AuditLogInputPort interface
 type auditLogUseCase struct {
 	auditLogRepo gateway.AuditLogRepository
@@ -27,7 +41,7 @@ func (u *auditLogUseCase) CreateAuditLog(ctx context.Context, input *input.Creat
 	// Create domain object
 	auditLog := &domain.AuditLog{
 		ID:           valueobject.UUID(uuid.New().String()),
-		ActorID:      valueobject.UUID(input.ActorID.String()),
+		ActorID:      input.ActorID,
 		ActorEmail:   input.ActorEmail,
 		Action:       input.Action,
 		ResourceType: input.ResourceType,
@@ -36,6 +50,7 @@ func (u *auditLogUseCase) CreateAuditLog(ctx context.Context, input *input.Creat
 		NewValues:    input.NewValues,
 		IPAddress:    input.IPAddress,
 		UserAgent:    input.UserAgent,
+		CreatedAt:    time.Now(),
 	}
 
 	// Save to repository
@@ -46,17 +61,134 @@ func (u *auditLogUseCase) CreateAuditLog(ctx context.Context, input *input.Creat
 	return auditLog, nil
 }
 
-// ListAuditLogsByActor lists audit logs by actor ID
-func (u *auditLogUseCase) ListAuditLogsByActor(ctx context.Context, actorID uuid.UUID, limit, offset int) ([]*domain.AuditLog, error) {
-	return u.auditLogRepo.FindByActor(ctx, valueobject.UUID(actorID.String()), limit, offset)
+// GetAuditLog gets an audit log by ID
+func (u *auditLogUseCase) GetAuditLog(ctx context.Context, auditLogID uuid.UUID) (*domain.AuditLog, error) {
+	return u.auditLogRepo.FindByID(ctx, valueobject.UUID(auditLogID.String()))
+}
+
+// ListAuditLogs returns one page of the audit logs matching the filter, newest first
+func (u *auditLogUseCase) ListAuditLogs(ctx context.Context, in *input.ListAuditLogsInput) (*input.ListAuditLogsResult, error) {
+	size := pageSize(in.PageSize, defaultAuditLogPageSize, maxAuditLogPageSize)
+	filter := valueobject.FilterDigest(in.Filter)
+	after, err := valueobject.ParsePageToken(in.PageToken, domain.AuditLogOrder, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch one extra log to learn whether another page follows
+	logs, err := u.auditLogRepo.Search(ctx, in.Filter, after, size+1)
+	if err != nil {
+		return nil, err
+	}
+	total, err := u.auditLogRepo.CountSearch(ctx, in.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &input.ListAuditLogsResult{TotalCount: total}
+	result.AuditLogs, result.NextPageToken = nextPage(logs, size, filter, func(l *domain.AuditLog) valueobject.PageCursor {
+		return valueobject.PageCursor{Order: domain.AuditLogOrder, Key: l.CreatedAt, ID: string(l.ID)}
+	})
+	return result, nil
 }
 
-// ListAuditLogsByResource lists audit logs by resource
-func (u *auditLogUseCase) ListAuditLogsByResource(ctx context.Context, resourceType, resourceID string, limit, offset int) ([]*domain.AuditLog, error) {
-	return u.auditLogRepo.FindByResource(ctx, resourceType, resourceID, limit, offset)
+// ExportAuditLogs writes every audit log matching the filter to w as JSON Lines
+func (u *auditLogUseCase) ExportAuditLogs(ctx context.Context, filter domain.AuditLogFilter, w io.Writer) (int, error) {
+	enc := json.NewEncoder(w)
+	written := 0
+	var after *valueobject.PageCursor
+	for {
+		logs, err := u.auditLogRepo.Search(ctx, filter, after, auditLogBatchSize)
+		if err != nil {
+			return written, err
+		}
+		for _, l := range logs {
+			if err := enc.Encode(toAuditLogRecord(l)); err != nil {
+				return written, fmt.Errorf("failed to write audit log %s: %w", l.ID, err)
+			}
+			written++
+		}
+		if len(logs) < auditLogBatchSize {
+			return written, nil
+		}
+
+		last := logs[len(logs)-1]
+		after = &valueobject.PageCursor{Order: domain.AuditLogOrder, Key: last.CreatedAt, ID: string(last.ID)}
+	}
 }
 
-// ListRecentAuditLogs lists recent audit logs
-func (u *auditLogUseCase) ListRecentAuditLogs(ctx context.Context, limit int) ([]*domain.AuditLog, error) {
-	return u.auditLogRepo.FindRecent(ctx, limit)
-}
\ No newline at end of file
+// PurgeAuditLogs deletes the audit logs created before the cutoff, archiving
+// them first when an archive is given. Nothing is deleted unless the archive is
+// committed; the caller aborts an archive left uncommitted.
+func (u *auditLogUseCase) PurgeAuditLogs(ctx context.Context, in *input.PurgeAuditLogsInput) (*input.PurgeAuditLogsResult, error) {
+	filter := domain.AuditLogFilter{CreatedBefore: &in.Before}
+	expired, err := u.auditLogRepo.CountSearch(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &input.PurgeAuditLogsResult{Expired: expired}
+	if expired == 0 {
+		return result, nil
+	}
+	if in.DryRun || domain.IsDryRun(ctx) {
+		domain.PlanChange(ctx, domain.PlannedChange{Action: domain.PlannedDelete, Resource: "audit_log", Detail: fmt.Sprintf("%d created before %s", expired, in.Before.Format(time.RFC3339))})
+		return result, nil
+	}
+
+	if in.Archive != nil {
+		result.Archived, err = u.ExportAuditLogs(ctx, filter, in.Archive)
+		if err != nil {
+			return result, fmt.Errorf("failed to archive audit logs: %w", err)
+		}
+		if err := in.Archive.Commit(); err != nil {
+			return result, fmt.Errorf("failed to store audit log archive: %w", err)
+		}
+	}
+
+	for {
+		deleted, err := u.auditLogRepo.DeleteBefore(ctx, in.Before, auditLogBatchSize)
+		if err != nil {
+			return result, err
+		}
+		result.Deleted += deleted
+		if deleted < auditLogBatchSize {
+			return result, nil
+		}
+	}
+}
+
+// auditLogRecord is the JSON Lines form of an exported audit log
+type auditLogRecord struct {
+	ID           string                 `json:"id"`
+	ActorID      string                 `json:"actor_id"`
+	ActorEmail   string                 `json:"actor_email,omitempty"`
+	Action       string                 `json:"action"`
+	ResourceType string                 `json:"resource_type"`
+	ResourceID   string                 `json:"resource_id,omitempty"`
+	OldValues    map[string]interface{} `json:"old_values,omitempty"`
+	NewValues    map[string]interface{} `json:"new_values,omitempty"`
+	IPAddress    string                 `json:"ip_address,omitempty"`
+	UserAgent    string                 `json:"user_agent,omitempty"`
+	CreatedAt    time.Time              `json:"created_at"`
+}
+
+// toAuditLogRecord converts an audit log to its exported form
+func toAuditLogRecord(l *domain.AuditLog) auditLogRecord {
+	r := auditLogRecord{
+		ID:           string(l.ID),
+		ActorID:      l.ActorID,
+		ActorEmail:   l.ActorEmail,
+		Action:       l.Action,
+		ResourceType: l.ResourceType,
+		ResourceID:   l.ResourceID,
+		OldValues:    l.OldValues,
+		NewValues:    l.NewValues,
+		UserAgent:    l.UserAgent,
+		CreatedAt:    l.CreatedAt,
+	}
+	if l.IPAddress != nil {
+		r.IPAddress = l.IPAddress.String()
+	}
+	return r
+}
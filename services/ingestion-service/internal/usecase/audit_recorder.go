@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/input"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
+)
+
+// auditRecorder writes the audit logs of changes made through the audited use cases
+type auditRecorder struct {
+	auditLogUseCase input.AuditLogInputPort
+	txManager       gateway.TransactionManager
+}
+
+// audited runs a change in a transaction together with the audit logs it
+// records, so the values read before the change are the ones it replaced and
+// a change is never committed without its log
+func audited[T any](ctx context.Context, r auditRecorder, change func(ctx context.Context) (T, error)) (T, error) {
+	var result T
+	err := r.txManager.Execute(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = change(ctx)
+		return err
+	})
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return result, nil
+}
+
+// transact runs a change that returns no value in a transaction together with
+// the audit logs it records
+func (r auditRecorder) transact(ctx context.Context, change func(ctx context.Context) error) error {
+	return r.txManager.Execute(ctx, change)
+}
+
+// record writes an audit log of a change of the resource from before to after,
+// attributed to the actor of the context. Creations pass a nil before and
+// deletions a nil after; other changes record only the fields that differ.
+// Dry runs change nothing and are not recorded.
+func (r auditRecorder) record(ctx context.Context, action, resourceType, resourceID string, before, after map[string]interface{}) error {
+	if domain.IsDryRun(ctx) {
+		return nil
+	}
+	actor := domain.ActorFromContext(ctx)
+	oldValues, newValues := diffValues(before, after)
+
+	_, err := r.auditLogUseCase.CreateAuditLog(ctx, &input.CreateAuditLogInput{
+		ActorID:      actor.ID,
+		ActorEmail:   actor.Email,
+		Action:       action,
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		OldValues:    oldValues,
+		NewValues:    newValues,
+		IPAddress:    actor.IPAddress,
+		UserAgent:    actor.UserAgent,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record audit log for %s %s %s: %w", action, resourceType, resourceID, err)
+	}
+	return nil
+}
+
+// diffValues returns the fields whose values differ between before and after.
+// When either side is nil the other is returned whole.
+func diffValues(before, after map[string]interface{}) (map[string]interface{}, map[string]interface{}) {
+	if before == nil || after == nil {
+		return before, after
+	}
+
+	oldValues := make(map[string]interface{})
+	newValues := make(map[string]interface{})
+	for k, v := range before {
+		if w, ok := after[k]; !ok || !reflect.DeepEqual(v, w) {
+			oldValues[k] = v
+		}
+	}
+	for k, w := range after {
+		if v, ok := before[k]; !ok || !reflect.DeepEqual(v, w) {
+			newValues[k] = w
+		}
+	}
+	return oldValues, newValues
+}
@@ -0,0 +1,274 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/input"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
+	"github.com/google/uuid"
+)
+
+// fakeTxKey marks the context of a fakeStore transaction
+type fakeTxKey struct{}
+
+// fakeStore is an in-memory database whose writes inside a transaction only
+// apply when it commits
+type fakeStore struct {
+	writesOutsideTx int
+}
+
+// Execute runs fn in a transaction that commits when fn succeeds
+func (s *fakeStore) Execute(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(fakeTxKey{}).(*[]func()); ok {
+		return fn(ctx) // Joins the outer transaction
+	}
+	var pending []func()
+	if err := fn(context.WithValue(ctx, fakeTxKey{}, &pending)); err != nil {
+		return err
+	}
+	for _, apply := range pending {
+		apply()
+	}
+	return nil
+}
+
+// write applies a write when the transaction of ctx commits, or right away
+// outside a transaction
+func (s *fakeStore) write(ctx context.Context, apply func()) {
+	if pending, ok := ctx.Value(fakeTxKey{}).(*[]func()); ok {
+		*pending = append(*pending, apply)
+		return
+	}
+	s.writesOutsideTx++
+	apply()
+}
+
+// fakeAuditLogRepo stores audit logs in a fakeStore. Methods the tests do not
+// use are left to the embedded nil interface.
+type fakeAuditLogRepo struct {
+	gateway.AuditLogRepository
+	store *fakeStore
+	err   error // Returned by Save when set
+	logs  []*domain.AuditLog
+}
+
+func (r *fakeAuditLogRepo) Save(ctx context.Context, log *domain.AuditLog) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.store.write(ctx, func() { r.logs = append(r.logs, log) })
+	return nil
+}
+
+// fakeKeywordGroupUseCase keeps keyword groups in a fakeStore
+type fakeKeywordGroupUseCase struct {
+	input.KeywordGroupInputPort
+	store  *fakeStore
+	groups map[uuid.UUID]domain.KeywordGroup
+}
+
+func (u *fakeKeywordGroupUseCase) CreateKeywordGroup(ctx context.Context, in input.CreateKeywordGroupInput) (*domain.KeywordGroup, error) {
+	id := uuid.New()
+	group, err := domain.NewKeywordGroup(valueobject.UUID(id.String()), valueobject.UUID(in.GenreID.String()), in.Name, in.FilterType, in.TargetField, in.Description, in.Keywords)
+	if err != nil {
+		return nil, err
+	}
+	group.CrossLanguage, group.Romaji = in.CrossLanguage, in.Romaji
+	if !domain.IsDryRun(ctx) {
+		u.store.write(ctx, func() { u.groups[id] = *group })
+	}
+	return group, nil
+}
+
+func (u *fakeKeywordGroupUseCase) UpdateKeywordGroup(ctx context.Context, groupID uuid.UUID, in input.UpdateKeywordGroupInput) (*domain.KeywordGroup, error) {
+	group, err := u.GetKeywordGroup(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if in.Name != nil {
+		group.Name = *in.Name
+	}
+	if in.TargetField != nil {
+		group.TargetField = *in.TargetField
+	}
+	if in.Description != nil {
+		group.Description = descriptionPtr(*in.Description)
+	}
+	if in.CrossLanguage != nil {
+		group.CrossLanguage = *in.CrossLanguage
+	}
+	if in.Romaji != nil {
+		group.Romaji = *in.Romaji
+	}
+	if !domain.IsDryRun(ctx) {
+		u.store.write(ctx, func() { u.groups[groupID] = *group })
+	}
+	return group, nil
+}
+
+func (u *fakeKeywordGroupUseCase) DeleteKeywordGroup(ctx context.Context, groupID uuid.UUID) error {
+	if _, err := u.GetKeywordGroup(ctx, groupID); err != nil {
+		return err
+	}
+	if !domain.IsDryRun(ctx) {
+		u.store.write(ctx, func() { delete(u.groups, groupID) })
+	}
+	return nil
+}
+
+func (u *fakeKeywordGroupUseCase) GetKeywordGroup(ctx context.Context, groupID uuid.UUID) (*domain.KeywordGroup, error) {
+	group, ok := u.groups[groupID]
+	if !ok {
+		return nil, domain.ErrKeywordGroupNotFound
+	}
+	return &group, nil
+}
+
+func TestAuditedKeywordGroupUseCase(t *testing.T) {
+	genreID := uuid.New()
+	groupID := uuid.New()
+	admin := domain.Actor{ID: "admin-1", Email: "admin@example.com", IPAddress: net.ParseIP("10.0.0.1"), UserAgent: "curl/8.0"}
+	newName := "Go"
+	errConnReset := errors.New("connection reset")
+
+	tests := []struct {
+		name      string
+		actor     *domain.Actor // Actor of the request; nil for none
+		dryRun    bool
+		auditErr  error // Failure of the audit log write
+		change    func(ctx context.Context, uc input.KeywordGroupInputPort) error
+		wantErr   error
+		wantLog   *domain.AuditLog // Audit log written, compared on action, resource, values and actor
+		wantGroup string           // Name of the stored group afterwards; "" when it does not exist
+	}{
+		{
+			name:  "update records only the changed fields and the actor",
+			actor: &admin,
+			change: func(ctx context.Context, uc input.KeywordGroupInputPort) error {
+				_, err := uc.UpdateKeywordGroup(ctx, groupID, input.UpdateKeywordGroupInput{Name: &newName})
+				return err
+			},
+			wantLog: &domain.AuditLog{
+				ActorID: admin.ID, ActorEmail: admin.Email, IPAddress: admin.IPAddress, UserAgent: admin.UserAgent,
+				Action: domain.AuditActionUpdate, ResourceType: domain.AuditResourceKeywordGroup, ResourceID: groupID.String(),
+				OldValues: map[string]interface{}{"name": "Golang"},
+				NewValues: map[string]interface{}{"name": "Go"},
+			},
+			wantGroup: "Go",
+		},
+		{
+			name:  "delete records the last values",
+			actor: &admin,
+			change: func(ctx context.Context, uc input.KeywordGroupInputPort) error {
+				return uc.DeleteKeywordGroup(ctx, groupID)
+			},
+			wantLog: &domain.AuditLog{
+				ActorID: admin.ID, ActorEmail: admin.Email, IPAddress: admin.IPAddress, UserAgent: admin.UserAgent,
+				Action: domain.AuditActionDelete, ResourceType: domain.AuditResourceKeywordGroup, ResourceID: groupID.String(),
+				OldValues: map[string]interface{}{
+					"genre_id": genreID.String(), "name": "Golang", "filter_type": "include", "target_field": "title",
+					"enabled": true, "keywords": []string{"golang"}, "description": nil, "cross_language": false, "romaji": false,
+				},
+			},
+		},
+		{
+			name: "change without a caller is attributed to the system",
+			change: func(ctx context.Context, uc input.KeywordGroupInputPort) error {
+				_, err := uc.UpdateKeywordGroup(ctx, groupID, input.UpdateKeywordGroupInput{Name: &newName})
+				return err
+			},
+			wantLog: &domain.AuditLog{
+				ActorID: domain.SystemActorID,
+				Action:  domain.AuditActionUpdate, ResourceType: domain.AuditResourceKeywordGroup, ResourceID: groupID.String(),
+				OldValues: map[string]interface{}{"name": "Golang"},
+				NewValues: map[string]interface{}{"name": "Go"},
+			},
+			wantGroup: "Go",
+		},
+		{
+			name:     "change is rolled back when its audit log cannot be written",
+			actor:    &admin,
+			auditErr: errConnReset,
+			change: func(ctx context.Context, uc input.KeywordGroupInputPort) error {
+				_, err := uc.UpdateKeywordGroup(ctx, groupID, input.UpdateKeywordGroupInput{Name: &newName})
+				return err
+			},
+			wantErr:   errConnReset,
+			wantGroup: "Golang",
+		},
+		{
+			name:  "failed change writes no audit log",
+			actor: &admin,
+			change: func(ctx context.Context, uc input.KeywordGroupInputPort) error {
+				return uc.DeleteKeywordGroup(ctx, uuid.New())
+			},
+			wantErr:   domain.ErrKeywordGroupNotFound,
+			wantGroup: "Golang",
+		},
+		{
+			name:   "dry run writes no audit log",
+			actor:  &admin,
+			dryRun: true,
+			change: func(ctx context.Context, uc input.KeywordGroupInputPort) error {
+				_, err := uc.UpdateKeywordGroup(ctx, groupID, input.UpdateKeywordGroupInput{Name: &newName})
+				return err
+			},
+			wantGroup: "Golang",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &fakeStore{}
+			auditRepo := &fakeAuditLogRepo{store: store, err: tt.auditErr}
+			group, err := domain.NewKeywordGroup(valueobject.UUID(groupID.String()), valueobject.UUID(genreID.String()), "Golang", valueobject.FilterTypeInclude, "", nil, []string{"golang"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			groups := &fakeKeywordGroupUseCase{store: store, groups: map[uuid.UUID]domain.KeywordGroup{groupID: *group}}
+			uc := NewAuditedKeywordGroupUseCase(groups, NewAuditLogUseCase(auditRepo), store)
+
+			ctx := context.Background()
+			if tt.actor != nil {
+				ctx = domain.WithActor(ctx, *tt.actor)
+			}
+			if tt.dryRun {
+				ctx = domain.WithDryRunReport(ctx, &domain.DryRunReport{})
+			}
+
+			err = tt.change(ctx, uc)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if store.writesOutsideTx != 0 {
+				t.Errorf("%d writes outside the change's transaction", store.writesOutsideTx)
+			}
+
+			stored := groups.groups[groupID]
+			if got := stored.Name; got != tt.wantGroup {
+				t.Errorf("stored group name = %q, want %q", got, tt.wantGroup)
+			}
+
+			if tt.wantLog == nil {
+				if len(auditRepo.logs) != 0 {
+					t.Fatalf("%d audit logs written, want none", len(auditRepo.logs))
+				}
+				return
+			}
+			if len(auditRepo.logs) != 1 {
+				t.Fatalf("%d audit logs written, want 1", len(auditRepo.logs))
+			}
+			got := *auditRepo.logs[0]
+			got.ID, got.CreatedAt = "", tt.wantLog.CreatedAt
+			if !reflect.DeepEqual(&got, tt.wantLog) {
+				t.Errorf("audit log = %+v, want %+v", got, *tt.wantLog)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/input"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
+	"github.com/google/uuid"
+)
+
+// auditedChannelUseCase decorates a channel use case with audit logging of subscription changes
+type auditedChannelUseCase struct {
+	input.ChannelInputPort
+	audit auditRecorder
+}
+
+// NewAuditedChannelUseCase wraps a channel use case so that each subscription change is audited
+func NewAuditedChannelUseCase(channelUseCase input.ChannelInputPort, auditLogUseCase input.AuditLogInputPort, txManager gateway.TransactionManager) input.ChannelInputPort {
+	return &auditedChannelUseCase{
+		ChannelInputPort: channelUseCase,
+		audit:            auditRecorder{auditLogUseCase: auditLogUseCase, txManager: txManager},
+	}
+}
+
+// SubscribeChannel subscribes to a channel and audits the change. A channel
+// registered by the subscription is audited whole.
+func (u *auditedChannelUseCase) SubscribeChannel(ctx context.Context, youtubeChannelID string) (*domain.Channel, error) {
+	return audited(ctx, u.audit, func(ctx context.Context) (*domain.Channel, error) {
+		var before map[string]interface{}
+		existing, err := u.ChannelInputPort.GetChannelByYouTubeID(ctx, youtubeChannelID)
+		switch {
+		case err == nil:
+			before = channelValues(existing)
+		case !errors.Is(err, domain.ErrChannelNotFound):
+			return nil, err
+		}
+
+		after, err := u.ChannelInputPort.SubscribeChannel(ctx, youtubeChannelID)
+		if err != nil {
+			return nil, err
+		}
+		return after, u.audit.record(ctx, domain.AuditActionSubscribe, domain.AuditResourceChannel, string(after.ID), before, channelValues(after))
+	})
+}
+
+// UnsubscribeChannel unsubscribes from a channel and audits the change
+func (u *auditedChannelUseCase) UnsubscribeChannel(ctx context.Context, channelID uuid.UUID) (*domain.Channel, error) {
+	return audited(ctx, u.audit, func(ctx context.Context) (*domain.Channel, error) {
+		before, err := u.ChannelInputPort.GetChannel(ctx, channelID)
+		if err != nil {
+			return nil, err
+		}
+		after, err := u.ChannelInputPort.UnsubscribeChannel(ctx, channelID)
+		if err != nil {
+			return nil, err
+		}
+		return after, u.audit.record(ctx, domain.AuditActionUnsubscribe, domain.AuditResourceChannel, string(after.ID), channelValues(before), channelValues(after))
+	})
+}
+
+// channelValues returns the audited fields of a channel
+func channelValues(c *domain.Channel) map[string]interface{} {
+	return map[string]interface{}{
+		"youtube_channel_id": string(c.YouTubeChannelID),
+		"title":              c.Title,
+		"subscribed":         c.Subscribed,
+	}
+}
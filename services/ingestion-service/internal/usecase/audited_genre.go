@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/input"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
+	"github.com/google/uuid"
+)
+
+// auditedGenreUseCase decorates a genre use case with audit logging of changes
+type auditedGenreUseCase struct {
+	input.GenreInputPort
+	audit auditRecorder
+}
+
+// NewAuditedGenreUseCase wraps a genre use case so that each change is audited
+func NewAuditedGenreUseCase(genreUseCase input.GenreInputPort, auditLogUseCase input.AuditLogInputPort, txManager gateway.TransactionManager) input.GenreInputPort {
+	return &auditedGenreUseCase{
+		GenreInputPort: genreUseCase,
+		audit:          auditRecorder{auditLogUseCase: auditLogUseCase, txManager: txManager},
+	}
+}
+
+// CreateGenre creates a genre and audits it
+func (u *auditedGenreUseCase) CreateGenre(ctx context.Context, in *input.CreateGenreInput) (*domain.Genre, error) {
+	return audited(ctx, u.audit, func(ctx context.Context) (*domain.Genre, error) {
+		genre, err := u.GenreInputPort.CreateGenre(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+		return genre, u.audit.record(ctx, domain.AuditActionCreate, domain.AuditResourceGenre, string(genre.ID), nil, genreValues(genre))
+	})
+}
+
+// UpdateGenre updates a genre and audits the change
+func (u *auditedGenreUseCase) UpdateGenre(ctx context.Context, in *input.UpdateGenreInput) (*domain.Genre, error) {
+	return u.change(ctx, domain.AuditActionUpdate, in.GenreID, func(ctx context.Context) (*domain.Genre, error) {
+		return u.GenreInputPort.UpdateGenre(ctx, in)
+	})
+}
+
+// EnableGenre enables a genre and audits the change
+func (u *auditedGenreUseCase) EnableGenre(ctx context.Context, genreID uuid.UUID) (*domain.Genre, error) {
+	return u.change(ctx, domain.AuditActionEnable, genreID, func(ctx context.Context) (*domain.Genre, error) {
+		return u.GenreInputPort.EnableGenre(ctx, genreID)
+	})
+}
+
+// DisableGenre disables a genre and audits the change
+func (u *auditedGenreUseCase) DisableGenre(ctx context.Context, genreID uuid.UUID) (*domain.Genre, error) {
+	return u.change(ctx, domain.AuditActionDisable, genreID, func(ctx context.Context) (*domain.Genre, error) {
+		return u.GenreInputPort.DisableGenre(ctx, genreID)
+	})
+}
+
+// change applies a change to an existing genre and audits its values before and after
+func (u *auditedGenreUseCase) change(ctx context.Context, action string, genreID uuid.UUID, apply func(ctx context.Context) (*domain.Genre, error)) (*domain.Genre, error) {
+	return audited(ctx, u.audit, func(ctx context.Context) (*domain.Genre, error) {
+		before, err := u.GenreInputPort.GetGenre(ctx, genreID)
+		if err != nil {
+			return nil, err
+		}
+		after, err := apply(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return after, u.audit.record(ctx, action, domain.AuditResourceGenre, string(after.ID), genreValues(before), genreValues(after))
+	})
+}
+
+// genreValues returns the audited fields of a genre
+func genreValues(g *domain.Genre) map[string]interface{} {
+	categoryIDs := make([]int, len(g.CategoryIDs))
+	for i, id := range g.CategoryIDs {
+		categoryIDs[i] = int(id)
+	}
+	return map[string]interface{}{
+		"code":                  g.Code,
+		"name":                  g.Name,
+		"language":              g.Language,
+		"region_code":           g.RegionCode,
+		"category_ids":          categoryIDs,
+		"enabled":               g.Enabled,
+		"checkpoint_profile_id": string(g.CheckpointProfileID),
+	}
+}
@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/input"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
+	"github.com/google/uuid"
+)
+
+// auditedKeywordUseCase decorates a keyword use case with audit logging of changes
+type auditedKeywordUseCase struct {
+	input.KeywordInputPort
+	audit auditRecorder
+}
+
+// NewAuditedKeywordUseCase wraps a keyword use case so that each change is audited
+func NewAuditedKeywordUseCase(keywordUseCase input.KeywordInputPort, auditLogUseCase input.AuditLogInputPort, txManager gateway.TransactionManager) input.KeywordInputPort {
+	return &auditedKeywordUseCase{
+		KeywordInputPort: keywordUseCase,
+		audit:            auditRecorder{auditLogUseCase: auditLogUseCase, txManager: txManager},
+	}
+}
+
+// CreateKeyword creates a keyword and audits it
+func (u *auditedKeywordUseCase) CreateKeyword(ctx context.Context, in *input.CreateKeywordInput) (*domain.Keyword, error) {
+	return audited(ctx, u.audit, func(ctx context.Context) (*domain.Keyword, error) {
+		keyword, err := u.KeywordInputPort.CreateKeyword(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+		return keyword, u.audit.record(ctx, domain.AuditActionCreate, domain.AuditResourceKeyword, string(keyword.ID), nil, keywordValues(keyword))
+	})
+}
+
+// UpdateKeyword updates a keyword and audits the change
+func (u *auditedKeywordUseCase) UpdateKeyword(ctx context.Context, in *input.UpdateKeywordInput) (*domain.Keyword, error) {
+	return u.change(ctx, domain.AuditActionUpdate, in.KeywordID, func(ctx context.Context) (*domain.Keyword, error) {
+		return u.KeywordInputPort.UpdateKeyword(ctx, in)
+	})
+}
+
+// EnableKeyword enables a keyword and audits the change
+func (u *auditedKeywordUseCase) EnableKeyword(ctx context.Context, keywordID uuid.UUID) (*domain.Keyword, error) {
+	return u.change(ctx, domain.AuditActionEnable, keywordID, func(ctx context.Context) (*domain.Keyword, error) {
+		return u.KeywordInputPort.EnableKeyword(ctx, keywordID)
+	})
+}
+
+// DisableKeyword disables a keyword and audits the change
+func (u *auditedKeywordUseCase) DisableKeyword(ctx context.Context, keywordID uuid.UUID) (*domain.Keyword, error) {
+	return u.change(ctx, domain.AuditActionDisable, keywordID, func(ctx context.Context) (*domain.Keyword, error) {
+		return u.KeywordInputPort.DisableKeyword(ctx, keywordID)
+	})
+}
+
+// DeleteKeyword deletes a keyword and audits its last values
+func (u *auditedKeywordUseCase) DeleteKeyword(ctx context.Context, keywordID uuid.UUID) error {
+	return u.audit.transact(ctx, func(ctx context.Context) error {
+		before, err := u.KeywordInputPort.GetKeyword(ctx, keywordID)
+		if err != nil {
+			return err
+		}
+		if err := u.KeywordInputPort.DeleteKeyword(ctx, keywordID); err != nil {
+			return err
+		}
+		return u.audit.record(ctx, domain.AuditActionDelete, domain.AuditResourceKeyword, string(before.ID), keywordValues(before), nil)
+	})
+}
+
+// change applies a change to an existing keyword and audits its values before and after
+func (u *auditedKeywordUseCase) change(ctx context.Context, action string, keywordID uuid.UUID, apply func(ctx context.Context) (*domain.Keyword, error)) (*domain.Keyword, error) {
+	return audited(ctx, u.audit, func(ctx context.Context) (*domain.Keyword, error) {
+		before, err := u.KeywordInputPort.GetKeyword(ctx, keywordID)
+		if err != nil {
+			return nil, err
+		}
+		after, err := apply(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return after, u.audit.record(ctx, action, domain.AuditResourceKeyword, string(after.ID), keywordValues(before), keywordValues(after))
+	})
+}
+
+// keywordValues returns the audited fields of a keyword
+func keywordValues(k *domain.Keyword) map[string]interface{} {
+	values := map[string]interface{}{
+		"genre_id":     string(k.GenreID),
+		"name":         k.Name,
+		"filter_type":  string(k.FilterType),
+		"pattern":      k.Pattern,
+		"target_field": k.TargetField,
+		"enabled":      k.Enabled,
+		"description":  nil,
+	}
+	if k.Description != nil {
+		values["description"] = *k.Description
+	}
+	return values
+}
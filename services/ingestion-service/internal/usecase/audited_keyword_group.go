@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/input"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
+	"github.com/google/uuid"
+)
+
+// auditedKeywordGroupUseCase decorates a keyword group use case with audit logging of changes
+type auditedKeywordGroupUseCase struct {
+	input.KeywordGroupInputPort
+	audit auditRecorder
+}
+
+// NewAuditedKeywordGroupUseCase wraps a keyword group use case so that each change is audited
+func NewAuditedKeywordGroupUseCase(keywordGroupUseCase input.KeywordGroupInputPort, auditLogUseCase input.AuditLogInputPort, txManager gateway.TransactionManager) input.KeywordGroupInputPort {
+	return &auditedKeywordGroupUseCase{
+		KeywordGroupInputPort: keywordGroupUseCase,
+		audit:                 auditRecorder{auditLogUseCase: auditLogUseCase, txManager: txManager},
+	}
+}
+
+// CreateKeywordGroup creates a keyword group and audits it
+func (u *auditedKeywordGroupUseCase) CreateKeywordGroup(ctx context.Context, in input.CreateKeywordGroupInput) (*domain.KeywordGroup, error) {
+	return audited(ctx, u.audit, func(ctx context.Context) (*domain.KeywordGroup, error) {
+		group, err := u.KeywordGroupInputPort.CreateKeywordGroup(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+		return group, u.audit.record(ctx, domain.AuditActionCreate, domain.AuditResourceKeywordGroup, string(group.ID), nil, keywordGroupValues(group))
+	})
+}
+
+// UpdateKeywordGroup updates a keyword group and audits the change
+func (u *auditedKeywordGroupUseCase) UpdateKeywordGroup(ctx context.Context, groupID uuid.UUID, in input.UpdateKeywordGroupInput) (*domain.KeywordGroup, error) {
+	return u.change(ctx, domain.AuditActionUpdate, groupID, func(ctx context.Context) (*domain.KeywordGroup, error) {
+		return u.KeywordGroupInputPort.UpdateKeywordGroup(ctx, groupID, in)
+	})
+}
+
+// UpdateKeywords replaces the keywords of a group and audits the change
+func (u *auditedKeywordGroupUseCase) UpdateKeywords(ctx context.Context, groupID uuid.UUID, keywords []string) (*domain.KeywordGroup, error) {
+	return u.change(ctx, domain.AuditActionUpdate, groupID, func(ctx context.Context) (*domain.KeywordGroup, error) {
+		return u.KeywordGroupInputPort.UpdateKeywords(ctx, groupID, keywords)
+	})
+}
+
+// AddKeyword adds a keyword to a group and audits the change
+func (u *auditedKeywordGroupUseCase) AddKeyword(ctx context.Context, groupID uuid.UUID, keyword string) (*domain.KeywordGroup, error) {
+	return u.change(ctx, domain.AuditActionUpdate, groupID, func(ctx context.Context) (*domain.KeywordGroup, error) {
+		return u.KeywordGroupInputPort.AddKeyword(ctx, groupID, keyword)
+	})
+}
+
+// RemoveKeyword removes a keyword from a group and audits the change
+func (u *auditedKeywordGroupUseCase) RemoveKeyword(ctx context.Context, groupID uuid.UUID, keyword string) (*domain.KeywordGroup, error) {
+	return u.change(ctx, domain.AuditActionUpdate, groupID, func(ctx context.Context) (*domain.KeywordGroup, error) {
+		return u.KeywordGroupInputPort.RemoveKeyword(ctx, groupID, keyword)
+	})
+}
+
+// EnableKeywordGroup enables a keyword group and audits the change
+func (u *auditedKeywordGroupUseCase) EnableKeywordGroup(ctx context.Context, groupID uuid.UUID) (*domain.KeywordGroup, error) {
+	return u.change(ctx, domain.AuditActionEnable, groupID, func(ctx context.Context) (*domain.KeywordGroup, error) {
+		return u.KeywordGroupInputPort.EnableKeywordGroup(ctx, groupID)
+	})
+}
+
+// DisableKeywordGroup disables a keyword group and audits the change
+func (u *auditedKeywordGroupUseCase) DisableKeywordGroup(ctx context.Context, groupID uuid.UUID) (*domain.KeywordGroup, error) {
+	return u.change(ctx, domain.AuditActionDisable, groupID, func(ctx context.Context) (*domain.KeywordGroup, error) {
+		return u.KeywordGroupInputPort.DisableKeywordGroup(ctx, groupID)
+	})
+}
+
+// DeleteKeywordGroup deletes a keyword group and audits its last values
+func (u *auditedKeywordGroupUseCase) DeleteKeywordGroup(ctx context.Context, groupID uuid.UUID) error {
+	return u.audit.transact(ctx, func(ctx context.Context) error {
+		before, err := u.KeywordGroupInputPort.GetKeywordGroup(ctx, groupID)
+		if err != nil {
+			return err
+		}
+		if err := u.KeywordGroupInputPort.DeleteKeywordGroup(ctx, groupID); err != nil {
+			return err
+		}
+		return u.audit.record(ctx, domain.AuditActionDelete, domain.AuditResourceKeywordGroup, string(before.ID), keywordGroupValues(before), nil)
+	})
+}
+
+// change applies a change to an existing keyword group and audits its values before and after
+func (u *auditedKeywordGroupUseCase) change(ctx context.Context, action string, groupID uuid.UUID, apply func(ctx context.Context) (*domain.KeywordGroup, error)) (*domain.KeywordGroup, error) {
+	return audited(ctx, u.audit, func(ctx context.Context) (*domain.KeywordGroup, error) {
+		before, err := u.KeywordGroupInputPort.GetKeywordGroup(ctx, groupID)
+		if err != nil {
+			return nil, err
+		}
+		after, err := apply(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return after, u.audit.record(ctx, action, domain.AuditResourceKeywordGroup, string(after.ID), keywordGroupValues(before), keywordGroupValues(after))
+	})
+}
+
+// keywordGroupValues returns the audited fields of a keyword group
+func keywordGroupValues(g *domain.KeywordGroup) map[string]interface{} {
+	values := map[string]interface{}{
+		"genre_id":       string(g.GenreID),
+		"name":           g.Name,
+		"filter_type":    string(g.FilterType),
+		"target_field":   g.TargetField,
+		"enabled":        g.Enabled,
+		"keywords":       g.GetKeywords(),
+		"description":    nil,
+		"cross_language": g.CrossLanguage,
+		"romaji":         g.Romaji,
+	}
+	if g.Description != nil {
+		values["description"] = *g.Description
+	}
+	return values
+}
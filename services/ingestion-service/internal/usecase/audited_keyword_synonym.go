@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/input"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
+	"github.com/google/uuid"
+)
+
+// auditedKeywordSynonymUseCase decorates a synonym dictionary use case with audit logging of changes
+type auditedKeywordSynonymUseCase struct {
+	input.KeywordSynonymInputPort
+	audit auditRecorder
+}
+
+// NewAuditedKeywordSynonymUseCase wraps a synonym dictionary use case so that each change is audited
+func NewAuditedKeywordSynonymUseCase(keywordSynonymUseCase input.KeywordSynonymInputPort, auditLogUseCase input.AuditLogInputPort, txManager gateway.TransactionManager) input.KeywordSynonymInputPort {
+	return &auditedKeywordSynonymUseCase{
+		KeywordSynonymInputPort: keywordSynonymUseCase,
+		audit:                   auditRecorder{auditLogUseCase: auditLogUseCase, txManager: txManager},
+	}
+}
+
+// CreateKeywordSynonym creates a synonym entry and audits it
+func (u *auditedKeywordSynonymUseCase) CreateKeywordSynonym(ctx context.Context, in input.CreateKeywordSynonymInput) (*domain.KeywordSynonym, error) {
+	return audited(ctx, u.audit, func(ctx context.Context) (*domain.KeywordSynonym, error) {
+		synonym, err := u.KeywordSynonymInputPort.CreateKeywordSynonym(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+		return synonym, u.audit.record(ctx, domain.AuditActionCreate, domain.AuditResourceKeywordSynonym, string(synonym.ID), nil, keywordSynonymValues(synonym))
+	})
+}
+
+// UpdateKeywordSynonym updates a synonym entry and audits the change
+func (u *auditedKeywordSynonymUseCase) UpdateKeywordSynonym(ctx context.Context, id uuid.UUID, in input.UpdateKeywordSynonymInput) (*domain.KeywordSynonym, error) {
+	return audited(ctx, u.audit, func(ctx context.Context) (*domain.KeywordSynonym, error) {
+		before, err := u.KeywordSynonymInputPort.GetKeywordSynonym(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		after, err := u.KeywordSynonymInputPort.UpdateKeywordSynonym(ctx, id, in)
+		if err != nil {
+			return nil, err
+		}
+		return after, u.audit.record(ctx, domain.AuditActionUpdate, domain.AuditResourceKeywordSynonym, string(after.ID), keywordSynonymValues(before), keywordSynonymValues(after))
+	})
+}
+
+// DeleteKeywordSynonym deletes a synonym entry and audits its last values
+func (u *auditedKeywordSynonymUseCase) DeleteKeywordSynonym(ctx context.Context, id uuid.UUID) error {
+	return u.audit.transact(ctx, func(ctx context.Context) error {
+		before, err := u.KeywordSynonymInputPort.GetKeywordSynonym(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := u.KeywordSynonymInputPort.DeleteKeywordSynonym(ctx, id); err != nil {
+			return err
+		}
+		return u.audit.record(ctx, domain.AuditActionDelete, domain.AuditResourceKeywordSynonym, string(before.ID), keywordSynonymValues(before), nil)
+	})
+}
+
+// keywordSynonymValues returns the audited fields of a synonym entry
+func keywordSynonymValues(s *domain.KeywordSynonym) map[string]interface{} {
+	return map[string]interface{}{
+		"language":       s.Language,
+		"term":           s.Term,
+		"alias":          s.Alias,
+		"alias_language": s.AliasLanguage,
+		"bidirectional":  s.Bidirectional,
+	}
+}
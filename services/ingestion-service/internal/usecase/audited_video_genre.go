@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/input"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
+	"github.com/google/uuid"
+)
+
+// auditedVideoGenreUseCase decorates a video-genre use case with audit logging
+// of genre assignments
+type auditedVideoGenreUseCase struct {
+	input.VideoGenreInputPort
+	audit auditRecorder
+}
+
+// NewAuditedVideoGenreUseCase wraps a video-genre use case so that each
+// assignment and removal is audited
+func NewAuditedVideoGenreUseCase(videoGenreUseCase input.VideoGenreInputPort, auditLogUseCase input.AuditLogInputPort, txManager gateway.TransactionManager) input.VideoGenreInputPort {
+	return &auditedVideoGenreUseCase{
+		VideoGenreInputPort: videoGenreUseCase,
+		audit:               auditRecorder{auditLogUseCase: auditLogUseCase, txManager: txManager},
+	}
+}
+
+// AssociateVideoWithGenre assigns a video to a genre and audits the assignment
+func (u *auditedVideoGenreUseCase) AssociateVideoWithGenre(ctx context.Context, videoID, genreID uuid.UUID) (*domain.VideoGenre, error) {
+	return audited(ctx, u.audit, func(ctx context.Context) (*domain.VideoGenre, error) {
+		videoGenre, err := u.VideoGenreInputPort.AssociateVideoWithGenre(ctx, videoID, genreID)
+		if err != nil {
+			return nil, err
+		}
+		return videoGenre, u.audit.record(ctx, domain.AuditActionAssign, domain.AuditResourceVideoGenre, string(videoGenre.ID), nil, videoGenreValues(videoGenre))
+	})
+}
+
+// AssociateVideoWithGenres assigns a video to genres and audits each new assignment
+func (u *auditedVideoGenreUseCase) AssociateVideoWithGenres(ctx context.Context, videoID uuid.UUID, genreIDs []uuid.UUID) ([]*domain.VideoGenre, error) {
+	return audited(ctx, u.audit, func(ctx context.Context) ([]*domain.VideoGenre, error) {
+		before, err := u.VideoGenreInputPort.GetVideoGenres(ctx, videoID)
+		if err != nil {
+			return nil, err
+		}
+		after, err := u.VideoGenreInputPort.AssociateVideoWithGenres(ctx, videoID, genreIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		existing := make(map[valueobject.UUID]bool, len(before))
+		for _, vg := range before {
+			existing[vg.ID] = true
+		}
+		for _, vg := range after {
+			if existing[vg.ID] {
+				continue
+			}
+			if err := u.audit.record(ctx, domain.AuditActionAssign, domain.AuditResourceVideoGenre, string(vg.ID), nil, videoGenreValues(vg)); err != nil {
+				return nil, err
+			}
+		}
+		return after, nil
+	})
+}
+
+// DisassociateVideoFromGenre removes a video from a genre and audits the removal
+func (u *auditedVideoGenreUseCase) DisassociateVideoFromGenre(ctx context.Context, videoID, genreID uuid.UUID) error {
+	return u.audit.transact(ctx, func(ctx context.Context) error {
+		before, err := u.VideoGenreInputPort.GetVideoGenres(ctx, videoID)
+		if err != nil {
+			return err
+		}
+		if err := u.VideoGenreInputPort.DisassociateVideoFromGenre(ctx, videoID, genreID); err != nil {
+			return err
+		}
+
+		for _, vg := range before {
+			if vg.GenreID != valueobject.UUID(genreID.String()) {
+				continue
+			}
+			if err := u.audit.record(ctx, domain.AuditActionRemove, domain.AuditResourceVideoGenre, string(vg.ID), videoGenreValues(vg), nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// DisassociateVideoFromAllGenres removes a video from all its genres and audits each removal
+func (u *auditedVideoGenreUseCase) DisassociateVideoFromAllGenres(ctx context.Context, videoID uuid.UUID) error {
+	return u.audit.transact(ctx, func(ctx context.Context) error {
+		before, err := u.VideoGenreInputPort.GetVideoGenres(ctx, videoID)
+		if err != nil {
+			return err
+		}
+		if err := u.VideoGenreInputPort.DisassociateVideoFromAllGenres(ctx, videoID); err != nil {
+			return err
+		}
+
+		for _, vg := range before {
+			if err := u.audit.record(ctx, domain.AuditActionRemove, domain.AuditResourceVideoGenre, string(vg.ID), videoGenreValues(vg), nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// videoGenreValues returns the audited fields of a genre assignment
+func videoGenreValues(vg *domain.VideoGenre) map[string]interface{} {
+	values := map[string]interface{}{
+		"video_id": string(vg.VideoID),
+		"genre_id": string(vg.GenreID),
+	}
+	if vg.Match != nil {
+		values["match_result"] = vg.Match.Result
+	}
+	return values
+}
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/input"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
+)
+
+// auditedYouTubeCategoryUseCase decorates a YouTube category use case with audit logging of changes
+type auditedYouTubeCategoryUseCase struct {
+	input.YouTubeCategoryInputPort
+	audit auditRecorder
+}
+
+// NewAuditedYouTubeCategoryUseCase wraps a YouTube category use case so that each change is audited
+func NewAuditedYouTubeCategoryUseCase(categoryUseCase input.YouTubeCategoryInputPort, auditLogUseCase input.AuditLogInputPort, txManager gateway.TransactionManager) input.YouTubeCategoryInputPort {
+	return &auditedYouTubeCategoryUseCase{
+		YouTubeCategoryInputPort: categoryUseCase,
+		audit:                    auditRecorder{auditLogUseCase: auditLogUseCase, txManager: txManager},
+	}
+}
+
+// CreateYouTubeCategory creates a YouTube category and audits it
+func (u *auditedYouTubeCategoryUseCase) CreateYouTubeCategory(ctx context.Context, in *input.CreateYouTubeCategoryInput) (*domain.YouTubeCategory, error) {
+	return audited(ctx, u.audit, func(ctx context.Context) (*domain.YouTubeCategory, error) {
+		category, err := u.YouTubeCategoryInputPort.CreateYouTubeCategory(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+		return category, u.audit.record(ctx, domain.AuditActionCreate, domain.AuditResourceYouTubeCategory, strconv.Itoa(int(category.ID)), nil, youtubeCategoryValues(category))
+	})
+}
+
+// UpdateYouTubeCategory updates a YouTube category and audits the change
+func (u *auditedYouTubeCategoryUseCase) UpdateYouTubeCategory(ctx context.Context, in *input.UpdateYouTubeCategoryInput) (*domain.YouTubeCategory, error) {
+	return audited(ctx, u.audit, func(ctx context.Context) (*domain.YouTubeCategory, error) {
+		before, err := u.YouTubeCategoryInputPort.GetYouTubeCategory(ctx, in.CategoryID)
+		if err != nil {
+			return nil, err
+		}
+		after, err := u.YouTubeCategoryInputPort.UpdateYouTubeCategory(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+		return after, u.audit.record(ctx, domain.AuditActionUpdate, domain.AuditResourceYouTubeCategory, strconv.Itoa(int(after.ID)), youtubeCategoryValues(before), youtubeCategoryValues(after))
+	})
+}
+
+// youtubeCategoryValues returns the audited fields of a YouTube category
+func youtubeCategoryValues(c *domain.YouTubeCategory) map[string]interface{} {
+	return map[string]interface{}{
+		"name":       c.Name,
+		"assignable": c.Assignable,
+	}
+}
@@ -2,6 +2,11 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync"
 	"time"
 
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
@@ -11,26 +16,69 @@ import (
 	"github.com/google/uuid"
 )
 
+// Page sizes for ListBatchJobs
+const (
+	defaultBatchJobPageSize = 100
+	maxBatchJobPageSize     = 1000
+)
+
+// Leases of running batch jobs. A holder that misses heartbeats for the whole
+// TTL is considered dead and its lease may be taken over.
+const (
+	batchJobLockTTL           = 2 * time.Minute
+	batchJobHeartbeatInterval = batchJobLockTTL / 4
+)
+
+// batchJobPollInterval is how often a running job records its progress and
+// checks whether cancellation was requested
+const batchJobPollInterval = 5 * time.Second
+
 // batchJobUseCase implements the BatchJobInputPort interface
 type batchJobUseCase struct {
 	batchJobRepo gateway.BatchJobRepository
+	lockRepo     gateway.BatchJobLockRepository
+	runners      map[domain.JobType]input.BatchJobRunner // Job types RetryBatchJob can re-run
+	holder       string                                  // Identifies this process in leases
+
+	// Retries run in the background until they finish or Shutdown cancels them
+	retryMu     sync.Mutex
+	retries     sync.WaitGroup
+	retryCtx    context.Context
+	stopRetries context.CancelCauseFunc
 }
 
-// NewBatchJobUseCase creates a new batch job use case
-func NewBatchJobUseCase(batchJobRepo gateway.BatchJobRepository) input.BatchJobInputPort {
+// NewBatchJobUseCase creates a new batch job use case. runners may be nil when
+// jobs are not retried from this process.
+func NewBatchJobUseCase(
+	batchJobRepo gateway.BatchJobRepository,
+	lockRepo gateway.BatchJobLockRepository,
+	runners map[domain.JobType]input.BatchJobRunner,
+) input.BatchJobInputPort {
+	retryCtx, stopRetries := context.WithCancelCause(context.Background())
 	return &batchJobUseCase{
 		batchJobRepo: batchJobRepo,
+		lockRepo:     lockRepo,
+		runners:      runners,
+		holder:       lockHolder(),
+		retryCtx:     retryCtx,
+		stopRetries:  stopRetries,
 	}
 }
 
-// CreateBatchJob creates a new batch job
+// CreateBatchJob creates a new pending batch job
 func (u *batchJobUseCase) CreateBatchJob(ctx context.Context, input *input.CreateBatchJobInput) (*domain.BatchJob, error) {
 	// Create domain object
-	batchJob := &domain.BatchJob{
-		ID:         valueobject.UUID(uuid.New().String()),
-		JobType:    domain.JobType(input.JobType),
-		Status:     domain.JobStatusPending,
-		Parameters: input.Parameters,
+	batchJob, err := domain.NewBatchJob(
+		valueobject.UUID(uuid.New().String()),
+		domain.JobType(input.JobType),
+		input.Parameters,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if input.RetryOf != nil {
+		retryOf := valueobject.UUID(input.RetryOf.String())
+		batchJob.RetryOf = &retryOf
 	}
 
 	// Save to repository
@@ -41,51 +89,454 @@ func (u *batchJobUseCase) CreateBatchJob(ctx context.Context, input *input.Creat
 	return batchJob, nil
 }
 
-// StartBatchJob starts a batch job
+// StartBatchJob starts a pending batch job
 func (u *batchJobUseCase) StartBatchJob(ctx context.Context, jobID uuid.UUID) (*domain.BatchJob, error) {
-	// Find the job
+	return u.transition(ctx, jobID, func(job *domain.BatchJob) error {
+		return job.Start()
+	})
+}
+
+// CompleteBatchJob completes a running batch job
+func (u *batchJobUseCase) CompleteBatchJob(ctx context.Context, jobID uuid.UUID, statistics map[string]interface{}) (*domain.BatchJob, error) {
+	return u.transition(ctx, jobID, func(job *domain.BatchJob) error {
+		return job.Complete(statistics)
+	})
+}
+
+// FailBatchJob marks a running batch job as failed
+func (u *batchJobUseCase) FailBatchJob(ctx context.Context, jobID uuid.UUID, errorMessage string) (*domain.BatchJob, error) {
+	return u.transition(ctx, jobID, func(job *domain.BatchJob) error {
+		return job.Fail(errorMessage)
+	})
+}
+
+// CancelBatchJob cancels a pending job right away and asks the runner of a
+// running job to stop; the runner marks the job cancelled at its next check
+func (u *batchJobUseCase) CancelBatchJob(ctx context.Context, jobID uuid.UUID) (*domain.BatchJob, error) {
 	job, err := u.batchJobRepo.FindByID(ctx, valueobject.UUID(jobID.String()))
 	if err != nil {
 		return nil, err
 	}
+	if job.Status != domain.JobStatusPending && job.Status != domain.JobStatusRunning {
+		return nil, fmt.Errorf("%w: job is already %s", domain.ErrInvalidJobTransition, job.Status)
+	}
 
-	// Update status
-	job.Status = domain.JobStatusRunning
-	now := time.Now()
-	job.StartedAt = &now
+	// A running job whose leases all expired has no runner left to stop it
+	if job.Status == domain.JobStatusRunning {
+		abandoned, err := u.isAbandoned(ctx, job.ID)
+		if err != nil {
+			return nil, err
+		}
+		if abandoned {
+			return u.transition(ctx, jobID, func(job *domain.BatchJob) error {
+				return job.Fail(fmt.Sprintf("%v: cancelled after its runner stopped heartbeating", domain.ErrBatchJobLeaseLost))
+			})
+		}
+	}
 
-	// Save to repository
-	if err := u.batchJobRepo.Update(ctx, job); err != nil {
+	// The request is recorded first so that a job starting meanwhile still stops
+	if err := u.batchJobRepo.RequestCancel(ctx, job.ID); err != nil {
 		return nil, err
 	}
-
-	return job, nil
+	if job.Status == domain.JobStatusPending {
+		return u.transition(ctx, jobID, func(job *domain.BatchJob) error {
+			if job.Status == domain.JobStatusRunning {
+				return nil // Started meanwhile; the runner stops it
+			}
+			return job.Cancel()
+		})
+	}
+	return u.batchJobRepo.FindByID(ctx, job.ID)
 }
 
-// CompleteBatchJob completes a batch job
-func (u *batchJobUseCase) CompleteBatchJob(ctx context.Context, jobID uuid.UUID, statistics map[string]interface{}) (*domain.BatchJob, error) {
-	// Find the job
+// RetryBatchJob re-runs a finished job with the same parameters in the
+// background and returns the new job
+func (u *batchJobUseCase) RetryBatchJob(ctx context.Context, jobID uuid.UUID) (*domain.BatchJob, error) {
 	job, err := u.batchJobRepo.FindByID(ctx, valueobject.UUID(jobID.String()))
 	if err != nil {
 		return nil, err
 	}
+	if !job.IsFinished() {
+		return nil, fmt.Errorf("%w: job is still %s", domain.ErrInvalidJobTransition, job.Status)
+	}
+	runner, ok := u.runners[job.JobType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", domain.ErrBatchJobNotRetryable, job.JobType)
+	}
 
-	// Update status
-	job.Status = domain.JobStatusCompleted
+	in, run, err := runner(ctx, job.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	in.RetryOf = &jobID
+
+	u.retryMu.Lock()
+	if u.retryCtx.Err() != nil {
+		u.retryMu.Unlock()
+		return nil, domain.ErrBatchJobShutdown
+	}
+	u.retries.Add(1)
+	u.retryMu.Unlock()
+
+	// The retry outlives the request but not the server
+	runCtx, cancelRun := context.WithCancelCause(context.WithoutCancel(ctx))
+	stopCancel := context.AfterFunc(u.retryCtx, func() {
+		cancelRun(context.Cause(u.retryCtx))
+	})
+	retry, held, err := u.beginBatchJob(runCtx, in)
+	if err != nil {
+		stopCancel()
+		cancelRun(nil)
+		u.retries.Done()
+		return retry, err
+	}
+	go func() {
+		defer u.retries.Done()
+		defer stopCancel()
+		defer cancelRun(nil)
+		defer func() {
+			if r := recover(); r != nil {
+				log.Printf("Batch job %s panicked: %v", retry.ID, r)
+			}
+		}()
+		if _, err := u.executeBatchJob(runCtx, retry, held, run); err != nil {
+			log.Printf("Batch job %s did not complete: %v", retry.ID, err)
+		}
+	}()
+	return retry, nil
+}
+
+// Shutdown cancels the retries running in the background, which are recorded
+// as failed so they can be retried again, and waits for them to finish.
+// RetryBatchJob fails with domain.ErrBatchJobShutdown afterwards.
+func (u *batchJobUseCase) Shutdown(ctx context.Context) error {
+	u.retryMu.Lock()
+	u.stopRetries(domain.ErrBatchJobShutdown)
+	u.retryMu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		u.retries.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("batch job retries still running at shutdown: %w", ctx.Err())
+	}
+}
+
+// isAbandoned reports whether none of the leases of a running job is still
+// held. Jobs take their leases before they start and keep them until they
+// finish, so such a job was left running by a runner that died.
+func (u *batchJobUseCase) isAbandoned(ctx context.Context, jobID valueobject.UUID) (bool, error) {
+	locks, err := u.lockRepo.FindByJob(ctx, jobID)
+	if err != nil {
+		return false, err
+	}
 	now := time.Now()
-	job.CompletedAt = &now
-	job.Statistics = statistics
+	for _, lock := range locks {
+		if !lock.IsStale(now) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
 
-	// Save to repository
-	if err := u.batchJobRepo.Update(ctx, job); err != nil {
-		return nil, err
+// RunBatchJob records a batch run as a job. It creates the job, takes its
+// leases and starts it, calls run while heartbeating the leases, and completes
+// the job with the returned statistics or fails it with the returned error,
+// which is returned as is. When another job holds one of the leases the job
+// is recorded as skipped_overlap and domain.ErrBatchJobOverlap is returned.
+// When the job is cancelled run's context is canceled, the job is recorded as
+// cancelled and domain.ErrBatchJobCancelled is returned.
+func (u *batchJobUseCase) RunBatchJob(ctx context.Context, in *input.RunBatchJobInput, run input.BatchJobFunc) (*domain.BatchJob, error) {
+	if domain.IsDryRun(ctx) {
+		return dryRunBatchJob(ctx, in, run)
+	}
+	job, held, err := u.beginBatchJob(ctx, in)
+	if err != nil {
+		return job, err
 	}
+	return u.executeBatchJob(ctx, job, held, run)
+}
 
+// dryRunBatchJob runs a job in memory. A dry run changes nothing, so it is
+// neither recorded nor locked against overlapping runs.
+func dryRunBatchJob(ctx context.Context, in *input.RunBatchJobInput, run input.BatchJobFunc) (*domain.BatchJob, error) {
+	job, err := domain.NewBatchJob(valueobject.UUID(uuid.New().String()), domain.JobType(in.JobType), in.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	if err := job.Start(); err != nil {
+		return nil, err
+	}
+	statistics, err := run(ctx)
+	if err != nil {
+		_ = job.Fail(err.Error())
+		return job, err
+	}
+	if err := job.Complete(statistics); err != nil {
+		return nil, err
+	}
 	return job, nil
 }
 
-// FailBatchJob marks a batch job as failed
-func (u *batchJobUseCase) FailBatchJob(ctx context.Context, jobID uuid.UUID, errorMessage string) (*domain.BatchJob, error) {
+// beginBatchJob creates a job, takes its leases and starts it, and returns the
+// leases it holds
+func (u *batchJobUseCase) beginBatchJob(ctx context.Context, in *input.RunBatchJobInput) (*domain.BatchJob, []string, error) {
+	job, err := u.CreateBatchJob(ctx, &input.CreateBatchJobInput{
+		JobType:    in.JobType,
+		Parameters: in.Parameters,
+		RetryOf:    in.RetryOf,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create batch job: %w", err)
+	}
+	jobID := uuid.MustParse(string(job.ID))
+
+	// The outcome is recorded even when ctx was canceled by a shutdown signal
+	recordCtx := context.WithoutCancel(ctx)
+
+	keys := in.LockKeys
+	if len(keys) == 0 {
+		keys = []string{domain.BatchJobLockKey(job.JobType, "")}
+	}
+	held, runningJobID, err := u.acquireLocks(ctx, job.ID, keys)
+	if err != nil || runningJobID != "" {
+		u.releaseLocks(recordCtx, job.ID, held)
+		if err != nil {
+			err = fmt.Errorf("failed to lock batch job: %w", err)
+			if _, ferr := u.FailBatchJob(recordCtx, jobID, err.Error()); ferr != nil {
+				log.Printf("Failed to record failure of batch job %s: %v", jobID, ferr)
+			}
+			return job, nil, err
+		}
+		if _, err := u.transition(recordCtx, jobID, func(job *domain.BatchJob) error {
+			return job.SkipOverlap(runningJobID)
+		}); err != nil {
+			log.Printf("Failed to record overlap of batch job %s: %v", jobID, err)
+		}
+		return job, nil, fmt.Errorf("%w: %s is held by job %s", domain.ErrBatchJobOverlap, job.JobType, runningJobID)
+	}
+
+	started, err := u.StartBatchJob(ctx, jobID)
+	if err != nil {
+		u.releaseLocks(recordCtx, job.ID, held)
+		// A pending job may be cancelled before it starts
+		if current, ferr := u.batchJobRepo.FindByID(recordCtx, job.ID); ferr == nil && current.Status == domain.JobStatusCancelled {
+			return current, nil, domain.ErrBatchJobCancelled
+		}
+		return job, nil, fmt.Errorf("failed to start batch job: %w", err)
+	}
+	return started, held, nil
+}
+
+// executeBatchJob calls run for a started job while recording its progress,
+// heartbeating its leases and watching for cancellation, then records the
+// outcome and releases the leases
+func (u *batchJobUseCase) executeBatchJob(ctx context.Context, job *domain.BatchJob, held []string, run input.BatchJobFunc) (*domain.BatchJob, error) {
+	jobID := uuid.MustParse(string(job.ID))
+	recordCtx := context.WithoutCancel(ctx)
+
+	progress := &progressTracker{}
+	runCtx, cancelRun := context.WithCancelCause(domain.WithProgressReporter(ctx, progress.report))
+	stopMonitor := u.monitor(recordCtx, cancelRun, job.ID, held, progress)
+	defer func() {
+		stopMonitor()
+		cancelRun(nil)
+		u.releaseLocks(recordCtx, job.ID, held)
+	}()
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, err := u.FailBatchJob(recordCtx, jobID, fmt.Sprintf("panic: %v", r)); err != nil {
+				log.Printf("Failed to record panic of batch job %s: %v", jobID, err)
+			}
+			panic(r)
+		}
+	}()
+
+	statistics, runErr := run(runCtx)
+	if _, err := u.batchJobRepo.SaveProgress(recordCtx, job.ID, progress.snapshot()); err != nil {
+		log.Printf("Failed to record progress of batch job %s: %v", jobID, err)
+	}
+
+	if runErr != nil {
+		cause := context.Cause(runCtx)
+		if errors.Is(cause, domain.ErrBatchJobCancelled) {
+			cancelled, err := u.transition(recordCtx, jobID, func(job *domain.BatchJob) error {
+				return job.Cancel()
+			})
+			if err != nil {
+				log.Printf("Failed to record cancellation of batch job %s: %v", jobID, err)
+				return job, cause
+			}
+			return cancelled, cause
+		}
+		if errors.Is(cause, domain.ErrBatchJobLeaseLost) || errors.Is(cause, domain.ErrBatchJobShutdown) {
+			runErr = fmt.Errorf("%w: %v", cause, runErr)
+		}
+		failed, err := u.FailBatchJob(recordCtx, jobID, runErr.Error())
+		if err != nil {
+			log.Printf("Failed to record failure of batch job %s: %v", jobID, err)
+			return job, runErr
+		}
+		return failed, runErr
+	}
+
+	completed, err := u.CompleteBatchJob(recordCtx, jobID, statistics)
+	if err != nil {
+		return job, fmt.Errorf("failed to complete batch job: %w", err)
+	}
+	return completed, nil
+}
+
+// acquireLocks takes the leases of a job in order and returns the keys it
+// holds. It stops at the first lease held by another running job and returns
+// that job's ID. A lease whose holder stopped heartbeating is taken over and
+// the abandoned job is failed.
+func (u *batchJobUseCase) acquireLocks(ctx context.Context, jobID valueobject.UUID, keys []string) ([]string, valueobject.UUID, error) {
+	held := make([]string, 0, len(keys))
+	for _, key := range keys {
+		previous, err := u.lockRepo.FindByKey(ctx, key)
+		if err != nil && !errors.Is(err, domain.ErrNotFound) {
+			return held, "", err
+		}
+
+		acquired, err := u.lockRepo.Acquire(ctx, key, jobID, u.holder, batchJobLockTTL)
+		if err != nil {
+			return held, "", err
+		}
+		if !acquired {
+			current, err := u.lockRepo.FindByKey(ctx, key)
+			if err != nil {
+				return held, "", fmt.Errorf("failed to find holder of lock %s: %w", key, err)
+			}
+			return held, current.JobID, nil
+		}
+		held = append(held, key)
+
+		if previous != nil {
+			u.failAbandoned(ctx, previous, jobID)
+		}
+	}
+	return held, "", nil
+}
+
+// failAbandoned fails the previous holder of a lease taken over by jobID when
+// it is still recorded as running, so that it no longer shows as running
+func (u *batchJobUseCase) failAbandoned(ctx context.Context, previous *domain.BatchJobLock, jobID valueobject.UUID) {
+	job, err := u.batchJobRepo.FindByID(ctx, previous.JobID)
+	if err != nil {
+		log.Printf("Failed to find abandoned batch job %s: %v", previous.JobID, err)
+		return
+	}
+	if job.Status != domain.JobStatusRunning {
+		return
+	}
+
+	log.Printf("Taking over lock %s of batch job %s held by %s (last heartbeat %s)",
+		previous.Key, previous.JobID, previous.Holder, previous.HeartbeatAt.Format(time.RFC3339))
+	if err := job.Fail(fmt.Sprintf("%v: no heartbeat from %s since %s; lock %s taken over by job %s",
+		domain.ErrBatchJobLeaseLost, previous.Holder, previous.HeartbeatAt.Format(time.RFC3339), previous.Key, jobID)); err != nil {
+		log.Printf("Failed to fail abandoned batch job %s: %v", previous.JobID, err)
+		return
+	}
+	if err := u.batchJobRepo.Update(ctx, job); err != nil {
+		log.Printf("Failed to fail abandoned batch job %s: %v", previous.JobID, err)
+	}
+}
+
+// monitor records the progress of a running job and heartbeats its leases
+// until the returned stop func is called. The run is canceled with
+// domain.ErrBatchJobCancelled when cancellation is requested and with
+// domain.ErrBatchJobLeaseLost when a lease is lost.
+func (u *batchJobUseCase) monitor(ctx context.Context, cancel context.CancelCauseFunc, jobID valueobject.UUID, keys []string, progress *progressTracker) (stop func()) {
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(batchJobPollInterval)
+		defer ticker.Stop()
+		lastHeartbeat := time.Now()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+
+			cancelRequested, err := u.batchJobRepo.SaveProgress(ctx, jobID, progress.snapshot())
+			if err != nil {
+				log.Printf("Failed to record progress of batch job %s: %v", jobID, err)
+			} else if cancelRequested {
+				cancel(domain.ErrBatchJobCancelled)
+			}
+
+			if time.Since(lastHeartbeat) < batchJobHeartbeatInterval {
+				continue
+			}
+			lastHeartbeat = time.Now()
+			for _, key := range keys {
+				held, err := u.lockRepo.Heartbeat(ctx, key, jobID, batchJobLockTTL)
+				if err != nil {
+					// Transient; the lease only expires after the whole TTL
+					log.Printf("Failed to heartbeat lock %s of batch job %s: %v", key, jobID, err)
+					continue
+				}
+				if !held {
+					cancel(fmt.Errorf("%w: %s", domain.ErrBatchJobLeaseLost, key))
+				}
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		wg.Wait()
+	}
+}
+
+// progressTracker keeps the latest progress reported by a running job
+type progressTracker struct {
+	mu       sync.Mutex
+	progress domain.BatchJobProgress
+}
+
+func (t *progressTracker) report(progress domain.BatchJobProgress) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.progress = progress
+}
+
+func (t *progressTracker) snapshot() domain.BatchJobProgress {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.progress
+}
+
+// releaseLocks gives up the leases of a job
+func (u *batchJobUseCase) releaseLocks(ctx context.Context, jobID valueobject.UUID, keys []string) {
+	for _, key := range keys {
+		if err := u.lockRepo.Release(ctx, key, jobID); err != nil {
+			log.Printf("Failed to release lock %s of batch job %s: %v", key, jobID, err)
+		}
+	}
+}
+
+// lockHolder identifies this process in leases
+func lockHolder() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	return fmt.Sprintf("%s:%d", host, os.Getpid())
+}
+
+// transition loads a job, applies a status change and saves it
+func (u *batchJobUseCase) transition(ctx context.Context, jobID uuid.UUID, apply func(job *domain.BatchJob) error) (*domain.BatchJob, error) {
 	// Find the job
 	job, err := u.batchJobRepo.FindByID(ctx, valueobject.UUID(jobID.String()))
 	if err != nil {
@@ -93,10 +544,9 @@ func (u *batchJobUseCase) FailBatchJob(ctx context.Context, jobID uuid.UUID, err
 	}
 
 	// Update status
-	job.Status = domain.JobStatusFailed
-	now := time.Now()
-	job.CompletedAt = &now
-	job.ErrorMessage = errorMessage
+	if err := apply(job); err != nil {
+		return nil, err
+	}
 
 	// Save to repository
 	if err := u.batchJobRepo.Update(ctx, job); err != nil {
@@ -116,14 +566,30 @@ func (u *batchJobUseCase) ListBatchJobsByType(ctx context.Context, jobType strin
 	return u.batchJobRepo.FindByTypeAndStatus(ctx, domain.JobType(jobType), domain.JobStatus(status))
 }
 
-// ListRecentBatchJobs lists recent batch jobs
-func (u *batchJobUseCase) ListRecentBatchJobs(ctx context.Context, jobType *string, limit int) ([]*domain.BatchJob, error) {
-	var jt *domain.JobType
-	if jobType != nil {
-		jobTypeVal := domain.JobType(*jobType)
-		jt = &jobTypeVal
+// ListBatchJobs returns one page of the batch jobs matching the filter, newest first
+func (u *batchJobUseCase) ListBatchJobs(ctx context.Context, in *input.ListBatchJobsInput) (*input.ListBatchJobsResult, error) {
+	size := pageSize(in.PageSize, defaultBatchJobPageSize, maxBatchJobPageSize)
+	filter := valueobject.FilterDigest(in.Filter)
+	after, err := valueobject.ParsePageToken(in.PageToken, domain.BatchJobOrder, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch one extra job to learn whether another page follows
+	jobs, err := u.batchJobRepo.Search(ctx, in.Filter, after, size+1)
+	if err != nil {
+		return nil, err
 	}
-	return u.batchJobRepo.FindRecent(ctx, jt, limit)
+	total, err := u.batchJobRepo.CountSearch(ctx, in.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &input.ListBatchJobsResult{TotalCount: total}
+	result.BatchJobs, result.NextPageToken = nextPage(jobs, size, filter, func(j *domain.BatchJob) valueobject.PageCursor {
+		return valueobject.PageCursor{Order: domain.BatchJobOrder, Key: j.CreatedAt, ID: string(j.ID)}
+	})
+	return result, nil
 }
 
 // GetRunningBatchJobs gets all running batch jobs
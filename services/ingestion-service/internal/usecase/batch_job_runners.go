@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/input"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
+)
+
+// NewBatchJobRunners returns the runners of the job types that can be retried
+// through the API. Jobs whose command takes options the parameters do not
+// record, such as audit retention, are not retryable.
+func NewBatchJobRunners(
+	videoUseCase input.VideoInputPort,
+	channelUseCase input.ChannelInputPort,
+	systemUseCase input.SystemInputPort,
+	genreRepo gateway.GenreRepository,
+) map[domain.JobType]input.BatchJobRunner {
+	return map[domain.JobType]input.BatchJobRunner{
+		domain.JobTypeCollectTrending: func(ctx context.Context, parameters map[string]interface{}) (*input.RunBatchJobInput, input.BatchJobFunc, error) {
+			if allGenres, _ := parameters["all_genres"].(bool); allGenres {
+				return collectAllTrendingJob(ctx, videoUseCase, genreRepo, nil)
+			}
+			var genreID *string
+			if id, ok := parameters["genre_id"].(string); ok && id != "" {
+				genreID = &id
+			}
+			in, run := collectTrendingJob(videoUseCase, genreID, nil)
+			return in, run, nil
+		},
+		domain.JobTypeCollectSnapshots: func(ctx context.Context, parameters map[string]interface{}) (*input.RunBatchJobInput, input.BatchJobFunc, error) {
+			// A dry run would be retried for real
+			if dryRun, _ := parameters["dry_run"].(bool); dryRun {
+				return nil, nil, fmt.Errorf("%w: dry run", domain.ErrBatchJobNotRetryable)
+			}
+			return &input.RunBatchJobInput{
+				JobType:    string(domain.JobTypeCollectSnapshots),
+				Parameters: parameters,
+			}, func(ctx context.Context) (map[string]interface{}, error) {
+				result, err := systemUseCase.ScheduleSnapshots(ctx, &input.ScheduleSnapshotsInput{Window: hoursParameter(parameters, "hours")})
+				if err != nil {
+					return nil, fmt.Errorf("failed to schedule snapshots: %w", err)
+				}
+				return map[string]interface{}{
+					"published_since":  result.PublishedSince.Format(time.RFC3339),
+					"videos_processed": result.VideosProcessed,
+					"tasks_scheduled":  result.TasksScheduled,
+				}, nil
+			}, nil
+		},
+		domain.JobTypeUpdateChannels: func(ctx context.Context, parameters map[string]interface{}) (*input.RunBatchJobInput, input.BatchJobFunc, error) {
+			return &input.RunBatchJobInput{
+				JobType:    string(domain.JobTypeUpdateChannels),
+				Parameters: parameters,
+			}, func(ctx context.Context) (map[string]interface{}, error) {
+				result, err := channelUseCase.UpdateChannels(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("failed to update channels: %w", err)
+				}
+				return map[string]interface{}{
+					"channels_processed": result.ChannelsProcessed,
+					"channels_updated":   result.ChannelsUpdated,
+					"snapshots_taken":    result.SnapshotsTaken,
+				}, nil
+			}, nil
+		},
+	}
+}
+
+// collectTrendingJob builds the job collecting trending videos for a genre, or
+// without a genre when genreID is nil. onResult, if set, receives the result.
+func collectTrendingJob(
+	videoUseCase input.VideoInputPort,
+	genreID *string,
+	onResult func(*input.CollectTrendingResult),
+) (*input.RunBatchJobInput, input.BatchJobFunc) {
+	var scope string
+	parameters := map[string]interface{}{}
+	if genreID != nil {
+		scope = *genreID
+		parameters["genre_id"] = *genreID
+	}
+
+	in := &input.RunBatchJobInput{
+		JobType:    string(domain.JobTypeCollectTrending),
+		Parameters: parameters,
+		LockKeys:   []string{domain.BatchJobLockKey(domain.JobTypeCollectTrending, scope)},
+	}
+	return in, func(ctx context.Context) (map[string]interface{}, error) {
+		result, err := videoUseCase.CollectTrending(ctx, genreID)
+		if err != nil {
+			return nil, err
+		}
+		if onResult != nil {
+			onResult(result)
+		}
+		return map[string]interface{}{
+			"genres":           1,
+			"videos_collected": result.VideosCollected,
+			"videos_created":   result.VideosCreated,
+			"videos_updated":   result.VideosUpdated,
+			"videos_filtered":  result.VideosFiltered,
+		}, nil
+	}
+}
+
+// collectAllTrendingJob builds the job collecting trending videos for every
+// enabled genre, locking each of them. onResult, if set, receives the result.
+func collectAllTrendingJob(
+	ctx context.Context,
+	videoUseCase input.VideoInputPort,
+	genreRepo gateway.GenreRepository,
+	onResult func(*input.CollectAllTrendingResult),
+) (*input.RunBatchJobInput, input.BatchJobFunc, error) {
+	genres, err := genreRepo.FindEnabled(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	keys := make([]string, len(genres))
+	for i, genre := range genres {
+		keys[i] = domain.BatchJobLockKey(domain.JobTypeCollectTrending, string(genre.ID))
+	}
+
+	in := &input.RunBatchJobInput{
+		JobType:    string(domain.JobTypeCollectTrending),
+		Parameters: map[string]interface{}{"all_genres": true, "genres": len(genres)},
+		LockKeys:   keys,
+	}
+	return in, func(ctx context.Context) (map[string]interface{}, error) {
+		result, err := videoUseCase.CollectAllTrending(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if onResult != nil {
+			onResult(result)
+		}
+		return map[string]interface{}{
+			"genres":           result.GenresProcessed + result.GenresFailed,
+			"genres_failed":    result.GenresFailed,
+			"videos_collected": result.TotalCollected,
+			"videos_created":   result.TotalCreated,
+			"videos_updated":   result.TotalUpdated,
+			"videos_filtered":  result.TotalFiltered,
+		}, nil
+	}, nil
+}
+
+// hoursParameter reads a number of hours from job parameters, where it is an
+// int when set in process and a float64 once read back from JSON. A missing
+// parameter is zero.
+func hoursParameter(parameters map[string]interface{}, key string) time.Duration {
+	switch v := parameters[key].(type) {
+	case int:
+		return time.Duration(v) * time.Hour
+	case float64:
+		return time.Duration(v * float64(time.Hour))
+	}
+	return 0
+}
@@ -0,0 +1,546 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/input"
+	"github.com/google/uuid"
+)
+
+// fakeBatchJobRepo keeps batch jobs in memory
+type fakeBatchJobRepo struct {
+	mu              sync.Mutex
+	jobs            map[valueobject.UUID]domain.BatchJob
+	cancelRequested map[valueobject.UUID]bool
+}
+
+func newFakeBatchJobRepo() *fakeBatchJobRepo {
+	return &fakeBatchJobRepo{
+		jobs:            map[valueobject.UUID]domain.BatchJob{},
+		cancelRequested: map[valueobject.UUID]bool{},
+	}
+}
+
+func (r *fakeBatchJobRepo) Save(ctx context.Context, job *domain.BatchJob) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.jobs[job.ID] = *job
+	return nil
+}
+
+func (r *fakeBatchJobRepo) Update(ctx context.Context, job *domain.BatchJob) error {
+	return r.Save(ctx, job)
+}
+
+func (r *fakeBatchJobRepo) FindByID(ctx context.Context, id valueobject.UUID) (*domain.BatchJob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	job, ok := r.jobs[id]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return &job, nil
+}
+
+func (r *fakeBatchJobRepo) FindByTypeAndStatus(ctx context.Context, jobType domain.JobType, status domain.JobStatus) ([]*domain.BatchJob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var jobs []*domain.BatchJob
+	for _, job := range r.jobs {
+		if job.JobType == jobType && job.Status == status {
+			job := job
+			jobs = append(jobs, &job)
+		}
+	}
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].CreatedAt.Before(jobs[j].CreatedAt) })
+	return jobs, nil
+}
+
+func (r *fakeBatchJobRepo) Search(ctx context.Context, filter domain.BatchJobFilter, after *valueobject.PageCursor, limit int) ([]*domain.BatchJob, error) {
+	return nil, errors.New("not used")
+}
+
+func (r *fakeBatchJobRepo) CountSearch(ctx context.Context, filter domain.BatchJobFilter) (int, error) {
+	return 0, errors.New("not used")
+}
+
+func (r *fakeBatchJobRepo) GetRunningJobs(ctx context.Context) ([]*domain.BatchJob, error) {
+	return nil, errors.New("not used")
+}
+
+func (r *fakeBatchJobRepo) RequestCancel(ctx context.Context, id valueobject.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cancelRequested[id] = true
+	return nil
+}
+
+func (r *fakeBatchJobRepo) SaveProgress(ctx context.Context, id valueobject.UUID, progress domain.BatchJobProgress) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	job, ok := r.jobs[id]
+	if !ok {
+		return false, domain.ErrNotFound
+	}
+	job.Progress = progress
+	r.jobs[id] = job
+	return r.cancelRequested[id], nil
+}
+
+// byType returns the jobs of a type in creation order
+func (r *fakeBatchJobRepo) byType(jobType domain.JobType) []domain.BatchJob {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var jobs []domain.BatchJob
+	for _, job := range r.jobs {
+		if job.JobType == jobType {
+			jobs = append(jobs, job)
+		}
+	}
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].CreatedAt.Before(jobs[j].CreatedAt) })
+	return jobs
+}
+
+// fakeBatchJobLockRepo keeps batch job leases in memory
+type fakeBatchJobLockRepo struct {
+	mu    sync.Mutex
+	locks map[string]domain.BatchJobLock
+}
+
+func newFakeBatchJobLockRepo() *fakeBatchJobLockRepo {
+	return &fakeBatchJobLockRepo{locks: map[string]domain.BatchJobLock{}}
+}
+
+func (r *fakeBatchJobLockRepo) Acquire(ctx context.Context, key string, jobID valueobject.UUID, holder string, ttl time.Duration) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	if lock, ok := r.locks[key]; ok && lock.JobID != jobID && !lock.IsStale(now) {
+		return false, nil
+	}
+	r.locks[key] = domain.BatchJobLock{Key: key, JobID: jobID, Holder: holder, AcquiredAt: now, HeartbeatAt: now, ExpiresAt: now.Add(ttl)}
+	return true, nil
+}
+
+func (r *fakeBatchJobLockRepo) Heartbeat(ctx context.Context, key string, jobID valueobject.UUID, ttl time.Duration) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	lock, ok := r.locks[key]
+	if !ok || lock.JobID != jobID {
+		return false, nil
+	}
+	now := time.Now()
+	lock.HeartbeatAt, lock.ExpiresAt = now, now.Add(ttl)
+	r.locks[key] = lock
+	return true, nil
+}
+
+func (r *fakeBatchJobLockRepo) Release(ctx context.Context, key string, jobID valueobject.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if lock, ok := r.locks[key]; ok && lock.JobID == jobID {
+		delete(r.locks, key)
+	}
+	return nil
+}
+
+func (r *fakeBatchJobLockRepo) FindByKey(ctx context.Context, key string) (*domain.BatchJobLock, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	lock, ok := r.locks[key]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return &lock, nil
+}
+
+func (r *fakeBatchJobLockRepo) FindByJob(ctx context.Context, jobID valueobject.UUID) ([]*domain.BatchJobLock, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var locks []*domain.BatchJobLock
+	for _, lock := range r.locks {
+		if lock.JobID == jobID {
+			lock := lock
+			locks = append(locks, &lock)
+		}
+	}
+	return locks, nil
+}
+
+// seedRunningJob records a running job of another process holding the leases
+// of keys, with leases that expire at expiresAt
+func seedRunningJob(t *testing.T, jobs *fakeBatchJobRepo, locks *fakeBatchJobLockRepo, jobType domain.JobType, expiresAt time.Time, keys ...string) valueobject.UUID {
+	t.Helper()
+	job, err := domain.NewBatchJob(valueobject.UUID(uuid.New().String()), jobType, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := job.Start(); err != nil {
+		t.Fatal(err)
+	}
+	jobs.jobs[job.ID] = *job
+	for _, key := range keys {
+		locks.locks[key] = domain.BatchJobLock{
+			Key:         key,
+			JobID:       job.ID,
+			Holder:      "other-host:1",
+			AcquiredAt:  expiresAt.Add(-batchJobLockTTL),
+			HeartbeatAt: expiresAt.Add(-batchJobLockTTL),
+			ExpiresAt:   expiresAt,
+		}
+	}
+	return job.ID
+}
+
+func TestBatchJobUseCase_RunBatchJob_LockContention(t *testing.T) {
+	genreKey := domain.BatchJobLockKey(domain.JobTypeCollectTrending, "genre-1")
+	otherKey := domain.BatchJobLockKey(domain.JobTypeCollectTrending, "genre-2")
+
+	tests := []struct {
+		name         string
+		lockKeys     []string
+		heldKeys     []string      // Leases held by another running job
+		heldFor      time.Duration // Time left on those leases; negative when they expired
+		wantErr      error
+		wantStatus   domain.JobStatus
+		wantRun      bool
+		wantOther    domain.JobStatus // Status of the other job afterwards
+		wantReleased bool             // Whether the job's leases are free afterwards
+	}{
+		{
+			name:         "free lease",
+			lockKeys:     []string{genreKey},
+			wantStatus:   domain.JobStatusCompleted,
+			wantRun:      true,
+			wantReleased: true,
+		},
+		{
+			name:         "lease held by a running job",
+			lockKeys:     []string{genreKey},
+			heldKeys:     []string{genreKey},
+			heldFor:      time.Minute,
+			wantErr:      domain.ErrBatchJobOverlap,
+			wantStatus:   domain.JobStatusSkippedOverlap,
+			wantOther:    domain.JobStatusRunning,
+			wantReleased: false,
+		},
+		{
+			name:         "leases taken before the held one are released",
+			lockKeys:     []string{otherKey, genreKey},
+			heldKeys:     []string{genreKey},
+			heldFor:      time.Minute,
+			wantErr:      domain.ErrBatchJobOverlap,
+			wantStatus:   domain.JobStatusSkippedOverlap,
+			wantOther:    domain.JobStatusRunning,
+			wantReleased: false,
+		},
+		{
+			name:         "expired lease is taken over and its job failed",
+			lockKeys:     []string{genreKey},
+			heldKeys:     []string{genreKey},
+			heldFor:      -time.Minute,
+			wantStatus:   domain.JobStatusCompleted,
+			wantRun:      true,
+			wantOther:    domain.JobStatusFailed,
+			wantReleased: true,
+		},
+		{
+			name:         "default lease of the job type",
+			heldKeys:     []string{domain.BatchJobLockKey(domain.JobTypeCollectTrending, "")},
+			heldFor:      time.Minute,
+			wantErr:      domain.ErrBatchJobOverlap,
+			wantStatus:   domain.JobStatusSkippedOverlap,
+			wantOther:    domain.JobStatusRunning,
+			wantReleased: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobs, locks := newFakeBatchJobRepo(), newFakeBatchJobLockRepo()
+			var otherID valueobject.UUID
+			if len(tt.heldKeys) > 0 {
+				otherID = seedRunningJob(t, jobs, locks, domain.JobTypeCollectTrending, time.Now().Add(tt.heldFor), tt.heldKeys...)
+			}
+			uc := NewBatchJobUseCase(jobs, locks, nil)
+
+			ran := false
+			job, err := uc.RunBatchJob(context.Background(), &input.RunBatchJobInput{
+				JobType:  string(domain.JobTypeCollectTrending),
+				LockKeys: tt.lockKeys,
+			}, func(ctx context.Context) (map[string]interface{}, error) {
+				ran = true
+				return map[string]interface{}{"videos": 1}, nil
+			})
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RunBatchJob() error = %v, want %v", err, tt.wantErr)
+			}
+			if ran != tt.wantRun {
+				t.Errorf("run called = %v, want %v", ran, tt.wantRun)
+			}
+			stored, _ := jobs.FindByID(context.Background(), job.ID)
+			if stored.Status != tt.wantStatus {
+				t.Errorf("status = %s, want %s (error message %q)", stored.Status, tt.wantStatus, stored.ErrorMessage)
+			}
+			if otherID != "" {
+				other, _ := jobs.FindByID(context.Background(), otherID)
+				if other.Status != tt.wantOther {
+					t.Errorf("other job status = %s, want %s", other.Status, tt.wantOther)
+				}
+				if other.Status == domain.JobStatusFailed && !strings.Contains(other.ErrorMessage, domain.ErrBatchJobLeaseLost.Error()) {
+					t.Errorf("other job error message = %q, want lease lost", other.ErrorMessage)
+				}
+			}
+			held, _ := locks.FindByJob(context.Background(), job.ID)
+			if len(held) != 0 {
+				t.Errorf("job still holds %d leases", len(held))
+			}
+			if tt.wantReleased {
+				for _, key := range tt.lockKeys {
+					if _, err := locks.FindByKey(context.Background(), key); !errors.Is(err, domain.ErrNotFound) {
+						t.Errorf("lease %s not released", key)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestBatchJobUseCase_CancelBatchJob(t *testing.T) {
+	tests := []struct {
+		name       string
+		leaseFor   time.Duration // Time left on the leases of the seeded running job
+		wantStatus domain.JobStatus
+		wantErr    error
+	}{
+		{
+			name:       "job whose runner died is failed",
+			leaseFor:   -time.Minute,
+			wantStatus: domain.JobStatusFailed,
+		},
+		{
+			name:       "job with a live runner is left to its runner",
+			leaseFor:   time.Minute,
+			wantStatus: domain.JobStatusRunning,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobs, locks := newFakeBatchJobRepo(), newFakeBatchJobLockRepo()
+			key := domain.BatchJobLockKey(domain.JobTypeUpdateChannels, "")
+			jobID := seedRunningJob(t, jobs, locks, domain.JobTypeUpdateChannels, time.Now().Add(tt.leaseFor), key)
+			uc := NewBatchJobUseCase(jobs, locks, nil)
+
+			job, err := uc.CancelBatchJob(context.Background(), uuid.MustParse(string(jobID)))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CancelBatchJob() error = %v, want %v", err, tt.wantErr)
+			}
+			if job.Status != tt.wantStatus {
+				t.Errorf("status = %s, want %s", job.Status, tt.wantStatus)
+			}
+			if tt.wantStatus == domain.JobStatusRunning && !jobs.cancelRequested[jobID] {
+				t.Error("cancellation was not requested")
+			}
+		})
+	}
+
+	t.Run("running job stops at its next check", func(t *testing.T) {
+		jobs, locks := newFakeBatchJobRepo(), newFakeBatchJobLockRepo()
+		uc := NewBatchJobUseCase(jobs, locks, nil)
+
+		started := make(chan struct{})
+		type outcome struct {
+			job *domain.BatchJob
+			err error
+		}
+		done := make(chan outcome, 1)
+		go func() {
+			job, err := uc.RunBatchJob(context.Background(), &input.RunBatchJobInput{
+				JobType: string(domain.JobTypeUpdateChannels),
+			}, func(ctx context.Context) (map[string]interface{}, error) {
+				close(started)
+				<-ctx.Done()
+				return nil, ctx.Err()
+			})
+			done <- outcome{job, err}
+		}()
+		<-started
+
+		running := jobs.byType(domain.JobTypeUpdateChannels)
+		if len(running) != 1 || running[0].Status != domain.JobStatusRunning {
+			t.Fatalf("jobs = %+v, want one running job", running)
+		}
+		requested, err := uc.CancelBatchJob(context.Background(), uuid.MustParse(string(running[0].ID)))
+		if err != nil {
+			t.Fatalf("CancelBatchJob() error = %v", err)
+		}
+		if requested.Status != domain.JobStatusRunning {
+			t.Errorf("status after the request = %s, want running", requested.Status)
+		}
+
+		select {
+		case got := <-done:
+			if !errors.Is(got.err, domain.ErrBatchJobCancelled) {
+				t.Errorf("RunBatchJob() error = %v, want %v", got.err, domain.ErrBatchJobCancelled)
+			}
+			if got.job.Status != domain.JobStatusCancelled {
+				t.Errorf("status = %s, want cancelled", got.job.Status)
+			}
+		case <-time.After(3 * batchJobPollInterval):
+			t.Fatal("job did not stop after cancellation was requested")
+		}
+		if held, _ := locks.FindByJob(context.Background(), running[0].ID); len(held) != 0 {
+			t.Errorf("cancelled job still holds %d leases", len(held))
+		}
+	})
+}
+
+func TestBatchJobUseCase_RetryBatchJob(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     domain.JobStatus
+		jobType    domain.JobType
+		runErr     error
+		wantErr    error
+		wantStatus domain.JobStatus // Status of the retry once it finished
+	}{
+		{
+			name:       "failed job runs again",
+			status:     domain.JobStatusFailed,
+			jobType:    domain.JobTypeUpdateChannels,
+			wantStatus: domain.JobStatusCompleted,
+		},
+		{
+			name:       "retry that fails again is recorded as failed",
+			status:     domain.JobStatusFailed,
+			jobType:    domain.JobTypeUpdateChannels,
+			runErr:     errors.New("quota exceeded"),
+			wantStatus: domain.JobStatusFailed,
+		},
+		{
+			name:    "running job cannot be retried",
+			status:  domain.JobStatusRunning,
+			jobType: domain.JobTypeUpdateChannels,
+			wantErr: domain.ErrInvalidJobTransition,
+		},
+		{
+			name:    "job type without a runner",
+			status:  domain.JobStatusFailed,
+			jobType: domain.JobTypePurgeAuditLogs,
+			wantErr: domain.ErrBatchJobNotRetryable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobs, locks := newFakeBatchJobRepo(), newFakeBatchJobLockRepo()
+			original, err := domain.NewBatchJob(valueobject.UUID(uuid.New().String()), tt.jobType, map[string]interface{}{"limit": 10})
+			if err != nil {
+				t.Fatal(err)
+			}
+			original.Status = tt.status
+			jobs.jobs[original.ID] = *original
+
+			var gotParameters map[string]interface{}
+			runners := map[domain.JobType]input.BatchJobRunner{
+				domain.JobTypeUpdateChannels: func(ctx context.Context, parameters map[string]interface{}) (*input.RunBatchJobInput, input.BatchJobFunc, error) {
+					gotParameters = parameters
+					return &input.RunBatchJobInput{JobType: string(domain.JobTypeUpdateChannels), Parameters: parameters},
+						func(ctx context.Context) (map[string]interface{}, error) {
+							return map[string]interface{}{"channels": 1}, tt.runErr
+						}, nil
+				},
+			}
+			uc := NewBatchJobUseCase(jobs, locks, runners)
+
+			retry, err := uc.RetryBatchJob(context.Background(), uuid.MustParse(string(original.ID)))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RetryBatchJob() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if got := jobs.byType(tt.jobType); len(got) != 1 {
+					t.Errorf("%d jobs recorded, want only the original", len(got))
+				}
+				return
+			}
+
+			// The retry runs in the background until Shutdown has drained it
+			if err := uc.Shutdown(context.Background()); err != nil {
+				t.Fatalf("Shutdown() error = %v", err)
+			}
+			stored, _ := jobs.FindByID(context.Background(), retry.ID)
+			if stored.Status != tt.wantStatus {
+				t.Errorf("retry status = %s, want %s", stored.Status, tt.wantStatus)
+			}
+			if stored.RetryOf == nil || *stored.RetryOf != original.ID {
+				t.Errorf("retry of = %v, want %s", stored.RetryOf, original.ID)
+			}
+			if gotParameters["limit"] != 10 {
+				t.Errorf("runner parameters = %v, want those of the original job", gotParameters)
+			}
+		})
+	}
+}
+
+func TestBatchJobUseCase_Shutdown(t *testing.T) {
+	jobs, locks := newFakeBatchJobRepo(), newFakeBatchJobLockRepo()
+	original, err := domain.NewBatchJob(valueobject.UUID(uuid.New().String()), domain.JobTypeUpdateChannels, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	original.Status = domain.JobStatusFailed
+	jobs.jobs[original.ID] = *original
+
+	started := make(chan struct{})
+	runners := map[domain.JobType]input.BatchJobRunner{
+		domain.JobTypeUpdateChannels: func(ctx context.Context, parameters map[string]interface{}) (*input.RunBatchJobInput, input.BatchJobFunc, error) {
+			return &input.RunBatchJobInput{JobType: string(domain.JobTypeUpdateChannels)},
+				func(ctx context.Context) (map[string]interface{}, error) {
+					close(started)
+					<-ctx.Done()
+					return nil, ctx.Err()
+				}, nil
+		},
+	}
+	uc := NewBatchJobUseCase(jobs, locks, runners)
+
+	// The request context ending does not stop the retry
+	reqCtx, cancelReq := context.WithCancel(context.Background())
+	retry, err := uc.RetryBatchJob(reqCtx, uuid.MustParse(string(original.ID)))
+	if err != nil {
+		t.Fatalf("RetryBatchJob() error = %v", err)
+	}
+	cancelReq()
+	<-started
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := uc.Shutdown(shutdownCtx); err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+
+	stored, _ := jobs.FindByID(context.Background(), retry.ID)
+	if stored.Status != domain.JobStatusFailed {
+		t.Errorf("drained retry status = %s, want failed", stored.Status)
+	}
+	if !strings.Contains(stored.ErrorMessage, domain.ErrBatchJobShutdown.Error()) {
+		t.Errorf("drained retry error message = %q, want shutdown", stored.ErrorMessage)
+	}
+	if held, _ := locks.FindByJob(context.Background(), retry.ID); len(held) != 0 {
+		t.Errorf("drained retry still holds %d leases", len(held))
+	}
+
+	if _, err := uc.RetryBatchJob(context.Background(), uuid.MustParse(string(original.ID))); !errors.Is(err, domain.ErrBatchJobShutdown) {
+		t.Errorf("RetryBatchJob() after Shutdown error = %v, want %v", err, domain.ErrBatchJobShutdown)
+	}
+}
@@ -2,6 +2,8 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"log"
 	"time"
 
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
@@ -11,18 +13,31 @@ import (
 	"github.com/google/uuid"
 )
 
+// defaultChannelGrowthLimit is the number of daily snapshots returned when no
+// limit is given, roughly three months
+const defaultChannelGrowthLimit = 90
+
+// Page sizes for ListChannels
+const (
+	defaultChannelPageSize = 50
+	maxChannelPageSize     = 200
+)
+
 type channelUseCase struct {
-	channelRepo gateway.ChannelRepository
-	youtubeAPI  gateway.YouTubeClient
+	channelRepo         gateway.ChannelRepository
+	channelSnapshotRepo gateway.ChannelSnapshotRepository
+	youtubeAPI          gateway.YouTubeClient
 }
 
 func NewChannelUseCase(
 	channelRepo gateway.ChannelRepository,
+	channelSnapshotRepo gateway.ChannelSnapshotRepository,
 	youtubeAPI gateway.YouTubeClient,
 ) input.ChannelInputPort {
 	return &channelUseCase{
-		channelRepo: channelRepo,
-		youtubeAPI:  youtubeAPI,
+		channelRepo:         channelRepo,
+		channelSnapshotRepo: channelSnapshotRepo,
+		youtubeAPI:          youtubeAPI,
 	}
 }
 
@@ -35,8 +50,16 @@ func (u *channelUseCase) UpdateChannels(ctx context.Context) (*input.UpdateChann
 		return nil, err
 	}
 
+	now := time.Now()
 	updated := 0
-	for _, channel := range channels {
+	snapshotsTaken := 0
+	for i, channel := range channels {
+		// Stop between channels when the run is cancelled
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		domain.ReportProgress(ctx, domain.BatchJobProgress{Processed: i, Total: len(channels), Current: channel.Title})
+
 		// Fetch latest metadata from YouTube API
 		metadata, err := u.youtubeAPI.GetChannel(ctx, channel.YouTubeChannelID)
 		if err != nil {
@@ -44,26 +67,79 @@ func (u *channelUseCase) UpdateChannels(ctx context.Context) (*input.UpdateChann
 			continue
 		}
 
-		// Update if changed
-		if channel.Title != metadata.Title || channel.ThumbnailURL != metadata.ThumbnailURL {
+		changed := channel.Title != metadata.Title || channel.ThumbnailURL != metadata.ThumbnailURL
+		if changed {
 			channel.Title = metadata.Title
 			channel.ThumbnailURL = metadata.ThumbnailURL
+		}
 
-			if err := u.channelRepo.Update(ctx, channel); err != nil {
-				// Continue with next channel on error
-				continue
+		// Take the daily statistics snapshot
+		snapshotted, err := u.addSnapshot(ctx, channel, now)
+		if err != nil {
+			log.Printf("failed to snapshot channel %s: %v", channel.ID, err)
+		}
+
+		if !changed && !snapshotted {
+			continue
+		}
+		if domain.IsDryRun(ctx) {
+			if changed {
+				domain.PlanChange(ctx, domain.PlannedChange{Action: domain.PlannedUpdate, Resource: "channel", ID: string(channel.YouTubeChannelID), Detail: channel.Title})
+			}
+			if snapshotted {
+				domain.PlanChange(ctx, domain.PlannedChange{Action: domain.PlannedInsert, Resource: "channel_snapshot", ID: string(channel.YouTubeChannelID)})
 			}
+		} else if err := u.channelRepo.SaveWithSnapshots(ctx, channel); err != nil {
+			// Continue with next channel on error
+			continue
+		}
+		if changed {
 			updated++
 		}
+		if snapshotted {
+			snapshotsTaken++
+		}
 	}
+	domain.ReportProgress(ctx, domain.BatchJobProgress{Processed: len(channels), Total: len(channels)})
 
 	return &input.UpdateChannelsResult{
 		ChannelsProcessed: len(channels),
 		ChannelsUpdated:   updated,
+		SnapshotsTaken:    snapshotsTaken,
 		Duration:          time.Since(start),
 	}, nil
 }
 
+// addSnapshot adds a statistics snapshot to the channel aggregate unless one
+// was already taken today. It reports whether a snapshot was added.
+func (u *channelUseCase) addSnapshot(ctx context.Context, channel *domain.Channel, now time.Time) (bool, error) {
+	latest, err := u.channelSnapshotRepo.Latest(ctx, channel.ID)
+	if err != nil && !errors.Is(err, domain.ErrChannelSnapshotNotFound) {
+		return false, err
+	}
+	if !domain.ChannelSnapshotDue(latest, now) {
+		return false, nil
+	}
+
+	stats, err := u.youtubeAPI.GetChannelStats(ctx, channel.YouTubeChannelID)
+	if err != nil {
+		return false, err
+	}
+
+	counts := domain.ChannelCounts{
+		SubscriptionCount: stats.SubscriberCount,
+		ViewCount:         stats.ViewCount,
+		VideoCount:        stats.VideoCount,
+	}
+	snapshot := domain.NewChannelSnapshot(valueobject.UUID(uuid.New().String()), channel.ID, now, counts)
+	if err := channel.AddSnapshot(snapshot); err != nil {
+		return false, err
+	}
+	channel.UpdateStats(counts)
+
+	return true, nil
+}
+
 func (u *channelUseCase) GetChannel(ctx context.Context, channelID uuid.UUID) (*domain.Channel, error) {
 	channel, err := u.channelRepo.GetByID(ctx, valueobject.UUID(channelID.String()))
 	if err != nil {
@@ -72,9 +148,122 @@ func (u *channelUseCase) GetChannel(ctx context.Context, channelID uuid.UUID) (*
 	return channel, nil
 }
 
-func (u *channelUseCase) ListChannels(ctx context.Context, onlySubscribed bool) ([]*domain.Channel, error) {
-	if onlySubscribed {
-		return u.channelRepo.ListSubscribed(ctx)
+// ListChannels returns one page of the channels matching the filter, newest first
+func (u *channelUseCase) ListChannels(ctx context.Context, in *input.ListChannelsInput) (*input.ListChannelsResult, error) {
+	size := pageSize(in.PageSize, defaultChannelPageSize, maxChannelPageSize)
+	filter := valueobject.FilterDigest(in.Filter)
+	after, err := valueobject.ParsePageToken(in.PageToken, domain.ChannelOrder, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch one extra channel to learn whether another page follows
+	channels, err := u.channelRepo.Search(ctx, in.Filter, after, size+1)
+	if err != nil {
+		return nil, err
+	}
+	total, err := u.channelRepo.CountSearch(ctx, in.Filter)
+	if err != nil {
+		return nil, err
 	}
-	return u.channelRepo.ListActive(ctx)
+
+	result := &input.ListChannelsResult{TotalCount: total}
+	result.Channels, result.NextPageToken = nextPage(channels, size, filter, func(c *domain.Channel) valueobject.PageCursor {
+		return valueobject.PageCursor{Order: domain.ChannelOrder, Key: c.CreatedAt, ID: string(c.ID)}
+	})
+	return result, nil
+}
+
+// GetChannelGrowth returns the growth series of the channel over its most
+// recent daily snapshots, oldest first
+func (u *channelUseCase) GetChannelGrowth(ctx context.Context, channelID uuid.UUID, limit int) ([]*domain.ChannelGrowthPoint, error) {
+	id := valueobject.UUID(channelID.String())
+	if _, err := u.channelRepo.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = defaultChannelGrowthLimit
+	}
+	snapshots, err := u.channelSnapshotRepo.ListByChannel(ctx, id, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewChannelGrowthSeries(snapshots), nil
+}
+
+// GetChannelByYouTubeID returns the stored channel with the YouTube channel ID
+func (u *channelUseCase) GetChannelByYouTubeID(ctx context.Context, youtubeChannelID string) (*domain.Channel, error) {
+	return u.channelRepo.FindByYouTubeID(ctx, valueobject.YouTubeChannelID(youtubeChannelID))
+}
+
+// SubscribeChannel subscribes to a channel, registering it with its YouTube
+// metadata when it is not known yet
+func (u *channelUseCase) SubscribeChannel(ctx context.Context, youtubeChannelID string) (*domain.Channel, error) {
+	if youtubeChannelID == "" {
+		return nil, domain.ErrEmptyYouTubeChannelID
+	}
+	ytID := valueobject.YouTubeChannelID(youtubeChannelID)
+
+	channel, err := u.channelRepo.FindByYouTubeID(ctx, ytID)
+	if err != nil && !errors.Is(err, domain.ErrChannelNotFound) {
+		return nil, err
+	}
+	isNew := channel == nil
+	if isNew {
+		metadata, err := u.youtubeAPI.GetChannel(ctx, ytID)
+		if err != nil {
+			return nil, err
+		}
+		channel, err = domain.NewChannel(
+			valueobject.UUID(uuid.New().String()),
+			ytID,
+			metadata.Title,
+			metadata.ThumbnailURL,
+			metadata.Description,
+			"",
+			0, 0, 0, // Filled in by the next channel update
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	channel.Subscribe()
+
+	if domain.IsDryRun(ctx) {
+		action := domain.PlannedUpdate
+		if isNew {
+			action = domain.PlannedInsert
+		}
+		domain.PlanChange(ctx, domain.PlannedChange{Action: action, Resource: "channel", ID: string(channel.ID), Detail: "subscribe " + string(ytID)})
+		return channel, nil
+	}
+	if isNew {
+		err = u.channelRepo.Save(ctx, channel)
+	} else {
+		err = u.channelRepo.Update(ctx, channel)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return channel, nil
+}
+
+// UnsubscribeChannel stops following a channel. The channel and its history are kept.
+func (u *channelUseCase) UnsubscribeChannel(ctx context.Context, channelID uuid.UUID) (*domain.Channel, error) {
+	channel, err := u.channelRepo.GetByID(ctx, valueobject.UUID(channelID.String()))
+	if err != nil {
+		return nil, err
+	}
+	channel.Unsubscribe()
+
+	if domain.IsDryRun(ctx) {
+		domain.PlanChange(ctx, domain.PlannedChange{Action: domain.PlannedUpdate, Resource: "channel", ID: string(channel.ID), Detail: "unsubscribe"})
+		return channel, nil
+	}
+	if err := u.channelRepo.Update(ctx, channel); err != nil {
+		return nil, err
+	}
+	return channel, nil
 }
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
+)
+
+// checkpointProfileResolver decides the checkpoint schedule of a video from
+// the profiles of its genres, shared by the use cases that schedule or audit snapshots
+type checkpointProfileResolver struct {
+	profileRepo gateway.CheckpointProfileRepository
+}
+
+// resolve returns the merged profile of the video's genres, or the default
+// profile when none of them has one
+func (r *checkpointProfileResolver) resolve(ctx context.Context, video *domain.Video) (*domain.CheckpointProfile, error) {
+	profiles, err := r.profileRepo.FindByVideo(ctx, video.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find checkpoint profiles of video %s: %w", video.ID, err)
+	}
+	if len(profiles) > 0 {
+		return domain.MergeCheckpointProfiles(profiles), nil
+	}
+
+	profile, err := r.profileRepo.FindByCode(ctx, domain.DefaultCheckpointProfileCode)
+	if errors.Is(err, domain.ErrNotFound) {
+		return domain.DefaultCheckpointProfile(), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find default checkpoint profile: %w", err)
+	}
+	return profile, nil
+}
+
+// active returns the profiles videos can be captured with: those of enabled
+// genres and the default profile
+func (r *checkpointProfileResolver) active(ctx context.Context) ([]*domain.CheckpointProfile, error) {
+	profiles, err := r.profileRepo.ListActive(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list active checkpoint profiles: %w", err)
+	}
+
+	profile, err := r.profileRepo.FindByCode(ctx, domain.DefaultCheckpointProfileCode)
+	if errors.Is(err, domain.ErrNotFound) {
+		return append(profiles, domain.DefaultCheckpointProfile()), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find default checkpoint profile: %w", err)
+	}
+	return append(profiles, profile), nil
+}
@@ -2,6 +2,8 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
@@ -10,21 +12,50 @@ import (
 	"github.com/google/uuid"
 )
 
+// Page sizes for ListGenres
+const (
+	defaultGenrePageSize = 100
+	maxGenrePageSize     = 500
+)
+
 // genreUseCase implements the GenreInputPort interface
 type genreUseCase struct {
-	genreRepo gateway.GenreRepository
+	genreRepo   gateway.GenreRepository
+	profileRepo gateway.CheckpointProfileRepository
 }
 
 // NewGenreUseCase creates a new genre use case
-func NewGenreUseCase(genreRepo gateway.GenreRepository) input.GenreInputPort {
+func NewGenreUseCase(genreRepo gateway.GenreRepository, profileRepo gateway.CheckpointProfileRepository) input.GenreInputPort {
 	return &genreUseCase{
-		genreRepo: genreRepo,
+		genreRepo:   genreRepo,
+		profileRepo: profileRepo,
 	}
 }
 
-// ListGenres lists all genres
-func (u *genreUseCase) ListGenres(ctx context.Context) ([]*domain.Genre, error) {
-	return u.genreRepo.FindAll(ctx)
+// ListGenres returns one page of genres ordered by code
+func (u *genreUseCase) ListGenres(ctx context.Context, in *input.ListGenresInput) (*input.ListGenresResult, error) {
+	size := pageSize(in.PageSize, defaultGenrePageSize, maxGenrePageSize)
+	filter := valueobject.FilterDigest(in.EnabledOnly)
+	after, err := valueobject.ParsePageToken(in.PageToken, domain.GenreOrder, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch one extra genre to learn whether another page follows
+	genres, err := u.genreRepo.List(ctx, in.EnabledOnly, after, size+1)
+	if err != nil {
+		return nil, err
+	}
+	total, err := u.genreRepo.Count(ctx, in.EnabledOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &input.ListGenresResult{TotalCount: total}
+	result.Genres, result.NextPageToken = nextPage(genres, size, filter, func(g *domain.Genre) valueobject.PageCursor {
+		return valueobject.PageCursor{Order: domain.GenreOrder, Text: g.Code, ID: string(g.ID)}
+	})
+	return result, nil
 }
 
 // ListEnabledGenres lists only enabled genres
@@ -66,7 +97,18 @@ func (u *genreUseCase) CreateGenre(ctx context.Context, input *input.CreateGenre
 		return nil, err
 	}
 
+	// Assign the snapshot schedule
+	profile, err := u.checkpointProfile(ctx, input.CheckpointProfile)
+	if err != nil {
+		return nil, err
+	}
+	genre.SetCheckpointProfile(profile)
+
 	// Save to repository
+	if domain.IsDryRun(ctx) {
+		domain.PlanChange(ctx, domain.PlannedChange{Action: domain.PlannedInsert, Resource: "genre", ID: string(genre.ID)})
+		return genre, nil
+	}
 	if err := u.genreRepo.Save(ctx, genre); err != nil {
 		return nil, err
 	}
@@ -92,8 +134,19 @@ func (u *genreUseCase) UpdateGenre(ctx context.Context, input *input.UpdateGenre
 	if err := genre.Update(input.Name, categoryIDs); err != nil {
 		return nil, err
 	}
+	if input.CheckpointProfile != nil {
+		profile, err := u.checkpointProfile(ctx, *input.CheckpointProfile)
+		if err != nil {
+			return nil, err
+		}
+		genre.SetCheckpointProfile(profile)
+	}
 
 	// Save to repository
+	if domain.IsDryRun(ctx) {
+		domain.PlanChange(ctx, domain.PlannedChange{Action: domain.PlannedUpdate, Resource: "genre", ID: string(genre.ID)})
+		return genre, nil
+	}
 	if err := u.genreRepo.Update(ctx, genre); err != nil {
 		return nil, err
 	}
@@ -115,6 +168,10 @@ func (u *genreUseCase) EnableGenre(ctx context.Context, genreID uuid.UUID) (*dom
 	}
 
 	// Save to repository
+	if domain.IsDryRun(ctx) {
+		domain.PlanChange(ctx, domain.PlannedChange{Action: domain.PlannedUpdate, Resource: "genre", ID: string(genre.ID), Detail: "enable"})
+		return genre, nil
+	}
 	if err := u.genreRepo.Update(ctx, genre); err != nil {
 		return nil, err
 	}
@@ -136,9 +193,28 @@ func (u *genreUseCase) DisableGenre(ctx context.Context, genreID uuid.UUID) (*do
 	}
 
 	// Save to repository
+	if domain.IsDryRun(ctx) {
+		domain.PlanChange(ctx, domain.PlannedChange{Action: domain.PlannedUpdate, Resource: "genre", ID: string(genre.ID), Detail: "disable"})
+		return genre, nil
+	}
 	if err := u.genreRepo.Update(ctx, genre); err != nil {
 		return nil, err
 	}
 
 	return genre, nil
 }
+
+// checkpointProfile looks up a profile by code; empty selects the default profile
+func (u *genreUseCase) checkpointProfile(ctx context.Context, code string) (*domain.CheckpointProfile, error) {
+	if code == "" || code == domain.DefaultCheckpointProfileCode {
+		return nil, nil
+	}
+	profile, err := u.profileRepo.FindByCode(ctx, code)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, fmt.Errorf("%w: unknown checkpoint profile %q", domain.ErrInvalidInput, code)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
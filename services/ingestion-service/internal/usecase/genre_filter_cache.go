@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/service"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+)
+
+// genreFilterCache keeps the compiled filter of each genre. A filter is
+// rebuilt when the version of its genre changes, so changes made by other
+// instances are picked up as well.
+type genreFilterCache struct {
+	mu      sync.Mutex
+	filters map[valueobject.UUID]cachedGenreFilter
+}
+
+type cachedGenreFilter struct {
+	version string
+	filter  *service.CompiledFilter
+}
+
+func newGenreFilterCache() *genreFilterCache {
+	return &genreFilterCache{filters: make(map[valueobject.UUID]cachedGenreFilter)}
+}
+
+// get returns the compiled filter of the genre at the version, compiling the
+// keywords returned by build when the cached filter is of another version
+func (c *genreFilterCache) get(genreID valueobject.UUID, version string, build func() []*domain.Keyword) (*service.CompiledFilter, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if cached, ok := c.filters[genreID]; ok && cached.version == version {
+		return cached.filter, nil
+	}
+
+	filter, err := service.CompileFilter(build())
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile filter of genre %s: %w", genreID, err)
+	}
+	c.filters[genreID] = cachedGenreFilter{version: version, filter: filter}
+	return filter, nil
+}
+
+// saved returns the compiled filter of the enabled keyword groups of the genre
+// as saved, with patterns generated by the generator
+func (c *genreFilterCache) saved(genreID valueobject.UUID, groups []*domain.KeywordGroup, generator *service.KeywordPatternGenerator) (*service.CompiledFilter, error) {
+	return c.get(genreID, genreFilterVersion(groups, generator.DictionaryVersion()), func() []*domain.Keyword {
+		var keywords []*domain.Keyword
+		for _, g := range groups {
+			if g.Enabled {
+				keywords = append(keywords, groupFilter(generator, g))
+			}
+		}
+		return keywords
+	})
+}
+
+// genreFilterVersion identifies the keyword groups of a genre as saved: any
+// change to a group updates its updated_at, a deleted group is no longer
+// listed, and patterns change with the synonym dictionary
+func genreFilterVersion(groups []*domain.KeywordGroup, dictionaryVersion int64) string {
+	var latest int64
+	for _, g := range groups {
+		changed := g.CreatedAt
+		if g.UpdatedAt != nil && g.UpdatedAt.After(changed) {
+			changed = *g.UpdatedAt
+		}
+		if t := changed.UnixNano(); t > latest {
+			latest = t
+		}
+	}
+	return fmt.Sprintf("%d:%d:%d", len(groups), latest, dictionaryVersion)
+}
@@ -0,0 +1,564 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/service"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/input"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
+	"github.com/google/uuid"
+)
+
+// defaultTargetField is the field keyword groups match when none is declared
+const defaultTargetField = "title"
+
+// genreManifestUseCase implements the GenreManifestInputPort interface on top
+// of the genre and keyword group use cases, so changes go through the same
+// validation, dry run handling and auditing as API calls
+type genreManifestUseCase struct {
+	genreUseCase        input.GenreInputPort
+	keywordGroupUseCase input.KeywordGroupInputPort
+	profileRepo         gateway.CheckpointProfileRepository
+	differ              service.GenreManifestDiffer
+}
+
+// NewGenreManifestUseCase creates a new genre manifest use case
+func NewGenreManifestUseCase(
+	genreUseCase input.GenreInputPort,
+	keywordGroupUseCase input.KeywordGroupInputPort,
+	profileRepo gateway.CheckpointProfileRepository,
+) input.GenreManifestInputPort {
+	return &genreManifestUseCase{
+		genreUseCase:        genreUseCase,
+		keywordGroupUseCase: keywordGroupUseCase,
+		profileRepo:         profileRepo,
+		differ:              service.NewGenreManifestDiffer(),
+	}
+}
+
+// ExportManifest returns all genres ordered by code with their keyword groups
+// ordered by filter type and name
+func (u *genreManifestUseCase) ExportManifest(ctx context.Context) (*input.GenreManifest, error) {
+	genres, err := u.listGenres(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &input.GenreManifest{Genres: make([]input.GenreSpec, 0, len(genres))}
+	for _, genre := range genres {
+		groups, err := u.keywordGroupUseCase.ListKeywordGroupsByGenre(ctx, uuid.MustParse(string(genre.ID)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list keyword groups of genre %s: %w", genre.Code, err)
+		}
+
+		profile, err := u.checkpointProfileCode(ctx, genre)
+		if err != nil {
+			return nil, err
+		}
+
+		spec := input.GenreSpec{
+			Code:              genre.Code,
+			Name:              genre.Name,
+			Language:          genre.Language,
+			RegionCode:        genre.RegionCode,
+			CategoryIDs:       categoryInts(genre.CategoryIDs),
+			Enabled:           boolPtr(genre.Enabled),
+			CheckpointProfile: profile,
+		}
+		for _, group := range groups {
+			spec.KeywordGroups = append(spec.KeywordGroups, toKeywordGroupSpec(group))
+		}
+		sort.SliceStable(spec.KeywordGroups, func(i, j int) bool {
+			a, b := spec.KeywordGroups[i], spec.KeywordGroups[j]
+			if a.FilterType != b.FilterType {
+				return a.FilterType < b.FilterType
+			}
+			return a.Name < b.Name
+		})
+		manifest.Genres = append(manifest.Genres, spec)
+	}
+	return manifest, nil
+}
+
+// ApplyManifest reconciles the database with the manifest. Genres are created
+// or updated to match it and their keyword groups are created, updated or
+// deleted. Genres missing from the manifest are left untouched. Changes are not
+// applied in a single transaction: on error the changes made so far are
+// returned with it, and applying the manifest again completes them.
+func (u *genreManifestUseCase) ApplyManifest(ctx context.Context, manifest *input.GenreManifest) (*input.ApplyManifestResult, error) {
+	if err := validateManifest(manifest); err != nil {
+		return nil, err
+	}
+
+	existing, err := u.listGenres(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &input.ApplyManifestResult{}
+	declared := make(map[string]bool, len(manifest.Genres))
+	for _, spec := range manifest.Genres {
+		declared[spec.Code] = true
+		if err := u.applyGenre(ctx, spec, result); err != nil {
+			return result, fmt.Errorf("genre %s: %w", spec.Code, err)
+		}
+	}
+	for _, genre := range existing {
+		if !declared[genre.Code] {
+			result.UnmanagedCodes = append(result.UnmanagedCodes, genre.Code)
+		}
+	}
+	return result, nil
+}
+
+// applyGenre creates or updates a genre and reconciles its keyword groups
+func (u *genreManifestUseCase) applyGenre(ctx context.Context, spec input.GenreSpec, result *input.ApplyManifestResult) error {
+	genre, err := u.genreUseCase.GetGenreByCode(ctx, spec.Code)
+	if errors.Is(err, domain.ErrNotFound) {
+		return u.createGenre(ctx, spec, result)
+	}
+	if err != nil {
+		return err
+	}
+
+	if genre.Language != spec.Language || genre.RegionCode != spec.RegionCode {
+		return fmt.Errorf("%w: language and region of an existing genre cannot change (%s/%s)",
+			domain.ErrInvalidInput, genre.Language, genre.RegionCode)
+	}
+
+	declared, err := u.declaredGenre(ctx, spec)
+	if err != nil {
+		return err
+	}
+	changed := u.differ.DiffGenre(genre, declared)
+
+	var diffs []string
+	if slices.Contains(changed, service.GenreFieldName) {
+		diffs = append(diffs, fmt.Sprintf("name: %s -> %s", genre.Name, spec.Name))
+	}
+	if slices.Contains(changed, service.GenreFieldCategoryIDs) {
+		diffs = append(diffs, fmt.Sprintf("category_ids: %v -> %v", categoryInts(genre.CategoryIDs), spec.CategoryIDs))
+	}
+	update := &input.UpdateGenreInput{
+		GenreID:     uuid.MustParse(string(genre.ID)),
+		Name:        spec.Name,
+		CategoryIDs: spec.CategoryIDs,
+	}
+	if slices.Contains(changed, service.GenreFieldCheckpointProfile) {
+		profile, err := u.checkpointProfileCode(ctx, genre)
+		if err != nil {
+			return err
+		}
+		code := checkpointProfileCode(spec.CheckpointProfile)
+		update.CheckpointProfile = &code
+		diffs = append(diffs, fmt.Sprintf("checkpoint_profile: %s -> %s", displayProfileCode(profile), displayProfileCode(code)))
+	}
+	if len(diffs) > 0 {
+		if _, err := u.genreUseCase.UpdateGenre(ctx, update); err != nil {
+			return err
+		}
+		result.Changes = append(result.Changes, domain.PlannedChange{
+			Action: domain.PlannedUpdate, Resource: "genre", ID: spec.Code, Detail: strings.Join(diffs, ", "),
+		})
+	}
+
+	if slices.Contains(changed, service.GenreFieldEnabled) {
+		toggle := u.genreUseCase.DisableGenre
+		detail := "disable"
+		if declared.Enabled {
+			toggle = u.genreUseCase.EnableGenre
+			detail = "enable"
+		}
+		if _, err := toggle(ctx, uuid.MustParse(string(genre.ID))); err != nil {
+			return err
+		}
+		result.Changes = append(result.Changes, domain.PlannedChange{
+			Action: domain.PlannedUpdate, Resource: "genre", ID: spec.Code, Detail: detail,
+		})
+	}
+
+	groups, err := u.keywordGroupUseCase.ListKeywordGroupsByGenre(ctx, uuid.MustParse(string(genre.ID)))
+	if err != nil {
+		return err
+	}
+	return u.applyKeywordGroups(ctx, genre, spec, groups, result)
+}
+
+// createGenre creates a genre missing from the database with all its keyword groups
+func (u *genreManifestUseCase) createGenre(ctx context.Context, spec input.GenreSpec, result *input.ApplyManifestResult) error {
+	genre, err := u.genreUseCase.CreateGenre(ctx, &input.CreateGenreInput{
+		Code:              spec.Code,
+		Name:              spec.Name,
+		Language:          spec.Language,
+		RegionCode:        spec.RegionCode,
+		CategoryIDs:       spec.CategoryIDs,
+		CheckpointProfile: checkpointProfileCode(spec.CheckpointProfile),
+	})
+	if err != nil {
+		return err
+	}
+	result.Changes = append(result.Changes, domain.PlannedChange{
+		Action: domain.PlannedInsert, Resource: "genre", ID: spec.Code, Detail: spec.Name,
+	})
+
+	// Genres are created enabled. A dry run did not save the genre, so there
+	// is nothing to disable yet.
+	if !isEnabled(spec.Enabled) {
+		if !domain.IsDryRun(ctx) {
+			if _, err := u.genreUseCase.DisableGenre(ctx, uuid.MustParse(string(genre.ID))); err != nil {
+				return err
+			}
+		}
+		result.Changes = append(result.Changes, domain.PlannedChange{
+			Action: domain.PlannedUpdate, Resource: "genre", ID: spec.Code, Detail: "disable",
+		})
+	}
+
+	return u.applyKeywordGroups(ctx, genre, spec, nil, result)
+}
+
+// applyKeywordGroups creates, updates and deletes the keyword groups of a
+// genre so they match its spec
+func (u *genreManifestUseCase) applyKeywordGroups(
+	ctx context.Context,
+	genre *domain.Genre,
+	spec input.GenreSpec,
+	groups []*domain.KeywordGroup,
+	result *input.ApplyManifestResult,
+) error {
+	current := make(map[string]*domain.KeywordGroup, len(groups))
+	for _, group := range groups {
+		current[keywordGroupKey(group.Name, string(group.FilterType))] = group
+	}
+
+	for _, groupSpec := range spec.KeywordGroups {
+		key := keywordGroupKey(groupSpec.Name, groupSpec.FilterType)
+		id := spec.Code + "/" + groupSpec.Name
+		group, ok := current[key]
+		delete(current, key)
+
+		if !ok {
+			if err := u.createKeywordGroup(ctx, genre, groupSpec, id, result); err != nil {
+				return fmt.Errorf("keyword group %s: %w", groupSpec.Name, err)
+			}
+			continue
+		}
+		if err := u.updateKeywordGroup(ctx, group, groupSpec, id, result); err != nil {
+			return fmt.Errorf("keyword group %s: %w", groupSpec.Name, err)
+		}
+	}
+
+	// Whatever is left is no longer declared. Delete in a stable order.
+	keys := make([]string, 0, len(current))
+	for key := range current {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		group := current[key]
+		if err := u.keywordGroupUseCase.DeleteKeywordGroup(ctx, uuid.MustParse(string(group.ID))); err != nil {
+			return fmt.Errorf("keyword group %s: %w", group.Name, err)
+		}
+		result.Changes = append(result.Changes, domain.PlannedChange{
+			Action: domain.PlannedDelete, Resource: "keyword_group", ID: spec.Code + "/" + group.Name, Detail: string(group.FilterType),
+		})
+	}
+	return nil
+}
+
+// createKeywordGroup creates a declared keyword group
+func (u *genreManifestUseCase) createKeywordGroup(
+	ctx context.Context,
+	genre *domain.Genre,
+	spec input.KeywordGroupSpec,
+	id string,
+	result *input.ApplyManifestResult,
+) error {
+	group, err := u.keywordGroupUseCase.CreateKeywordGroup(ctx, input.CreateKeywordGroupInput{
+		GenreID:       uuid.MustParse(string(genre.ID)),
+		Name:          spec.Name,
+		Keywords:      spec.Keywords,
+		FilterType:    valueobject.FilterType(spec.FilterType),
+		TargetField:   targetField(spec.TargetField),
+		Description:   descriptionPtr(spec.Description),
+		CrossLanguage: spec.CrossLanguage,
+		Romaji:        spec.Romaji,
+	})
+	if err != nil {
+		return err
+	}
+	result.Changes = append(result.Changes, domain.PlannedChange{
+		Action: domain.PlannedInsert, Resource: "keyword_group", ID: id,
+		Detail: fmt.Sprintf("%s, %d keywords", spec.FilterType, len(group.Items)),
+	})
+
+	// Groups are created enabled. A dry run did not save the group, so there
+	// is nothing to disable yet.
+	if !isEnabled(spec.Enabled) {
+		if !domain.IsDryRun(ctx) {
+			if _, err := u.keywordGroupUseCase.DisableKeywordGroup(ctx, uuid.MustParse(string(group.ID))); err != nil {
+				return err
+			}
+		}
+		result.Changes = append(result.Changes, domain.PlannedChange{
+			Action: domain.PlannedUpdate, Resource: "keyword_group", ID: id, Detail: "disable",
+		})
+	}
+	return nil
+}
+
+// updateKeywordGroup updates an existing keyword group where it differs from its spec
+func (u *genreManifestUseCase) updateKeywordGroup(
+	ctx context.Context,
+	group *domain.KeywordGroup,
+	spec input.KeywordGroupSpec,
+	id string,
+	result *input.ApplyManifestResult,
+) error {
+	groupID := uuid.MustParse(string(group.ID))
+
+	declared := declaredKeywordGroup(spec)
+	changed := u.differ.DiffKeywordGroup(group, declared)
+
+	var update input.UpdateKeywordGroupInput
+	var diffs []string
+	if slices.Contains(changed, service.KeywordGroupFieldTargetField) {
+		update.TargetField = &declared.TargetField
+		diffs = append(diffs, fmt.Sprintf("target_field: %s -> %s", group.TargetField, declared.TargetField))
+	}
+	if slices.Contains(changed, service.KeywordGroupFieldDescription) {
+		update.Description = &spec.Description
+		diffs = append(diffs, "description")
+	}
+	if slices.Contains(changed, service.KeywordGroupFieldCrossLanguage) {
+		update.CrossLanguage = &spec.CrossLanguage
+		diffs = append(diffs, fmt.Sprintf("cross_language: %t -> %t", group.CrossLanguage, spec.CrossLanguage))
+	}
+	if slices.Contains(changed, service.KeywordGroupFieldRomaji) {
+		update.Romaji = &spec.Romaji
+		diffs = append(diffs, fmt.Sprintf("romaji: %t -> %t", group.Romaji, spec.Romaji))
+	}
+	if len(diffs) > 0 {
+		if _, err := u.keywordGroupUseCase.UpdateKeywordGroup(ctx, groupID, update); err != nil {
+			return err
+		}
+		result.Changes = append(result.Changes, domain.PlannedChange{
+			Action: domain.PlannedUpdate, Resource: "keyword_group", ID: id, Detail: strings.Join(diffs, ", "),
+		})
+	}
+
+	if added, removed := u.differ.DiffKeywords(group.GetKeywords(), spec.Keywords); len(added)+len(removed) > 0 {
+		if _, err := u.keywordGroupUseCase.UpdateKeywords(ctx, groupID, spec.Keywords); err != nil {
+			return err
+		}
+		var changes []string
+		for _, keyword := range added {
+			changes = append(changes, "+"+keyword)
+		}
+		for _, keyword := range removed {
+			changes = append(changes, "-"+keyword)
+		}
+		result.Changes = append(result.Changes, domain.PlannedChange{
+			Action: domain.PlannedUpdate, Resource: "keyword_group", ID: id, Detail: "keywords: " + strings.Join(changes, " "),
+		})
+	}
+
+	if slices.Contains(changed, service.KeywordGroupFieldEnabled) {
+		toggle := u.keywordGroupUseCase.DisableKeywordGroup
+		detail := "disable"
+		if declared.Enabled {
+			toggle = u.keywordGroupUseCase.EnableKeywordGroup
+			detail = "enable"
+		}
+		if _, err := toggle(ctx, groupID); err != nil {
+			return err
+		}
+		result.Changes = append(result.Changes, domain.PlannedChange{
+			Action: domain.PlannedUpdate, Resource: "keyword_group", ID: id, Detail: detail,
+		})
+	}
+	return nil
+}
+
+// listGenres lists all genres, enabled or not, ordered by code
+func (u *genreManifestUseCase) listGenres(ctx context.Context) ([]*domain.Genre, error) {
+	var genres []*domain.Genre
+	in := &input.ListGenresInput{PageSize: maxGenrePageSize}
+	for {
+		page, err := u.genreUseCase.ListGenres(ctx, in)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list genres: %w", err)
+		}
+		genres = append(genres, page.Genres...)
+		if page.NextPageToken == "" {
+			return genres, nil
+		}
+		in.PageToken = page.NextPageToken
+	}
+}
+
+// checkpointProfileCode returns the code of the genre's checkpoint profile,
+// empty for the default profile
+func (u *genreManifestUseCase) checkpointProfileCode(ctx context.Context, genre *domain.Genre) (string, error) {
+	if genre.CheckpointProfileID == "" {
+		return "", nil
+	}
+	profile, err := u.profileRepo.FindByID(ctx, genre.CheckpointProfileID)
+	if err != nil {
+		return "", fmt.Errorf("failed to find checkpoint profile of genre %s: %w", genre.Code, err)
+	}
+	return checkpointProfileCode(profile.Code), nil
+}
+
+// declaredGenre returns the state the spec declares for an existing genre
+func (u *genreManifestUseCase) declaredGenre(ctx context.Context, spec input.GenreSpec) (service.DeclaredGenre, error) {
+	declared := service.DeclaredGenre{
+		Name:        spec.Name,
+		CategoryIDs: make([]valueobject.CategoryID, len(spec.CategoryIDs)),
+		Enabled:     isEnabled(spec.Enabled),
+	}
+	for i, id := range spec.CategoryIDs {
+		declared.CategoryIDs[i] = valueobject.CategoryID(id)
+	}
+	if code := checkpointProfileCode(spec.CheckpointProfile); code != "" {
+		profile, err := u.profileRepo.FindByCode(ctx, code)
+		if err != nil {
+			return service.DeclaredGenre{}, fmt.Errorf("failed to find checkpoint profile %s: %w", code, err)
+		}
+		declared.CheckpointProfileID = profile.ID
+	}
+	return declared, nil
+}
+
+// validateManifest checks what the use cases cannot see one change at a time:
+// required genre fields and duplicates across the manifest
+func validateManifest(manifest *input.GenreManifest) error {
+	if manifest == nil {
+		return fmt.Errorf("%w: empty manifest", domain.ErrInvalidInput)
+	}
+
+	codes := make(map[string]bool, len(manifest.Genres))
+	for i, genre := range manifest.Genres {
+		if genre.Code == "" {
+			return fmt.Errorf("%w: genres[%d]: code is required", domain.ErrInvalidInput, i)
+		}
+		if codes[genre.Code] {
+			return fmt.Errorf("%w: genre %s is declared twice", domain.ErrInvalidInput, genre.Code)
+		}
+		codes[genre.Code] = true
+		if genre.Language == "" || genre.RegionCode == "" {
+			return fmt.Errorf("%w: genre %s: language and region_code are required", domain.ErrInvalidInput, genre.Code)
+		}
+
+		groups := make(map[string]bool, len(genre.KeywordGroups))
+		for _, group := range genre.KeywordGroups {
+			if !valueobject.FilterType(group.FilterType).IsValid() {
+				return fmt.Errorf("%w: genre %s: keyword group %s: filter_type must be include or exclude",
+					domain.ErrInvalidInput, genre.Code, group.Name)
+			}
+			key := keywordGroupKey(group.Name, group.FilterType)
+			if groups[key] {
+				return fmt.Errorf("%w: genre %s: keyword group %s (%s) is declared twice",
+					domain.ErrInvalidInput, genre.Code, group.Name, group.FilterType)
+			}
+			groups[key] = true
+		}
+	}
+	return nil
+}
+
+// toKeywordGroupSpec converts a keyword group to its spec with sorted keywords
+func toKeywordGroupSpec(group *domain.KeywordGroup) input.KeywordGroupSpec {
+	keywords := group.GetKeywords()
+	sort.Strings(keywords)
+	return input.KeywordGroupSpec{
+		Name:          group.Name,
+		FilterType:    string(group.FilterType),
+		TargetField:   group.TargetField,
+		Description:   derefString(group.Description),
+		Enabled:       boolPtr(group.Enabled),
+		CrossLanguage: group.CrossLanguage,
+		Romaji:        group.Romaji,
+		Keywords:      keywords,
+	}
+}
+
+// declaredKeywordGroup returns the state the spec declares for an existing keyword group
+func declaredKeywordGroup(spec input.KeywordGroupSpec) service.DeclaredKeywordGroup {
+	return service.DeclaredKeywordGroup{
+		TargetField:   targetField(spec.TargetField),
+		Description:   spec.Description,
+		CrossLanguage: spec.CrossLanguage,
+		Romaji:        spec.Romaji,
+		Enabled:       isEnabled(spec.Enabled),
+	}
+}
+
+// keywordGroupKey identifies a keyword group within its genre
+func keywordGroupKey(name, filterType string) string {
+	return filterType + "/" + name
+}
+
+// targetField applies the default to a declared target field
+func targetField(field string) string {
+	if field == "" {
+		return defaultTargetField
+	}
+	return field
+}
+
+// descriptionPtr maps an empty description to none
+func descriptionPtr(description string) *string {
+	if description == "" {
+		return nil
+	}
+	return &description
+}
+
+// checkpointProfileCode maps the default profile to none, so declaring it
+// explicitly is the same as leaving it out
+func checkpointProfileCode(code string) string {
+	if code == domain.DefaultCheckpointProfileCode {
+		return ""
+	}
+	return code
+}
+
+// displayProfileCode names the default profile in change details
+func displayProfileCode(code string) string {
+	if code == "" {
+		return domain.DefaultCheckpointProfileCode
+	}
+	return code
+}
+
+// isEnabled applies the default to a declared enabled flag
+func isEnabled(enabled *bool) bool {
+	return enabled == nil || *enabled
+}
+
+func categoryInts(ids []valueobject.CategoryID) []int {
+	ints := make([]int, len(ids))
+	for i, id := range ids {
+		ints[i] = int(id)
+	}
+	return ints
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
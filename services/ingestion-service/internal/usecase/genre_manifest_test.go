@@ -0,0 +1,307 @@
+package usecase
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/input"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
+	"github.com/google/uuid"
+)
+
+// fakeGenreUseCase keeps genres in memory and changes nothing in dry runs
+type fakeGenreUseCase struct {
+	input.GenreInputPort
+	profiles *fakeCheckpointProfileRepo
+	genres   map[string]domain.Genre // By code
+}
+
+func (u *fakeGenreUseCase) ListGenres(ctx context.Context, in *input.ListGenresInput) (*input.ListGenresResult, error) {
+	result := &input.ListGenresResult{}
+	for _, genre := range u.genres {
+		genre := genre
+		result.Genres = append(result.Genres, &genre)
+	}
+	sort.Slice(result.Genres, func(i, j int) bool { return result.Genres[i].Code < result.Genres[j].Code })
+	result.TotalCount = len(result.Genres)
+	return result, nil
+}
+
+func (u *fakeGenreUseCase) GetGenreByCode(ctx context.Context, code string) (*domain.Genre, error) {
+	genre, ok := u.genres[code]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return &genre, nil
+}
+
+func (u *fakeGenreUseCase) CreateGenre(ctx context.Context, in *input.CreateGenreInput) (*domain.Genre, error) {
+	genre, err := domain.NewGenre(valueobject.UUID(uuid.New().String()), in.Code, in.Name, in.Language, in.RegionCode, categoryIDs(in.CategoryIDs))
+	if err != nil {
+		return nil, err
+	}
+	if genre.CheckpointProfileID, err = u.profiles.idOf(in.CheckpointProfile); err != nil {
+		return nil, err
+	}
+	if !domain.IsDryRun(ctx) {
+		u.genres[genre.Code] = *genre
+	}
+	return genre, nil
+}
+
+func (u *fakeGenreUseCase) UpdateGenre(ctx context.Context, in *input.UpdateGenreInput) (*domain.Genre, error) {
+	genre, err := u.byID(in.GenreID)
+	if err != nil {
+		return nil, err
+	}
+	genre.Name = in.Name
+	genre.CategoryIDs = categoryIDs(in.CategoryIDs)
+	if in.CheckpointProfile != nil {
+		if genre.CheckpointProfileID, err = u.profiles.idOf(*in.CheckpointProfile); err != nil {
+			return nil, err
+		}
+	}
+	return u.save(ctx, genre), nil
+}
+
+func (u *fakeGenreUseCase) EnableGenre(ctx context.Context, genreID uuid.UUID) (*domain.Genre, error) {
+	genre, err := u.byID(genreID)
+	if err != nil {
+		return nil, err
+	}
+	genre.Enabled = true
+	return u.save(ctx, genre), nil
+}
+
+func (u *fakeGenreUseCase) DisableGenre(ctx context.Context, genreID uuid.UUID) (*domain.Genre, error) {
+	genre, err := u.byID(genreID)
+	if err != nil {
+		return nil, err
+	}
+	genre.Enabled = false
+	return u.save(ctx, genre), nil
+}
+
+func (u *fakeGenreUseCase) byID(id uuid.UUID) (*domain.Genre, error) {
+	for _, genre := range u.genres {
+		if genre.ID == valueobject.UUID(id.String()) {
+			return &genre, nil
+		}
+	}
+	return nil, domain.ErrNotFound
+}
+
+func (u *fakeGenreUseCase) save(ctx context.Context, genre *domain.Genre) *domain.Genre {
+	if !domain.IsDryRun(ctx) {
+		u.genres[genre.Code] = *genre
+	}
+	return genre
+}
+
+// fakeCheckpointProfileRepo keeps checkpoint profiles in memory
+type fakeCheckpointProfileRepo struct {
+	gateway.CheckpointProfileRepository
+	profiles []domain.CheckpointProfile
+}
+
+func (r *fakeCheckpointProfileRepo) FindByID(ctx context.Context, id valueobject.UUID) (*domain.CheckpointProfile, error) {
+	for _, profile := range r.profiles {
+		if profile.ID == id {
+			return &profile, nil
+		}
+	}
+	return nil, domain.ErrNotFound
+}
+
+func (r *fakeCheckpointProfileRepo) FindByCode(ctx context.Context, code string) (*domain.CheckpointProfile, error) {
+	for _, profile := range r.profiles {
+		if profile.Code == code {
+			return &profile, nil
+		}
+	}
+	return nil, domain.ErrNotFound
+}
+
+// idOf returns the ID of a profile code, empty for the default profile
+func (r *fakeCheckpointProfileRepo) idOf(code string) (valueobject.UUID, error) {
+	if code == "" {
+		return "", nil
+	}
+	profile, err := r.FindByCode(context.Background(), code)
+	if err != nil {
+		return "", err
+	}
+	return profile.ID, nil
+}
+
+func (u *fakeKeywordGroupUseCase) UpdateKeywords(ctx context.Context, groupID uuid.UUID, keywords []string) (*domain.KeywordGroup, error) {
+	group, err := u.GetKeywordGroup(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	replaced, err := domain.NewKeywordGroup(group.ID, group.GenreID, group.Name, group.FilterType, group.TargetField, group.Description, keywords)
+	if err != nil {
+		return nil, err
+	}
+	group.Items = replaced.Items
+	return u.save(ctx, groupID, group), nil
+}
+
+func (u *fakeKeywordGroupUseCase) EnableKeywordGroup(ctx context.Context, groupID uuid.UUID) (*domain.KeywordGroup, error) {
+	group, err := u.GetKeywordGroup(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	group.Enabled = true
+	return u.save(ctx, groupID, group), nil
+}
+
+func (u *fakeKeywordGroupUseCase) DisableKeywordGroup(ctx context.Context, groupID uuid.UUID) (*domain.KeywordGroup, error) {
+	group, err := u.GetKeywordGroup(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	group.Enabled = false
+	return u.save(ctx, groupID, group), nil
+}
+
+func (u *fakeKeywordGroupUseCase) ListKeywordGroupsByGenre(ctx context.Context, genreID uuid.UUID) ([]*domain.KeywordGroup, error) {
+	var groups []*domain.KeywordGroup
+	for _, group := range u.groups {
+		if group.GenreID == valueobject.UUID(genreID.String()) {
+			group := group
+			groups = append(groups, &group)
+		}
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].Name < groups[j].Name })
+	return groups, nil
+}
+
+func (u *fakeKeywordGroupUseCase) save(ctx context.Context, groupID uuid.UUID, group *domain.KeywordGroup) *domain.KeywordGroup {
+	if !domain.IsDryRun(ctx) {
+		u.store.write(ctx, func() { u.groups[groupID] = *group })
+	}
+	return group
+}
+
+func categoryIDs(ids []int) []valueobject.CategoryID {
+	categories := make([]valueobject.CategoryID, len(ids))
+	for i, id := range ids {
+		categories[i] = valueobject.CategoryID(id)
+	}
+	return categories
+}
+
+func TestGenreManifestUseCase_PlanApplyPlan(t *testing.T) {
+	disabled := false
+	dense := domain.CheckpointProfile{ID: valueobject.UUID(uuid.New().String()), Code: "dense", Name: "Dense"}
+
+	tests := []struct {
+		name        string
+		manifest    *input.GenreManifest
+		wantChanges int // Changes planned, then applied
+	}{
+		{
+			name: "existing genre is updated and its groups reconciled",
+			manifest: &input.GenreManifest{Genres: []input.GenreSpec{{
+				Code: "engineering_jp", Name: "Engineering (JP)", Language: "ja", RegionCode: "JP",
+				CategoryIDs: []int{27, 28}, CheckpointProfile: "dense",
+				KeywordGroups: []input.KeywordGroupSpec{
+					{Name: "Go", FilterType: "include", Romaji: true, Keywords: []string{"go言語", "golang"}},
+					{Name: "Rust", FilterType: "include", Enabled: &disabled, Keywords: []string{"rust"}},
+				},
+			}}},
+			// Genre update, Go romaji and keywords, Rust insert and disable, Spam delete
+			wantChanges: 6,
+		},
+		{
+			name: "new disabled genre is created with its groups",
+			manifest: &input.GenreManifest{Genres: []input.GenreSpec{{
+				Code: "gaming_jp", Name: "Gaming (JP)", Language: "ja", RegionCode: "JP",
+				CategoryIDs: []int{20}, Enabled: &disabled,
+				KeywordGroups: []input.KeywordGroupSpec{
+					{Name: "RPG", FilterType: "include", Keywords: []string{"rpg"}},
+				},
+			}}},
+			wantChanges: 3,
+		},
+		{
+			name: "manifest matching the database plans nothing",
+			manifest: &input.GenreManifest{Genres: []input.GenreSpec{{
+				Code: "engineering_jp", Name: "Engineering", Language: "ja", RegionCode: "JP",
+				CategoryIDs: []int{28}, CheckpointProfile: domain.DefaultCheckpointProfileCode,
+				KeywordGroups: []input.KeywordGroupSpec{
+					{Name: "Go", FilterType: "include", Keywords: []string{"golang"}},
+					{Name: "Spam", FilterType: "exclude", Keywords: []string{"ad"}},
+				},
+			}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profiles := &fakeCheckpointProfileRepo{profiles: []domain.CheckpointProfile{dense}}
+			genre, err := domain.NewGenre(valueobject.UUID(uuid.New().String()), "engineering_jp", "Engineering", "ja", "JP", []valueobject.CategoryID{28})
+			if err != nil {
+				t.Fatal(err)
+			}
+			genres := &fakeGenreUseCase{profiles: profiles, genres: map[string]domain.Genre{genre.Code: *genre}}
+			groups := &fakeKeywordGroupUseCase{store: &fakeStore{}, groups: map[uuid.UUID]domain.KeywordGroup{}}
+			for _, seed := range []struct {
+				name, filterType, keyword string
+			}{{"Go", "include", "golang"}, {"Spam", "exclude", "ad"}} {
+				id := uuid.New()
+				group, err := domain.NewKeywordGroup(valueobject.UUID(id.String()), genre.ID, seed.name, valueobject.FilterType(seed.filterType), "", nil, []string{seed.keyword})
+				if err != nil {
+					t.Fatal(err)
+				}
+				groups.groups[id] = *group
+			}
+			uc := NewGenreManifestUseCase(genres, groups, profiles)
+
+			before, err := uc.ExportManifest(context.Background())
+			if err != nil {
+				t.Fatalf("ExportManifest() error = %v", err)
+			}
+			plan, err := uc.ApplyManifest(domain.WithDryRunReport(context.Background(), &domain.DryRunReport{}), tt.manifest)
+			if err != nil {
+				t.Fatalf("plan error = %v", err)
+			}
+			if len(plan.Changes) != tt.wantChanges {
+				t.Errorf("plan has %d changes, want %d: %+v", len(plan.Changes), tt.wantChanges, plan.Changes)
+			}
+			if after, _ := uc.ExportManifest(context.Background()); !reflect.DeepEqual(after, before) {
+				t.Errorf("plan changed the database")
+			}
+
+			applied, err := uc.ApplyManifest(context.Background(), tt.manifest)
+			if err != nil {
+				t.Fatalf("apply error = %v", err)
+			}
+			if !reflect.DeepEqual(applied.Changes, plan.Changes) {
+				t.Errorf("applied changes = %+v, want the planned %+v", applied.Changes, plan.Changes)
+			}
+
+			replan, err := uc.ApplyManifest(domain.WithDryRunReport(context.Background(), &domain.DryRunReport{}), tt.manifest)
+			if err != nil {
+				t.Fatalf("replan error = %v", err)
+			}
+			if len(replan.Changes) != 0 {
+				t.Errorf("plan after apply has changes: %+v", replan.Changes)
+			}
+
+			// The exported manifest declares the state it was exported from
+			exported, err := uc.ExportManifest(context.Background())
+			if err != nil {
+				t.Fatalf("ExportManifest() error = %v", err)
+			}
+			if replan, err := uc.ApplyManifest(domain.WithDryRunReport(context.Background(), &domain.DryRunReport{}), exported); err != nil || len(replan.Changes) != 0 {
+				t.Errorf("plan of the exported manifest = %+v, %v, want no changes", replan, err)
+			}
+		})
+	}
+}
@@ -10,6 +10,12 @@ import (
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
 )
 
+// Page sizes for ListKeywords
+const (
+	defaultKeywordPageSize = 100
+	maxKeywordPageSize     = 500
+)
+
 type keywordUseCase struct {
 	keywordRepo gateway.KeywordRepository
 }
@@ -22,9 +28,30 @@ func NewKeywordUseCase(
 	}
 }
 
-func (u *keywordUseCase) ListKeywords(ctx context.Context) ([]*domain.Keyword, error) {
-	// Get all keywords (not just enabled ones)
-	return u.keywordRepo.FindAll(ctx, false)
+// ListKeywords returns one page of the keywords matching the filter, by name
+func (u *keywordUseCase) ListKeywords(ctx context.Context, in *input.ListKeywordsInput) (*input.ListKeywordsResult, error) {
+	size := pageSize(in.PageSize, defaultKeywordPageSize, maxKeywordPageSize)
+	filter := valueobject.FilterDigest(in.Filter)
+	after, err := valueobject.ParsePageToken(in.PageToken, domain.KeywordOrder, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch one extra keyword to learn whether another page follows
+	keywords, err := u.keywordRepo.Search(ctx, in.Filter, after, size+1)
+	if err != nil {
+		return nil, err
+	}
+	total, err := u.keywordRepo.CountSearch(ctx, in.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &input.ListKeywordsResult{TotalCount: total}
+	result.Keywords, result.NextPageToken = nextPage(keywords, size, filter, func(k *domain.Keyword) valueobject.PageCursor {
+		return valueobject.PageCursor{Order: domain.KeywordOrder, Text: k.Name, ID: string(k.ID)}
+	})
+	return result, nil
 }
 
 func (u *keywordUseCase) CreateKeyword(ctx context.Context, input *input.CreateKeywordInput) (*domain.Keyword, error) {
@@ -49,6 +76,10 @@ func (u *keywordUseCase) CreateKeyword(ctx context.Context, input *input.CreateK
 	}
 
 	// Save keyword
+	if domain.IsDryRun(ctx) {
+		domain.PlanChange(ctx, domain.PlannedChange{Action: domain.PlannedInsert, Resource: "keyword", ID: string(keyword.ID)})
+		return keyword, nil
+	}
 	if err := u.keywordRepo.Save(ctx, keyword); err != nil {
 		return nil, err
 	}
@@ -90,6 +121,10 @@ func (u *keywordUseCase) UpdateKeyword(ctx context.Context, input *input.UpdateK
 	}
 
 	// Save updated keyword
+	if domain.IsDryRun(ctx) {
+		domain.PlanChange(ctx, domain.PlannedChange{Action: domain.PlannedUpdate, Resource: "keyword", ID: string(keyword.ID)})
+		return keyword, nil
+	}
 	if err := u.keywordRepo.Save(ctx, keyword); err != nil {
 		return nil, err
 	}
@@ -108,6 +143,10 @@ func (u *keywordUseCase) EnableKeyword(ctx context.Context, keywordID uuid.UUID)
 	keyword.Enable()
 
 	// Save
+	if domain.IsDryRun(ctx) {
+		domain.PlanChange(ctx, domain.PlannedChange{Action: domain.PlannedUpdate, Resource: "keyword", ID: string(keyword.ID), Detail: "enable"})
+		return keyword, nil
+	}
 	if err := u.keywordRepo.Save(ctx, keyword); err != nil {
 		return nil, err
 	}
@@ -126,6 +165,10 @@ func (u *keywordUseCase) DisableKeyword(ctx context.Context, keywordID uuid.UUID
 	keyword.Disable()
 
 	// Save
+	if domain.IsDryRun(ctx) {
+		domain.PlanChange(ctx, domain.PlannedChange{Action: domain.PlannedUpdate, Resource: "keyword", ID: string(keyword.ID), Detail: "disable"})
+		return keyword, nil
+	}
 	if err := u.keywordRepo.Save(ctx, keyword); err != nil {
 		return nil, err
 	}
@@ -133,11 +176,14 @@ func (u *keywordUseCase) DisableKeyword(ctx context.Context, keywordID uuid.UUID
 	return keyword, nil
 }
 
-func (u *keywordUseCase) ListKeywordsByGenre(ctx context.Context, genreID uuid.UUID) ([]*domain.Keyword, error) {
-	// Get all keywords for the genre (not just enabled ones)
-	return u.keywordRepo.FindByGenre(ctx, valueobject.UUID(genreID.String()), false)
-}
-
 func (u *keywordUseCase) DeleteKeyword(ctx context.Context, keywordID uuid.UUID) error {
+	if domain.IsDryRun(ctx) {
+		// Only check that the keyword exists
+		if _, err := u.keywordRepo.FindByID(ctx, valueobject.UUID(keywordID.String())); err != nil {
+			return err
+		}
+		domain.PlanChange(ctx, domain.PlannedChange{Action: domain.PlannedDelete, Resource: "keyword", ID: keywordID.String()})
+		return nil
+	}
 	return u.keywordRepo.SoftDelete(ctx, valueobject.UUID(keywordID.String()))
 }
\ No newline at end of file
@@ -0,0 +1,253 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/service"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/input"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/repository"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultKeywordGroupTestDays = 30
+	maxKeywordGroupTestDays     = 90
+	// maxKeywordGroupTestVideos bounds the videos a test evaluates, newest first
+	maxKeywordGroupTestVideos = 5000
+	keywordGroupTestBatchSize = 500
+)
+
+// keywordGroupTesterUseCase implements the KeywordGroupTesterInputPort interface
+type keywordGroupTesterUseCase struct {
+	groupRepo         repository.KeywordGroupRepository
+	genreRepo         gateway.GenreRepository
+	videoRepo         gateway.VideoRepository
+	patternGenerators *patternGeneratorSource
+	// filters holds the compiled saved filters of each genre tested
+	filters *genreFilterCache
+}
+
+// NewKeywordGroupTesterUseCase creates a new keyword group tester use case
+func NewKeywordGroupTesterUseCase(
+	groupRepo repository.KeywordGroupRepository,
+	genreRepo gateway.GenreRepository,
+	videoRepo gateway.VideoRepository,
+	synonymRepo repository.KeywordSynonymRepository,
+) input.KeywordGroupTesterInputPort {
+	return &keywordGroupTesterUseCase{
+		groupRepo:         groupRepo,
+		genreRepo:         genreRepo,
+		videoRepo:         videoRepo,
+		patternGenerators: newPatternGeneratorSource(synonymRepo),
+		filters:           newGenreFilterCache(),
+	}
+}
+
+// TestKeywordGroup filters the recent videos in the scope of the genre with
+// its enabled keyword groups as saved and as proposed, and returns the videos
+// whose result changes. Nothing is saved.
+func (u *keywordGroupTesterUseCase) TestKeywordGroup(
+	ctx context.Context,
+	in input.TestKeywordGroupInput,
+) (*input.TestKeywordGroupResult, error) {
+	days := in.Days
+	if days == 0 {
+		days = defaultKeywordGroupTestDays
+	}
+	if days < 0 || days > maxKeywordGroupTestDays {
+		return nil, fmt.Errorf("%w: days must be between 1 and %d", domain.ErrInvalidInput, maxKeywordGroupTestDays)
+	}
+
+	proposed, err := u.proposedGroup(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	genre, err := u.genreRepo.FindByID(ctx, proposed.GenreID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find genre: %w", err)
+	}
+
+	groups, err := u.groupRepo.FindByGenreID(ctx, proposed.GenreID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find keyword groups: %w", err)
+	}
+
+	generator, err := u.patternGenerators.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The saved filters of the genre, and the same filters with the proposed
+	// group in place of the saved one. A proposed group that is disabled only
+	// takes the saved one out.
+	current, err := u.filters.saved(proposed.GenreID, groups, generator)
+	if err != nil {
+		return nil, err
+	}
+
+	var keywords []*domain.Keyword
+	replaced := false
+	for _, g := range groups {
+		if g.ID == proposed.ID {
+			replaced = true
+			if proposed.Enabled {
+				keywords = append(keywords, groupFilter(generator, proposed))
+			}
+		} else if g.Enabled {
+			keywords = append(keywords, groupFilter(generator, g))
+		}
+	}
+	if !replaced && proposed.Enabled {
+		keywords = append(keywords, groupFilter(generator, proposed))
+	}
+	candidate, err := service.CompileFilter(keywords)
+	if err != nil {
+		return nil, fmt.Errorf("invalid keyword group: %w", err)
+	}
+
+	result := &input.TestKeywordGroupResult{
+		Pattern: groupPattern(generator, proposed),
+		Enabled: proposed.Enabled,
+	}
+
+	since := time.Now().AddDate(0, 0, -days)
+	scope := domain.NewGenreVideoScope(genre, since)
+	var after *valueobject.PageCursor
+	for {
+		videos, err := u.videoRepo.SearchGenreScope(ctx, scope, after, keywordGroupTestBatchSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list videos: %w", err)
+		}
+		for _, v := range videos {
+			if result.VideosEvaluated == maxKeywordGroupTestVideos {
+				result.Truncated = true
+				return result, nil
+			}
+			result.VideosEvaluated++
+			u.compare(result, v, current, candidate)
+		}
+		if len(videos) < keywordGroupTestBatchSize {
+			return result, nil
+		}
+
+		last := videos[len(videos)-1]
+		after = &valueobject.PageCursor{Order: domain.DefaultVideoOrder.String(), Key: last.PublishedAt, ID: string(last.ID)}
+	}
+}
+
+// proposedGroup returns the group as it would be saved, validated the same way
+func (u *keywordGroupTesterUseCase) proposedGroup(ctx context.Context, in input.TestKeywordGroupInput) (*domain.KeywordGroup, error) {
+	if in.GroupID == nil {
+		filterType := valueobject.FilterTypeInclude
+		if in.FilterType != nil {
+			filterType = *in.FilterType
+		}
+		group, err := domain.NewKeywordGroup(
+			valueobject.UUID(uuid.New().String()),
+			valueobject.UUID(in.GenreID.String()),
+			"(proposed)",
+			filterType,
+			defaultTargetField,
+			nil,
+			in.Keywords,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid keyword group: %w", err)
+		}
+		if in.CrossLanguage != nil {
+			group.CrossLanguage = *in.CrossLanguage
+		}
+		if in.Romaji != nil {
+			group.Romaji = *in.Romaji
+		}
+		if in.Enabled != nil {
+			group.Enabled = *in.Enabled
+		}
+		return group, nil
+	}
+
+	group, err := u.groupRepo.FindByID(ctx, valueobject.UUID(in.GroupID.String()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find keyword group: %w", err)
+	}
+	if len(in.Keywords) > 0 {
+		if err := group.UpdateKeywords(in.Keywords); err != nil {
+			return nil, fmt.Errorf("invalid keywords: %w", err)
+		}
+	}
+	if in.FilterType != nil {
+		if err := group.Update(nil, in.FilterType, nil, nil); err != nil {
+			return nil, fmt.Errorf("invalid keyword group: %w", err)
+		}
+	}
+	if in.CrossLanguage != nil {
+		group.SetCrossLanguage(*in.CrossLanguage)
+	}
+	if in.Romaji != nil {
+		group.SetRomaji(*in.Romaji)
+	}
+	if in.Enabled != nil {
+		if *in.Enabled {
+			group.Enable()
+		} else {
+			group.Disable()
+		}
+	}
+	return group, nil
+}
+
+// groupFilter returns the filter of a keyword group with the pattern generated
+// from its keywords
+func groupFilter(generator *service.KeywordPatternGenerator, g *domain.KeywordGroup) *domain.Keyword {
+	return &domain.Keyword{
+		ID:          g.ID,
+		GenreID:     g.GenreID,
+		Name:        g.Name,
+		FilterType:  g.FilterType,
+		Pattern:     groupPattern(generator, g),
+		TargetField: g.TargetField,
+		Enabled:     true,
+	}
+}
+
+// groupPattern generates the pattern of a keyword group with its options
+func groupPattern(generator *service.KeywordPatternGenerator, g *domain.KeywordGroup) string {
+	return generator.GeneratePatternWithOptions(g.GetKeywords(), groupPatternOptions(g))
+}
+
+// compare records the video in the result when the candidate filters include
+// or stop including it
+func (u *keywordGroupTesterUseCase) compare(result *input.TestKeywordGroupResult, v *domain.Video, current, candidate *service.CompiledFilter) {
+	before := current.Match(v.Title)
+	after := candidate.Match(v.Title)
+	included := before.Result != service.FilterResultInclude && after.Result == service.FilterResultInclude
+	excluded := before.Result == service.FilterResultInclude && after.Result != service.FilterResultInclude
+	if !included && !excluded {
+		return
+	}
+
+	// Videos that become neutral are explained by the match they lose
+	decisive := after
+	if after.Result == service.FilterResultNeutral {
+		decisive = before
+	}
+	m := &input.KeywordGroupTestMatch{
+		Video:        v,
+		Before:       before.Result,
+		After:        after.Result,
+		KeywordGroup: decisive.Keyword.Name,
+		MatchStart:   decisive.Start,
+		MatchEnd:     decisive.End,
+	}
+	if included {
+		result.NewlyIncluded = append(result.NewlyIncluded, m)
+	} else {
+		result.NewlyExcluded = append(result.NewlyExcluded, m)
+	}
+}
@@ -5,57 +5,41 @@ import (
 	"fmt"
 
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
-	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/service"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/input"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/repository"
 	"github.com/google/uuid"
 )
 
-// KeywordGroupManagementUseCase handles keyword group operations
-type KeywordGroupManagementUseCase interface {
-	CreateKeywordGroup(ctx context.Context, input CreateKeywordGroupInput) (*domain.KeywordGroup, error)
-	UpdateKeywordGroup(ctx context.Context, groupID uuid.UUID, input UpdateKeywordGroupInput) (*domain.KeywordGroup, error)
-	UpdateKeywords(ctx context.Context, groupID uuid.UUID, keywords []string) (*domain.KeywordGroup, error)
-	DeleteKeywordGroup(ctx context.Context, groupID uuid.UUID) error
-	GetKeywordGroup(ctx context.Context, groupID uuid.UUID) (*domain.KeywordGroup, error)
-	ListKeywordGroupsByGenre(ctx context.Context, genreID uuid.UUID) ([]*domain.KeywordGroup, error)
-	GeneratePatternForGroup(ctx context.Context, groupID uuid.UUID) (string, error)
-}
-
-type CreateKeywordGroupInput struct {
-	GenreID     uuid.UUID
-	Name        string
-	Keywords    []string
-	FilterType  valueobject.FilterType
-	TargetField string
-	Description *string
-}
-
-type UpdateKeywordGroupInput struct {
-	Name        *string
-	FilterType  *valueobject.FilterType
-	Description *string
-}
+// Page sizes for ListKeywordGroups
+const (
+	defaultKeywordGroupPageSize = 50
+	maxKeywordGroupPageSize     = 200
+)
 
+// keywordGroupManagementUseCase implements the KeywordGroupInputPort interface
 type keywordGroupManagementUseCase struct {
-	groupRepo        repository.KeywordGroupRepository
-	patternGenerator *service.KeywordPatternGenerator
+	groupRepo         repository.KeywordGroupRepository
+	patternGenerators *patternGeneratorSource
 }
 
-// NewKeywordGroupManagementUseCase creates a new keyword group management use case
+// NewKeywordGroupManagementUseCase creates a new keyword group management use
+// case. Patterns are generated with the synonym dictionary of synonymRepo, or
+// with the built-in synonyms when it is nil.
 func NewKeywordGroupManagementUseCase(
 	groupRepo repository.KeywordGroupRepository,
-) KeywordGroupManagementUseCase {
+	synonymRepo repository.KeywordSynonymRepository,
+) input.KeywordGroupInputPort {
 	return &keywordGroupManagementUseCase{
-		groupRepo:        groupRepo,
-		patternGenerator: service.NewKeywordPatternGenerator(),
+		groupRepo:         groupRepo,
+		patternGenerators: newPatternGeneratorSource(synonymRepo),
 	}
 }
 
 // CreateKeywordGroup creates a new keyword group with keywords
 func (u *keywordGroupManagementUseCase) CreateKeywordGroup(
 	ctx context.Context,
-	input CreateKeywordGroupInput,
+	in input.CreateKeywordGroupInput,
 ) (*domain.KeywordGroup, error) {
 	// Generate group ID
 	groupID := valueobject.UUID(uuid.New().String())
@@ -63,18 +47,27 @@ func (u *keywordGroupManagementUseCase) CreateKeywordGroup(
 	// Create keyword group domain object
 	group, err := domain.NewKeywordGroup(
 		groupID,
-		valueobject.UUID(input.GenreID.String()),
-		input.Name,
-		input.FilterType,
-		input.TargetField,
-		input.Description,
-		input.Keywords,
+		valueobject.UUID(in.GenreID.String()),
+		in.Name,
+		in.FilterType,
+		in.TargetField,
+		in.Description,
+		in.Keywords,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create keyword group: %w", err)
 	}
+	group.CrossLanguage = in.CrossLanguage
+	group.Romaji = in.Romaji
+	if err := u.validatePattern(ctx, group); err != nil {
+		return nil, err
+	}
 
 	// Save to repository
+	if domain.IsDryRun(ctx) {
+		domain.PlanChange(ctx, domain.PlannedChange{Action: domain.PlannedInsert, Resource: "keyword_group", ID: string(group.ID), Detail: group.Name})
+		return group, nil
+	}
 	if err := u.groupRepo.Create(ctx, group); err != nil {
 		return nil, fmt.Errorf("failed to save keyword group: %w", err)
 	}
@@ -86,7 +79,7 @@ func (u *keywordGroupManagementUseCase) CreateKeywordGroup(
 func (u *keywordGroupManagementUseCase) UpdateKeywordGroup(
 	ctx context.Context,
 	groupID uuid.UUID,
-	input UpdateKeywordGroupInput,
+	in input.UpdateKeywordGroupInput,
 ) (*domain.KeywordGroup, error) {
 	// Find existing group
 	group, err := u.groupRepo.FindByID(ctx, valueobject.UUID(groupID.String()))
@@ -95,11 +88,24 @@ func (u *keywordGroupManagementUseCase) UpdateKeywordGroup(
 	}
 
 	// Update group
-	if err := group.Update(input.Name, input.FilterType, input.Description); err != nil {
+	if err := group.Update(in.Name, in.FilterType, in.TargetField, in.Description); err != nil {
 		return nil, fmt.Errorf("failed to update keyword group: %w", err)
 	}
+	if in.CrossLanguage != nil {
+		group.SetCrossLanguage(*in.CrossLanguage)
+	}
+	if in.Romaji != nil {
+		group.SetRomaji(*in.Romaji)
+	}
+	if err := u.validatePattern(ctx, group); err != nil {
+		return nil, err
+	}
 
 	// Save to repository
+	if domain.IsDryRun(ctx) {
+		domain.PlanChange(ctx, domain.PlannedChange{Action: domain.PlannedUpdate, Resource: "keyword_group", ID: string(group.ID), Detail: group.Name})
+		return group, nil
+	}
 	if err := u.groupRepo.Update(ctx, group); err != nil {
 		return nil, fmt.Errorf("failed to save updated keyword group: %w", err)
 	}
@@ -123,8 +129,15 @@ func (u *keywordGroupManagementUseCase) UpdateKeywords(
 	if err := group.UpdateKeywords(keywords); err != nil {
 		return nil, fmt.Errorf("failed to update keywords: %w", err)
 	}
+	if err := u.validatePattern(ctx, group); err != nil {
+		return nil, err
+	}
 
 	// Save to repository with items
+	if domain.IsDryRun(ctx) {
+		domain.PlanChange(ctx, domain.PlannedChange{Action: domain.PlannedUpdate, Resource: "keyword_group", ID: string(group.ID), Detail: "keywords"})
+		return group, nil
+	}
 	if err := u.groupRepo.UpdateWithItems(ctx, group); err != nil {
 		return nil, fmt.Errorf("failed to save updated keywords: %w", err)
 	}
@@ -132,11 +145,122 @@ func (u *keywordGroupManagementUseCase) UpdateKeywords(
 	return group, nil
 }
 
+// AddKeyword adds a keyword to a group
+func (u *keywordGroupManagementUseCase) AddKeyword(
+	ctx context.Context,
+	groupID uuid.UUID,
+	keyword string,
+) (*domain.KeywordGroup, error) {
+	group, err := u.groupRepo.FindByID(ctx, valueobject.UUID(groupID.String()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find keyword group: %w", err)
+	}
+
+	if err := group.AddKeyword(keyword); err != nil {
+		return nil, fmt.Errorf("failed to add keyword: %w", err)
+	}
+	if err := u.validatePattern(ctx, group); err != nil {
+		return nil, err
+	}
+
+	// Existing items keep their IDs when the items are replaced
+	if domain.IsDryRun(ctx) {
+		domain.PlanChange(ctx, domain.PlannedChange{Action: domain.PlannedInsert, Resource: "keyword_item", ID: string(group.ID), Detail: group.Items[len(group.Items)-1].Keyword})
+		return group, nil
+	}
+	if err := u.groupRepo.UpdateWithItems(ctx, group); err != nil {
+		return nil, fmt.Errorf("failed to save added keyword: %w", err)
+	}
+
+	return group, nil
+}
+
+// RemoveKeyword removes a keyword from a group. The last keyword of a group
+// cannot be removed.
+func (u *keywordGroupManagementUseCase) RemoveKeyword(
+	ctx context.Context,
+	groupID uuid.UUID,
+	keyword string,
+) (*domain.KeywordGroup, error) {
+	group, err := u.groupRepo.FindByID(ctx, valueobject.UUID(groupID.String()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find keyword group: %w", err)
+	}
+
+	if err := group.RemoveKeyword(keyword); err != nil {
+		return nil, fmt.Errorf("failed to remove keyword: %w", err)
+	}
+
+	if domain.IsDryRun(ctx) {
+		domain.PlanChange(ctx, domain.PlannedChange{Action: domain.PlannedDelete, Resource: "keyword_item", ID: string(group.ID), Detail: keyword})
+		return group, nil
+	}
+	if err := u.groupRepo.UpdateWithItems(ctx, group); err != nil {
+		return nil, fmt.Errorf("failed to save removed keyword: %w", err)
+	}
+
+	return group, nil
+}
+
+// EnableKeywordGroup enables a keyword group
+func (u *keywordGroupManagementUseCase) EnableKeywordGroup(
+	ctx context.Context,
+	groupID uuid.UUID,
+) (*domain.KeywordGroup, error) {
+	return u.setEnabled(ctx, groupID, true)
+}
+
+// DisableKeywordGroup disables a keyword group
+func (u *keywordGroupManagementUseCase) DisableKeywordGroup(
+	ctx context.Context,
+	groupID uuid.UUID,
+) (*domain.KeywordGroup, error) {
+	return u.setEnabled(ctx, groupID, false)
+}
+
+// setEnabled enables or disables a keyword group
+func (u *keywordGroupManagementUseCase) setEnabled(
+	ctx context.Context,
+	groupID uuid.UUID,
+	enabled bool,
+) (*domain.KeywordGroup, error) {
+	group, err := u.groupRepo.FindByID(ctx, valueobject.UUID(groupID.String()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find keyword group: %w", err)
+	}
+
+	detail := "disable"
+	if enabled {
+		group.Enable()
+		detail = "enable"
+	} else {
+		group.Disable()
+	}
+
+	if domain.IsDryRun(ctx) {
+		domain.PlanChange(ctx, domain.PlannedChange{Action: domain.PlannedUpdate, Resource: "keyword_group", ID: string(group.ID), Detail: detail})
+		return group, nil
+	}
+	if err := u.groupRepo.Update(ctx, group); err != nil {
+		return nil, fmt.Errorf("failed to save keyword group: %w", err)
+	}
+
+	return group, nil
+}
+
 // DeleteKeywordGroup soft deletes a keyword group
 func (u *keywordGroupManagementUseCase) DeleteKeywordGroup(
 	ctx context.Context,
 	groupID uuid.UUID,
 ) error {
+	if domain.IsDryRun(ctx) {
+		// Only check that the group exists
+		if _, err := u.groupRepo.FindByID(ctx, valueobject.UUID(groupID.String())); err != nil {
+			return err
+		}
+		domain.PlanChange(ctx, domain.PlannedChange{Action: domain.PlannedDelete, Resource: "keyword_group", ID: groupID.String()})
+		return nil
+	}
 	return u.groupRepo.Delete(ctx, valueobject.UUID(groupID.String()))
 }
 
@@ -156,6 +280,49 @@ func (u *keywordGroupManagementUseCase) ListKeywordGroupsByGenre(
 	return u.groupRepo.FindByGenreID(ctx, valueobject.UUID(genreID.String()))
 }
 
+// ListKeywordGroups returns one page of the keyword groups of a genre ordered by name
+func (u *keywordGroupManagementUseCase) ListKeywordGroups(
+	ctx context.Context,
+	in *input.ListKeywordGroupsInput,
+) (*input.ListKeywordGroupsResult, error) {
+	size := pageSize(in.PageSize, defaultKeywordGroupPageSize, maxKeywordGroupPageSize)
+	filter := valueobject.FilterDigest(in.GenreID, in.EnabledOnly)
+	after, err := valueobject.ParsePageToken(in.PageToken, domain.KeywordGroupOrder, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	genreID := valueobject.UUID(in.GenreID.String())
+	// Fetch one extra group to learn whether another page follows
+	groups, err := u.groupRepo.ListByGenre(ctx, genreID, in.EnabledOnly, after, size+1)
+	if err != nil {
+		return nil, err
+	}
+	total, err := u.groupRepo.CountByGenre(ctx, genreID, in.EnabledOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &input.ListKeywordGroupsResult{TotalCount: total}
+	result.KeywordGroups, result.NextPageToken = nextPage(groups, size, filter, func(g *domain.KeywordGroup) valueobject.PageCursor {
+		return valueobject.PageCursor{Order: domain.KeywordGroupOrder, Text: g.Name, ID: string(g.ID)}
+	})
+	return result, nil
+}
+
+// validatePattern checks that the pattern generated for the group compiles,
+// so that a group the filter cannot use is rejected when it is saved
+func (u *keywordGroupManagementUseCase) validatePattern(ctx context.Context, group *domain.KeywordGroup) error {
+	generator, err := u.patternGenerators.get(ctx)
+	if err != nil {
+		return err
+	}
+	if err := domain.ValidatePattern(generator.GeneratePatternWithOptions(group.GetKeywords(), groupPatternOptions(group))); err != nil {
+		return fmt.Errorf("keyword group %q: %w", group.Name, err)
+	}
+	return nil
+}
+
 // GeneratePatternForGroup generates a regex pattern for a keyword group
 func (u *keywordGroupManagementUseCase) GeneratePatternForGroup(
 	ctx context.Context,
@@ -167,9 +334,14 @@ func (u *keywordGroupManagementUseCase) GeneratePatternForGroup(
 		return "", fmt.Errorf("failed to find keyword group: %w", err)
 	}
 
+	generator, err := u.patternGenerators.get(ctx)
+	if err != nil {
+		return "", err
+	}
+
 	// Generate pattern from keywords
 	keywords := group.GetKeywords()
-	pattern := u.patternGenerator.GeneratePattern(keywords)
+	pattern := generator.GeneratePatternWithOptions(keywords, groupPatternOptions(group))
 
 	return pattern, nil
 }
@@ -185,6 +357,11 @@ func (u *keywordGroupManagementUseCase) GeneratePatternsForGenre(
 		return nil, fmt.Errorf("failed to find keyword groups: %w", err)
 	}
 
+	generator, err := u.patternGenerators.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Generate patterns by filter type
 	patterns := make(map[valueobject.FilterType][]string)
 	for _, group := range groups {
@@ -193,12 +370,12 @@ func (u *keywordGroupManagementUseCase) GeneratePatternsForGenre(
 		}
 
 		keywords := group.GetKeywords()
-		pattern := u.patternGenerator.GeneratePattern(keywords)
-		
+		pattern := generator.GeneratePatternWithOptions(keywords, groupPatternOptions(group))
+
 		if pattern != "" {
 			patterns[group.FilterType] = append(patterns[group.FilterType], pattern)
 		}
 	}
 
 	return patterns, nil
-}
\ No newline at end of file
+}
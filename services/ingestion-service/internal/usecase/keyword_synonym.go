@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/input"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/repository"
+	"github.com/google/uuid"
+)
+
+// Page sizes for ListKeywordSynonyms
+const (
+	defaultKeywordSynonymPageSize = 50
+	maxKeywordSynonymPageSize     = 200
+)
+
+// keywordSynonymUseCase implements the KeywordSynonymInputPort interface
+type keywordSynonymUseCase struct {
+	synonymRepo repository.KeywordSynonymRepository
+}
+
+// NewKeywordSynonymUseCase creates a new synonym dictionary use case
+func NewKeywordSynonymUseCase(synonymRepo repository.KeywordSynonymRepository) input.KeywordSynonymInputPort {
+	return &keywordSynonymUseCase{synonymRepo: synonymRepo}
+}
+
+// ListKeywordSynonyms returns one page of the entries of a language, or of all
+// languages when language is empty, ordered by language, term and alias
+func (u *keywordSynonymUseCase) ListKeywordSynonyms(ctx context.Context, in *input.ListKeywordSynonymsInput) (*input.ListKeywordSynonymsResult, error) {
+	if in.Language != "" && !domain.IsSynonymLanguage(in.Language) {
+		return nil, domain.ErrInvalidSynonymLanguage
+	}
+	size := pageSize(in.PageSize, defaultKeywordSynonymPageSize, maxKeywordSynonymPageSize)
+	filter := valueobject.FilterDigest(in.Language)
+	after, err := valueobject.ParsePageToken(in.PageToken, domain.KeywordSynonymOrder, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch one extra entry to learn whether another page follows
+	synonyms, err := u.synonymRepo.ListPage(ctx, in.Language, after, size+1)
+	if err != nil {
+		return nil, err
+	}
+	total, err := u.synonymRepo.Count(ctx, in.Language)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &input.ListKeywordSynonymsResult{TotalCount: total}
+	result.KeywordSynonyms, result.NextPageToken = nextPage(synonyms, size, filter, func(s *domain.KeywordSynonym) valueobject.PageCursor {
+		return valueobject.PageCursor{Order: domain.KeywordSynonymOrder, Text: s.PageKey(), ID: string(s.ID)}
+	})
+	return result, nil
+}
+
+// GetKeywordSynonym retrieves a synonym entry by ID
+func (u *keywordSynonymUseCase) GetKeywordSynonym(ctx context.Context, id uuid.UUID) (*domain.KeywordSynonym, error) {
+	return u.synonymRepo.FindByID(ctx, valueobject.UUID(id.String()))
+}
+
+// CreateKeywordSynonym creates a synonym entry. An entry mapping the same term
+// to the same alias cannot be created twice.
+func (u *keywordSynonymUseCase) CreateKeywordSynonym(ctx context.Context, in input.CreateKeywordSynonymInput) (*domain.KeywordSynonym, error) {
+	synonym, err := domain.NewKeywordSynonym(
+		valueobject.UUID(uuid.New().String()),
+		in.Language,
+		in.Term,
+		in.Alias,
+		in.AliasLanguage,
+		in.Bidirectional,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create keyword synonym: %w", err)
+	}
+	if err := u.checkDuplicate(ctx, synonym); err != nil {
+		return nil, err
+	}
+
+	if domain.IsDryRun(ctx) {
+		domain.PlanChange(ctx, domain.PlannedChange{Action: domain.PlannedInsert, Resource: "keyword_synonym", ID: string(synonym.ID), Detail: synonymDetail(synonym)})
+		return synonym, nil
+	}
+	if err := u.synonymRepo.Create(ctx, synonym); err != nil {
+		return nil, fmt.Errorf("failed to save keyword synonym: %w", err)
+	}
+	return synonym, nil
+}
+
+// UpdateKeywordSynonym updates a synonym entry
+func (u *keywordSynonymUseCase) UpdateKeywordSynonym(ctx context.Context, id uuid.UUID, in input.UpdateKeywordSynonymInput) (*domain.KeywordSynonym, error) {
+	synonym, err := u.synonymRepo.FindByID(ctx, valueobject.UUID(id.String()))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := synonym.Update(in.Term, in.Alias, in.AliasLanguage, in.Bidirectional); err != nil {
+		return nil, fmt.Errorf("failed to update keyword synonym: %w", err)
+	}
+	if err := u.checkDuplicate(ctx, synonym); err != nil {
+		return nil, err
+	}
+
+	if domain.IsDryRun(ctx) {
+		domain.PlanChange(ctx, domain.PlannedChange{Action: domain.PlannedUpdate, Resource: "keyword_synonym", ID: string(synonym.ID), Detail: synonymDetail(synonym)})
+		return synonym, nil
+	}
+	if err := u.synonymRepo.Update(ctx, synonym); err != nil {
+		return nil, fmt.Errorf("failed to save updated keyword synonym: %w", err)
+	}
+	return synonym, nil
+}
+
+// DeleteKeywordSynonym deletes a synonym entry
+func (u *keywordSynonymUseCase) DeleteKeywordSynonym(ctx context.Context, id uuid.UUID) error {
+	if domain.IsDryRun(ctx) {
+		// Only check that the entry exists
+		synonym, err := u.synonymRepo.FindByID(ctx, valueobject.UUID(id.String()))
+		if err != nil {
+			return err
+		}
+		domain.PlanChange(ctx, domain.PlannedChange{Action: domain.PlannedDelete, Resource: "keyword_synonym", ID: id.String(), Detail: synonymDetail(synonym)})
+		return nil
+	}
+	return u.synonymRepo.Delete(ctx, valueobject.UUID(id.String()))
+}
+
+// checkDuplicate fails when another entry maps the same term to the same alias
+func (u *keywordSynonymUseCase) checkDuplicate(ctx context.Context, synonym *domain.KeywordSynonym) error {
+	existing, err := u.synonymRepo.List(ctx, synonym.Language)
+	if err != nil {
+		return fmt.Errorf("failed to list keyword synonyms: %w", err)
+	}
+	for _, s := range existing {
+		if s.ID != synonym.ID && s.SameEntry(synonym) {
+			return domain.ErrDuplicateKeywordSynonym
+		}
+	}
+	return nil
+}
+
+// synonymDetail describes a synonym entry in a planned change, e.g. "ja:パイソン <-> en:Python"
+func synonymDetail(s *domain.KeywordSynonym) string {
+	arrow := "->"
+	if s.Bidirectional {
+		arrow = "<->"
+	}
+	return fmt.Sprintf("%s:%s %s %s:%s", s.Language, s.Term, arrow, s.AliasLanguage, s.Alias)
+}
@@ -0,0 +1,27 @@
+package usecase
+
+import "github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+
+// pageSize applies the default and the limit to a requested page size
+func pageSize(requested, def, max int) int {
+	if requested <= 0 {
+		return def
+	}
+	if requested > max {
+		return max
+	}
+	return requested
+}
+
+// nextPage trims a page fetched with one extra item down to size. When the
+// extra item was there, it also returns the token of the page that follows,
+// positioned at the last item kept and bound to the listing's filter digest.
+func nextPage[T any](items []T, size int, filter string, cursor func(T) valueobject.PageCursor) ([]T, string) {
+	if len(items) <= size {
+		return items, ""
+	}
+	items = items[:size]
+	c := cursor(items[size-1])
+	c.Filter = filter
+	return items, c.Token()
+}
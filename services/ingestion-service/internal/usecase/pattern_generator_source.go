@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/service"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/repository"
+)
+
+// patternGeneratorSource provides a pattern generator built from the synonym
+// dictionary. The generator, with the patterns it has cached, is kept until
+// the dictionary version changes.
+type patternGeneratorSource struct {
+	synonymRepo repository.KeywordSynonymRepository
+
+	mu        sync.Mutex
+	generator *service.KeywordPatternGenerator
+}
+
+// newPatternGeneratorSource creates a generator source. Without a synonym
+// repository the built-in synonyms are used.
+func newPatternGeneratorSource(synonymRepo repository.KeywordSynonymRepository) *patternGeneratorSource {
+	return &patternGeneratorSource{synonymRepo: synonymRepo}
+}
+
+// get returns the generator for the current dictionary version, loading the
+// dictionary when it has changed since the last call
+func (s *patternGeneratorSource) get(ctx context.Context) (*service.KeywordPatternGenerator, error) {
+	if s.synonymRepo == nil {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if s.generator == nil {
+			s.generator = service.NewKeywordPatternGenerator()
+		}
+		return s.generator, nil
+	}
+
+	version, err := s.synonymRepo.Version(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get synonym dictionary version: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.generator != nil && s.generator.DictionaryVersion() == version {
+		return s.generator, nil
+	}
+
+	// Entries changed after the version was read are picked up by the next
+	// call, which sees a newer version
+	synonyms, err := s.synonymRepo.List(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load synonym dictionary: %w", err)
+	}
+	s.generator = service.NewKeywordPatternGeneratorWithDictionary(service.NewSynonymDictionary(version, synonyms))
+	return s.generator, nil
+}
+
+// groupPatternOptions returns the pattern options of a keyword group
+func groupPatternOptions(g *domain.KeywordGroup) service.PatternOptions {
+	return service.PatternOptions{CrossLanguage: g.CrossLanguage, Romaji: g.Romaji}
+}
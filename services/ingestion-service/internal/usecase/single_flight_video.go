@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/input"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
+)
+
+// singleFlightVideoUseCase decorates a video use case so that trending
+// collection runs as a batch job locked per genre. Scheduled and manual runs
+// for the same genre never collect concurrently.
+type singleFlightVideoUseCase struct {
+	input.VideoInputPort
+	batchJobUseCase input.BatchJobInputPort
+	genreRepo       gateway.GenreRepository
+}
+
+// NewSingleFlightVideoUseCase wraps a video use case so that trending
+// collection is tracked and never overlaps. Overlapping runs return
+// domain.ErrBatchJobOverlap.
+func NewSingleFlightVideoUseCase(
+	videoUseCase input.VideoInputPort,
+	batchJobUseCase input.BatchJobInputPort,
+	genreRepo gateway.GenreRepository,
+) input.VideoInputPort {
+	return &singleFlightVideoUseCase{
+		VideoInputPort:  videoUseCase,
+		batchJobUseCase: batchJobUseCase,
+		genreRepo:       genreRepo,
+	}
+}
+
+// CollectTrending collects trending videos while holding the lock of the genre
+func (u *singleFlightVideoUseCase) CollectTrending(ctx context.Context, genreID *string) (*input.CollectTrendingResult, error) {
+	var result *input.CollectTrendingResult
+	in, run := collectTrendingJob(u.VideoInputPort, genreID, func(r *input.CollectTrendingResult) { result = r })
+	if _, err := u.batchJobUseCase.RunBatchJob(ctx, in, run); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CollectTrendingByGenre collects trending videos while holding the lock of the genre
+func (u *singleFlightVideoUseCase) CollectTrendingByGenre(ctx context.Context, genreID string) (*input.CollectTrendingResult, error) {
+	return u.CollectTrending(ctx, &genreID)
+}
+
+// CollectAllTrending collects trending videos while holding the locks of every
+// enabled genre. The run is skipped when any genre is being collected.
+func (u *singleFlightVideoUseCase) CollectAllTrending(ctx context.Context) (*input.CollectAllTrendingResult, error) {
+	var result *input.CollectAllTrendingResult
+	in, run, err := collectAllTrendingJob(ctx, u.VideoInputPort, u.genreRepo, func(r *input.CollectAllTrendingResult) { result = r })
+	if err != nil {
+		return nil, err
+	}
+	if _, err := u.batchJobUseCase.RunBatchJob(ctx, in, run); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
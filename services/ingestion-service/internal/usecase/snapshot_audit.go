@@ -0,0 +1,244 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/service"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/input"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
+)
+
+// checkpointOutcome is the audit state of one due checkpoint of one video
+type checkpointOutcome int
+
+const (
+	outcomeCaptured checkpointOutcome = iota
+	outcomeBackfilled
+	outcomeGap
+	outcomeMissing
+)
+
+type snapshotAuditUseCase struct {
+	videoRepo      gateway.VideoRepository
+	snapshotRepo   gateway.VideoSnapshotRepository
+	gapRepo        gateway.SnapshotGapRepository
+	videoGenreRepo gateway.VideoGenreRepository
+	genreRepo      gateway.GenreRepository
+	auditor        service.SnapshotAuditor
+	profiles       *checkpointProfileResolver
+	capturer       *snapshotCapturer
+}
+
+// NewSnapshotAuditUseCase creates a new snapshot audit use case
+func NewSnapshotAuditUseCase(
+	videoRepo gateway.VideoRepository,
+	snapshotRepo gateway.VideoSnapshotRepository,
+	gapRepo gateway.SnapshotGapRepository,
+	videoGenreRepo gateway.VideoGenreRepository,
+	genreRepo gateway.GenreRepository,
+	profileRepo gateway.CheckpointProfileRepository,
+	channelSnapshotRepo gateway.ChannelSnapshotRepository,
+	auditor service.SnapshotAuditor,
+	youtubeAPI gateway.YouTubeClient,
+	eventPublisher gateway.EventPublisher,
+) input.SnapshotAuditInputPort {
+	return &snapshotAuditUseCase{
+		videoRepo:      videoRepo,
+		snapshotRepo:   snapshotRepo,
+		gapRepo:        gapRepo,
+		videoGenreRepo: videoGenreRepo,
+		genreRepo:      genreRepo,
+		auditor:        auditor,
+		profiles:       &checkpointProfileResolver{profileRepo: profileRepo},
+		capturer: &snapshotCapturer{
+			videoRepo:           videoRepo,
+			channelSnapshotRepo: channelSnapshotRepo,
+			youtubeAPI:          youtubeAPI,
+			eventPublisher:      eventPublisher,
+		},
+	}
+}
+
+// AuditSnapshots finds due checkpoints without snapshots, takes late
+// snapshots for those still within tolerance, marks the rest as gaps and
+// reports coverage per genre and checkpoint
+func (u *snapshotAuditUseCase) AuditSnapshots(ctx context.Context, in *input.AuditSnapshotsInput) (*input.AuditSnapshotsResult, error) {
+	start := time.Now()
+	if in.DryRun && !domain.IsDryRun(ctx) {
+		ctx = domain.WithDryRun(ctx)
+	}
+
+	publishedSince := in.PublishedSince
+	if publishedSince.IsZero() {
+		profiles, err := u.profiles.active(ctx)
+		if err != nil {
+			return nil, err
+		}
+		publishedSince = start.Add(-u.auditor.AuditWindow(profiles))
+	}
+
+	videos, err := u.videoRepo.ListActive(ctx, publishedSince)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list videos: %w", err)
+	}
+
+	genres, err := u.genreRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list genres: %w", err)
+	}
+	genreCodes := make(map[valueobject.UUID]string, len(genres))
+	for _, g := range genres {
+		genreCodes[g.ID] = g.Code
+	}
+
+	result := &input.AuditSnapshotsResult{VideosAudited: len(videos), PublishedSince: publishedSince}
+	coverage := make(map[coverageKey]*input.CheckpointCoverage)
+
+	for _, video := range videos {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		outcomes, err := u.auditVideo(ctx, video, result)
+		if err != nil {
+			return nil, err
+		}
+
+		videoGenres, err := u.videoGenreRepo.FindByVideo(ctx, video.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list genres of video %s: %w", video.ID, err)
+		}
+		genreIDs := []valueobject.UUID{""}
+		if len(videoGenres) > 0 {
+			genreIDs = genreIDs[:0]
+			for _, vg := range videoGenres {
+				genreIDs = append(genreIDs, vg.GenreID)
+			}
+		}
+
+		for _, genreID := range genreIDs {
+			for cp, outcome := range outcomes {
+				key := coverageKey{genreID: genreID, cp: cp}
+				c, ok := coverage[key]
+				if !ok {
+					c = &input.CheckpointCoverage{GenreID: genreID, GenreCode: genreCodes[genreID], CheckpointHour: cp}
+					coverage[key] = c
+				}
+				c.Due++
+				switch outcome {
+				case outcomeCaptured:
+					c.Captured++
+				case outcomeBackfilled:
+					c.Captured++
+					c.Backfilled++
+				case outcomeGap:
+					c.Gaps++
+				}
+			}
+		}
+	}
+
+	for _, c := range coverage {
+		result.Coverage = append(result.Coverage, c)
+	}
+	sort.Slice(result.Coverage, func(i, j int) bool {
+		a, b := result.Coverage[i], result.Coverage[j]
+		if a.GenreCode != b.GenreCode {
+			return a.GenreCode < b.GenreCode
+		}
+		return a.CheckpointHour < b.CheckpointHour
+	})
+
+	result.Duration = time.Since(start)
+	return result, nil
+}
+
+// auditVideo resolves the outcome of every due checkpoint of the video,
+// backfilling or marking gaps as needed. A dry run still fetches the
+// statistics a backfill would capture but only plans the writes.
+func (u *snapshotAuditUseCase) auditVideo(
+	ctx context.Context,
+	video *domain.Video,
+	result *input.AuditSnapshotsResult,
+) (map[valueobject.CheckpointHour]checkpointOutcome, error) {
+	now := time.Now()
+
+	profile, err := u.profiles.resolve(ctx, video)
+	if err != nil {
+		return nil, err
+	}
+	snapshots, err := u.snapshotRepo.ListByVideo(ctx, video.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list snapshots of video %s: %w", video.ID, err)
+	}
+	gaps, err := u.gapRepo.ListByVideo(ctx, video.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list gaps of video %s: %w", video.ID, err)
+	}
+
+	outcomes := make(map[valueobject.CheckpointHour]checkpointOutcome)
+	for _, cp := range u.auditor.DueCheckpoints(video, profile, now) {
+		outcomes[cp] = outcomeMissing
+	}
+	for _, s := range snapshots {
+		if _, due := outcomes[s.CheckpointHour]; due {
+			outcomes[s.CheckpointHour] = outcomeCaptured
+		}
+	}
+	for _, g := range gaps {
+		if _, due := outcomes[g.CheckpointHour]; due {
+			outcomes[g.CheckpointHour] = outcomeGap
+		}
+	}
+
+	unavailable := false
+	for _, m := range u.auditor.FindMissing(video, profile, snapshots, gaps, now) {
+		result.Missing++
+
+		reason := domain.GapReasonExpired
+		if m.Recoverable && !unavailable {
+			_, err := u.capturer.capture(ctx, video, m.CheckpointHour, valueobject.SourceBackfill)
+			if err == nil {
+				outcomes[m.CheckpointHour] = outcomeBackfilled
+				result.Backfilled++
+				continue
+			}
+			if !errors.Is(err, domain.ErrVideoNotFound) {
+				// Leave it missing so the next run can retry while still in tolerance
+				log.Printf("failed to backfill video %s at %dh: %v", video.ID, m.CheckpointHour, err)
+				result.BackfillFailed++
+				continue
+			}
+			unavailable = true
+		}
+		if unavailable {
+			reason = domain.GapReasonVideoUnavailable
+		}
+
+		gap, err := domain.NewSnapshotGap(video.ID, m.CheckpointHour, m.DueAt, reason)
+		if err != nil {
+			return nil, err
+		}
+		if domain.IsDryRun(ctx) {
+			domain.PlanChange(ctx, domain.PlannedChange{Action: domain.PlannedInsert, Resource: "snapshot_gap", ID: string(video.YouTubeVideoID), Detail: fmt.Sprintf("checkpoint %dh, %s", m.CheckpointHour, reason)})
+		} else if err := u.gapRepo.Save(ctx, gap); err != nil {
+			return nil, fmt.Errorf("failed to mark gap for video %s: %w", video.ID, err)
+		}
+		outcomes[m.CheckpointHour] = outcomeGap
+		result.GapsMarked++
+	}
+
+	return outcomes, nil
+}
+
+type coverageKey struct {
+	genreID valueobject.UUID
+	cp      valueobject.CheckpointHour
+}
@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
+	"github.com/google/uuid"
+)
+
+// snapshotCapturer takes a video snapshot from the YouTube API, persists it
+// with the video aggregate and announces it to analytics
+type snapshotCapturer struct {
+	videoRepo           gateway.VideoRepository
+	channelSnapshotRepo gateway.ChannelSnapshotRepository
+	youtubeAPI          gateway.YouTubeClient
+	eventPublisher      gateway.EventPublisher
+}
+
+// capture records the current statistics of the video for the checkpoint.
+// MeasuredAt is always the real measurement time, even for late snapshots.
+func (c *snapshotCapturer) capture(
+	ctx context.Context,
+	video *domain.Video,
+	cp valueobject.CheckpointHour,
+	source valueobject.Source,
+) (*domain.VideoSnapshot, error) {
+	// Fetch current stats from YouTube API
+	stats, err := c.youtubeAPI.GetVideoStatistics(ctx, string(video.YouTubeVideoID))
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := make(map[string]int64)
+	if stats.FavoriteCount != nil {
+		metrics[domain.SnapshotMetricFavorites] = *stats.FavoriteCount
+	}
+
+	// Postgres keeps microseconds; truncating keeps the event ID derived from
+	// the measurement time the same when the stored snapshot is republished
+	measuredAt := time.Now().Truncate(time.Microsecond)
+	subs, err := c.subscriptionCount(ctx, video, cp, measuredAt)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create snapshot
+	snapshot, err := domain.NewVideoSnapshot(
+		valueobject.UUID(uuid.New().String()),
+		video.ID,
+		cp,
+		measuredAt,
+		domain.SnapshotCounts{
+			ViewsCount:        stats.ViewCount,
+			LikesCount:        stats.LikeCount,
+			SubscriptionCount: subs,
+			CommentsCount:     stats.CommentCount,
+			Metrics:           metrics,
+		},
+		source,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add snapshot to video aggregate
+	if err := video.AddSnapshot(snapshot); err != nil {
+		return nil, err
+	}
+
+	if domain.IsDryRun(ctx) {
+		domain.PlanChange(ctx, domain.PlannedChange{Action: domain.PlannedInsert, Resource: "video_snapshot", ID: string(video.YouTubeVideoID), Detail: fmt.Sprintf("checkpoint %dh, %d views", cp, snapshot.ViewsCount)})
+		domain.PlanChange(ctx, domain.PlannedChange{Action: domain.PlannedPublish, Resource: "snapshot_added", ID: string(video.YouTubeVideoID)})
+		return snapshot, nil
+	}
+
+	// Save video with snapshots
+	if err := c.videoRepo.SaveWithSnapshots(ctx, video); err != nil {
+		return nil, err
+	}
+
+	// Notify analytics so metrics for this checkpoint get recomputed
+	if err := c.publish(ctx, video, snapshot); err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// publish announces a saved snapshot of the video to analytics
+func (c *snapshotCapturer) publish(ctx context.Context, video *domain.Video, snapshot *domain.VideoSnapshot) error {
+	if domain.IsDryRun(ctx) {
+		domain.PlanChange(ctx, domain.PlannedChange{Action: domain.PlannedPublish, Resource: "snapshot_added", ID: string(snapshot.VideoID)})
+		return nil
+	}
+	return c.eventPublisher.PublishSnapshotAdded(ctx, gateway.NewSnapshotAddedEvent(video, snapshot))
+}
+
+// subscriptionCount returns the subscriber count of the video's channel from
+// the daily channel snapshot nearest to the time the checkpoint describes: the
+// publish time for the 0h baseline, so subs@0 is what the channel had when the
+// video went out, otherwise the measurement time. Channels that have not been
+// captured yet fall back to the live count.
+func (c *snapshotCapturer) subscriptionCount(
+	ctx context.Context,
+	video *domain.Video,
+	cp valueobject.CheckpointHour,
+	measuredAt time.Time,
+) (int64, error) {
+	at := measuredAt
+	if cp == valueobject.CheckpointHour0 {
+		at = video.PublishedAt
+	}
+
+	if video.ChannelID != "" {
+		snapshot, err := c.channelSnapshotRepo.Nearest(ctx, video.ChannelID, at)
+		if err == nil {
+			return snapshot.SubscriptionCount, nil
+		}
+		if !errors.Is(err, domain.ErrChannelSnapshotNotFound) {
+			return 0, err
+		}
+	}
+
+	stats, err := c.youtubeAPI.GetChannelStats(ctx, video.YouTubeChannelID)
+	if err != nil {
+		return 0, err
+	}
+	return stats.SubscriberCount, nil
+}
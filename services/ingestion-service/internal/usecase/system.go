@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
@@ -12,12 +13,19 @@ import (
 	"github.com/google/uuid"
 )
 
+// Page sizes for ListSnapshots and batch sizes for StreamSnapshots
+const (
+	defaultSnapshotPageSize = 100
+	maxSnapshotPageSize     = 1000
+)
+
 type systemUseCase struct {
 	videoRepo         gateway.VideoRepository
 	snapshotRepo      gateway.VideoSnapshotRepository
 	taskScheduler     gateway.TaskScheduler
 	snapshotScheduler service.SnapshotScheduler
-	youtubeAPI        gateway.YouTubeClient
+	profiles          *checkpointProfileResolver
+	capturer          *snapshotCapturer
 }
 
 func NewSystemUseCase(
@@ -25,30 +33,64 @@ func NewSystemUseCase(
 	snapshotRepo gateway.VideoSnapshotRepository,
 	taskScheduler gateway.TaskScheduler,
 	snapshotScheduler service.SnapshotScheduler,
+	profileRepo gateway.CheckpointProfileRepository,
+	channelSnapshotRepo gateway.ChannelSnapshotRepository,
 	youtubeAPI gateway.YouTubeClient,
+	eventPublisher gateway.EventPublisher,
 ) input.SystemInputPort {
 	return &systemUseCase{
 		videoRepo:         videoRepo,
 		snapshotRepo:      snapshotRepo,
 		taskScheduler:     taskScheduler,
 		snapshotScheduler: snapshotScheduler,
-		youtubeAPI:        youtubeAPI,
+		profiles:          &checkpointProfileResolver{profileRepo: profileRepo},
+		capturer: &snapshotCapturer{
+			videoRepo:           videoRepo,
+			channelSnapshotRepo: channelSnapshotRepo,
+			youtubeAPI:          youtubeAPI,
+			eventPublisher:      eventPublisher,
+		},
 	}
 }
 
-func (u *systemUseCase) ScheduleSnapshots(ctx context.Context) (*input.ScheduleSnapshotsResult, error) {
+func (u *systemUseCase) ScheduleSnapshots(ctx context.Context, in *input.ScheduleSnapshotsInput) (*input.ScheduleSnapshotsResult, error) {
 	start := time.Now()
+	if in.Window < 0 {
+		return nil, fmt.Errorf("%w: negative schedule window %s", domain.ErrInvalidInput, in.Window)
+	}
+
+	// Videos published within the last checkpoint of any active profile can
+	// still have checkpoints ahead
+	window := in.Window
+	if window == 0 {
+		profiles, err := u.profiles.active(ctx)
+		if err != nil {
+			return nil, err
+		}
+		window = u.snapshotScheduler.ScheduleWindow(profiles)
+	}
+	publishedSince := start.Add(-window)
 
 	// Get active videos (videos that need snapshots)
-	activeVideos, err := u.videoRepo.ListActive(ctx, time.Now().Add(-24*time.Hour))
+	activeVideos, err := u.videoRepo.ListActive(ctx, publishedSince)
 	if err != nil {
 		return nil, err
 	}
 
 	tasksScheduled := 0
-	for _, video := range activeVideos {
-		// Determine checkpoint hours for this video
-		checkpoints := u.snapshotScheduler.DetermineCheckpoints(video)
+	for i, video := range activeVideos {
+		// Stop between videos when the run is cancelled
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		domain.ReportProgress(ctx, domain.BatchJobProgress{Processed: i, Total: len(activeVideos), Current: string(video.YouTubeVideoID)})
+
+		// Determine checkpoint hours for this video from its genres' profiles
+		profile, err := u.profiles.resolve(ctx, video)
+		if err != nil {
+			return nil, err
+		}
+		checkpoints := u.snapshotScheduler.DetermineCheckpoints(video, profile)
 
 		for _, checkpoint := range checkpoints {
 			// Schedule snapshot task
@@ -58,6 +100,11 @@ func (u *systemUseCase) ScheduleSnapshots(ctx context.Context) (*input.ScheduleS
 				ScheduledAt:    time.Now(),
 			}
 
+			if domain.IsDryRun(ctx) {
+				domain.PlanChange(ctx, domain.PlannedChange{Action: domain.PlannedSchedule, Resource: "snapshot_task", ID: string(video.YouTubeVideoID), Detail: fmt.Sprintf("checkpoint %dh", checkpoint)})
+				tasksScheduled++
+				continue
+			}
 			if err := u.taskScheduler.ScheduleSnapshot(ctx, task); err != nil {
 				// Log error but continue with other tasks
 				continue
@@ -65,10 +112,12 @@ func (u *systemUseCase) ScheduleSnapshots(ctx context.Context) (*input.ScheduleS
 			tasksScheduled++
 		}
 	}
+	domain.ReportProgress(ctx, domain.BatchJobProgress{Processed: len(activeVideos), Total: len(activeVideos)})
 
 	return &input.ScheduleSnapshotsResult{
 		VideosProcessed: len(activeVideos),
 		TasksScheduled:  tasksScheduled,
+		PublishedSince:  publishedSince,
 		Duration:        time.Since(start),
 	}, nil
 }
@@ -86,52 +135,104 @@ func (u *systemUseCase) CreateSnapshot(ctx context.Context, input *input.CreateS
 		return nil, err
 	}
 	if exists {
+		// A retried task finds the snapshot it saved; announce it again in
+		// case the first attempt failed to publish after saving. The event ID
+		// is derived from the snapshot, so analytics drops duplicates.
+		snapshot, err := u.snapshotRepo.FindByVideoAndCP(ctx, video.ID, valueobject.CheckpointHour(input.CheckpointHour))
+		if err != nil {
+			return nil, err
+		}
+		if err := u.capturer.publish(ctx, video, snapshot); err != nil {
+			return nil, err
+		}
 		return nil, domain.ErrSnapshotAlreadyExists
 	}
 
-	// Fetch current stats from YouTube API
-	stats, err := u.youtubeAPI.GetVideoStatistics(ctx, string(video.YouTubeVideoID))
+	return u.capturer.capture(ctx, video, valueobject.CheckpointHour(input.CheckpointHour), valueobject.SourceTask)
+}
+
+func (u *systemUseCase) GetVideoSnapshots(ctx context.Context, videoID uuid.UUID) ([]*domain.VideoSnapshot, error) {
+	// Verify video exists
+	_, err := u.videoRepo.GetByID(ctx, valueobject.UUID(videoID.String()))
 	if err != nil {
 		return nil, err
 	}
 
-	// Create snapshot
-	snapshot, err := domain.NewVideoSnapshot(
-		valueobject.UUID(uuid.New().String()),
-		video.ID,
-		valueobject.CheckpointHour(input.CheckpointHour),
-		time.Now(),
-		domain.SnapshotCounts{
-			ViewsCount:        stats.ViewCount,
-			LikesCount:        stats.LikeCount,
-			SubscriptionCount: stats.CommentCount,
-		},
-		valueobject.Source("youtube_api"),
-	)
-	if err != nil {
-		return nil, err
+	// Get all snapshots for the video
+	return u.snapshotRepo.ListByVideoID(ctx, valueobject.UUID(videoID.String()))
+}
+
+// GetSnapshot returns the snapshot of a video at a checkpoint
+func (u *systemUseCase) GetSnapshot(ctx context.Context, videoID uuid.UUID, checkpointHour int) (*domain.VideoSnapshot, error) {
+	cp := valueobject.CheckpointHour(checkpointHour)
+	if !cp.IsValid() {
+		return nil, domain.ErrInvalidCheckpoint
 	}
+	return u.snapshotRepo.FindByVideoAndCP(ctx, valueobject.UUID(videoID.String()), cp)
+}
 
-	// Add snapshot to video aggregate
-	if err := video.AddSnapshot(snapshot); err != nil {
+// ListSnapshots returns one page of the snapshots matching the filter, oldest
+// measurement first
+func (u *systemUseCase) ListSnapshots(ctx context.Context, in *input.ListSnapshotsInput) (*input.ListSnapshotsResult, error) {
+	size := pageSize(in.PageSize, defaultSnapshotPageSize, maxSnapshotPageSize)
+	filter := valueobject.FilterDigest(in.Filter)
+	after, err := valueobject.ParsePageToken(in.PageToken, domain.SnapshotOrder, filter)
+	if err != nil {
 		return nil, err
 	}
 
-	// Save video with snapshots
-	if err := u.videoRepo.SaveWithSnapshots(ctx, video); err != nil {
+	// Fetch one extra snapshot to learn whether another page follows
+	snapshots, err := u.snapshotRepo.Search(ctx, in.Filter, after, size+1)
+	if err != nil {
 		return nil, err
 	}
 
-	return snapshot, nil
+	result := &input.ListSnapshotsResult{}
+	result.Snapshots, result.NextPageToken = nextPage(snapshots, size, filter, snapshotCursor)
+	return result, nil
 }
 
-func (u *systemUseCase) GetVideoSnapshots(ctx context.Context, videoID uuid.UUID) ([]*domain.VideoSnapshot, error) {
-	// Verify video exists
-	_, err := u.videoRepo.GetByID(ctx, valueobject.UUID(videoID.String()))
+// StreamSnapshots walks the snapshots matching the filter one keyset page at a
+// time, oldest measurement first. Every batch carries a resume token, including
+// the last one, so a consumer can later pick up snapshots measured since.
+func (u *systemUseCase) StreamSnapshots(ctx context.Context, in *input.ListSnapshotsInput, send func(*input.SnapshotBatch) error) error {
+	batchSize := pageSize(in.PageSize, defaultSnapshotPageSize, maxSnapshotPageSize)
+	filter := valueobject.FilterDigest(in.Filter)
+	after, err := valueobject.ParsePageToken(in.PageToken, domain.SnapshotOrder, filter)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	// Get all snapshots for the video
-	return u.snapshotRepo.ListByVideoID(ctx, valueobject.UUID(videoID.String()))
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		snapshots, err := u.snapshotRepo.Search(ctx, in.Filter, after, batchSize)
+		if err != nil {
+			return err
+		}
+		if len(snapshots) == 0 {
+			return nil
+		}
+
+		cursor := snapshotCursor(snapshots[len(snapshots)-1])
+		cursor.Filter = filter
+		if err := send(&input.SnapshotBatch{Snapshots: snapshots, ResumeToken: cursor.Token()}); err != nil {
+			return err
+		}
+		if len(snapshots) < batchSize {
+			return nil
+		}
+		after = &cursor
+	}
+}
+
+// snapshotCursor returns the keyset position of a snapshot in domain.SnapshotOrder
+func snapshotCursor(s *domain.VideoSnapshot) valueobject.PageCursor {
+	return valueobject.PageCursor{
+		Order: domain.SnapshotOrder,
+		Key:   s.MeasuredAt,
+		ID:    string(s.ID),
+	}
 }
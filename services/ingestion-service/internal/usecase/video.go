@@ -2,85 +2,190 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log"
 	"time"
 
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/input"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
+	"github.com/google/uuid"
 )
 
+// Page sizes for ListVideos
+const (
+	defaultVideoPageSize = 50
+	maxVideoPageSize     = 200
+)
+
+// defaultTrendingRegion is the region whose chart is collected when no genre is given
+const defaultTrendingRegion = "JP"
+
 type videoUseCase struct {
 	videoRepo      gateway.VideoRepository
 	channelRepo    gateway.ChannelRepository
+	snapshotRepo   gateway.VideoSnapshotRepository
+	videoGenreRepo gateway.VideoGenreRepository
+	genreRepo      gateway.GenreRepository
 	youtubeAPI     gateway.YouTubeClient
 	eventPublisher gateway.EventPublisher
+	// videoGenreUseCase filters trending videos by the keyword groups of their
+	// genre and assigns them to it; nil keeps every trending video unassigned
+	videoGenreUseCase input.VideoGenreInputPort
 }
 
+// NewVideoUseCase creates a new video use case. With a video genre use case,
+// trending videos collected for a genre are filtered by its keyword groups and
+// assigned to it.
 func NewVideoUseCase(
 	videoRepo gateway.VideoRepository,
 	channelRepo gateway.ChannelRepository,
+	snapshotRepo gateway.VideoSnapshotRepository,
+	videoGenreRepo gateway.VideoGenreRepository,
+	genreRepo gateway.GenreRepository,
 	youtubeAPI gateway.YouTubeClient,
 	eventPublisher gateway.EventPublisher,
+	videoGenreUseCase input.VideoGenreInputPort,
 ) input.VideoInputPort {
 	return &videoUseCase{
-		videoRepo:      videoRepo,
-		channelRepo:    channelRepo,
-		youtubeAPI:     youtubeAPI,
-		eventPublisher: eventPublisher,
+		videoRepo:         videoRepo,
+		channelRepo:       channelRepo,
+		snapshotRepo:      snapshotRepo,
+		videoGenreRepo:    videoGenreRepo,
+		genreRepo:         genreRepo,
+		youtubeAPI:        youtubeAPI,
+		eventPublisher:    eventPublisher,
+		videoGenreUseCase: videoGenreUseCase,
 	}
 }
 
+// CollectTrending collects the trending videos of the genre's region and
+// categories. Without a genre it collects the chart of the default region
+// across all categories.
 func (u *videoUseCase) CollectTrending(ctx context.Context, genreID *string) (*input.CollectTrendingResult, error) {
+	if genreID == nil {
+		return u.collectTrending(ctx, defaultTrendingRegion, nil, nil)
+	}
+
+	genre, err := u.genreRepo.FindByID(ctx, valueobject.UUID(*genreID))
+	if err != nil {
+		return nil, err
+	}
+	return u.collectGenreTrending(ctx, genre)
+}
+
+// collectGenreTrending collects the trending videos of the genre's region and
+// categories that its keyword groups let in
+func (u *videoUseCase) collectGenreTrending(ctx context.Context, genre *domain.Genre) (*input.CollectTrendingResult, error) {
+	result, err := u.collectTrending(ctx, genre.RegionCode, genre.CategoryIDs, genre)
+	if err != nil {
+		return nil, err
+	}
+	result.GenreCode = genre.Code
+	return result, nil
+}
+
+// collectTrending saves the trending videos of the region in the categories
+// that are not stored yet. For a genre, only the videos its keyword groups let
+// in are saved, and they are assigned to the genre whether new or not.
+func (u *videoUseCase) collectTrending(ctx context.Context, regionCode string, categoryIDs []valueobject.CategoryID, genre *domain.Genre) (*input.CollectTrendingResult, error) {
 	start := time.Now()
 	// Fetch trending videos from YouTube API
-	trendingVideos, err := u.youtubeAPI.GetTrendingVideos(ctx)
+	trendingVideos, err := u.youtubeAPI.GetTrendingVideos(ctx, regionCode, categoryIDs)
 	if err != nil {
 		return nil, err
 	}
 
+	filtered := genre != nil && u.videoGenreUseCase != nil
 	videosAdded := 0
-	for _, videoMeta := range trendingVideos {
+	videosFiltered := 0
+	for i, videoMeta := range trendingVideos {
+		// Stop between videos when the run is cancelled
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		domain.ReportProgress(ctx, domain.BatchJobProgress{Processed: i, Total: len(trendingVideos), Current: string(videoMeta.ID)})
+
 		// Check if video already exists
-		exists, err := u.videoRepo.ExistsByYouTubeVideoID(ctx, videoMeta.ID)
-		if err != nil {
+		video, err := u.videoRepo.FindByYouTubeID(ctx, videoMeta.ID)
+		isNew := errors.Is(err, domain.ErrVideoNotFound)
+		if err != nil && !isNew {
 			continue
 		}
-		if exists {
+		if !isNew && !filtered {
 			continue
 		}
 
-		// Create new video
-		video := &domain.Video{
-			ID:               valueobject.GenerateUUID(),
-			YouTubeVideoID:   valueobject.YouTubeVideoID(videoMeta.ID),
-			YouTubeChannelID: valueobject.YouTubeChannelID(videoMeta.ChannelID),
-			Title:            videoMeta.Title,
-			PublishedAt:      videoMeta.PublishedAt,
-			CreatedAt:        time.Now(),
+		if isNew {
+			// Create new video
+			video = &domain.Video{
+				ID:               valueobject.GenerateUUID(),
+				YouTubeVideoID:   valueobject.YouTubeVideoID(videoMeta.ID),
+				YouTubeChannelID: valueobject.YouTubeChannelID(videoMeta.ChannelID),
+				Title:            videoMeta.Title,
+				PublishedAt:      videoMeta.PublishedAt,
+				CategoryID:       videoMeta.CategoryID,
+				Duration:         videoMeta.Duration,
+				CreatedAt:        time.Now(),
+			}
 		}
 
-		if err := u.videoRepo.Save(ctx, video); err != nil {
-			continue
+		if filtered {
+			admitted, err := u.videoGenreUseCase.AdmitsVideo(ctx, video, uuid.MustParse(string(genre.ID)))
+			if err != nil {
+				return nil, err
+			}
+			if !admitted {
+				videosFiltered++
+				continue
+			}
 		}
 
-		// Publish event
-		if err := u.eventPublisher.PublishVideoDiscovered(ctx, video); err != nil {
-			// Log error but continue
-			continue
+		if isNew {
+			if err := u.saveDiscoveredVideo(ctx, video); err != nil {
+				continue
+			}
+			videosAdded++
 		}
 
-		videosAdded++
+		if filtered {
+			if err := u.assignGenre(ctx, video, genre); err != nil {
+				log.Printf("Failed to assign video %s to genre %s: %v", video.YouTubeVideoID, genre.Code, err)
+			}
+		}
 	}
+	domain.ReportProgress(ctx, domain.BatchJobProgress{Processed: len(trendingVideos), Total: len(trendingVideos)})
 
 	return &input.CollectTrendingResult{
 		VideosCollected: len(trendingVideos),
 		VideosCreated:   videosAdded,
 		VideosUpdated:   0, // TODO: Track updated videos
+		VideosFiltered:  videosFiltered,
 		Duration:        time.Since(start),
 	}, nil
 }
 
+// assignGenre assigns a collected video to the genre it was collected for. A
+// dry run only plans new assignments.
+func (u *videoUseCase) assignGenre(ctx context.Context, video *domain.Video, genre *domain.Genre) error {
+	if domain.IsDryRun(ctx) {
+		exists, err := u.videoGenreRepo.ExistsByVideoAndGenre(ctx, video.ID, genre.ID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			domain.PlanChange(ctx, domain.PlannedChange{
+				Action: domain.PlannedInsert, Resource: "video_genre", ID: string(video.YouTubeVideoID), Detail: genre.Code,
+			})
+		}
+		return nil
+	}
+	_, err := u.videoGenreUseCase.AssociateVideoWithGenre(ctx, uuid.MustParse(string(video.ID)), uuid.MustParse(string(genre.ID)))
+	return err
+}
+
 func (u *videoUseCase) CollectSubscriptions(ctx context.Context) (*input.CollectSubscriptionsResult, error) {
 	start := time.Now()
 	// Get all subscribed channels
@@ -118,16 +223,11 @@ func (u *videoUseCase) CollectSubscriptions(ctx context.Context) (*input.Collect
 				YouTubeChannelID: channel.YouTubeChannelID,
 				Title:            videoMeta.Title,
 				PublishedAt:      videoMeta.PublishedAt,
+				CategoryID:       videoMeta.CategoryID,
 				CreatedAt:        time.Now(),
 			}
 
-			if err := u.videoRepo.Save(ctx, video); err != nil {
-				continue
-			}
-
-			// Publish event
-			if err := u.eventPublisher.PublishVideoDiscovered(ctx, video); err != nil {
-				// Log error but continue
+			if err := u.saveDiscoveredVideo(ctx, video); err != nil {
 				continue
 			}
 
@@ -143,21 +243,139 @@ func (u *videoUseCase) CollectSubscriptions(ctx context.Context) (*input.Collect
 	}, nil
 }
 
+// saveDiscoveredVideo saves a newly found video and publishes its discovery.
+// A dry run only plans both.
+func (u *videoUseCase) saveDiscoveredVideo(ctx context.Context, video *domain.Video) error {
+	if domain.IsDryRun(ctx) {
+		domain.PlanChange(ctx, domain.PlannedChange{Action: domain.PlannedInsert, Resource: "video", ID: string(video.YouTubeVideoID), Detail: video.Title})
+		domain.PlanChange(ctx, domain.PlannedChange{Action: domain.PlannedPublish, Resource: "video_discovered", ID: string(video.YouTubeVideoID)})
+		return nil
+	}
+	if err := u.videoRepo.Save(ctx, video); err != nil {
+		return err
+	}
+	// Publish event
+	return u.eventPublisher.PublishVideoDiscovered(ctx, video)
+}
+
 func (u *videoUseCase) CollectTrendingByGenre(ctx context.Context, genreID string) (*input.CollectTrendingResult, error) {
 	// This is a wrapper around CollectTrending with a required genreID
 	return u.CollectTrending(ctx, &genreID)
 }
 
+// CollectAllTrending collects trending videos for every enabled genre. A genre
+// that fails is counted and skipped; the run fails only when every genre fails.
 func (u *videoUseCase) CollectAllTrending(ctx context.Context) (*input.CollectAllTrendingResult, error) {
 	start := time.Now()
-	// TODO: Implement collecting trending videos for all enabled genres
-	// For now, return empty result
-	return &input.CollectAllTrendingResult{
-		GenresProcessed: 0,
-		TotalCollected:  0,
-		TotalCreated:    0,
-		TotalUpdated:    0,
-		GenreResults:    []*input.CollectTrendingResult{},
-		Duration:        time.Since(start),
-	}, nil
+	genres, err := u.genreRepo.FindEnabled(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &input.CollectAllTrendingResult{
+		GenreResults: make([]*input.CollectTrendingResult, 0, len(genres)),
+	}
+	var lastErr error
+	// Progress is reported per genre, not per video of each genre
+	genreCtx := domain.WithProgressReporter(ctx, nil)
+	for i, genre := range genres {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		domain.ReportProgress(ctx, domain.BatchJobProgress{Processed: i, Total: len(genres), Current: genre.Code})
+
+		genreResult, err := u.collectGenreTrending(genreCtx, genre)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			result.GenresFailed++
+			lastErr = fmt.Errorf("genre %s: %w", genre.Code, err)
+			continue
+		}
+		result.GenresProcessed++
+		result.TotalCollected += genreResult.VideosCollected
+		result.TotalCreated += genreResult.VideosCreated
+		result.TotalUpdated += genreResult.VideosUpdated
+		result.TotalFiltered += genreResult.VideosFiltered
+		result.GenreResults = append(result.GenreResults, genreResult)
+	}
+	domain.ReportProgress(ctx, domain.BatchJobProgress{Processed: len(genres), Total: len(genres)})
+	result.Duration = time.Since(start)
+
+	if result.GenresFailed > 0 && result.GenresProcessed == 0 {
+		return nil, fmt.Errorf("failed to collect trending videos for all %d genres: %w", result.GenresFailed, lastErr)
+	}
+	return result, nil
+}
+
+// GetVideo returns the video with its channel, assigned genres and latest snapshot
+func (u *videoUseCase) GetVideo(ctx context.Context, videoID uuid.UUID) (*input.VideoDetail, error) {
+	video, err := u.videoRepo.GetByID(ctx, valueobject.UUID(videoID.String()))
+	if err != nil {
+		return nil, err
+	}
+	detail := &input.VideoDetail{Video: video}
+
+	channel, err := u.channelRepo.FindByID(ctx, video.ChannelID)
+	switch {
+	case err == nil:
+		detail.Channel = channel
+	case !errors.Is(err, domain.ErrChannelNotFound):
+		return nil, err
+	}
+
+	videoGenres, err := u.videoGenreRepo.FindByVideo(ctx, video.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, vg := range videoGenres {
+		genre, err := u.genreRepo.FindByID(ctx, vg.GenreID)
+		if err != nil {
+			return nil, err
+		}
+		detail.Genres = append(detail.Genres, genre)
+	}
+
+	snapshots, err := u.snapshotRepo.ListByVideo(ctx, video.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range snapshots {
+		if detail.LatestSnapshot == nil || s.MeasuredAt.After(detail.LatestSnapshot.MeasuredAt) {
+			detail.LatestSnapshot = s
+		}
+	}
+
+	return detail, nil
+}
+
+// ListVideos returns one page of the videos matching the filter
+func (u *videoUseCase) ListVideos(ctx context.Context, in *input.ListVideosInput) (*input.ListVideosResult, error) {
+	size := pageSize(in.PageSize, defaultVideoPageSize, maxVideoPageSize)
+	filter := valueobject.FilterDigest(in.Filter)
+	after, err := valueobject.ParsePageToken(in.PageToken, in.Order.String(), filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch one extra video to learn whether another page follows
+	videos, err := u.videoRepo.Search(ctx, in.Filter, in.Order, after, size+1)
+	if err != nil {
+		return nil, err
+	}
+	total, err := u.videoRepo.CountSearch(ctx, in.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &input.ListVideosResult{TotalCount: total}
+	result.Videos, result.NextPageToken = nextPage(videos, size, filter, func(v *domain.Video) valueobject.PageCursor {
+		return valueobject.PageCursor{
+			Order: in.Order.String(),
+			Key:   in.Order.SortKey(v),
+			ID:    string(v.ID),
+		}
+	})
+	return result, nil
 }
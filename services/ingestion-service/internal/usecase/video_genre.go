@@ -2,23 +2,49 @@ package usecase
 
 import (
 	"context"
+	"fmt"
+	"log"
+	"time"
 
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/service"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/input"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
+	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/repository"
 	"github.com/google/uuid"
 )
 
+// Page sizes for ListVideoGenres
+const (
+	defaultVideoGenrePageSize = 50
+	maxVideoGenrePageSize     = 200
+)
+
 // videoGenreUseCase implements the VideoGenreInputPort interface
 type videoGenreUseCase struct {
-	videoGenreRepo gateway.VideoGenreRepository
+	videoGenreRepo    gateway.VideoGenreRepository
+	videoRepo         gateway.VideoRepository
+	groupRepo         repository.KeywordGroupRepository
+	patternGenerators *patternGeneratorSource
+	// filters holds the compiled filters of each genre videos are assigned to
+	filters *genreFilterCache
 }
 
-// NewVideoGenreUseCase creates a new video genre use case
-func NewVideoGenreUseCase(videoGenreRepo gateway.VideoGenreRepository) input.VideoGenreInputPort {
+// NewVideoGenreUseCase creates a new video genre use case. New assignments
+// record the match of the keyword groups of the genre against the video.
+func NewVideoGenreUseCase(
+	videoGenreRepo gateway.VideoGenreRepository,
+	videoRepo gateway.VideoRepository,
+	groupRepo repository.KeywordGroupRepository,
+	synonymRepo repository.KeywordSynonymRepository,
+) input.VideoGenreInputPort {
 	return &videoGenreUseCase{
-		videoGenreRepo: videoGenreRepo,
+		videoGenreRepo:    videoGenreRepo,
+		videoRepo:         videoRepo,
+		groupRepo:         groupRepo,
+		patternGenerators: newPatternGeneratorSource(synonymRepo),
+		filters:           newGenreFilterCache(),
 	}
 }
 
@@ -43,10 +69,12 @@ func (u *videoGenreUseCase) AssociateVideoWithGenre(ctx context.Context, videoID
 	}
 
 	// Create new association
+	video := u.findVideo(ctx, videoID)
 	videoGenre := &domain.VideoGenre{
 		ID:      valueobject.UUID(uuid.New().String()),
 		VideoID: valueobject.UUID(videoID.String()),
 		GenreID: valueobject.UUID(genreID.String()),
+		Match:   u.recordedMatch(ctx, video, valueobject.UUID(genreID.String())),
 	}
 
 	// Save to repository
@@ -60,6 +88,8 @@ func (u *videoGenreUseCase) AssociateVideoWithGenre(ctx context.Context, videoID
 // AssociateVideoWithGenres associates a video with multiple genres
 func (u *videoGenreUseCase) AssociateVideoWithGenres(ctx context.Context, videoID uuid.UUID, genreIDs []uuid.UUID) ([]*domain.VideoGenre, error) {
 	// Create associations
+	var video *domain.Video
+	videoLoaded := false
 	videoGenres := make([]*domain.VideoGenre, 0, len(genreIDs))
 	for _, genreID := range genreIDs {
 		// Check if association already exists
@@ -68,10 +98,16 @@ func (u *videoGenreUseCase) AssociateVideoWithGenres(ctx context.Context, videoI
 			return nil, err
 		}
 		if !exists {
+			if !videoLoaded {
+				video = u.findVideo(ctx, videoID)
+				videoLoaded = true
+			}
+
 			videoGenre := &domain.VideoGenre{
 				ID:      valueobject.UUID(uuid.New().String()),
 				VideoID: valueobject.UUID(videoID.String()),
 				GenreID: valueobject.UUID(genreID.String()),
+				Match:   u.recordedMatch(ctx, video, valueobject.UUID(genreID.String())),
 			}
 			videoGenres = append(videoGenres, videoGenre)
 		}
@@ -88,11 +124,47 @@ func (u *videoGenreUseCase) AssociateVideoWithGenres(ctx context.Context, videoI
 	return u.videoGenreRepo.FindByVideo(ctx, valueobject.UUID(videoID.String()))
 }
 
+// AdmitsVideo filters the video title with the enabled keyword groups of the genre as saved
+func (u *videoGenreUseCase) AdmitsVideo(ctx context.Context, video *domain.Video, genreID uuid.UUID) (bool, error) {
+	filter, err := u.genreFilter(ctx, valueobject.UUID(genreID.String()))
+	if err != nil {
+		return false, err
+	}
+	return filter.Admits(video.Title), nil
+}
+
 // GetVideoGenres gets all genres associated with a video
 func (u *videoGenreUseCase) GetVideoGenres(ctx context.Context, videoID uuid.UUID) ([]*domain.VideoGenre, error) {
 	return u.videoGenreRepo.FindByVideo(ctx, valueobject.UUID(videoID.String()))
 }
 
+// ListVideoGenres returns one page of the genres associated with a video
+func (u *videoGenreUseCase) ListVideoGenres(ctx context.Context, in *input.ListVideoGenresInput) (*input.ListVideoGenresResult, error) {
+	size := pageSize(in.PageSize, defaultVideoGenrePageSize, maxVideoGenrePageSize)
+	filter := valueobject.FilterDigest(in.VideoID)
+	after, err := valueobject.ParsePageToken(in.PageToken, domain.VideoGenreOrder, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	videoID := valueobject.UUID(in.VideoID.String())
+	// Fetch one extra association to learn whether another page follows
+	videoGenres, err := u.videoGenreRepo.ListByVideo(ctx, videoID, after, size+1)
+	if err != nil {
+		return nil, err
+	}
+	total, err := u.videoGenreRepo.CountByVideo(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &input.ListVideoGenresResult{TotalCount: total}
+	result.VideoGenres, result.NextPageToken = nextPage(videoGenres, size, filter, func(vg *domain.VideoGenre) valueobject.PageCursor {
+		return valueobject.PageCursor{Order: domain.VideoGenreOrder, Key: vg.CreatedAt, ID: string(vg.ID)}
+	})
+	return result, nil
+}
+
 // GetGenreVideos gets all videos associated with a genre
 func (u *videoGenreUseCase) GetGenreVideos(ctx context.Context, genreID uuid.UUID) ([]*domain.VideoGenre, error) {
 	return u.videoGenreRepo.FindByGenre(ctx, valueobject.UUID(genreID.String()))
@@ -108,4 +180,75 @@ func (u *videoGenreUseCase) DisassociateVideoFromGenre(ctx context.Context, vide
 // DisassociateVideoFromAllGenres removes all genre associations for a video
 func (u *videoGenreUseCase) DisassociateVideoFromAllGenres(ctx context.Context, videoID uuid.UUID) error {
 	return u.videoGenreRepo.DeleteByVideo(ctx, valueobject.UUID(videoID.String()))
-}
\ No newline at end of file
+}
+
+// findVideo returns the video whose match a new assignment records, or nil
+// when it cannot be loaded
+func (u *videoGenreUseCase) findVideo(ctx context.Context, videoID uuid.UUID) *domain.Video {
+	video, err := u.videoRepo.FindByID(ctx, valueobject.UUID(videoID.String()))
+	if err != nil {
+		log.Printf("Failed to find video %s to match against its genres: %v", videoID, err)
+		return nil
+	}
+	return video
+}
+
+// recordedMatch returns the match a new assignment records. The assignment
+// does not depend on it, so when the video is missing or the filters of the
+// genre do not compile the assignment is made without a match.
+func (u *videoGenreUseCase) recordedMatch(ctx context.Context, video *domain.Video, genreID valueobject.UUID) *domain.GenreMatch {
+	if video == nil {
+		return nil
+	}
+	match, err := u.genreMatch(ctx, video, genreID)
+	if err != nil {
+		log.Printf("Failed to match video %s against genre %s: %v", video.ID, genreID, err)
+		return nil
+	}
+	return match
+}
+
+// genreMatch filters the video with the enabled keyword groups of the genre
+// as saved, and records every group that matched
+func (u *videoGenreUseCase) genreMatch(ctx context.Context, video *domain.Video, genreID valueobject.UUID) (*domain.GenreMatch, error) {
+	filter, err := u.genreFilter(ctx, genreID)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]string{service.FieldTitle: video.Title}
+	explanation := filter.Explain(fields)
+	match := &domain.GenreMatch{
+		Result:      explanation.Result.String(),
+		Matches:     make([]domain.GenreKeywordMatch, len(explanation.Matches)),
+		EvaluatedAt: time.Now(),
+	}
+	for i, m := range explanation.Matches {
+		field := []rune(fields[m.Field])
+		match.Matches[i] = domain.GenreKeywordMatch{
+			KeywordID:   m.Keyword.ID,
+			KeywordName: m.Keyword.Name,
+			FilterType:  m.Keyword.FilterType,
+			Field:       m.Field,
+			Start:       m.Start,
+			End:         m.End,
+			MatchedText: string(field[m.Start:m.End]),
+			Decisive:    m.Decisive,
+			Overridden:  m.Overridden,
+		}
+	}
+	return match, nil
+}
+
+// genreFilter returns the compiled filter of the enabled keyword groups of the genre as saved
+func (u *videoGenreUseCase) genreFilter(ctx context.Context, genreID valueobject.UUID) (*service.CompiledFilter, error) {
+	groups, err := u.groupRepo.FindByGenreID(ctx, genreID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find keyword groups: %w", err)
+	}
+	generator, err := u.patternGenerators.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return u.filters.saved(genreID, groups, generator)
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain"
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/domain/valueobject"
@@ -9,6 +10,12 @@ import (
 	"github.com/YukiOnishi1129/youtube-analytics/services/ingestion-service/internal/port/output/gateway"
 )
 
+// Page sizes for ListYouTubeCategories
+const (
+	defaultYouTubeCategoryPageSize = 100
+	maxYouTubeCategoryPageSize     = 500
+)
+
 // youtubeCategoryUseCase implements the YouTubeCategoryInputPort interface
 type youtubeCategoryUseCase struct {
 	categoryRepo gateway.YouTubeCategoryRepository
@@ -21,14 +28,30 @@ func NewYouTubeCategoryUseCase(categoryRepo gateway.YouTubeCategoryRepository) i
 	}
 }
 
-// ListYouTubeCategories lists all YouTube categories
-func (u *youtubeCategoryUseCase) ListYouTubeCategories(ctx context.Context) ([]*domain.YouTubeCategory, error) {
-	return u.categoryRepo.FindAll(ctx)
-}
+// ListYouTubeCategories returns one page of YouTube categories ordered by ID
+func (u *youtubeCategoryUseCase) ListYouTubeCategories(ctx context.Context, in *input.ListYouTubeCategoriesInput) (*input.ListYouTubeCategoriesResult, error) {
+	size := pageSize(in.PageSize, defaultYouTubeCategoryPageSize, maxYouTubeCategoryPageSize)
+	filter := valueobject.FilterDigest(in.AssignableOnly)
+	after, err := valueobject.ParseIntPageToken(in.PageToken, domain.YouTubeCategoryOrder, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch one extra category to learn whether another page follows
+	categories, err := u.categoryRepo.List(ctx, in.AssignableOnly, after, size+1)
+	if err != nil {
+		return nil, err
+	}
+	total, err := u.categoryRepo.Count(ctx, in.AssignableOnly)
+	if err != nil {
+		return nil, err
+	}
 
-// ListAssignableYouTubeCategories lists only assignable YouTube categories
-func (u *youtubeCategoryUseCase) ListAssignableYouTubeCategories(ctx context.Context) ([]*domain.YouTubeCategory, error) {
-	return u.categoryRepo.FindAssignable(ctx)
+	result := &input.ListYouTubeCategoriesResult{TotalCount: total}
+	result.Categories, result.NextPageToken = nextPage(categories, size, filter, func(c *domain.YouTubeCategory) valueobject.PageCursor {
+		return valueobject.PageCursor{Order: domain.YouTubeCategoryOrder, ID: strconv.Itoa(int(c.ID))}
+	})
+	return result, nil
 }
 
 // GetYouTubeCategory gets a YouTube category by ID
@@ -10,6 +10,17 @@ type Claims struct {
     EmailVerified bool
     DisplayName   string
     PhotoURL      string
+    Roles         []string // Roles granted through the token's custom claims
+}
+
+// HasRole reports whether the claims grant the role
+func (c Claims) HasRole(role string) bool {
+    for _, r := range c.Roles {
+        if r == role {
+            return true
+        }
+    }
+    return false
 }
 
 type ctxKey string
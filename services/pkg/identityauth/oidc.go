@@ -0,0 +1,54 @@
+package identityauth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+)
+
+// OIDCVerifier verifies ID tokens against an OIDC provider such as Identity Platform
+type OIDCVerifier struct {
+	v *oidc.IDTokenVerifier
+}
+
+// NewOIDCVerifier creates a verifier for tokens issued by issuer for audience.
+// For Identity Platform the issuer is https://securetoken.google.com/<PROJECT_ID>
+// and the audience is the project ID.
+func NewOIDCVerifier(ctx context.Context, issuer, audience string) (*OIDCVerifier, error) {
+	provider, err := oidc.NewProvider(ctx, issuer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create oidc provider: %w", err)
+	}
+	return &OIDCVerifier{v: provider.Verifier(&oidc.Config{ClientID: audience})}, nil
+}
+
+// Verify verifies the token and returns its claims. Roles are read from the
+// "roles" custom claim.
+func (o *OIDCVerifier) Verify(ctx context.Context, idToken string) (Claims, error) {
+	tok, err := o.v.Verify(ctx, idToken)
+	if err != nil {
+		return Claims{}, fmt.Errorf("failed to verify id token: %w", err)
+	}
+
+	var claims struct {
+		Sub           string   `json:"sub"`
+		Email         string   `json:"email"`
+		EmailVerified bool     `json:"email_verified"`
+		Name          string   `json:"name"`
+		Picture       string   `json:"picture"`
+		Roles         []string `json:"roles"`
+	}
+	if err := tok.Claims(&claims); err != nil {
+		return Claims{}, fmt.Errorf("failed to decode id token claims: %w", err)
+	}
+
+	return Claims{
+		Subject:       claims.Sub,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+		DisplayName:   claims.Name,
+		PhotoURL:      claims.Picture,
+		Roles:         claims.Roles,
+	}, nil
+}
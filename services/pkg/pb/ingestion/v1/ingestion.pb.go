@@ -255,7 +255,7 @@ type ListChannelsRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	SubscribedOnly bool                   `protobuf:"varint,1,opt,name=subscribed_only,json=subscribedOnly,proto3" json:"subscribed_only,omitempty"`
 	Query          string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
-	PageSize       int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageSize       int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // Defaults to 50, at most 200
 	PageToken      string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
@@ -555,6 +555,195 @@ func (x *UnsubscribeChannelResponse) GetChannel() *Channel {
 	return nil
 }
 
+// Daily channel statistics with the change since the previous snapshot
+type ChannelGrowthPoint struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	MeasuredAt             *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=measured_at,json=measuredAt,proto3" json:"measured_at,omitempty"`
+	SubscriptionCount      int64                  `protobuf:"varint,2,opt,name=subscription_count,json=subscriptionCount,proto3" json:"subscription_count,omitempty"`
+	ViewCount              int64                  `protobuf:"varint,3,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
+	VideoCount             int64                  `protobuf:"varint,4,opt,name=video_count,json=videoCount,proto3" json:"video_count,omitempty"`
+	SubscriptionCountDelta int64                  `protobuf:"varint,5,opt,name=subscription_count_delta,json=subscriptionCountDelta,proto3" json:"subscription_count_delta,omitempty"`
+	ViewCountDelta         int64                  `protobuf:"varint,6,opt,name=view_count_delta,json=viewCountDelta,proto3" json:"view_count_delta,omitempty"`
+	VideoCountDelta        int64                  `protobuf:"varint,7,opt,name=video_count_delta,json=videoCountDelta,proto3" json:"video_count_delta,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ChannelGrowthPoint) Reset() {
+	*x = ChannelGrowthPoint{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelGrowthPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelGrowthPoint) ProtoMessage() {}
+
+func (x *ChannelGrowthPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelGrowthPoint.ProtoReflect.Descriptor instead.
+func (*ChannelGrowthPoint) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ChannelGrowthPoint) GetMeasuredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MeasuredAt
+	}
+	return nil
+}
+
+func (x *ChannelGrowthPoint) GetSubscriptionCount() int64 {
+	if x != nil {
+		return x.SubscriptionCount
+	}
+	return 0
+}
+
+func (x *ChannelGrowthPoint) GetViewCount() int64 {
+	if x != nil {
+		return x.ViewCount
+	}
+	return 0
+}
+
+func (x *ChannelGrowthPoint) GetVideoCount() int64 {
+	if x != nil {
+		return x.VideoCount
+	}
+	return 0
+}
+
+func (x *ChannelGrowthPoint) GetSubscriptionCountDelta() int64 {
+	if x != nil {
+		return x.SubscriptionCountDelta
+	}
+	return 0
+}
+
+func (x *ChannelGrowthPoint) GetViewCountDelta() int64 {
+	if x != nil {
+		return x.ViewCountDelta
+	}
+	return 0
+}
+
+func (x *ChannelGrowthPoint) GetVideoCountDelta() int64 {
+	if x != nil {
+		return x.VideoCountDelta
+	}
+	return 0
+}
+
+type GetChannelGrowthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Number of most recent daily snapshots, defaults to 90
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChannelGrowthRequest) Reset() {
+	*x = GetChannelGrowthRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChannelGrowthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelGrowthRequest) ProtoMessage() {}
+
+func (x *GetChannelGrowthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelGrowthRequest.ProtoReflect.Descriptor instead.
+func (*GetChannelGrowthRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetChannelGrowthRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *GetChannelGrowthRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetChannelGrowthResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Points        []*ChannelGrowthPoint  `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"` // Oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChannelGrowthResponse) Reset() {
+	*x = GetChannelGrowthResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChannelGrowthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelGrowthResponse) ProtoMessage() {}
+
+func (x *GetChannelGrowthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelGrowthResponse.ProtoReflect.Descriptor instead.
+func (*GetChannelGrowthResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetChannelGrowthResponse) GetPoints() []*ChannelGrowthPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
 // Video messages
 type Video struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
@@ -567,14 +756,16 @@ type Video struct {
 	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	DeletedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
-	GenreIds         []string               `protobuf:"bytes,10,rep,name=genre_ids,json=genreIds,proto3" json:"genre_ids,omitempty"` // Associated genre IDs
+	GenreIds         []string               `protobuf:"bytes,10,rep,name=genre_ids,json=genreIds,proto3" json:"genre_ids,omitempty"`                       // Associated genre IDs
+	DurationSeconds  int32                  `protobuf:"varint,11,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"` // Zero when unknown
+	IsShort          bool                   `protobuf:"varint,12,opt,name=is_short,json=isShort,proto3" json:"is_short,omitempty"`                         // Runs three minutes or less
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Video) Reset() {
 	*x = Video{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[9]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -586,7 +777,7 @@ func (x *Video) String() string {
 func (*Video) ProtoMessage() {}
 
 func (x *Video) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[9]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -599,7 +790,7 @@ func (x *Video) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Video.ProtoReflect.Descriptor instead.
 func (*Video) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{9}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Video) GetId() string {
@@ -672,6 +863,20 @@ func (x *Video) GetGenreIds() []string {
 	return nil
 }
 
+func (x *Video) GetDurationSeconds() int32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *Video) GetIsShort() bool {
+	if x != nil {
+		return x.IsShort
+	}
+	return false
+}
+
 type GetVideoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -681,7 +886,7 @@ type GetVideoRequest struct {
 
 func (x *GetVideoRequest) Reset() {
 	*x = GetVideoRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[10]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -693,7 +898,7 @@ func (x *GetVideoRequest) String() string {
 func (*GetVideoRequest) ProtoMessage() {}
 
 func (x *GetVideoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[10]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -706,7 +911,7 @@ func (x *GetVideoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVideoRequest.ProtoReflect.Descriptor instead.
 func (*GetVideoRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{10}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetVideoRequest) GetId() string {
@@ -717,15 +922,18 @@ func (x *GetVideoRequest) GetId() string {
 }
 
 type GetVideoResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Video         *Video                 `protobuf:"bytes,1,opt,name=video,proto3" json:"video,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Video          *Video                 `protobuf:"bytes,1,opt,name=video,proto3" json:"video,omitempty"`
+	Channel        *Channel               `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"` // Unset when the channel is not stored
+	Genres         []*Genre               `protobuf:"bytes,3,rep,name=genres,proto3" json:"genres,omitempty"`
+	LatestSnapshot *VideoSnapshot         `protobuf:"bytes,4,opt,name=latest_snapshot,json=latestSnapshot,proto3" json:"latest_snapshot,omitempty"` // Unset before the first snapshot
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetVideoResponse) Reset() {
 	*x = GetVideoResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[11]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -737,7 +945,7 @@ func (x *GetVideoResponse) String() string {
 func (*GetVideoResponse) ProtoMessage() {}
 
 func (x *GetVideoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[11]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -750,7 +958,7 @@ func (x *GetVideoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVideoResponse.ProtoReflect.Descriptor instead.
 func (*GetVideoResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{11}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetVideoResponse) GetVideo() *Video {
@@ -760,20 +968,46 @@ func (x *GetVideoResponse) GetVideo() *Video {
 	return nil
 }
 
+func (x *GetVideoResponse) GetChannel() *Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *GetVideoResponse) GetGenres() []*Genre {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *GetVideoResponse) GetLatestSnapshot() *VideoSnapshot {
+	if x != nil {
+		return x.LatestSnapshot
+	}
+	return nil
+}
+
 type ListVideosRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ChannelId      string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	PublishedAfter *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=published_after,json=publishedAfter,proto3" json:"published_after,omitempty"`
-	PageSize       int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken      string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	GenreId        string                 `protobuf:"bytes,5,opt,name=genre_id,json=genreId,proto3" json:"genre_id,omitempty"` // Filter by genre
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId       string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	PublishedAfter  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=published_after,json=publishedAfter,proto3" json:"published_after,omitempty"` // Inclusive
+	PageSize        int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                  // Defaults to 50, at most 200
+	PageToken       string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	GenreId         string                 `protobuf:"bytes,5,opt,name=genre_id,json=genreId,proto3" json:"genre_id,omitempty"` // Filter by genre
+	CategoryId      *int32                 `protobuf:"varint,6,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	PublishedBefore *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=published_before,json=publishedBefore,proto3" json:"published_before,omitempty"` // Exclusive
+	Shorts          *bool                  `protobuf:"varint,8,opt,name=shorts,proto3,oneof" json:"shorts,omitempty"`                                   // true lists only Shorts, false excludes them
+	Query           string                 `protobuf:"bytes,9,opt,name=query,proto3" json:"query,omitempty"`                                            // Case-insensitive substring of the title
+	OrderBy         string                 `protobuf:"bytes,10,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`                        // published_at (default) or created_at, optionally followed by asc or desc (default)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ListVideosRequest) Reset() {
 	*x = ListVideosRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[12]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -785,7 +1019,7 @@ func (x *ListVideosRequest) String() string {
 func (*ListVideosRequest) ProtoMessage() {}
 
 func (x *ListVideosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[12]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -798,7 +1032,7 @@ func (x *ListVideosRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListVideosRequest.ProtoReflect.Descriptor instead.
 func (*ListVideosRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{12}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ListVideosRequest) GetChannelId() string {
@@ -836,6 +1070,41 @@ func (x *ListVideosRequest) GetGenreId() string {
 	return ""
 }
 
+func (x *ListVideosRequest) GetCategoryId() int32 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *ListVideosRequest) GetPublishedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedBefore
+	}
+	return nil
+}
+
+func (x *ListVideosRequest) GetShorts() bool {
+	if x != nil && x.Shorts != nil {
+		return *x.Shorts
+	}
+	return false
+}
+
+func (x *ListVideosRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListVideosRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 type ListVideosResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Videos        []*Video               `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
@@ -847,7 +1116,7 @@ type ListVideosResponse struct {
 
 func (x *ListVideosResponse) Reset() {
 	*x = ListVideosResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[13]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -859,7 +1128,7 @@ func (x *ListVideosResponse) String() string {
 func (*ListVideosResponse) ProtoMessage() {}
 
 func (x *ListVideosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[13]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -872,7 +1141,7 @@ func (x *ListVideosResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListVideosResponse.ProtoReflect.Descriptor instead.
 func (*ListVideosResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{13}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ListVideosResponse) GetVideos() []*Video {
@@ -905,7 +1174,7 @@ type CollectTrendingRequest struct {
 
 func (x *CollectTrendingRequest) Reset() {
 	*x = CollectTrendingRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[14]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -917,7 +1186,7 @@ func (x *CollectTrendingRequest) String() string {
 func (*CollectTrendingRequest) ProtoMessage() {}
 
 func (x *CollectTrendingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[14]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -930,7 +1199,7 @@ func (x *CollectTrendingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CollectTrendingRequest.ProtoReflect.Descriptor instead.
 func (*CollectTrendingRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{14}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *CollectTrendingRequest) GetGenreId() string {
@@ -952,7 +1221,7 @@ type CollectTrendingResponse struct {
 
 func (x *CollectTrendingResponse) Reset() {
 	*x = CollectTrendingResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[15]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -964,7 +1233,7 @@ func (x *CollectTrendingResponse) String() string {
 func (*CollectTrendingResponse) ProtoMessage() {}
 
 func (x *CollectTrendingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[15]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -977,7 +1246,7 @@ func (x *CollectTrendingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CollectTrendingResponse.ProtoReflect.Descriptor instead.
 func (*CollectTrendingResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{15}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *CollectTrendingResponse) GetVideosProcessed() int32 {
@@ -1016,7 +1285,7 @@ type CollectSubscriptionsRequest struct {
 
 func (x *CollectSubscriptionsRequest) Reset() {
 	*x = CollectSubscriptionsRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[16]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1028,7 +1297,7 @@ func (x *CollectSubscriptionsRequest) String() string {
 func (*CollectSubscriptionsRequest) ProtoMessage() {}
 
 func (x *CollectSubscriptionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[16]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1041,7 +1310,7 @@ func (x *CollectSubscriptionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CollectSubscriptionsRequest.ProtoReflect.Descriptor instead.
 func (*CollectSubscriptionsRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{16}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{19}
 }
 
 type CollectSubscriptionsResponse struct {
@@ -1056,7 +1325,7 @@ type CollectSubscriptionsResponse struct {
 
 func (x *CollectSubscriptionsResponse) Reset() {
 	*x = CollectSubscriptionsResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[17]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1068,7 +1337,7 @@ func (x *CollectSubscriptionsResponse) String() string {
 func (*CollectSubscriptionsResponse) ProtoMessage() {}
 
 func (x *CollectSubscriptionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[17]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1081,7 +1350,7 @@ func (x *CollectSubscriptionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CollectSubscriptionsResponse.ProtoReflect.Descriptor instead.
 func (*CollectSubscriptionsResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{17}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *CollectSubscriptionsResponse) GetChannelsProcessed() int32 {
@@ -1114,23 +1383,24 @@ func (x *CollectSubscriptionsResponse) GetDurationMs() int64 {
 
 // Genre messages
 type Genre struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Language      string                 `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"`
-	RegionCode    string                 `protobuf:"bytes,5,opt,name=region_code,json=regionCode,proto3" json:"region_code,omitempty"`
-	CategoryIds   []int32                `protobuf:"varint,6,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
-	Enabled       bool                   `protobuf:"varint,7,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code                string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name                string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Language            string                 `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"`
+	RegionCode          string                 `protobuf:"bytes,5,opt,name=region_code,json=regionCode,proto3" json:"region_code,omitempty"`
+	CategoryIds         []int32                `protobuf:"varint,6,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	Enabled             bool                   `protobuf:"varint,7,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CheckpointProfileId string                 `protobuf:"bytes,10,opt,name=checkpoint_profile_id,json=checkpointProfileId,proto3" json:"checkpoint_profile_id,omitempty"` // Empty when the genre follows the default profile
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *Genre) Reset() {
 	*x = Genre{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[18]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1142,7 +1412,7 @@ func (x *Genre) String() string {
 func (*Genre) ProtoMessage() {}
 
 func (x *Genre) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[18]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1155,7 +1425,7 @@ func (x *Genre) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Genre.ProtoReflect.Descriptor instead.
 func (*Genre) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{18}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *Genre) GetId() string {
@@ -1221,10 +1491,17 @@ func (x *Genre) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Genre) GetCheckpointProfileId() string {
+	if x != nil {
+		return x.CheckpointProfileId
+	}
+	return ""
+}
+
 type ListGenresRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	EnabledOnly   bool                   `protobuf:"varint,1,opt,name=enabled_only,json=enabledOnly,proto3" json:"enabled_only,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // Defaults to 100, at most 500
 	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1232,7 +1509,7 @@ type ListGenresRequest struct {
 
 func (x *ListGenresRequest) Reset() {
 	*x = ListGenresRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[19]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1244,7 +1521,7 @@ func (x *ListGenresRequest) String() string {
 func (*ListGenresRequest) ProtoMessage() {}
 
 func (x *ListGenresRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[19]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1257,7 +1534,7 @@ func (x *ListGenresRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListGenresRequest.ProtoReflect.Descriptor instead.
 func (*ListGenresRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{19}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ListGenresRequest) GetEnabledOnly() bool {
@@ -1292,7 +1569,7 @@ type ListGenresResponse struct {
 
 func (x *ListGenresResponse) Reset() {
 	*x = ListGenresResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[20]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1304,7 +1581,7 @@ func (x *ListGenresResponse) String() string {
 func (*ListGenresResponse) ProtoMessage() {}
 
 func (x *ListGenresResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[20]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1317,7 +1594,7 @@ func (x *ListGenresResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListGenresResponse.ProtoReflect.Descriptor instead.
 func (*ListGenresResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{20}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ListGenresResponse) GetGenres() []*Genre {
@@ -1350,7 +1627,7 @@ type GetGenreRequest struct {
 
 func (x *GetGenreRequest) Reset() {
 	*x = GetGenreRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[21]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1362,7 +1639,7 @@ func (x *GetGenreRequest) String() string {
 func (*GetGenreRequest) ProtoMessage() {}
 
 func (x *GetGenreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[21]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1375,7 +1652,7 @@ func (x *GetGenreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGenreRequest.ProtoReflect.Descriptor instead.
 func (*GetGenreRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{21}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetGenreRequest) GetId() string {
@@ -1394,7 +1671,7 @@ type GetGenreResponse struct {
 
 func (x *GetGenreResponse) Reset() {
 	*x = GetGenreResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[22]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1406,7 +1683,7 @@ func (x *GetGenreResponse) String() string {
 func (*GetGenreResponse) ProtoMessage() {}
 
 func (x *GetGenreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[22]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1419,7 +1696,7 @@ func (x *GetGenreResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGenreResponse.ProtoReflect.Descriptor instead.
 func (*GetGenreResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{22}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GetGenreResponse) GetGenre() *Genre {
@@ -1438,7 +1715,7 @@ type GetGenreByCodeRequest struct {
 
 func (x *GetGenreByCodeRequest) Reset() {
 	*x = GetGenreByCodeRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[23]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1450,7 +1727,7 @@ func (x *GetGenreByCodeRequest) String() string {
 func (*GetGenreByCodeRequest) ProtoMessage() {}
 
 func (x *GetGenreByCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[23]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1463,7 +1740,7 @@ func (x *GetGenreByCodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGenreByCodeRequest.ProtoReflect.Descriptor instead.
 func (*GetGenreByCodeRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{23}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetGenreByCodeRequest) GetCode() string {
@@ -1482,7 +1759,7 @@ type GetGenreByCodeResponse struct {
 
 func (x *GetGenreByCodeResponse) Reset() {
 	*x = GetGenreByCodeResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[24]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1494,7 +1771,7 @@ func (x *GetGenreByCodeResponse) String() string {
 func (*GetGenreByCodeResponse) ProtoMessage() {}
 
 func (x *GetGenreByCodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[24]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1507,7 +1784,7 @@ func (x *GetGenreByCodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGenreByCodeResponse.ProtoReflect.Descriptor instead.
 func (*GetGenreByCodeResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{24}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetGenreByCodeResponse) GetGenre() *Genre {
@@ -1518,19 +1795,20 @@ func (x *GetGenreByCodeResponse) GetGenre() *Genre {
 }
 
 type CreateGenreRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Language      string                 `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
-	RegionCode    string                 `protobuf:"bytes,4,opt,name=region_code,json=regionCode,proto3" json:"region_code,omitempty"`
-	CategoryIds   []int32                `protobuf:"varint,5,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Code              string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Language          string                 `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	RegionCode        string                 `protobuf:"bytes,4,opt,name=region_code,json=regionCode,proto3" json:"region_code,omitempty"`
+	CategoryIds       []int32                `protobuf:"varint,5,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	CheckpointProfile string                 `protobuf:"bytes,6,opt,name=checkpoint_profile,json=checkpointProfile,proto3" json:"checkpoint_profile,omitempty"` // Checkpoint profile code; empty selects the default profile
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *CreateGenreRequest) Reset() {
 	*x = CreateGenreRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[25]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1542,7 +1820,7 @@ func (x *CreateGenreRequest) String() string {
 func (*CreateGenreRequest) ProtoMessage() {}
 
 func (x *CreateGenreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[25]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1555,7 +1833,7 @@ func (x *CreateGenreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateGenreRequest.ProtoReflect.Descriptor instead.
 func (*CreateGenreRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{25}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *CreateGenreRequest) GetCode() string {
@@ -1593,6 +1871,13 @@ func (x *CreateGenreRequest) GetCategoryIds() []int32 {
 	return nil
 }
 
+func (x *CreateGenreRequest) GetCheckpointProfile() string {
+	if x != nil {
+		return x.CheckpointProfile
+	}
+	return ""
+}
+
 type CreateGenreResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Genre         *Genre                 `protobuf:"bytes,1,opt,name=genre,proto3" json:"genre,omitempty"`
@@ -1602,7 +1887,7 @@ type CreateGenreResponse struct {
 
 func (x *CreateGenreResponse) Reset() {
 	*x = CreateGenreResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[26]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1614,7 +1899,7 @@ func (x *CreateGenreResponse) String() string {
 func (*CreateGenreResponse) ProtoMessage() {}
 
 func (x *CreateGenreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[26]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1627,7 +1912,7 @@ func (x *CreateGenreResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateGenreResponse.ProtoReflect.Descriptor instead.
 func (*CreateGenreResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{26}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *CreateGenreResponse) GetGenre() *Genre {
@@ -1638,20 +1923,21 @@ func (x *CreateGenreResponse) GetGenre() *Genre {
 }
 
 type UpdateGenreRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Language      string                 `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"`
-	RegionCode    string                 `protobuf:"bytes,5,opt,name=region_code,json=regionCode,proto3" json:"region_code,omitempty"`
-	CategoryIds   []int32                `protobuf:"varint,6,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code              string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name              string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Language          string                 `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"`
+	RegionCode        string                 `protobuf:"bytes,5,opt,name=region_code,json=regionCode,proto3" json:"region_code,omitempty"`
+	CategoryIds       []int32                `protobuf:"varint,6,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	CheckpointProfile *string                `protobuf:"bytes,7,opt,name=checkpoint_profile,json=checkpointProfile,proto3,oneof" json:"checkpoint_profile,omitempty"` // Checkpoint profile code; unset keeps it, empty selects the default profile
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *UpdateGenreRequest) Reset() {
 	*x = UpdateGenreRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[27]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1663,7 +1949,7 @@ func (x *UpdateGenreRequest) String() string {
 func (*UpdateGenreRequest) ProtoMessage() {}
 
 func (x *UpdateGenreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[27]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1676,7 +1962,7 @@ func (x *UpdateGenreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateGenreRequest.ProtoReflect.Descriptor instead.
 func (*UpdateGenreRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{27}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *UpdateGenreRequest) GetId() string {
@@ -1721,6 +2007,13 @@ func (x *UpdateGenreRequest) GetCategoryIds() []int32 {
 	return nil
 }
 
+func (x *UpdateGenreRequest) GetCheckpointProfile() string {
+	if x != nil && x.CheckpointProfile != nil {
+		return *x.CheckpointProfile
+	}
+	return ""
+}
+
 type UpdateGenreResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Genre         *Genre                 `protobuf:"bytes,1,opt,name=genre,proto3" json:"genre,omitempty"`
@@ -1730,7 +2023,7 @@ type UpdateGenreResponse struct {
 
 func (x *UpdateGenreResponse) Reset() {
 	*x = UpdateGenreResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[28]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1742,7 +2035,7 @@ func (x *UpdateGenreResponse) String() string {
 func (*UpdateGenreResponse) ProtoMessage() {}
 
 func (x *UpdateGenreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[28]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1755,7 +2048,7 @@ func (x *UpdateGenreResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateGenreResponse.ProtoReflect.Descriptor instead.
 func (*UpdateGenreResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{28}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *UpdateGenreResponse) GetGenre() *Genre {
@@ -1774,7 +2067,7 @@ type EnableGenreRequest struct {
 
 func (x *EnableGenreRequest) Reset() {
 	*x = EnableGenreRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[29]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1786,7 +2079,7 @@ func (x *EnableGenreRequest) String() string {
 func (*EnableGenreRequest) ProtoMessage() {}
 
 func (x *EnableGenreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[29]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1799,7 +2092,7 @@ func (x *EnableGenreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnableGenreRequest.ProtoReflect.Descriptor instead.
 func (*EnableGenreRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{29}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *EnableGenreRequest) GetId() string {
@@ -1818,7 +2111,7 @@ type EnableGenreResponse struct {
 
 func (x *EnableGenreResponse) Reset() {
 	*x = EnableGenreResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[30]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1830,7 +2123,7 @@ func (x *EnableGenreResponse) String() string {
 func (*EnableGenreResponse) ProtoMessage() {}
 
 func (x *EnableGenreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[30]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1843,7 +2136,7 @@ func (x *EnableGenreResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnableGenreResponse.ProtoReflect.Descriptor instead.
 func (*EnableGenreResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{30}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *EnableGenreResponse) GetGenre() *Genre {
@@ -1862,7 +2155,7 @@ type DisableGenreRequest struct {
 
 func (x *DisableGenreRequest) Reset() {
 	*x = DisableGenreRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[31]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1874,7 +2167,7 @@ func (x *DisableGenreRequest) String() string {
 func (*DisableGenreRequest) ProtoMessage() {}
 
 func (x *DisableGenreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[31]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1887,7 +2180,7 @@ func (x *DisableGenreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DisableGenreRequest.ProtoReflect.Descriptor instead.
 func (*DisableGenreRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{31}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *DisableGenreRequest) GetId() string {
@@ -1906,7 +2199,7 @@ type DisableGenreResponse struct {
 
 func (x *DisableGenreResponse) Reset() {
 	*x = DisableGenreResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[32]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1918,7 +2211,7 @@ func (x *DisableGenreResponse) String() string {
 func (*DisableGenreResponse) ProtoMessage() {}
 
 func (x *DisableGenreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[32]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1931,7 +2224,7 @@ func (x *DisableGenreResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DisableGenreResponse.ProtoReflect.Descriptor instead.
 func (*DisableGenreResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{32}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *DisableGenreResponse) GetGenre() *Genre {
@@ -1956,7 +2249,7 @@ type YouTubeCategory struct {
 
 func (x *YouTubeCategory) Reset() {
 	*x = YouTubeCategory{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[33]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1968,7 +2261,7 @@ func (x *YouTubeCategory) String() string {
 func (*YouTubeCategory) ProtoMessage() {}
 
 func (x *YouTubeCategory) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[33]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1981,7 +2274,7 @@ func (x *YouTubeCategory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use YouTubeCategory.ProtoReflect.Descriptor instead.
 func (*YouTubeCategory) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{33}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *YouTubeCategory) GetId() int32 {
@@ -2029,7 +2322,7 @@ func (x *YouTubeCategory) GetUpdatedAt() *timestamppb.Timestamp {
 type ListYouTubeCategoriesRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	AssignableOnly bool                   `protobuf:"varint,1,opt,name=assignable_only,json=assignableOnly,proto3" json:"assignable_only,omitempty"`
-	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // Defaults to 100, at most 500
 	PageToken      string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
@@ -2037,7 +2330,7 @@ type ListYouTubeCategoriesRequest struct {
 
 func (x *ListYouTubeCategoriesRequest) Reset() {
 	*x = ListYouTubeCategoriesRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[34]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2049,7 +2342,7 @@ func (x *ListYouTubeCategoriesRequest) String() string {
 func (*ListYouTubeCategoriesRequest) ProtoMessage() {}
 
 func (x *ListYouTubeCategoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[34]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2062,7 +2355,7 @@ func (x *ListYouTubeCategoriesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListYouTubeCategoriesRequest.ProtoReflect.Descriptor instead.
 func (*ListYouTubeCategoriesRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{34}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ListYouTubeCategoriesRequest) GetAssignableOnly() bool {
@@ -2097,7 +2390,7 @@ type ListYouTubeCategoriesResponse struct {
 
 func (x *ListYouTubeCategoriesResponse) Reset() {
 	*x = ListYouTubeCategoriesResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[35]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2109,7 +2402,7 @@ func (x *ListYouTubeCategoriesResponse) String() string {
 func (*ListYouTubeCategoriesResponse) ProtoMessage() {}
 
 func (x *ListYouTubeCategoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[35]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2122,7 +2415,7 @@ func (x *ListYouTubeCategoriesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListYouTubeCategoriesResponse.ProtoReflect.Descriptor instead.
 func (*ListYouTubeCategoriesResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{35}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ListYouTubeCategoriesResponse) GetCategories() []*YouTubeCategory {
@@ -2155,7 +2448,7 @@ type GetYouTubeCategoryRequest struct {
 
 func (x *GetYouTubeCategoryRequest) Reset() {
 	*x = GetYouTubeCategoryRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[36]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2167,7 +2460,7 @@ func (x *GetYouTubeCategoryRequest) String() string {
 func (*GetYouTubeCategoryRequest) ProtoMessage() {}
 
 func (x *GetYouTubeCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[36]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2180,7 +2473,7 @@ func (x *GetYouTubeCategoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetYouTubeCategoryRequest.ProtoReflect.Descriptor instead.
 func (*GetYouTubeCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{36}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *GetYouTubeCategoryRequest) GetId() int32 {
@@ -2199,7 +2492,7 @@ type GetYouTubeCategoryResponse struct {
 
 func (x *GetYouTubeCategoryResponse) Reset() {
 	*x = GetYouTubeCategoryResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[37]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2211,7 +2504,7 @@ func (x *GetYouTubeCategoryResponse) String() string {
 func (*GetYouTubeCategoryResponse) ProtoMessage() {}
 
 func (x *GetYouTubeCategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[37]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2224,7 +2517,7 @@ func (x *GetYouTubeCategoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetYouTubeCategoryResponse.ProtoReflect.Descriptor instead.
 func (*GetYouTubeCategoryResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{37}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GetYouTubeCategoryResponse) GetCategory() *YouTubeCategory {
@@ -2246,7 +2539,7 @@ type UpdateYouTubeCategoryRequest struct {
 
 func (x *UpdateYouTubeCategoryRequest) Reset() {
 	*x = UpdateYouTubeCategoryRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[38]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2258,7 +2551,7 @@ func (x *UpdateYouTubeCategoryRequest) String() string {
 func (*UpdateYouTubeCategoryRequest) ProtoMessage() {}
 
 func (x *UpdateYouTubeCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[38]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2271,7 +2564,7 @@ func (x *UpdateYouTubeCategoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateYouTubeCategoryRequest.ProtoReflect.Descriptor instead.
 func (*UpdateYouTubeCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{38}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *UpdateYouTubeCategoryRequest) GetId() int32 {
@@ -2311,7 +2604,7 @@ type UpdateYouTubeCategoryResponse struct {
 
 func (x *UpdateYouTubeCategoryResponse) Reset() {
 	*x = UpdateYouTubeCategoryResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[39]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2323,7 +2616,7 @@ func (x *UpdateYouTubeCategoryResponse) String() string {
 func (*UpdateYouTubeCategoryResponse) ProtoMessage() {}
 
 func (x *UpdateYouTubeCategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[39]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2336,7 +2629,7 @@ func (x *UpdateYouTubeCategoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateYouTubeCategoryResponse.ProtoReflect.Descriptor instead.
 func (*UpdateYouTubeCategoryResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{39}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *UpdateYouTubeCategoryResponse) GetCategory() *YouTubeCategory {
@@ -2346,7 +2639,7 @@ func (x *UpdateYouTubeCategoryResponse) GetCategory() *YouTubeCategory {
 	return nil
 }
 
-// Keyword messages (updated)
+// Keyword messages (legacy, deprecated in favor of KeywordGroup)
 type Keyword struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -2366,7 +2659,7 @@ type Keyword struct {
 
 func (x *Keyword) Reset() {
 	*x = Keyword{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[40]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2378,7 +2671,7 @@ func (x *Keyword) String() string {
 func (*Keyword) ProtoMessage() {}
 
 func (x *Keyword) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[40]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2391,7 +2684,7 @@ func (x *Keyword) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Keyword.ProtoReflect.Descriptor instead.
 func (*Keyword) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{40}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *Keyword) GetId() string {
@@ -2480,7 +2773,7 @@ type GetKeywordRequest struct {
 
 func (x *GetKeywordRequest) Reset() {
 	*x = GetKeywordRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[41]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2492,7 +2785,7 @@ func (x *GetKeywordRequest) String() string {
 func (*GetKeywordRequest) ProtoMessage() {}
 
 func (x *GetKeywordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[41]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2505,7 +2798,7 @@ func (x *GetKeywordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKeywordRequest.ProtoReflect.Descriptor instead.
 func (*GetKeywordRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{41}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *GetKeywordRequest) GetId() string {
@@ -2524,7 +2817,7 @@ type GetKeywordResponse struct {
 
 func (x *GetKeywordResponse) Reset() {
 	*x = GetKeywordResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[42]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2536,7 +2829,7 @@ func (x *GetKeywordResponse) String() string {
 func (*GetKeywordResponse) ProtoMessage() {}
 
 func (x *GetKeywordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[42]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2549,7 +2842,7 @@ func (x *GetKeywordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKeywordResponse.ProtoReflect.Descriptor instead.
 func (*GetKeywordResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{42}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *GetKeywordResponse) GetKeyword() *Keyword {
@@ -2562,7 +2855,7 @@ func (x *GetKeywordResponse) GetKeyword() *Keyword {
 type ListKeywordsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // Defaults to 100, at most 500
 	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	EnabledOnly   bool                   `protobuf:"varint,4,opt,name=enabled_only,json=enabledOnly,proto3" json:"enabled_only,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -2571,7 +2864,7 @@ type ListKeywordsRequest struct {
 
 func (x *ListKeywordsRequest) Reset() {
 	*x = ListKeywordsRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[43]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2583,7 +2876,7 @@ func (x *ListKeywordsRequest) String() string {
 func (*ListKeywordsRequest) ProtoMessage() {}
 
 func (x *ListKeywordsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[43]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2596,7 +2889,7 @@ func (x *ListKeywordsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListKeywordsRequest.ProtoReflect.Descriptor instead.
 func (*ListKeywordsRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{43}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *ListKeywordsRequest) GetQuery() string {
@@ -2638,7 +2931,7 @@ type ListKeywordsResponse struct {
 
 func (x *ListKeywordsResponse) Reset() {
 	*x = ListKeywordsResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[44]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2650,7 +2943,7 @@ func (x *ListKeywordsResponse) String() string {
 func (*ListKeywordsResponse) ProtoMessage() {}
 
 func (x *ListKeywordsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[44]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2663,7 +2956,7 @@ func (x *ListKeywordsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListKeywordsResponse.ProtoReflect.Descriptor instead.
 func (*ListKeywordsResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{44}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *ListKeywordsResponse) GetKeywords() []*Keyword {
@@ -2691,7 +2984,7 @@ type ListKeywordsByGenreRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GenreId       string                 `protobuf:"bytes,1,opt,name=genre_id,json=genreId,proto3" json:"genre_id,omitempty"`
 	EnabledOnly   bool                   `protobuf:"varint,2,opt,name=enabled_only,json=enabledOnly,proto3" json:"enabled_only,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // Defaults to 100, at most 500
 	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -2699,7 +2992,7 @@ type ListKeywordsByGenreRequest struct {
 
 func (x *ListKeywordsByGenreRequest) Reset() {
 	*x = ListKeywordsByGenreRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[45]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2711,7 +3004,7 @@ func (x *ListKeywordsByGenreRequest) String() string {
 func (*ListKeywordsByGenreRequest) ProtoMessage() {}
 
 func (x *ListKeywordsByGenreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[45]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2724,7 +3017,7 @@ func (x *ListKeywordsByGenreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListKeywordsByGenreRequest.ProtoReflect.Descriptor instead.
 func (*ListKeywordsByGenreRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{45}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *ListKeywordsByGenreRequest) GetGenreId() string {
@@ -2766,7 +3059,7 @@ type ListKeywordsByGenreResponse struct {
 
 func (x *ListKeywordsByGenreResponse) Reset() {
 	*x = ListKeywordsByGenreResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[46]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2778,7 +3071,7 @@ func (x *ListKeywordsByGenreResponse) String() string {
 func (*ListKeywordsByGenreResponse) ProtoMessage() {}
 
 func (x *ListKeywordsByGenreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[46]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2791,7 +3084,7 @@ func (x *ListKeywordsByGenreResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListKeywordsByGenreResponse.ProtoReflect.Descriptor instead.
 func (*ListKeywordsByGenreResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{46}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *ListKeywordsByGenreResponse) GetKeywords() []*Keyword {
@@ -2829,7 +3122,7 @@ type CreateKeywordRequest struct {
 
 func (x *CreateKeywordRequest) Reset() {
 	*x = CreateKeywordRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[47]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2841,7 +3134,7 @@ func (x *CreateKeywordRequest) String() string {
 func (*CreateKeywordRequest) ProtoMessage() {}
 
 func (x *CreateKeywordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[47]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2854,7 +3147,7 @@ func (x *CreateKeywordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateKeywordRequest.ProtoReflect.Descriptor instead.
 func (*CreateKeywordRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{47}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *CreateKeywordRequest) GetGenreId() string {
@@ -2908,7 +3201,7 @@ type CreateKeywordResponse struct {
 
 func (x *CreateKeywordResponse) Reset() {
 	*x = CreateKeywordResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[48]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2920,7 +3213,7 @@ func (x *CreateKeywordResponse) String() string {
 func (*CreateKeywordResponse) ProtoMessage() {}
 
 func (x *CreateKeywordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[48]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2933,7 +3226,7 @@ func (x *CreateKeywordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateKeywordResponse.ProtoReflect.Descriptor instead.
 func (*CreateKeywordResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{48}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *CreateKeywordResponse) GetKeyword() *Keyword {
@@ -2958,7 +3251,7 @@ type UpdateKeywordRequest struct {
 
 func (x *UpdateKeywordRequest) Reset() {
 	*x = UpdateKeywordRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[49]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2970,7 +3263,7 @@ func (x *UpdateKeywordRequest) String() string {
 func (*UpdateKeywordRequest) ProtoMessage() {}
 
 func (x *UpdateKeywordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[49]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2983,7 +3276,7 @@ func (x *UpdateKeywordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateKeywordRequest.ProtoReflect.Descriptor instead.
 func (*UpdateKeywordRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{49}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *UpdateKeywordRequest) GetId() string {
@@ -3044,7 +3337,7 @@ type UpdateKeywordResponse struct {
 
 func (x *UpdateKeywordResponse) Reset() {
 	*x = UpdateKeywordResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[50]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3056,7 +3349,7 @@ func (x *UpdateKeywordResponse) String() string {
 func (*UpdateKeywordResponse) ProtoMessage() {}
 
 func (x *UpdateKeywordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[50]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3069,7 +3362,7 @@ func (x *UpdateKeywordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateKeywordResponse.ProtoReflect.Descriptor instead.
 func (*UpdateKeywordResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{50}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *UpdateKeywordResponse) GetKeyword() *Keyword {
@@ -3088,7 +3381,7 @@ type EnableKeywordRequest struct {
 
 func (x *EnableKeywordRequest) Reset() {
 	*x = EnableKeywordRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[51]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3100,7 +3393,7 @@ func (x *EnableKeywordRequest) String() string {
 func (*EnableKeywordRequest) ProtoMessage() {}
 
 func (x *EnableKeywordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[51]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3113,7 +3406,7 @@ func (x *EnableKeywordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnableKeywordRequest.ProtoReflect.Descriptor instead.
 func (*EnableKeywordRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{51}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *EnableKeywordRequest) GetId() string {
@@ -3132,7 +3425,7 @@ type EnableKeywordResponse struct {
 
 func (x *EnableKeywordResponse) Reset() {
 	*x = EnableKeywordResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[52]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3144,7 +3437,7 @@ func (x *EnableKeywordResponse) String() string {
 func (*EnableKeywordResponse) ProtoMessage() {}
 
 func (x *EnableKeywordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[52]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3157,7 +3450,7 @@ func (x *EnableKeywordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnableKeywordResponse.ProtoReflect.Descriptor instead.
 func (*EnableKeywordResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{52}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *EnableKeywordResponse) GetKeyword() *Keyword {
@@ -3176,7 +3469,7 @@ type DisableKeywordRequest struct {
 
 func (x *DisableKeywordRequest) Reset() {
 	*x = DisableKeywordRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[53]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3188,7 +3481,7 @@ func (x *DisableKeywordRequest) String() string {
 func (*DisableKeywordRequest) ProtoMessage() {}
 
 func (x *DisableKeywordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[53]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3201,7 +3494,7 @@ func (x *DisableKeywordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DisableKeywordRequest.ProtoReflect.Descriptor instead.
 func (*DisableKeywordRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{53}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *DisableKeywordRequest) GetId() string {
@@ -3220,7 +3513,7 @@ type DisableKeywordResponse struct {
 
 func (x *DisableKeywordResponse) Reset() {
 	*x = DisableKeywordResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[54]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3232,7 +3525,7 @@ func (x *DisableKeywordResponse) String() string {
 func (*DisableKeywordResponse) ProtoMessage() {}
 
 func (x *DisableKeywordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[54]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3245,38 +3538,2406 @@ func (x *DisableKeywordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DisableKeywordResponse.ProtoReflect.Descriptor instead.
 func (*DisableKeywordResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{54}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DisableKeywordResponse) GetKeyword() *Keyword {
+	if x != nil {
+		return x.Keyword
+	}
+	return nil
+}
+
+type DeleteKeywordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteKeywordRequest) Reset() {
+	*x = DeleteKeywordRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteKeywordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteKeywordRequest) ProtoMessage() {}
+
+func (x *DeleteKeywordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteKeywordRequest.ProtoReflect.Descriptor instead.
+func (*DeleteKeywordRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *DeleteKeywordRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteKeywordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteKeywordResponse) Reset() {
+	*x = DeleteKeywordResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteKeywordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteKeywordResponse) ProtoMessage() {}
+
+func (x *DeleteKeywordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteKeywordResponse.ProtoReflect.Descriptor instead.
+func (*DeleteKeywordResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{59}
+}
+
+// Keyword group messages. A group is a list of plain keywords matched against
+// a field of videos; it replaces the single-regex Keyword.
+type KeywordGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GenreId       string                 `protobuf:"bytes,2,opt,name=genre_id,json=genreId,proto3" json:"genre_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	FilterType    string                 `protobuf:"bytes,4,opt,name=filter_type,json=filterType,proto3" json:"filter_type,omitempty"` // include or exclude
+	TargetField   string                 `protobuf:"bytes,5,opt,name=target_field,json=targetField,proto3" json:"target_field,omitempty"`
+	Enabled       bool                   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	Keywords      []string               `protobuf:"bytes,8,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CrossLanguage bool                   `protobuf:"varint,11,opt,name=cross_language,json=crossLanguage,proto3" json:"cross_language,omitempty"` // Cross-language synonyms apply, such as パイソン for Python
+	Romaji        bool                   `protobuf:"varint,12,opt,name=romaji,proto3" json:"romaji,omitempty"`                                    // Romaji spellings of kana keywords match, such as ramen for ラーメン
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeywordGroup) Reset() {
+	*x = KeywordGroup{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeywordGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeywordGroup) ProtoMessage() {}
+
+func (x *KeywordGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeywordGroup.ProtoReflect.Descriptor instead.
+func (*KeywordGroup) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *KeywordGroup) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *KeywordGroup) GetGenreId() string {
+	if x != nil {
+		return x.GenreId
+	}
+	return ""
+}
+
+func (x *KeywordGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *KeywordGroup) GetFilterType() string {
+	if x != nil {
+		return x.FilterType
+	}
+	return ""
+}
+
+func (x *KeywordGroup) GetTargetField() string {
+	if x != nil {
+		return x.TargetField
+	}
+	return ""
+}
+
+func (x *KeywordGroup) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *KeywordGroup) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *KeywordGroup) GetKeywords() []string {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+func (x *KeywordGroup) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *KeywordGroup) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *KeywordGroup) GetCrossLanguage() bool {
+	if x != nil {
+		return x.CrossLanguage
+	}
+	return false
+}
+
+func (x *KeywordGroup) GetRomaji() bool {
+	if x != nil {
+		return x.Romaji
+	}
+	return false
+}
+
+type GetKeywordGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKeywordGroupRequest) Reset() {
+	*x = GetKeywordGroupRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKeywordGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKeywordGroupRequest) ProtoMessage() {}
+
+func (x *GetKeywordGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKeywordGroupRequest.ProtoReflect.Descriptor instead.
+func (*GetKeywordGroupRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetKeywordGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetKeywordGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeywordGroup  *KeywordGroup          `protobuf:"bytes,1,opt,name=keyword_group,json=keywordGroup,proto3" json:"keyword_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKeywordGroupResponse) Reset() {
+	*x = GetKeywordGroupResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKeywordGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKeywordGroupResponse) ProtoMessage() {}
+
+func (x *GetKeywordGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKeywordGroupResponse.ProtoReflect.Descriptor instead.
+func (*GetKeywordGroupResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetKeywordGroupResponse) GetKeywordGroup() *KeywordGroup {
+	if x != nil {
+		return x.KeywordGroup
+	}
+	return nil
+}
+
+// Lists the keyword groups of a genre ordered by filter type and name
+type ListKeywordGroupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GenreId       string                 `protobuf:"bytes,1,opt,name=genre_id,json=genreId,proto3" json:"genre_id,omitempty"`
+	EnabledOnly   bool                   `protobuf:"varint,2,opt,name=enabled_only,json=enabledOnly,proto3" json:"enabled_only,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // Defaults to 50, at most 200
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKeywordGroupsRequest) Reset() {
+	*x = ListKeywordGroupsRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKeywordGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKeywordGroupsRequest) ProtoMessage() {}
+
+func (x *ListKeywordGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKeywordGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListKeywordGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListKeywordGroupsRequest) GetGenreId() string {
+	if x != nil {
+		return x.GenreId
+	}
+	return ""
+}
+
+func (x *ListKeywordGroupsRequest) GetEnabledOnly() bool {
+	if x != nil {
+		return x.EnabledOnly
+	}
+	return false
+}
+
+func (x *ListKeywordGroupsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListKeywordGroupsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListKeywordGroupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeywordGroups []*KeywordGroup        `protobuf:"bytes,1,rep,name=keyword_groups,json=keywordGroups,proto3" json:"keyword_groups,omitempty"` // Ordered by name
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKeywordGroupsResponse) Reset() {
+	*x = ListKeywordGroupsResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKeywordGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKeywordGroupsResponse) ProtoMessage() {}
+
+func (x *ListKeywordGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKeywordGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListKeywordGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListKeywordGroupsResponse) GetKeywordGroups() []*KeywordGroup {
+	if x != nil {
+		return x.KeywordGroups
+	}
+	return nil
+}
+
+func (x *ListKeywordGroupsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListKeywordGroupsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type CreateKeywordGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GenreId       string                 `protobuf:"bytes,1,opt,name=genre_id,json=genreId,proto3" json:"genre_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	FilterType    string                 `protobuf:"bytes,3,opt,name=filter_type,json=filterType,proto3" json:"filter_type,omitempty"`    // include or exclude
+	TargetField   string                 `protobuf:"bytes,4,opt,name=target_field,json=targetField,proto3" json:"target_field,omitempty"` // Defaults to title
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Keywords      []string               `protobuf:"bytes,6,rep,name=keywords,proto3" json:"keywords,omitempty"`                                 // At least one
+	CrossLanguage bool                   `protobuf:"varint,7,opt,name=cross_language,json=crossLanguage,proto3" json:"cross_language,omitempty"` // Apply cross-language synonyms
+	Romaji        bool                   `protobuf:"varint,8,opt,name=romaji,proto3" json:"romaji,omitempty"`                                    // Also match romaji spellings of kana keywords
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateKeywordGroupRequest) Reset() {
+	*x = CreateKeywordGroupRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateKeywordGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateKeywordGroupRequest) ProtoMessage() {}
+
+func (x *CreateKeywordGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateKeywordGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateKeywordGroupRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CreateKeywordGroupRequest) GetGenreId() string {
+	if x != nil {
+		return x.GenreId
+	}
+	return ""
+}
+
+func (x *CreateKeywordGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateKeywordGroupRequest) GetFilterType() string {
+	if x != nil {
+		return x.FilterType
+	}
+	return ""
+}
+
+func (x *CreateKeywordGroupRequest) GetTargetField() string {
+	if x != nil {
+		return x.TargetField
+	}
+	return ""
+}
+
+func (x *CreateKeywordGroupRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateKeywordGroupRequest) GetKeywords() []string {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+func (x *CreateKeywordGroupRequest) GetCrossLanguage() bool {
+	if x != nil {
+		return x.CrossLanguage
+	}
+	return false
+}
+
+func (x *CreateKeywordGroupRequest) GetRomaji() bool {
+	if x != nil {
+		return x.Romaji
+	}
+	return false
+}
+
+type CreateKeywordGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeywordGroup  *KeywordGroup          `protobuf:"bytes,1,opt,name=keyword_group,json=keywordGroup,proto3" json:"keyword_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateKeywordGroupResponse) Reset() {
+	*x = CreateKeywordGroupResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateKeywordGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateKeywordGroupResponse) ProtoMessage() {}
+
+func (x *CreateKeywordGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateKeywordGroupResponse.ProtoReflect.Descriptor instead.
+func (*CreateKeywordGroupResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CreateKeywordGroupResponse) GetKeywordGroup() *KeywordGroup {
+	if x != nil {
+		return x.KeywordGroup
+	}
+	return nil
+}
+
+// Updates the fields that are set and leaves the others unchanged
+type UpdateKeywordGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	FilterType    *string                `protobuf:"bytes,3,opt,name=filter_type,json=filterType,proto3,oneof" json:"filter_type,omitempty"`
+	TargetField   *string                `protobuf:"bytes,4,opt,name=target_field,json=targetField,proto3,oneof" json:"target_field,omitempty"`
+	Description   *string                `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	CrossLanguage *bool                  `protobuf:"varint,6,opt,name=cross_language,json=crossLanguage,proto3,oneof" json:"cross_language,omitempty"`
+	Romaji        *bool                  `protobuf:"varint,7,opt,name=romaji,proto3,oneof" json:"romaji,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateKeywordGroupRequest) Reset() {
+	*x = UpdateKeywordGroupRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateKeywordGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateKeywordGroupRequest) ProtoMessage() {}
+
+func (x *UpdateKeywordGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateKeywordGroupRequest.ProtoReflect.Descriptor instead.
+func (*UpdateKeywordGroupRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *UpdateKeywordGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateKeywordGroupRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateKeywordGroupRequest) GetFilterType() string {
+	if x != nil && x.FilterType != nil {
+		return *x.FilterType
+	}
+	return ""
+}
+
+func (x *UpdateKeywordGroupRequest) GetTargetField() string {
+	if x != nil && x.TargetField != nil {
+		return *x.TargetField
+	}
+	return ""
+}
+
+func (x *UpdateKeywordGroupRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateKeywordGroupRequest) GetCrossLanguage() bool {
+	if x != nil && x.CrossLanguage != nil {
+		return *x.CrossLanguage
+	}
+	return false
+}
+
+func (x *UpdateKeywordGroupRequest) GetRomaji() bool {
+	if x != nil && x.Romaji != nil {
+		return *x.Romaji
+	}
+	return false
+}
+
+type UpdateKeywordGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeywordGroup  *KeywordGroup          `protobuf:"bytes,1,opt,name=keyword_group,json=keywordGroup,proto3" json:"keyword_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateKeywordGroupResponse) Reset() {
+	*x = UpdateKeywordGroupResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateKeywordGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateKeywordGroupResponse) ProtoMessage() {}
+
+func (x *UpdateKeywordGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateKeywordGroupResponse.ProtoReflect.Descriptor instead.
+func (*UpdateKeywordGroupResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *UpdateKeywordGroupResponse) GetKeywordGroup() *KeywordGroup {
+	if x != nil {
+		return x.KeywordGroup
+	}
+	return nil
+}
+
+// Replaces all keywords of a group
+type UpdateKeywordGroupKeywordsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Keywords      []string               `protobuf:"bytes,2,rep,name=keywords,proto3" json:"keywords,omitempty"` // At least one
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateKeywordGroupKeywordsRequest) Reset() {
+	*x = UpdateKeywordGroupKeywordsRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateKeywordGroupKeywordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateKeywordGroupKeywordsRequest) ProtoMessage() {}
+
+func (x *UpdateKeywordGroupKeywordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateKeywordGroupKeywordsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateKeywordGroupKeywordsRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *UpdateKeywordGroupKeywordsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateKeywordGroupKeywordsRequest) GetKeywords() []string {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+type UpdateKeywordGroupKeywordsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeywordGroup  *KeywordGroup          `protobuf:"bytes,1,opt,name=keyword_group,json=keywordGroup,proto3" json:"keyword_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateKeywordGroupKeywordsResponse) Reset() {
+	*x = UpdateKeywordGroupKeywordsResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateKeywordGroupKeywordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateKeywordGroupKeywordsResponse) ProtoMessage() {}
+
+func (x *UpdateKeywordGroupKeywordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateKeywordGroupKeywordsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateKeywordGroupKeywordsResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *UpdateKeywordGroupKeywordsResponse) GetKeywordGroup() *KeywordGroup {
+	if x != nil {
+		return x.KeywordGroup
+	}
+	return nil
+}
+
+type AddKeywordGroupItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Keyword group ID
+	Keyword       string                 `protobuf:"bytes,2,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddKeywordGroupItemRequest) Reset() {
+	*x = AddKeywordGroupItemRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddKeywordGroupItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddKeywordGroupItemRequest) ProtoMessage() {}
+
+func (x *AddKeywordGroupItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddKeywordGroupItemRequest.ProtoReflect.Descriptor instead.
+func (*AddKeywordGroupItemRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *AddKeywordGroupItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AddKeywordGroupItemRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+type AddKeywordGroupItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeywordGroup  *KeywordGroup          `protobuf:"bytes,1,opt,name=keyword_group,json=keywordGroup,proto3" json:"keyword_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddKeywordGroupItemResponse) Reset() {
+	*x = AddKeywordGroupItemResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddKeywordGroupItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddKeywordGroupItemResponse) ProtoMessage() {}
+
+func (x *AddKeywordGroupItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddKeywordGroupItemResponse.ProtoReflect.Descriptor instead.
+func (*AddKeywordGroupItemResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *AddKeywordGroupItemResponse) GetKeywordGroup() *KeywordGroup {
+	if x != nil {
+		return x.KeywordGroup
+	}
+	return nil
+}
+
+// Removes a keyword from a group. The last keyword of a group cannot be removed.
+type RemoveKeywordGroupItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Keyword group ID
+	Keyword       string                 `protobuf:"bytes,2,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveKeywordGroupItemRequest) Reset() {
+	*x = RemoveKeywordGroupItemRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveKeywordGroupItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveKeywordGroupItemRequest) ProtoMessage() {}
+
+func (x *RemoveKeywordGroupItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveKeywordGroupItemRequest.ProtoReflect.Descriptor instead.
+func (*RemoveKeywordGroupItemRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *RemoveKeywordGroupItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RemoveKeywordGroupItemRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+type RemoveKeywordGroupItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeywordGroup  *KeywordGroup          `protobuf:"bytes,1,opt,name=keyword_group,json=keywordGroup,proto3" json:"keyword_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveKeywordGroupItemResponse) Reset() {
+	*x = RemoveKeywordGroupItemResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveKeywordGroupItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveKeywordGroupItemResponse) ProtoMessage() {}
+
+func (x *RemoveKeywordGroupItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveKeywordGroupItemResponse.ProtoReflect.Descriptor instead.
+func (*RemoveKeywordGroupItemResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *RemoveKeywordGroupItemResponse) GetKeywordGroup() *KeywordGroup {
+	if x != nil {
+		return x.KeywordGroup
+	}
+	return nil
+}
+
+type EnableKeywordGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnableKeywordGroupRequest) Reset() {
+	*x = EnableKeywordGroupRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnableKeywordGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableKeywordGroupRequest) ProtoMessage() {}
+
+func (x *EnableKeywordGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableKeywordGroupRequest.ProtoReflect.Descriptor instead.
+func (*EnableKeywordGroupRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *EnableKeywordGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type EnableKeywordGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeywordGroup  *KeywordGroup          `protobuf:"bytes,1,opt,name=keyword_group,json=keywordGroup,proto3" json:"keyword_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnableKeywordGroupResponse) Reset() {
+	*x = EnableKeywordGroupResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnableKeywordGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableKeywordGroupResponse) ProtoMessage() {}
+
+func (x *EnableKeywordGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableKeywordGroupResponse.ProtoReflect.Descriptor instead.
+func (*EnableKeywordGroupResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *EnableKeywordGroupResponse) GetKeywordGroup() *KeywordGroup {
+	if x != nil {
+		return x.KeywordGroup
+	}
+	return nil
+}
+
+type DisableKeywordGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableKeywordGroupRequest) Reset() {
+	*x = DisableKeywordGroupRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableKeywordGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableKeywordGroupRequest) ProtoMessage() {}
+
+func (x *DisableKeywordGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableKeywordGroupRequest.ProtoReflect.Descriptor instead.
+func (*DisableKeywordGroupRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DisableKeywordGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DisableKeywordGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeywordGroup  *KeywordGroup          `protobuf:"bytes,1,opt,name=keyword_group,json=keywordGroup,proto3" json:"keyword_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableKeywordGroupResponse) Reset() {
+	*x = DisableKeywordGroupResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableKeywordGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableKeywordGroupResponse) ProtoMessage() {}
+
+func (x *DisableKeywordGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableKeywordGroupResponse.ProtoReflect.Descriptor instead.
+func (*DisableKeywordGroupResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *DisableKeywordGroupResponse) GetKeywordGroup() *KeywordGroup {
+	if x != nil {
+		return x.KeywordGroup
+	}
+	return nil
+}
+
+type DeleteKeywordGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteKeywordGroupRequest) Reset() {
+	*x = DeleteKeywordGroupRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteKeywordGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteKeywordGroupRequest) ProtoMessage() {}
+
+func (x *DeleteKeywordGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteKeywordGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteKeywordGroupRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *DeleteKeywordGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteKeywordGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteKeywordGroupResponse) Reset() {
+	*x = DeleteKeywordGroupResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteKeywordGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteKeywordGroupResponse) ProtoMessage() {}
+
+func (x *DeleteKeywordGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteKeywordGroupResponse.ProtoReflect.Descriptor instead.
+func (*DeleteKeywordGroupResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{80}
+}
+
+// Returns the regular expression the keywords of a group compile to
+type GetKeywordGroupPatternRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKeywordGroupPatternRequest) Reset() {
+	*x = GetKeywordGroupPatternRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKeywordGroupPatternRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKeywordGroupPatternRequest) ProtoMessage() {}
+
+func (x *GetKeywordGroupPatternRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKeywordGroupPatternRequest.ProtoReflect.Descriptor instead.
+func (*GetKeywordGroupPatternRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetKeywordGroupPatternRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetKeywordGroupPatternResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pattern       string                 `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKeywordGroupPatternResponse) Reset() {
+	*x = GetKeywordGroupPatternResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKeywordGroupPatternResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKeywordGroupPatternResponse) ProtoMessage() {}
+
+func (x *GetKeywordGroupPatternResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKeywordGroupPatternResponse.ProtoReflect.Descriptor instead.
+func (*GetKeywordGroupPatternResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetKeywordGroupPatternResponse) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+// TestKeywordGroupRequest proposes a new keyword group, or edits of an existing
+// one when id is set
+type TestKeywordGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GenreId       string                 `protobuf:"bytes,1,opt,name=genre_id,json=genreId,proto3" json:"genre_id,omitempty"`                          // Genre of a new group; ignored when id is set
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`                                                   // Group the edits apply to; empty tests a new group
+	Keywords      []string               `protobuf:"bytes,3,rep,name=keywords,proto3" json:"keywords,omitempty"`                                       // Proposed keywords; empty keeps those of the group
+	FilterType    *string                `protobuf:"bytes,4,opt,name=filter_type,json=filterType,proto3,oneof" json:"filter_type,omitempty"`           // Proposed filter type; defaults to that of the group, or include
+	Days          int32                  `protobuf:"varint,5,opt,name=days,proto3" json:"days,omitempty"`                                              // Videos published in the last days; default 30, max 90
+	CrossLanguage *bool                  `protobuf:"varint,6,opt,name=cross_language,json=crossLanguage,proto3,oneof" json:"cross_language,omitempty"` // Proposed cross-language synonyms setting; defaults to that of the group, or off
+	Romaji        *bool                  `protobuf:"varint,7,opt,name=romaji,proto3,oneof" json:"romaji,omitempty"`                                    // Proposed romaji setting; defaults to that of the group, or off
+	Enabled       *bool                  `protobuf:"varint,8,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`                                  // Proposed enabled state; defaults to that of the group, or on
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestKeywordGroupRequest) Reset() {
+	*x = TestKeywordGroupRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestKeywordGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestKeywordGroupRequest) ProtoMessage() {}
+
+func (x *TestKeywordGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestKeywordGroupRequest.ProtoReflect.Descriptor instead.
+func (*TestKeywordGroupRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *TestKeywordGroupRequest) GetGenreId() string {
+	if x != nil {
+		return x.GenreId
+	}
+	return ""
+}
+
+func (x *TestKeywordGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TestKeywordGroupRequest) GetKeywords() []string {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+func (x *TestKeywordGroupRequest) GetFilterType() string {
+	if x != nil && x.FilterType != nil {
+		return *x.FilterType
+	}
+	return ""
+}
+
+func (x *TestKeywordGroupRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *TestKeywordGroupRequest) GetCrossLanguage() bool {
+	if x != nil && x.CrossLanguage != nil {
+		return *x.CrossLanguage
+	}
+	return false
+}
+
+func (x *TestKeywordGroupRequest) GetRomaji() bool {
+	if x != nil && x.Romaji != nil {
+		return *x.Romaji
+	}
+	return false
+}
+
+func (x *TestKeywordGroupRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+// TestKeywordGroupResponse lists the videos whose filter result the proposed
+// group changes. Videos are matched by title.
+type TestKeywordGroupResponse struct {
+	state           protoimpl.MessageState   `protogen:"open.v1"`
+	Pattern         string                   `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"` // Pattern generated from the proposed keywords
+	VideosEvaluated int32                    `protobuf:"varint,2,opt,name=videos_evaluated,json=videosEvaluated,proto3" json:"videos_evaluated,omitempty"`
+	Truncated       bool                     `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"` // Only the newest videos of the period were evaluated
+	NewlyIncluded   []*KeywordGroupTestMatch `protobuf:"bytes,4,rep,name=newly_included,json=newlyIncluded,proto3" json:"newly_included,omitempty"`
+	NewlyExcluded   []*KeywordGroupTestMatch `protobuf:"bytes,5,rep,name=newly_excluded,json=newlyExcluded,proto3" json:"newly_excluded,omitempty"` // Included before, excluded or neutral now
+	Enabled         bool                     `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`                                 // Whether the proposed group is enabled; a disabled group changes no result it matches
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TestKeywordGroupResponse) Reset() {
+	*x = TestKeywordGroupResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestKeywordGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestKeywordGroupResponse) ProtoMessage() {}
+
+func (x *TestKeywordGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestKeywordGroupResponse.ProtoReflect.Descriptor instead.
+func (*TestKeywordGroupResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *TestKeywordGroupResponse) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *TestKeywordGroupResponse) GetVideosEvaluated() int32 {
+	if x != nil {
+		return x.VideosEvaluated
+	}
+	return 0
+}
+
+func (x *TestKeywordGroupResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *TestKeywordGroupResponse) GetNewlyIncluded() []*KeywordGroupTestMatch {
+	if x != nil {
+		return x.NewlyIncluded
+	}
+	return nil
+}
+
+func (x *TestKeywordGroupResponse) GetNewlyExcluded() []*KeywordGroupTestMatch {
+	if x != nil {
+		return x.NewlyExcluded
+	}
+	return nil
+}
+
+func (x *TestKeywordGroupResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type KeywordGroupTestMatch struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	VideoId          string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	YoutubeVideoId   string                 `protobuf:"bytes,2,opt,name=youtube_video_id,json=youtubeVideoId,proto3" json:"youtube_video_id,omitempty"`
+	Title            string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	PublishedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	Before           string                 `protobuf:"bytes,5,opt,name=before,proto3" json:"before,omitempty"`                                 // Filter result with the saved groups: include, exclude or neutral
+	After            string                 `protobuf:"bytes,6,opt,name=after,proto3" json:"after,omitempty"`                                   // Filter result with the proposed group
+	KeywordGroup     string                 `protobuf:"bytes,7,opt,name=keyword_group,json=keywordGroup,proto3" json:"keyword_group,omitempty"` // Group whose match decided the change
+	MatchStart       int32                  `protobuf:"varint,8,opt,name=match_start,json=matchStart,proto3" json:"match_start,omitempty"`      // Character offsets of the match in the title
+	MatchEnd         int32                  `protobuf:"varint,9,opt,name=match_end,json=matchEnd,proto3" json:"match_end,omitempty"`
+	MatchedText      string                 `protobuf:"bytes,10,opt,name=matched_text,json=matchedText,proto3" json:"matched_text,omitempty"`
+	HighlightedTitle string                 `protobuf:"bytes,11,opt,name=highlighted_title,json=highlightedTitle,proto3" json:"highlighted_title,omitempty"` // Title with the match wrapped in [[ ]]
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *KeywordGroupTestMatch) Reset() {
+	*x = KeywordGroupTestMatch{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeywordGroupTestMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeywordGroupTestMatch) ProtoMessage() {}
+
+func (x *KeywordGroupTestMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeywordGroupTestMatch.ProtoReflect.Descriptor instead.
+func (*KeywordGroupTestMatch) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *KeywordGroupTestMatch) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *KeywordGroupTestMatch) GetYoutubeVideoId() string {
+	if x != nil {
+		return x.YoutubeVideoId
+	}
+	return ""
+}
+
+func (x *KeywordGroupTestMatch) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *KeywordGroupTestMatch) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+func (x *KeywordGroupTestMatch) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *KeywordGroupTestMatch) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *KeywordGroupTestMatch) GetKeywordGroup() string {
+	if x != nil {
+		return x.KeywordGroup
+	}
+	return ""
+}
+
+func (x *KeywordGroupTestMatch) GetMatchStart() int32 {
+	if x != nil {
+		return x.MatchStart
+	}
+	return 0
+}
+
+func (x *KeywordGroupTestMatch) GetMatchEnd() int32 {
+	if x != nil {
+		return x.MatchEnd
+	}
+	return 0
+}
+
+func (x *KeywordGroupTestMatch) GetMatchedText() string {
+	if x != nil {
+		return x.MatchedText
+	}
+	return ""
+}
+
+func (x *KeywordGroupTestMatch) GetHighlightedTitle() string {
+	if x != nil {
+		return x.HighlightedTitle
+	}
+	return ""
+}
+
+// Synonym dictionary messages. An entry maps a term to an alias; a
+// bidirectional entry also maps the alias back to the term. Entries whose
+// alias_language differs from language are cross-language and only apply to
+// keyword groups with cross_language set.
+type KeywordSynonym struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Language      string                 `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"` // en or ja
+	Term          string                 `protobuf:"bytes,3,opt,name=term,proto3" json:"term,omitempty"`
+	Alias         string                 `protobuf:"bytes,4,opt,name=alias,proto3" json:"alias,omitempty"`
+	AliasLanguage string                 `protobuf:"bytes,5,opt,name=alias_language,json=aliasLanguage,proto3" json:"alias_language,omitempty"` // en or ja
+	Bidirectional bool                   `protobuf:"varint,6,opt,name=bidirectional,proto3" json:"bidirectional,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeywordSynonym) Reset() {
+	*x = KeywordSynonym{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeywordSynonym) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeywordSynonym) ProtoMessage() {}
+
+func (x *KeywordSynonym) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeywordSynonym.ProtoReflect.Descriptor instead.
+func (*KeywordSynonym) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *KeywordSynonym) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *KeywordSynonym) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *KeywordSynonym) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *KeywordSynonym) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *KeywordSynonym) GetAliasLanguage() string {
+	if x != nil {
+		return x.AliasLanguage
+	}
+	return ""
+}
+
+func (x *KeywordSynonym) GetBidirectional() bool {
+	if x != nil {
+		return x.Bidirectional
+	}
+	return false
+}
+
+func (x *KeywordSynonym) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *KeywordSynonym) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Lists the entries of a language, or of all languages when language is empty,
+// ordered by language, term and alias
+type ListKeywordSynonymsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Language      string                 `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // Defaults to 50, at most 200
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKeywordSynonymsRequest) Reset() {
+	*x = ListKeywordSynonymsRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKeywordSynonymsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKeywordSynonymsRequest) ProtoMessage() {}
+
+func (x *ListKeywordSynonymsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKeywordSynonymsRequest.ProtoReflect.Descriptor instead.
+func (*ListKeywordSynonymsRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ListKeywordSynonymsRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *ListKeywordSynonymsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListKeywordSynonymsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListKeywordSynonymsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	KeywordSynonyms []*KeywordSynonym      `protobuf:"bytes,1,rep,name=keyword_synonyms,json=keywordSynonyms,proto3" json:"keyword_synonyms,omitempty"` // Ordered by language, term and alias
+	NextPageToken   string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount      int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListKeywordSynonymsResponse) Reset() {
+	*x = ListKeywordSynonymsResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKeywordSynonymsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKeywordSynonymsResponse) ProtoMessage() {}
+
+func (x *ListKeywordSynonymsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKeywordSynonymsResponse.ProtoReflect.Descriptor instead.
+func (*ListKeywordSynonymsResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ListKeywordSynonymsResponse) GetKeywordSynonyms() []*KeywordSynonym {
+	if x != nil {
+		return x.KeywordSynonyms
+	}
+	return nil
+}
+
+func (x *ListKeywordSynonymsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListKeywordSynonymsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type GetKeywordSynonymRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKeywordSynonymRequest) Reset() {
+	*x = GetKeywordSynonymRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKeywordSynonymRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKeywordSynonymRequest) ProtoMessage() {}
+
+func (x *GetKeywordSynonymRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKeywordSynonymRequest.ProtoReflect.Descriptor instead.
+func (*GetKeywordSynonymRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetKeywordSynonymRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetKeywordSynonymResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	KeywordSynonym *KeywordSynonym        `protobuf:"bytes,1,opt,name=keyword_synonym,json=keywordSynonym,proto3" json:"keyword_synonym,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetKeywordSynonymResponse) Reset() {
+	*x = GetKeywordSynonymResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKeywordSynonymResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKeywordSynonymResponse) ProtoMessage() {}
+
+func (x *GetKeywordSynonymResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKeywordSynonymResponse.ProtoReflect.Descriptor instead.
+func (*GetKeywordSynonymResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GetKeywordSynonymResponse) GetKeywordSynonym() *KeywordSynonym {
+	if x != nil {
+		return x.KeywordSynonym
+	}
+	return nil
+}
+
+type CreateKeywordSynonymRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Language      string                 `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"`
+	Term          string                 `protobuf:"bytes,2,opt,name=term,proto3" json:"term,omitempty"`
+	Alias         string                 `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"`
+	AliasLanguage string                 `protobuf:"bytes,4,opt,name=alias_language,json=aliasLanguage,proto3" json:"alias_language,omitempty"` // Defaults to language
+	Bidirectional bool                   `protobuf:"varint,5,opt,name=bidirectional,proto3" json:"bidirectional,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateKeywordSynonymRequest) Reset() {
+	*x = CreateKeywordSynonymRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateKeywordSynonymRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateKeywordSynonymRequest) ProtoMessage() {}
+
+func (x *CreateKeywordSynonymRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateKeywordSynonymRequest.ProtoReflect.Descriptor instead.
+func (*CreateKeywordSynonymRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *CreateKeywordSynonymRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *CreateKeywordSynonymRequest) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *CreateKeywordSynonymRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *CreateKeywordSynonymRequest) GetAliasLanguage() string {
+	if x != nil {
+		return x.AliasLanguage
+	}
+	return ""
+}
+
+func (x *CreateKeywordSynonymRequest) GetBidirectional() bool {
+	if x != nil {
+		return x.Bidirectional
+	}
+	return false
+}
+
+type CreateKeywordSynonymResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	KeywordSynonym *KeywordSynonym        `protobuf:"bytes,1,opt,name=keyword_synonym,json=keywordSynonym,proto3" json:"keyword_synonym,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateKeywordSynonymResponse) Reset() {
+	*x = CreateKeywordSynonymResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateKeywordSynonymResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateKeywordSynonymResponse) ProtoMessage() {}
+
+func (x *CreateKeywordSynonymResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateKeywordSynonymResponse.ProtoReflect.Descriptor instead.
+func (*CreateKeywordSynonymResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *CreateKeywordSynonymResponse) GetKeywordSynonym() *KeywordSynonym {
+	if x != nil {
+		return x.KeywordSynonym
+	}
+	return nil
+}
+
+// Updates the fields that are set and leaves the others unchanged. The
+// language of the term cannot change.
+type UpdateKeywordSynonymRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Term          *string                `protobuf:"bytes,2,opt,name=term,proto3,oneof" json:"term,omitempty"`
+	Alias         *string                `protobuf:"bytes,3,opt,name=alias,proto3,oneof" json:"alias,omitempty"`
+	AliasLanguage *string                `protobuf:"bytes,4,opt,name=alias_language,json=aliasLanguage,proto3,oneof" json:"alias_language,omitempty"`
+	Bidirectional *bool                  `protobuf:"varint,5,opt,name=bidirectional,proto3,oneof" json:"bidirectional,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateKeywordSynonymRequest) Reset() {
+	*x = UpdateKeywordSynonymRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateKeywordSynonymRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateKeywordSynonymRequest) ProtoMessage() {}
+
+func (x *UpdateKeywordSynonymRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateKeywordSynonymRequest.ProtoReflect.Descriptor instead.
+func (*UpdateKeywordSynonymRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *UpdateKeywordSynonymRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateKeywordSynonymRequest) GetTerm() string {
+	if x != nil && x.Term != nil {
+		return *x.Term
+	}
+	return ""
+}
+
+func (x *UpdateKeywordSynonymRequest) GetAlias() string {
+	if x != nil && x.Alias != nil {
+		return *x.Alias
+	}
+	return ""
+}
+
+func (x *UpdateKeywordSynonymRequest) GetAliasLanguage() string {
+	if x != nil && x.AliasLanguage != nil {
+		return *x.AliasLanguage
+	}
+	return ""
+}
+
+func (x *UpdateKeywordSynonymRequest) GetBidirectional() bool {
+	if x != nil && x.Bidirectional != nil {
+		return *x.Bidirectional
+	}
+	return false
+}
+
+type UpdateKeywordSynonymResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	KeywordSynonym *KeywordSynonym        `protobuf:"bytes,1,opt,name=keyword_synonym,json=keywordSynonym,proto3" json:"keyword_synonym,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateKeywordSynonymResponse) Reset() {
+	*x = UpdateKeywordSynonymResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateKeywordSynonymResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateKeywordSynonymResponse) ProtoMessage() {}
+
+func (x *UpdateKeywordSynonymResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateKeywordSynonymResponse.ProtoReflect.Descriptor instead.
+func (*UpdateKeywordSynonymResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *UpdateKeywordSynonymResponse) GetKeywordSynonym() *KeywordSynonym {
+	if x != nil {
+		return x.KeywordSynonym
+	}
+	return nil
+}
+
+type DeleteKeywordSynonymRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteKeywordSynonymRequest) Reset() {
+	*x = DeleteKeywordSynonymRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteKeywordSynonymRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteKeywordSynonymRequest) ProtoMessage() {}
+
+func (x *DeleteKeywordSynonymRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteKeywordSynonymRequest.ProtoReflect.Descriptor instead.
+func (*DeleteKeywordSynonymRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *DeleteKeywordSynonymRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteKeywordSynonymResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteKeywordSynonymResponse) Reset() {
+	*x = DeleteKeywordSynonymResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteKeywordSynonymResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteKeywordSynonymResponse) ProtoMessage() {}
+
+func (x *DeleteKeywordSynonymResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteKeywordSynonymResponse.ProtoReflect.Descriptor instead.
+func (*DeleteKeywordSynonymResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{96}
+}
+
+// Video-Genre relationship messages
+type VideoGenre struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VideoId       string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	GenreId       string                 `protobuf:"bytes,2,opt,name=genre_id,json=genreId,proto3" json:"genre_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Match         *GenreMatch            `protobuf:"bytes,4,opt,name=match,proto3" json:"match,omitempty"` // Why the video is in the genre; unset for assignments made before matches were recorded
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VideoGenre) Reset() {
+	*x = VideoGenre{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VideoGenre) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoGenre) ProtoMessage() {}
+
+func (x *VideoGenre) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoGenre.ProtoReflect.Descriptor instead.
+func (*VideoGenre) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *VideoGenre) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *VideoGenre) GetGenreId() string {
+	if x != nil {
+		return x.GenreId
+	}
+	return ""
+}
+
+func (x *VideoGenre) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *DisableKeywordResponse) GetKeyword() *Keyword {
+func (x *VideoGenre) GetMatch() *GenreMatch {
 	if x != nil {
-		return x.Keyword
+		return x.Match
 	}
 	return nil
 }
 
-type DeleteKeywordRequest struct {
+// Result of the keyword groups of a genre for a video, evaluated when the
+// video was assigned to the genre
+type GenreMatch struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`   // include, exclude or neutral; a video assigned by hand may match no group
+	Matches       []*GenreKeywordMatch   `protobuf:"bytes,2,rep,name=matches,proto3" json:"matches,omitempty"` // Exclusions, then inclusions, each in group order
+	EvaluatedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=evaluated_at,json=evaluatedAt,proto3" json:"evaluated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteKeywordRequest) Reset() {
-	*x = DeleteKeywordRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[55]
+func (x *GenreMatch) Reset() {
+	*x = GenreMatch{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteKeywordRequest) String() string {
+func (x *GenreMatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteKeywordRequest) ProtoMessage() {}
+func (*GenreMatch) ProtoMessage() {}
 
-func (x *DeleteKeywordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[55]
+func (x *GenreMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3287,39 +5948,62 @@ func (x *DeleteKeywordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteKeywordRequest.ProtoReflect.Descriptor instead.
-func (*DeleteKeywordRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use GenreMatch.ProtoReflect.Descriptor instead.
+func (*GenreMatch) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *DeleteKeywordRequest) GetId() string {
+func (x *GenreMatch) GetResult() string {
 	if x != nil {
-		return x.Id
+		return x.Result
 	}
 	return ""
 }
 
-type DeleteKeywordResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GenreMatch) GetMatches() []*GenreKeywordMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
 }
 
-func (x *DeleteKeywordResponse) Reset() {
-	*x = DeleteKeywordResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[56]
+func (x *GenreMatch) GetEvaluatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EvaluatedAt
+	}
+	return nil
+}
+
+type GenreKeywordMatch struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	KeywordGroupId string                 `protobuf:"bytes,1,opt,name=keyword_group_id,json=keywordGroupId,proto3" json:"keyword_group_id,omitempty"`
+	KeywordGroup   string                 `protobuf:"bytes,2,opt,name=keyword_group,json=keywordGroup,proto3" json:"keyword_group,omitempty"`
+	FilterType     string                 `protobuf:"bytes,3,opt,name=filter_type,json=filterType,proto3" json:"filter_type,omitempty"`  // include or exclude
+	Field          string                 `protobuf:"bytes,4,opt,name=field,proto3" json:"field,omitempty"`                              // Field of the video matched, such as title
+	MatchStart     int32                  `protobuf:"varint,5,opt,name=match_start,json=matchStart,proto3" json:"match_start,omitempty"` // Character offsets of the match in the field
+	MatchEnd       int32                  `protobuf:"varint,6,opt,name=match_end,json=matchEnd,proto3" json:"match_end,omitempty"`
+	MatchedText    string                 `protobuf:"bytes,7,opt,name=matched_text,json=matchedText,proto3" json:"matched_text,omitempty"`
+	Decisive       bool                   `protobuf:"varint,8,opt,name=decisive,proto3" json:"decisive,omitempty"`     // The match decided the result
+	Overridden     bool                   `protobuf:"varint,9,opt,name=overridden,proto3" json:"overridden,omitempty"` // An inclusion overridden by a matching exclusion
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GenreKeywordMatch) Reset() {
+	*x = GenreKeywordMatch{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteKeywordResponse) String() string {
+func (x *GenreKeywordMatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteKeywordResponse) ProtoMessage() {}
+func (*GenreKeywordMatch) ProtoMessage() {}
 
-func (x *DeleteKeywordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[56]
+func (x *GenreKeywordMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3330,82 +6014,86 @@ func (x *DeleteKeywordResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteKeywordResponse.ProtoReflect.Descriptor instead.
-func (*DeleteKeywordResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use GenreKeywordMatch.ProtoReflect.Descriptor instead.
+func (*GenreKeywordMatch) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{99}
 }
 
-// Video-Genre relationship messages
-type VideoGenre struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	VideoId       string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
-	GenreId       string                 `protobuf:"bytes,2,opt,name=genre_id,json=genreId,proto3" json:"genre_id,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GenreKeywordMatch) GetKeywordGroupId() string {
+	if x != nil {
+		return x.KeywordGroupId
+	}
+	return ""
 }
 
-func (x *VideoGenre) Reset() {
-	*x = VideoGenre{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[57]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GenreKeywordMatch) GetKeywordGroup() string {
+	if x != nil {
+		return x.KeywordGroup
+	}
+	return ""
 }
 
-func (x *VideoGenre) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GenreKeywordMatch) GetFilterType() string {
+	if x != nil {
+		return x.FilterType
+	}
+	return ""
 }
 
-func (*VideoGenre) ProtoMessage() {}
+func (x *GenreKeywordMatch) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
 
-func (x *VideoGenre) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[57]
+func (x *GenreKeywordMatch) GetMatchStart() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MatchStart
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use VideoGenre.ProtoReflect.Descriptor instead.
-func (*VideoGenre) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{57}
+func (x *GenreKeywordMatch) GetMatchEnd() int32 {
+	if x != nil {
+		return x.MatchEnd
+	}
+	return 0
 }
 
-func (x *VideoGenre) GetVideoId() string {
+func (x *GenreKeywordMatch) GetMatchedText() string {
 	if x != nil {
-		return x.VideoId
+		return x.MatchedText
 	}
 	return ""
 }
 
-func (x *VideoGenre) GetGenreId() string {
+func (x *GenreKeywordMatch) GetDecisive() bool {
 	if x != nil {
-		return x.GenreId
+		return x.Decisive
 	}
-	return ""
+	return false
 }
 
-func (x *VideoGenre) GetCreatedAt() *timestamppb.Timestamp {
+func (x *GenreKeywordMatch) GetOverridden() bool {
 	if x != nil {
-		return x.CreatedAt
+		return x.Overridden
 	}
-	return nil
+	return false
 }
 
 type ListVideoGenresRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	VideoId       string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // Defaults to 50, at most 200
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListVideoGenresRequest) Reset() {
 	*x = ListVideoGenresRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[58]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3417,7 +6105,7 @@ func (x *ListVideoGenresRequest) String() string {
 func (*ListVideoGenresRequest) ProtoMessage() {}
 
 func (x *ListVideoGenresRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[58]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3430,7 +6118,7 @@ func (x *ListVideoGenresRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListVideoGenresRequest.ProtoReflect.Descriptor instead.
 func (*ListVideoGenresRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{58}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *ListVideoGenresRequest) GetVideoId() string {
@@ -3440,16 +6128,32 @@ func (x *ListVideoGenresRequest) GetVideoId() string {
 	return ""
 }
 
+func (x *ListVideoGenresRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListVideoGenresRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type ListVideoGenresResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	VideoGenres   []*VideoGenre          `protobuf:"bytes,1,rep,name=video_genres,json=videoGenres,proto3" json:"video_genres,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListVideoGenresResponse) Reset() {
 	*x = ListVideoGenresResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[59]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3461,7 +6165,7 @@ func (x *ListVideoGenresResponse) String() string {
 func (*ListVideoGenresResponse) ProtoMessage() {}
 
 func (x *ListVideoGenresResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[59]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3474,7 +6178,7 @@ func (x *ListVideoGenresResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListVideoGenresResponse.ProtoReflect.Descriptor instead.
 func (*ListVideoGenresResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{59}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *ListVideoGenresResponse) GetVideoGenres() []*VideoGenre {
@@ -3484,6 +6188,20 @@ func (x *ListVideoGenresResponse) GetVideoGenres() []*VideoGenre {
 	return nil
 }
 
+func (x *ListVideoGenresResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListVideoGenresResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
 type AssignVideoToGenreRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	VideoId       string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
@@ -3494,7 +6212,7 @@ type AssignVideoToGenreRequest struct {
 
 func (x *AssignVideoToGenreRequest) Reset() {
 	*x = AssignVideoToGenreRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[60]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3506,7 +6224,7 @@ func (x *AssignVideoToGenreRequest) String() string {
 func (*AssignVideoToGenreRequest) ProtoMessage() {}
 
 func (x *AssignVideoToGenreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[60]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3519,7 +6237,7 @@ func (x *AssignVideoToGenreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssignVideoToGenreRequest.ProtoReflect.Descriptor instead.
 func (*AssignVideoToGenreRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{60}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *AssignVideoToGenreRequest) GetVideoId() string {
@@ -3545,7 +6263,7 @@ type AssignVideoToGenreResponse struct {
 
 func (x *AssignVideoToGenreResponse) Reset() {
 	*x = AssignVideoToGenreResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[61]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3557,7 +6275,7 @@ func (x *AssignVideoToGenreResponse) String() string {
 func (*AssignVideoToGenreResponse) ProtoMessage() {}
 
 func (x *AssignVideoToGenreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[61]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3570,7 +6288,7 @@ func (x *AssignVideoToGenreResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssignVideoToGenreResponse.ProtoReflect.Descriptor instead.
 func (*AssignVideoToGenreResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{61}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{103}
 }
 
 func (x *AssignVideoToGenreResponse) GetVideoGenre() *VideoGenre {
@@ -3590,7 +6308,7 @@ type RemoveVideoFromGenreRequest struct {
 
 func (x *RemoveVideoFromGenreRequest) Reset() {
 	*x = RemoveVideoFromGenreRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[62]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3602,7 +6320,7 @@ func (x *RemoveVideoFromGenreRequest) String() string {
 func (*RemoveVideoFromGenreRequest) ProtoMessage() {}
 
 func (x *RemoveVideoFromGenreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[62]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3615,7 +6333,7 @@ func (x *RemoveVideoFromGenreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveVideoFromGenreRequest.ProtoReflect.Descriptor instead.
 func (*RemoveVideoFromGenreRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{62}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{104}
 }
 
 func (x *RemoveVideoFromGenreRequest) GetVideoId() string {
@@ -3640,7 +6358,7 @@ type RemoveVideoFromGenreResponse struct {
 
 func (x *RemoveVideoFromGenreResponse) Reset() {
 	*x = RemoveVideoFromGenreResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[63]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3652,7 +6370,7 @@ func (x *RemoveVideoFromGenreResponse) String() string {
 func (*RemoveVideoFromGenreResponse) ProtoMessage() {}
 
 func (x *RemoveVideoFromGenreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[63]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3665,7 +6383,7 @@ func (x *RemoveVideoFromGenreResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveVideoFromGenreResponse.ProtoReflect.Descriptor instead.
 func (*RemoveVideoFromGenreResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{63}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{105}
 }
 
 // Audit log messages
@@ -3688,7 +6406,7 @@ type AuditLog struct {
 
 func (x *AuditLog) Reset() {
 	*x = AuditLog{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[64]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3700,7 +6418,7 @@ func (x *AuditLog) String() string {
 func (*AuditLog) ProtoMessage() {}
 
 func (x *AuditLog) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[64]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3713,7 +6431,7 @@ func (x *AuditLog) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuditLog.ProtoReflect.Descriptor instead.
 func (*AuditLog) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{64}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{106}
 }
 
 func (x *AuditLog) GetId() string {
@@ -3798,15 +6516,19 @@ type ListAuditLogsRequest struct {
 	ActorId       string                 `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
 	ResourceType  string                 `protobuf:"bytes,2,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
 	ResourceId    string                 `protobuf:"bytes,3,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // Defaults to 100, at most 1000
 	PageToken     string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Action        string                 `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`                                    // e.g. create, update, enable, disable, delete, assign, remove
+	ChangedField  string                 `protobuf:"bytes,7,opt,name=changed_field,json=changedField,proto3" json:"changed_field,omitempty"`    // Only logs whose old or new values include this field, e.g. category_ids
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`    // Inclusive
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"` // Exclusive
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListAuditLogsRequest) Reset() {
 	*x = ListAuditLogsRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[65]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3818,7 +6540,7 @@ func (x *ListAuditLogsRequest) String() string {
 func (*ListAuditLogsRequest) ProtoMessage() {}
 
 func (x *ListAuditLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[65]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3831,7 +6553,7 @@ func (x *ListAuditLogsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAuditLogsRequest.ProtoReflect.Descriptor instead.
 func (*ListAuditLogsRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{65}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{107}
 }
 
 func (x *ListAuditLogsRequest) GetActorId() string {
@@ -3869,6 +6591,34 @@ func (x *ListAuditLogsRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListAuditLogsRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ListAuditLogsRequest) GetChangedField() string {
+	if x != nil {
+		return x.ChangedField
+	}
+	return ""
+}
+
+func (x *ListAuditLogsRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ListAuditLogsRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
 type ListAuditLogsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AuditLogs     []*AuditLog            `protobuf:"bytes,1,rep,name=audit_logs,json=auditLogs,proto3" json:"audit_logs,omitempty"`
@@ -3880,7 +6630,7 @@ type ListAuditLogsResponse struct {
 
 func (x *ListAuditLogsResponse) Reset() {
 	*x = ListAuditLogsResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[66]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3892,7 +6642,7 @@ func (x *ListAuditLogsResponse) String() string {
 func (*ListAuditLogsResponse) ProtoMessage() {}
 
 func (x *ListAuditLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[66]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3905,7 +6655,7 @@ func (x *ListAuditLogsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAuditLogsResponse.ProtoReflect.Descriptor instead.
 func (*ListAuditLogsResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{66}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{108}
 }
 
 func (x *ListAuditLogsResponse) GetAuditLogs() []*AuditLog {
@@ -3938,7 +6688,7 @@ type GetAuditLogRequest struct {
 
 func (x *GetAuditLogRequest) Reset() {
 	*x = GetAuditLogRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[67]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3949,8 +6699,104 @@ func (x *GetAuditLogRequest) String() string {
 
 func (*GetAuditLogRequest) ProtoMessage() {}
 
-func (x *GetAuditLogRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[67]
+func (x *GetAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*GetAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *GetAuditLogRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetAuditLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuditLog      *AuditLog              `protobuf:"bytes,1,opt,name=audit_log,json=auditLog,proto3" json:"audit_log,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuditLogResponse) Reset() {
+	*x = GetAuditLogResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditLogResponse) ProtoMessage() {}
+
+func (x *GetAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*GetAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *GetAuditLogResponse) GetAuditLog() *AuditLog {
+	if x != nil {
+		return x.AuditLog
+	}
+	return nil
+}
+
+// Exports every audit log matching the filters as JSON Lines, newest first, for
+// compliance reviews. Concatenating the data of all messages gives the export.
+type ExportAuditLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActorId       string                 `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	ResourceType  string                 `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	ResourceId    string                 `protobuf:"bytes,4,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	ChangedField  string                 `protobuf:"bytes,5,opt,name=changed_field,json=changedField,proto3" json:"changed_field,omitempty"`
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`    // Inclusive
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"` // Exclusive
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAuditLogsRequest) Reset() {
+	*x = ExportAuditLogsRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAuditLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAuditLogsRequest) ProtoMessage() {}
+
+func (x *ExportAuditLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3961,40 +6807,82 @@ func (x *GetAuditLogRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAuditLogRequest.ProtoReflect.Descriptor instead.
-func (*GetAuditLogRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use ExportAuditLogsRequest.ProtoReflect.Descriptor instead.
+func (*ExportAuditLogsRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *GetAuditLogRequest) GetId() string {
+func (x *ExportAuditLogsRequest) GetActorId() string {
 	if x != nil {
-		return x.Id
+		return x.ActorId
 	}
 	return ""
 }
 
-type GetAuditLogResponse struct {
+func (x *ExportAuditLogsRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ExportAuditLogsRequest) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *ExportAuditLogsRequest) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *ExportAuditLogsRequest) GetChangedField() string {
+	if x != nil {
+		return x.ChangedField
+	}
+	return ""
+}
+
+func (x *ExportAuditLogsRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ExportAuditLogsRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+type ExportAuditLogsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AuditLog      *AuditLog              `protobuf:"bytes,1,opt,name=audit_log,json=auditLog,proto3" json:"audit_log,omitempty"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"` // One or more complete JSON lines
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAuditLogResponse) Reset() {
-	*x = GetAuditLogResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[68]
+func (x *ExportAuditLogsResponse) Reset() {
+	*x = ExportAuditLogsResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAuditLogResponse) String() string {
+func (x *ExportAuditLogsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAuditLogResponse) ProtoMessage() {}
+func (*ExportAuditLogsResponse) ProtoMessage() {}
 
-func (x *GetAuditLogResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[68]
+func (x *ExportAuditLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4005,37 +6893,46 @@ func (x *GetAuditLogResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAuditLogResponse.ProtoReflect.Descriptor instead.
-func (*GetAuditLogResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use ExportAuditLogsResponse.ProtoReflect.Descriptor instead.
+func (*ExportAuditLogsResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *GetAuditLogResponse) GetAuditLog() *AuditLog {
+func (x *ExportAuditLogsResponse) GetData() []byte {
 	if x != nil {
-		return x.AuditLog
+		return x.Data
 	}
 	return nil
 }
 
 // Batch job messages
 type BatchJob struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	JobType       string                 `protobuf:"bytes,2,opt,name=job_type,json=jobType,proto3" json:"job_type,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	Parameters    map[string]string      `protobuf:"bytes,4,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
-	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
-	ErrorMessage  string                 `protobuf:"bytes,7,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
-	Statistics    map[string]string      `protobuf:"bytes,8,rep,name=statistics,proto3" json:"statistics,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	JobType      string                 `protobuf:"bytes,2,opt,name=job_type,json=jobType,proto3" json:"job_type,omitempty"`
+	Status       string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Parameters   map[string]string      `protobuf:"bytes,4,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	StartedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	ErrorMessage string                 `protobuf:"bytes,7,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Statistics   map[string]string      `protobuf:"bytes,8,rep,name=statistics,proto3" json:"statistics,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Set once cancellation was requested; a running job stops at its next check
+	CancelRequestedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=cancel_requested_at,json=cancelRequestedAt,proto3" json:"cancel_requested_at,omitempty"`
+	// Job this job re-runs, if it is a retry
+	RetryOf string `protobuf:"bytes,11,opt,name=retry_of,json=retryOf,proto3" json:"retry_of,omitempty"`
+	// Live progress: items processed out of total (zero when unknown) and the
+	// item being processed, such as the genre being collected
+	ProgressProcessed int32  `protobuf:"varint,12,opt,name=progress_processed,json=progressProcessed,proto3" json:"progress_processed,omitempty"`
+	ProgressTotal     int32  `protobuf:"varint,13,opt,name=progress_total,json=progressTotal,proto3" json:"progress_total,omitempty"`
+	ProgressCurrent   string `protobuf:"bytes,14,opt,name=progress_current,json=progressCurrent,proto3" json:"progress_current,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *BatchJob) Reset() {
 	*x = BatchJob{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[69]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4047,7 +6944,7 @@ func (x *BatchJob) String() string {
 func (*BatchJob) ProtoMessage() {}
 
 func (x *BatchJob) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[69]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4060,7 +6957,7 @@ func (x *BatchJob) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchJob.ProtoReflect.Descriptor instead.
 func (*BatchJob) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{69}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{113}
 }
 
 func (x *BatchJob) GetId() string {
@@ -4126,11 +7023,46 @@ func (x *BatchJob) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *BatchJob) GetCancelRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelRequestedAt
+	}
+	return nil
+}
+
+func (x *BatchJob) GetRetryOf() string {
+	if x != nil {
+		return x.RetryOf
+	}
+	return ""
+}
+
+func (x *BatchJob) GetProgressProcessed() int32 {
+	if x != nil {
+		return x.ProgressProcessed
+	}
+	return 0
+}
+
+func (x *BatchJob) GetProgressTotal() int32 {
+	if x != nil {
+		return x.ProgressTotal
+	}
+	return 0
+}
+
+func (x *BatchJob) GetProgressCurrent() string {
+	if x != nil {
+		return x.ProgressCurrent
+	}
+	return ""
+}
+
 type ListBatchJobsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	JobType       string                 `protobuf:"bytes,1,opt,name=job_type,json=jobType,proto3" json:"job_type,omitempty"`
 	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // Defaults to 100, at most 1000
 	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -4138,7 +7070,7 @@ type ListBatchJobsRequest struct {
 
 func (x *ListBatchJobsRequest) Reset() {
 	*x = ListBatchJobsRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[70]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4150,7 +7082,7 @@ func (x *ListBatchJobsRequest) String() string {
 func (*ListBatchJobsRequest) ProtoMessage() {}
 
 func (x *ListBatchJobsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[70]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4163,7 +7095,7 @@ func (x *ListBatchJobsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBatchJobsRequest.ProtoReflect.Descriptor instead.
 func (*ListBatchJobsRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{70}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{114}
 }
 
 func (x *ListBatchJobsRequest) GetJobType() string {
@@ -4205,7 +7137,7 @@ type ListBatchJobsResponse struct {
 
 func (x *ListBatchJobsResponse) Reset() {
 	*x = ListBatchJobsResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[71]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4217,7 +7149,7 @@ func (x *ListBatchJobsResponse) String() string {
 func (*ListBatchJobsResponse) ProtoMessage() {}
 
 func (x *ListBatchJobsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[71]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4230,7 +7162,7 @@ func (x *ListBatchJobsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBatchJobsResponse.ProtoReflect.Descriptor instead.
 func (*ListBatchJobsResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{71}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{115}
 }
 
 func (x *ListBatchJobsResponse) GetBatchJobs() []*BatchJob {
@@ -4263,7 +7195,7 @@ type GetBatchJobRequest struct {
 
 func (x *GetBatchJobRequest) Reset() {
 	*x = GetBatchJobRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[72]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4275,7 +7207,7 @@ func (x *GetBatchJobRequest) String() string {
 func (*GetBatchJobRequest) ProtoMessage() {}
 
 func (x *GetBatchJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[72]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4288,7 +7220,7 @@ func (x *GetBatchJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBatchJobRequest.ProtoReflect.Descriptor instead.
 func (*GetBatchJobRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{72}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{116}
 }
 
 func (x *GetBatchJobRequest) GetId() string {
@@ -4307,7 +7239,7 @@ type GetBatchJobResponse struct {
 
 func (x *GetBatchJobResponse) Reset() {
 	*x = GetBatchJobResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[73]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4319,7 +7251,7 @@ func (x *GetBatchJobResponse) String() string {
 func (*GetBatchJobResponse) ProtoMessage() {}
 
 func (x *GetBatchJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[73]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4332,7 +7264,7 @@ func (x *GetBatchJobResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBatchJobResponse.ProtoReflect.Descriptor instead.
 func (*GetBatchJobResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{73}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{117}
 }
 
 func (x *GetBatchJobResponse) GetBatchJob() *BatchJob {
@@ -4342,6 +7274,183 @@ func (x *GetBatchJobResponse) GetBatchJob() *BatchJob {
 	return nil
 }
 
+type CancelBatchJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBatchJobRequest) Reset() {
+	*x = CancelBatchJobRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBatchJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBatchJobRequest) ProtoMessage() {}
+
+func (x *CancelBatchJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBatchJobRequest.ProtoReflect.Descriptor instead.
+func (*CancelBatchJobRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *CancelBatchJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelBatchJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BatchJob      *BatchJob              `protobuf:"bytes,1,opt,name=batch_job,json=batchJob,proto3" json:"batch_job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBatchJobResponse) Reset() {
+	*x = CancelBatchJobResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBatchJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBatchJobResponse) ProtoMessage() {}
+
+func (x *CancelBatchJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBatchJobResponse.ProtoReflect.Descriptor instead.
+func (*CancelBatchJobResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *CancelBatchJobResponse) GetBatchJob() *BatchJob {
+	if x != nil {
+		return x.BatchJob
+	}
+	return nil
+}
+
+// Re-runs a finished job with the same parameters in the background
+type RetryBatchJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryBatchJobRequest) Reset() {
+	*x = RetryBatchJobRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryBatchJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryBatchJobRequest) ProtoMessage() {}
+
+func (x *RetryBatchJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryBatchJobRequest.ProtoReflect.Descriptor instead.
+func (*RetryBatchJobRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *RetryBatchJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RetryBatchJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BatchJob      *BatchJob              `protobuf:"bytes,1,opt,name=batch_job,json=batchJob,proto3" json:"batch_job,omitempty"` // The new job
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryBatchJobResponse) Reset() {
+	*x = RetryBatchJobResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryBatchJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryBatchJobResponse) ProtoMessage() {}
+
+func (x *RetryBatchJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryBatchJobResponse.ProtoReflect.Descriptor instead.
+func (*RetryBatchJobResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *RetryBatchJobResponse) GetBatchJob() *BatchJob {
+	if x != nil {
+		return x.BatchJob
+	}
+	return nil
+}
+
 // Snapshot messages
 type VideoSnapshot struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
@@ -4354,13 +7463,19 @@ type VideoSnapshot struct {
 	SubscriptionCount int64                  `protobuf:"varint,7,opt,name=subscription_count,json=subscriptionCount,proto3" json:"subscription_count,omitempty"`
 	Source            string                 `protobuf:"bytes,8,opt,name=source,proto3" json:"source,omitempty"`
 	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Offset of measured_at from published_at + checkpoint_hour (positive when late)
+	DriftSeconds int64 `protobuf:"varint,10,opt,name=drift_seconds,json=driftSeconds,proto3" json:"drift_seconds,omitempty"`
+	// Unset for snapshots taken before comments were recorded
+	CommentsCount *int64 `protobuf:"varint,11,opt,name=comments_count,json=commentsCount,proto3,oneof" json:"comments_count,omitempty"`
+	// Counts not available for every snapshot, keyed by metric name (e.g. favorite_count)
+	Metrics       map[string]int64 `protobuf:"bytes,12,rep,name=metrics,proto3" json:"metrics,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *VideoSnapshot) Reset() {
 	*x = VideoSnapshot{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[74]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4372,7 +7487,7 @@ func (x *VideoSnapshot) String() string {
 func (*VideoSnapshot) ProtoMessage() {}
 
 func (x *VideoSnapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[74]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4385,7 +7500,7 @@ func (x *VideoSnapshot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VideoSnapshot.ProtoReflect.Descriptor instead.
 func (*VideoSnapshot) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{74}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{122}
 }
 
 func (x *VideoSnapshot) GetId() string {
@@ -4427,26 +7542,47 @@ func (x *VideoSnapshot) GetLikesCount() int64 {
 	if x != nil {
 		return x.LikesCount
 	}
-	return 0
+	return 0
+}
+
+func (x *VideoSnapshot) GetSubscriptionCount() int64 {
+	if x != nil {
+		return x.SubscriptionCount
+	}
+	return 0
+}
+
+func (x *VideoSnapshot) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *VideoSnapshot) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *VideoSnapshot) GetSubscriptionCount() int64 {
+func (x *VideoSnapshot) GetDriftSeconds() int64 {
 	if x != nil {
-		return x.SubscriptionCount
+		return x.DriftSeconds
 	}
 	return 0
 }
 
-func (x *VideoSnapshot) GetSource() string {
-	if x != nil {
-		return x.Source
+func (x *VideoSnapshot) GetCommentsCount() int64 {
+	if x != nil && x.CommentsCount != nil {
+		return *x.CommentsCount
 	}
-	return ""
+	return 0
 }
 
-func (x *VideoSnapshot) GetCreatedAt() *timestamppb.Timestamp {
+func (x *VideoSnapshot) GetMetrics() map[string]int64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Metrics
 	}
 	return nil
 }
@@ -4461,7 +7597,7 @@ type CreateSnapshotRequest struct {
 
 func (x *CreateSnapshotRequest) Reset() {
 	*x = CreateSnapshotRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[75]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4473,7 +7609,7 @@ func (x *CreateSnapshotRequest) String() string {
 func (*CreateSnapshotRequest) ProtoMessage() {}
 
 func (x *CreateSnapshotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[75]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4486,7 +7622,7 @@ func (x *CreateSnapshotRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSnapshotRequest.ProtoReflect.Descriptor instead.
 func (*CreateSnapshotRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{75}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{123}
 }
 
 func (x *CreateSnapshotRequest) GetVideoId() string {
@@ -4512,7 +7648,7 @@ type CreateSnapshotResponse struct {
 
 func (x *CreateSnapshotResponse) Reset() {
 	*x = CreateSnapshotResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[76]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4524,7 +7660,7 @@ func (x *CreateSnapshotResponse) String() string {
 func (*CreateSnapshotResponse) ProtoMessage() {}
 
 func (x *CreateSnapshotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[76]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4537,7 +7673,7 @@ func (x *CreateSnapshotResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSnapshotResponse.ProtoReflect.Descriptor instead.
 func (*CreateSnapshotResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{76}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{124}
 }
 
 func (x *CreateSnapshotResponse) GetSnapshot() *VideoSnapshot {
@@ -4557,7 +7693,7 @@ type GetSnapshotRequest struct {
 
 func (x *GetSnapshotRequest) Reset() {
 	*x = GetSnapshotRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[77]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4569,7 +7705,7 @@ func (x *GetSnapshotRequest) String() string {
 func (*GetSnapshotRequest) ProtoMessage() {}
 
 func (x *GetSnapshotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[77]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4582,7 +7718,7 @@ func (x *GetSnapshotRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSnapshotRequest.ProtoReflect.Descriptor instead.
 func (*GetSnapshotRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{77}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{125}
 }
 
 func (x *GetSnapshotRequest) GetVideoId() string {
@@ -4608,7 +7744,7 @@ type GetSnapshotResponse struct {
 
 func (x *GetSnapshotResponse) Reset() {
 	*x = GetSnapshotResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[78]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4620,7 +7756,7 @@ func (x *GetSnapshotResponse) String() string {
 func (*GetSnapshotResponse) ProtoMessage() {}
 
 func (x *GetSnapshotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[78]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4633,7 +7769,7 @@ func (x *GetSnapshotResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSnapshotResponse.ProtoReflect.Descriptor instead.
 func (*GetSnapshotResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{78}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{126}
 }
 
 func (x *GetSnapshotResponse) GetSnapshot() *VideoSnapshot {
@@ -4643,16 +7779,23 @@ func (x *GetSnapshotResponse) GetSnapshot() *VideoSnapshot {
 	return nil
 }
 
+// Lists snapshots across videos ordered by measured_at, oldest first. Every filter is optional.
 type ListSnapshotsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	VideoId       string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	VideoId        string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	GenreId        string                 `protobuf:"bytes,2,opt,name=genre_id,json=genreId,proto3" json:"genre_id,omitempty"`
+	CheckpointHour *int32                 `protobuf:"varint,3,opt,name=checkpoint_hour,json=checkpointHour,proto3,oneof" json:"checkpoint_hour,omitempty"`
+	MeasuredAfter  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=measured_after,json=measuredAfter,proto3" json:"measured_after,omitempty"`    // Inclusive
+	MeasuredBefore *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=measured_before,json=measuredBefore,proto3" json:"measured_before,omitempty"` // Exclusive
+	PageSize       int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                  // Defaults to 100, at most 1000
+	PageToken      string                 `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ListSnapshotsRequest) Reset() {
 	*x = ListSnapshotsRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[79]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4664,7 +7807,7 @@ func (x *ListSnapshotsRequest) String() string {
 func (*ListSnapshotsRequest) ProtoMessage() {}
 
 func (x *ListSnapshotsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[79]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4677,7 +7820,7 @@ func (x *ListSnapshotsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSnapshotsRequest.ProtoReflect.Descriptor instead.
 func (*ListSnapshotsRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{79}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{127}
 }
 
 func (x *ListSnapshotsRequest) GetVideoId() string {
@@ -4687,16 +7830,59 @@ func (x *ListSnapshotsRequest) GetVideoId() string {
 	return ""
 }
 
+func (x *ListSnapshotsRequest) GetGenreId() string {
+	if x != nil {
+		return x.GenreId
+	}
+	return ""
+}
+
+func (x *ListSnapshotsRequest) GetCheckpointHour() int32 {
+	if x != nil && x.CheckpointHour != nil {
+		return *x.CheckpointHour
+	}
+	return 0
+}
+
+func (x *ListSnapshotsRequest) GetMeasuredAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MeasuredAfter
+	}
+	return nil
+}
+
+func (x *ListSnapshotsRequest) GetMeasuredBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MeasuredBefore
+	}
+	return nil
+}
+
+func (x *ListSnapshotsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSnapshotsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type ListSnapshotsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Snapshots     []*VideoSnapshot       `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListSnapshotsResponse) Reset() {
 	*x = ListSnapshotsResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[80]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4708,7 +7894,7 @@ func (x *ListSnapshotsResponse) String() string {
 func (*ListSnapshotsResponse) ProtoMessage() {}
 
 func (x *ListSnapshotsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[80]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4721,7 +7907,7 @@ func (x *ListSnapshotsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSnapshotsResponse.ProtoReflect.Descriptor instead.
 func (*ListSnapshotsResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{80}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{128}
 }
 
 func (x *ListSnapshotsResponse) GetSnapshots() []*VideoSnapshot {
@@ -4731,16 +7917,176 @@ func (x *ListSnapshotsResponse) GetSnapshots() []*VideoSnapshot {
 	return nil
 }
 
+func (x *ListSnapshotsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Streams every snapshot matching the filters in batches, ordered by measured_at, oldest first.
+// The server reads the next batch only once the previous one has been sent, so a slow
+// consumer holds back the stream instead of buffering on the server.
+type StreamSnapshotsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	GenreId        string                 `protobuf:"bytes,1,opt,name=genre_id,json=genreId,proto3" json:"genre_id,omitempty"`
+	CheckpointHour *int32                 `protobuf:"varint,2,opt,name=checkpoint_hour,json=checkpointHour,proto3,oneof" json:"checkpoint_hour,omitempty"`
+	MeasuredAfter  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=measured_after,json=measuredAfter,proto3" json:"measured_after,omitempty"`    // Inclusive
+	MeasuredBefore *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=measured_before,json=measuredBefore,proto3" json:"measured_before,omitempty"` // Exclusive
+	VideoId        string                 `protobuf:"bytes,5,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	// Resume after the snapshot a previous stream ended on. Takes a resume_token or a
+	// ListSnapshots page token issued for the same filters.
+	ResumeToken   string `protobuf:"bytes,6,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	BatchSize     int32  `protobuf:"varint,7,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"` // Snapshots per message. Defaults to 100, at most 1000
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamSnapshotsRequest) Reset() {
+	*x = StreamSnapshotsRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamSnapshotsRequest) ProtoMessage() {}
+
+func (x *StreamSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*StreamSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *StreamSnapshotsRequest) GetGenreId() string {
+	if x != nil {
+		return x.GenreId
+	}
+	return ""
+}
+
+func (x *StreamSnapshotsRequest) GetCheckpointHour() int32 {
+	if x != nil && x.CheckpointHour != nil {
+		return *x.CheckpointHour
+	}
+	return 0
+}
+
+func (x *StreamSnapshotsRequest) GetMeasuredAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MeasuredAfter
+	}
+	return nil
+}
+
+func (x *StreamSnapshotsRequest) GetMeasuredBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MeasuredBefore
+	}
+	return nil
+}
+
+func (x *StreamSnapshotsRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *StreamSnapshotsRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *StreamSnapshotsRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type StreamSnapshotsResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Snapshots []*VideoSnapshot       `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	// Resumes the stream after the last snapshot of this batch
+	ResumeToken   string `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamSnapshotsResponse) Reset() {
+	*x = StreamSnapshotsResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamSnapshotsResponse) ProtoMessage() {}
+
+func (x *StreamSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*StreamSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *StreamSnapshotsResponse) GetSnapshots() []*VideoSnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+func (x *StreamSnapshotsResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
 // System operation messages
 type ScheduleSnapshotsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Schedules videos published within the last N hours. 0 uses the last
+	// checkpoint of the longest active checkpoint profile.
+	Hours         int32 `protobuf:"varint,1,opt,name=hours,proto3" json:"hours,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ScheduleSnapshotsRequest) Reset() {
 	*x = ScheduleSnapshotsRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[81]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4752,7 +8098,7 @@ func (x *ScheduleSnapshotsRequest) String() string {
 func (*ScheduleSnapshotsRequest) ProtoMessage() {}
 
 func (x *ScheduleSnapshotsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[81]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4765,7 +8111,14 @@ func (x *ScheduleSnapshotsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleSnapshotsRequest.ProtoReflect.Descriptor instead.
 func (*ScheduleSnapshotsRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{81}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *ScheduleSnapshotsRequest) GetHours() int32 {
+	if x != nil {
+		return x.Hours
+	}
+	return 0
 }
 
 type ScheduleSnapshotsResponse struct {
@@ -4773,13 +8126,15 @@ type ScheduleSnapshotsResponse struct {
 	VideosProcessed int32                  `protobuf:"varint,1,opt,name=videos_processed,json=videosProcessed,proto3" json:"videos_processed,omitempty"`
 	TasksScheduled  int32                  `protobuf:"varint,2,opt,name=tasks_scheduled,json=tasksScheduled,proto3" json:"tasks_scheduled,omitempty"`
 	DurationMs      int64                  `protobuf:"varint,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Start of the publish window videos were scheduled from
+	PublishedSince *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=published_since,json=publishedSince,proto3" json:"published_since,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ScheduleSnapshotsResponse) Reset() {
 	*x = ScheduleSnapshotsResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[82]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4791,7 +8146,7 @@ func (x *ScheduleSnapshotsResponse) String() string {
 func (*ScheduleSnapshotsResponse) ProtoMessage() {}
 
 func (x *ScheduleSnapshotsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[82]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4804,7 +8159,7 @@ func (x *ScheduleSnapshotsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleSnapshotsResponse.ProtoReflect.Descriptor instead.
 func (*ScheduleSnapshotsResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{82}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{132}
 }
 
 func (x *ScheduleSnapshotsResponse) GetVideosProcessed() int32 {
@@ -4828,6 +8183,13 @@ func (x *ScheduleSnapshotsResponse) GetDurationMs() int64 {
 	return 0
 }
 
+func (x *ScheduleSnapshotsResponse) GetPublishedSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedSince
+	}
+	return nil
+}
+
 type UpdateChannelsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -4836,7 +8198,7 @@ type UpdateChannelsRequest struct {
 
 func (x *UpdateChannelsRequest) Reset() {
 	*x = UpdateChannelsRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[83]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4848,7 +8210,7 @@ func (x *UpdateChannelsRequest) String() string {
 func (*UpdateChannelsRequest) ProtoMessage() {}
 
 func (x *UpdateChannelsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[83]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4861,7 +8223,7 @@ func (x *UpdateChannelsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateChannelsRequest.ProtoReflect.Descriptor instead.
 func (*UpdateChannelsRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{83}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{133}
 }
 
 type UpdateChannelsResponse struct {
@@ -4869,13 +8231,14 @@ type UpdateChannelsResponse struct {
 	ChannelsProcessed int32                  `protobuf:"varint,1,opt,name=channels_processed,json=channelsProcessed,proto3" json:"channels_processed,omitempty"`
 	ChannelsUpdated   int32                  `protobuf:"varint,2,opt,name=channels_updated,json=channelsUpdated,proto3" json:"channels_updated,omitempty"`
 	DurationMs        int64                  `protobuf:"varint,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	SnapshotsTaken    int32                  `protobuf:"varint,4,opt,name=snapshots_taken,json=snapshotsTaken,proto3" json:"snapshots_taken,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
 func (x *UpdateChannelsResponse) Reset() {
 	*x = UpdateChannelsResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[84]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4887,7 +8250,7 @@ func (x *UpdateChannelsResponse) String() string {
 func (*UpdateChannelsResponse) ProtoMessage() {}
 
 func (x *UpdateChannelsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[84]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4900,7 +8263,7 @@ func (x *UpdateChannelsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateChannelsResponse.ProtoReflect.Descriptor instead.
 func (*UpdateChannelsResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{84}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{134}
 }
 
 func (x *UpdateChannelsResponse) GetChannelsProcessed() int32 {
@@ -4924,6 +8287,13 @@ func (x *UpdateChannelsResponse) GetDurationMs() int64 {
 	return 0
 }
 
+func (x *UpdateChannelsResponse) GetSnapshotsTaken() int32 {
+	if x != nil {
+		return x.SnapshotsTaken
+	}
+	return 0
+}
+
 type CollectTrendingByGenreRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GenreId       string                 `protobuf:"bytes,1,opt,name=genre_id,json=genreId,proto3" json:"genre_id,omitempty"`
@@ -4933,7 +8303,7 @@ type CollectTrendingByGenreRequest struct {
 
 func (x *CollectTrendingByGenreRequest) Reset() {
 	*x = CollectTrendingByGenreRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[85]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4945,7 +8315,7 @@ func (x *CollectTrendingByGenreRequest) String() string {
 func (*CollectTrendingByGenreRequest) ProtoMessage() {}
 
 func (x *CollectTrendingByGenreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[85]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4958,7 +8328,7 @@ func (x *CollectTrendingByGenreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CollectTrendingByGenreRequest.ProtoReflect.Descriptor instead.
 func (*CollectTrendingByGenreRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{85}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{135}
 }
 
 func (x *CollectTrendingByGenreRequest) GetGenreId() string {
@@ -4980,7 +8350,7 @@ type CollectTrendingByGenreResponse struct {
 
 func (x *CollectTrendingByGenreResponse) Reset() {
 	*x = CollectTrendingByGenreResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[86]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4992,7 +8362,7 @@ func (x *CollectTrendingByGenreResponse) String() string {
 func (*CollectTrendingByGenreResponse) ProtoMessage() {}
 
 func (x *CollectTrendingByGenreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[86]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5005,7 +8375,7 @@ func (x *CollectTrendingByGenreResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CollectTrendingByGenreResponse.ProtoReflect.Descriptor instead.
 func (*CollectTrendingByGenreResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{86}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{136}
 }
 
 func (x *CollectTrendingByGenreResponse) GetGenreCode() string {
@@ -5044,7 +8414,7 @@ type CollectAllTrendingRequest struct {
 
 func (x *CollectAllTrendingRequest) Reset() {
 	*x = CollectAllTrendingRequest{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[87]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5056,7 +8426,7 @@ func (x *CollectAllTrendingRequest) String() string {
 func (*CollectAllTrendingRequest) ProtoMessage() {}
 
 func (x *CollectAllTrendingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[87]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5069,7 +8439,7 @@ func (x *CollectAllTrendingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CollectAllTrendingRequest.ProtoReflect.Descriptor instead.
 func (*CollectAllTrendingRequest) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{87}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{137}
 }
 
 type CollectAllTrendingResponse struct {
@@ -5085,7 +8455,7 @@ type CollectAllTrendingResponse struct {
 
 func (x *CollectAllTrendingResponse) Reset() {
 	*x = CollectAllTrendingResponse{}
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[88]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5097,7 +8467,7 @@ func (x *CollectAllTrendingResponse) String() string {
 func (*CollectAllTrendingResponse) ProtoMessage() {}
 
 func (x *CollectAllTrendingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ingestion_v1_ingestion_proto_msgTypes[88]
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5110,7 +8480,7 @@ func (x *CollectAllTrendingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CollectAllTrendingResponse.ProtoReflect.Descriptor instead.
 func (*CollectAllTrendingResponse) Descriptor() ([]byte, []int) {
-	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{88}
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{138}
 }
 
 func (x *CollectAllTrendingResponse) GetGenresProcessed() int32 {
@@ -5198,7 +8568,24 @@ const file_ingestion_v1_ingestion_proto_rawDesc = "" +
 	"\n" +
 	"channel_id\x18\x01 \x01(\tR\tchannelId\"M\n" +
 	"\x1aUnsubscribeChannelResponse\x12/\n" +
-	"\achannel\x18\x01 \x01(\v2\x15.ingestion.v1.ChannelR\achannel\"\xb3\x03\n" +
+	"\achannel\x18\x01 \x01(\v2\x15.ingestion.v1.ChannelR\achannel\"\xd0\x02\n" +
+	"\x12ChannelGrowthPoint\x12;\n" +
+	"\vmeasured_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"measuredAt\x12-\n" +
+	"\x12subscription_count\x18\x02 \x01(\x03R\x11subscriptionCount\x12\x1d\n" +
+	"\n" +
+	"view_count\x18\x03 \x01(\x03R\tviewCount\x12\x1f\n" +
+	"\vvideo_count\x18\x04 \x01(\x03R\n" +
+	"videoCount\x128\n" +
+	"\x18subscription_count_delta\x18\x05 \x01(\x03R\x16subscriptionCountDelta\x12(\n" +
+	"\x10view_count_delta\x18\x06 \x01(\x03R\x0eviewCountDelta\x12*\n" +
+	"\x11video_count_delta\x18\a \x01(\x03R\x0fvideoCountDelta\"N\n" +
+	"\x17GetChannelGrowthRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"T\n" +
+	"\x18GetChannelGrowthResponse\x128\n" +
+	"\x06points\x18\x01 \x03(\v2 .ingestion.v1.ChannelGrowthPointR\x06points\"\xf9\x03\n" +
 	"\x05Video\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12(\n" +
 	"\x10youtube_video_id\x18\x02 \x01(\tR\x0eyoutubeVideoId\x12,\n" +
@@ -5214,11 +8601,16 @@ const file_ingestion_v1_ingestion_proto_rawDesc = "" +
 	"\n" +
 	"deleted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1b\n" +
 	"\tgenre_ids\x18\n" +
-	" \x03(\tR\bgenreIds\"!\n" +
+	" \x03(\tR\bgenreIds\x12)\n" +
+	"\x10duration_seconds\x18\v \x01(\x05R\x0fdurationSeconds\x12\x19\n" +
+	"\bis_short\x18\f \x01(\bR\aisShort\"!\n" +
 	"\x0fGetVideoRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"=\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xe1\x01\n" +
 	"\x10GetVideoResponse\x12)\n" +
-	"\x05video\x18\x01 \x01(\v2\x13.ingestion.v1.VideoR\x05video\"\xce\x01\n" +
+	"\x05video\x18\x01 \x01(\v2\x13.ingestion.v1.VideoR\x05video\x12/\n" +
+	"\achannel\x18\x02 \x01(\v2\x15.ingestion.v1.ChannelR\achannel\x12+\n" +
+	"\x06genres\x18\x03 \x03(\v2\x13.ingestion.v1.GenreR\x06genres\x12D\n" +
+	"\x0flatest_snapshot\x18\x04 \x01(\v2\x1b.ingestion.v1.VideoSnapshotR\x0elatestSnapshot\"\xa4\x03\n" +
 	"\x11ListVideosRequest\x12\x1d\n" +
 	"\n" +
 	"channel_id\x18\x01 \x01(\tR\tchannelId\x12C\n" +
@@ -5226,7 +8618,16 @@ const file_ingestion_v1_ingestion_proto_rawDesc = "" +
 	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
 	"page_token\x18\x04 \x01(\tR\tpageToken\x12\x19\n" +
-	"\bgenre_id\x18\x05 \x01(\tR\agenreId\"\x8a\x01\n" +
+	"\bgenre_id\x18\x05 \x01(\tR\agenreId\x12$\n" +
+	"\vcategory_id\x18\x06 \x01(\x05H\x00R\n" +
+	"categoryId\x88\x01\x01\x12E\n" +
+	"\x10published_before\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0fpublishedBefore\x12\x1b\n" +
+	"\x06shorts\x18\b \x01(\bH\x01R\x06shorts\x88\x01\x01\x12\x14\n" +
+	"\x05query\x18\t \x01(\tR\x05query\x12\x19\n" +
+	"\border_by\x18\n" +
+	" \x01(\tR\aorderByB\x0e\n" +
+	"\f_category_idB\t\n" +
+	"\a_shorts\"\x8a\x01\n" +
 	"\x12ListVideosResponse\x12+\n" +
 	"\x06videos\x18\x01 \x03(\v2\x13.ingestion.v1.VideoR\x06videos\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
@@ -5247,7 +8648,7 @@ const file_ingestion_v1_ingestion_proto_rawDesc = "" +
 	"\x10videos_processed\x18\x02 \x01(\x05R\x0fvideosProcessed\x12!\n" +
 	"\fvideos_added\x18\x03 \x01(\x05R\vvideosAdded\x12\x1f\n" +
 	"\vduration_ms\x18\x04 \x01(\x03R\n" +
-	"durationMs\"\xaf\x02\n" +
+	"durationMs\"\xe3\x02\n" +
 	"\x05Genre\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
@@ -5260,7 +8661,9 @@ const file_ingestion_v1_ingestion_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"r\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x122\n" +
+	"\x15checkpoint_profile_id\x18\n" +
+	" \x01(\tR\x13checkpointProfileId\"r\n" +
 	"\x11ListGenresRequest\x12!\n" +
 	"\fenabled_only\x18\x01 \x01(\bR\venabledOnly\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
@@ -5278,16 +8681,17 @@ const file_ingestion_v1_ingestion_proto_rawDesc = "" +
 	"\x15GetGenreByCodeRequest\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\tR\x04code\"C\n" +
 	"\x16GetGenreByCodeResponse\x12)\n" +
-	"\x05genre\x18\x01 \x01(\v2\x13.ingestion.v1.GenreR\x05genre\"\x9c\x01\n" +
+	"\x05genre\x18\x01 \x01(\v2\x13.ingestion.v1.GenreR\x05genre\"\xcb\x01\n" +
 	"\x12CreateGenreRequest\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
 	"\blanguage\x18\x03 \x01(\tR\blanguage\x12\x1f\n" +
 	"\vregion_code\x18\x04 \x01(\tR\n" +
 	"regionCode\x12!\n" +
-	"\fcategory_ids\x18\x05 \x03(\x05R\vcategoryIds\"@\n" +
+	"\fcategory_ids\x18\x05 \x03(\x05R\vcategoryIds\x12-\n" +
+	"\x12checkpoint_profile\x18\x06 \x01(\tR\x11checkpointProfile\"@\n" +
 	"\x13CreateGenreResponse\x12)\n" +
-	"\x05genre\x18\x01 \x01(\v2\x13.ingestion.v1.GenreR\x05genre\"\xac\x01\n" +
+	"\x05genre\x18\x01 \x01(\v2\x13.ingestion.v1.GenreR\x05genre\"\xf7\x01\n" +
 	"\x12UpdateGenreRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
@@ -5295,7 +8699,9 @@ const file_ingestion_v1_ingestion_proto_rawDesc = "" +
 	"\blanguage\x18\x04 \x01(\tR\blanguage\x12\x1f\n" +
 	"\vregion_code\x18\x05 \x01(\tR\n" +
 	"regionCode\x12!\n" +
-	"\fcategory_ids\x18\x06 \x03(\x05R\vcategoryIds\"@\n" +
+	"\fcategory_ids\x18\x06 \x03(\x05R\vcategoryIds\x122\n" +
+	"\x12checkpoint_profile\x18\a \x01(\tH\x00R\x11checkpointProfile\x88\x01\x01B\x15\n" +
+	"\x13_checkpoint_profile\"@\n" +
 	"\x13UpdateGenreResponse\x12)\n" +
 	"\x05genre\x18\x01 \x01(\v2\x13.ingestion.v1.GenreR\x05genre\"$\n" +
 	"\x12EnableGenreRequest\x12\x0e\n" +
@@ -5418,17 +8824,218 @@ const file_ingestion_v1_ingestion_proto_rawDesc = "" +
 	"\akeyword\x18\x01 \x01(\v2\x15.ingestion.v1.KeywordR\akeyword\"&\n" +
 	"\x14DeleteKeywordRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x17\n" +
-	"\x15DeleteKeywordResponse\"}\n" +
+	"\x15DeleteKeywordResponse\"\x9e\x03\n" +
+	"\fKeywordGroup\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bgenre_id\x18\x02 \x01(\tR\agenreId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1f\n" +
+	"\vfilter_type\x18\x04 \x01(\tR\n" +
+	"filterType\x12!\n" +
+	"\ftarget_field\x18\x05 \x01(\tR\vtargetField\x12\x18\n" +
+	"\aenabled\x18\x06 \x01(\bR\aenabled\x12 \n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\x12\x1a\n" +
+	"\bkeywords\x18\b \x03(\tR\bkeywords\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12%\n" +
+	"\x0ecross_language\x18\v \x01(\bR\rcrossLanguage\x12\x16\n" +
+	"\x06romaji\x18\f \x01(\bR\x06romaji\"(\n" +
+	"\x16GetKeywordGroupRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"Z\n" +
+	"\x17GetKeywordGroupResponse\x12?\n" +
+	"\rkeyword_group\x18\x01 \x01(\v2\x1a.ingestion.v1.KeywordGroupR\fkeywordGroup\"\x94\x01\n" +
+	"\x18ListKeywordGroupsRequest\x12\x19\n" +
+	"\bgenre_id\x18\x01 \x01(\tR\agenreId\x12!\n" +
+	"\fenabled_only\x18\x02 \x01(\bR\venabledOnly\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"\xa7\x01\n" +
+	"\x19ListKeywordGroupsResponse\x12A\n" +
+	"\x0ekeyword_groups\x18\x01 \x03(\v2\x1a.ingestion.v1.KeywordGroupR\rkeywordGroups\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"\x8b\x02\n" +
+	"\x19CreateKeywordGroupRequest\x12\x19\n" +
+	"\bgenre_id\x18\x01 \x01(\tR\agenreId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
+	"\vfilter_type\x18\x03 \x01(\tR\n" +
+	"filterType\x12!\n" +
+	"\ftarget_field\x18\x04 \x01(\tR\vtargetField\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bkeywords\x18\x06 \x03(\tR\bkeywords\x12%\n" +
+	"\x0ecross_language\x18\a \x01(\bR\rcrossLanguage\x12\x16\n" +
+	"\x06romaji\x18\b \x01(\bR\x06romaji\"]\n" +
+	"\x1aCreateKeywordGroupResponse\x12?\n" +
+	"\rkeyword_group\x18\x01 \x01(\v2\x1a.ingestion.v1.KeywordGroupR\fkeywordGroup\"\xda\x02\n" +
+	"\x19UpdateKeywordGroupRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12$\n" +
+	"\vfilter_type\x18\x03 \x01(\tH\x01R\n" +
+	"filterType\x88\x01\x01\x12&\n" +
+	"\ftarget_field\x18\x04 \x01(\tH\x02R\vtargetField\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x05 \x01(\tH\x03R\vdescription\x88\x01\x01\x12*\n" +
+	"\x0ecross_language\x18\x06 \x01(\bH\x04R\rcrossLanguage\x88\x01\x01\x12\x1b\n" +
+	"\x06romaji\x18\a \x01(\bH\x05R\x06romaji\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_filter_typeB\x0f\n" +
+	"\r_target_fieldB\x0e\n" +
+	"\f_descriptionB\x11\n" +
+	"\x0f_cross_languageB\t\n" +
+	"\a_romaji\"]\n" +
+	"\x1aUpdateKeywordGroupResponse\x12?\n" +
+	"\rkeyword_group\x18\x01 \x01(\v2\x1a.ingestion.v1.KeywordGroupR\fkeywordGroup\"O\n" +
+	"!UpdateKeywordGroupKeywordsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bkeywords\x18\x02 \x03(\tR\bkeywords\"e\n" +
+	"\"UpdateKeywordGroupKeywordsResponse\x12?\n" +
+	"\rkeyword_group\x18\x01 \x01(\v2\x1a.ingestion.v1.KeywordGroupR\fkeywordGroup\"F\n" +
+	"\x1aAddKeywordGroupItemRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\akeyword\x18\x02 \x01(\tR\akeyword\"^\n" +
+	"\x1bAddKeywordGroupItemResponse\x12?\n" +
+	"\rkeyword_group\x18\x01 \x01(\v2\x1a.ingestion.v1.KeywordGroupR\fkeywordGroup\"I\n" +
+	"\x1dRemoveKeywordGroupItemRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\akeyword\x18\x02 \x01(\tR\akeyword\"a\n" +
+	"\x1eRemoveKeywordGroupItemResponse\x12?\n" +
+	"\rkeyword_group\x18\x01 \x01(\v2\x1a.ingestion.v1.KeywordGroupR\fkeywordGroup\"+\n" +
+	"\x19EnableKeywordGroupRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"]\n" +
+	"\x1aEnableKeywordGroupResponse\x12?\n" +
+	"\rkeyword_group\x18\x01 \x01(\v2\x1a.ingestion.v1.KeywordGroupR\fkeywordGroup\",\n" +
+	"\x1aDisableKeywordGroupRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"^\n" +
+	"\x1bDisableKeywordGroupResponse\x12?\n" +
+	"\rkeyword_group\x18\x01 \x01(\v2\x1a.ingestion.v1.KeywordGroupR\fkeywordGroup\"+\n" +
+	"\x19DeleteKeywordGroupRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1c\n" +
+	"\x1aDeleteKeywordGroupResponse\"/\n" +
+	"\x1dGetKeywordGroupPatternRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\":\n" +
+	"\x1eGetKeywordGroupPatternResponse\x12\x18\n" +
+	"\apattern\x18\x01 \x01(\tR\apattern\"\xbc\x02\n" +
+	"\x17TestKeywordGroupRequest\x12\x19\n" +
+	"\bgenre_id\x18\x01 \x01(\tR\agenreId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1a\n" +
+	"\bkeywords\x18\x03 \x03(\tR\bkeywords\x12$\n" +
+	"\vfilter_type\x18\x04 \x01(\tH\x00R\n" +
+	"filterType\x88\x01\x01\x12\x12\n" +
+	"\x04days\x18\x05 \x01(\x05R\x04days\x12*\n" +
+	"\x0ecross_language\x18\x06 \x01(\bH\x01R\rcrossLanguage\x88\x01\x01\x12\x1b\n" +
+	"\x06romaji\x18\a \x01(\bH\x02R\x06romaji\x88\x01\x01\x12\x1d\n" +
+	"\aenabled\x18\b \x01(\bH\x03R\aenabled\x88\x01\x01B\x0e\n" +
+	"\f_filter_typeB\x11\n" +
+	"\x0f_cross_languageB\t\n" +
+	"\a_romajiB\n" +
+	"\n" +
+	"\b_enabled\"\xaf\x02\n" +
+	"\x18TestKeywordGroupResponse\x12\x18\n" +
+	"\apattern\x18\x01 \x01(\tR\apattern\x12)\n" +
+	"\x10videos_evaluated\x18\x02 \x01(\x05R\x0fvideosEvaluated\x12\x1c\n" +
+	"\ttruncated\x18\x03 \x01(\bR\ttruncated\x12J\n" +
+	"\x0enewly_included\x18\x04 \x03(\v2#.ingestion.v1.KeywordGroupTestMatchR\rnewlyIncluded\x12J\n" +
+	"\x0enewly_excluded\x18\x05 \x03(\v2#.ingestion.v1.KeywordGroupTestMatchR\rnewlyExcluded\x12\x18\n" +
+	"\aenabled\x18\x06 \x01(\bR\aenabled\"\x92\x03\n" +
+	"\x15KeywordGroupTestMatch\x12\x19\n" +
+	"\bvideo_id\x18\x01 \x01(\tR\avideoId\x12(\n" +
+	"\x10youtube_video_id\x18\x02 \x01(\tR\x0eyoutubeVideoId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12=\n" +
+	"\fpublished_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vpublishedAt\x12\x16\n" +
+	"\x06before\x18\x05 \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\x06 \x01(\tR\x05after\x12#\n" +
+	"\rkeyword_group\x18\a \x01(\tR\fkeywordGroup\x12\x1f\n" +
+	"\vmatch_start\x18\b \x01(\x05R\n" +
+	"matchStart\x12\x1b\n" +
+	"\tmatch_end\x18\t \x01(\x05R\bmatchEnd\x12!\n" +
+	"\fmatched_text\x18\n" +
+	" \x01(\tR\vmatchedText\x12+\n" +
+	"\x11highlighted_title\x18\v \x01(\tR\x10highlightedTitle\"\xa9\x02\n" +
+	"\x0eKeywordSynonym\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\blanguage\x18\x02 \x01(\tR\blanguage\x12\x12\n" +
+	"\x04term\x18\x03 \x01(\tR\x04term\x12\x14\n" +
+	"\x05alias\x18\x04 \x01(\tR\x05alias\x12%\n" +
+	"\x0ealias_language\x18\x05 \x01(\tR\raliasLanguage\x12$\n" +
+	"\rbidirectional\x18\x06 \x01(\bR\rbidirectional\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"t\n" +
+	"\x1aListKeywordSynonymsRequest\x12\x1a\n" +
+	"\blanguage\x18\x01 \x01(\tR\blanguage\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\xaf\x01\n" +
+	"\x1bListKeywordSynonymsResponse\x12G\n" +
+	"\x10keyword_synonyms\x18\x01 \x03(\v2\x1c.ingestion.v1.KeywordSynonymR\x0fkeywordSynonyms\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"*\n" +
+	"\x18GetKeywordSynonymRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"b\n" +
+	"\x19GetKeywordSynonymResponse\x12E\n" +
+	"\x0fkeyword_synonym\x18\x01 \x01(\v2\x1c.ingestion.v1.KeywordSynonymR\x0ekeywordSynonym\"\xb0\x01\n" +
+	"\x1bCreateKeywordSynonymRequest\x12\x1a\n" +
+	"\blanguage\x18\x01 \x01(\tR\blanguage\x12\x12\n" +
+	"\x04term\x18\x02 \x01(\tR\x04term\x12\x14\n" +
+	"\x05alias\x18\x03 \x01(\tR\x05alias\x12%\n" +
+	"\x0ealias_language\x18\x04 \x01(\tR\raliasLanguage\x12$\n" +
+	"\rbidirectional\x18\x05 \x01(\bR\rbidirectional\"e\n" +
+	"\x1cCreateKeywordSynonymResponse\x12E\n" +
+	"\x0fkeyword_synonym\x18\x01 \x01(\v2\x1c.ingestion.v1.KeywordSynonymR\x0ekeywordSynonym\"\xf0\x01\n" +
+	"\x1bUpdateKeywordSynonymRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04term\x18\x02 \x01(\tH\x00R\x04term\x88\x01\x01\x12\x19\n" +
+	"\x05alias\x18\x03 \x01(\tH\x01R\x05alias\x88\x01\x01\x12*\n" +
+	"\x0ealias_language\x18\x04 \x01(\tH\x02R\raliasLanguage\x88\x01\x01\x12)\n" +
+	"\rbidirectional\x18\x05 \x01(\bH\x03R\rbidirectional\x88\x01\x01B\a\n" +
+	"\x05_termB\b\n" +
+	"\x06_aliasB\x11\n" +
+	"\x0f_alias_languageB\x10\n" +
+	"\x0e_bidirectional\"e\n" +
+	"\x1cUpdateKeywordSynonymResponse\x12E\n" +
+	"\x0fkeyword_synonym\x18\x01 \x01(\v2\x1c.ingestion.v1.KeywordSynonymR\x0ekeywordSynonym\"-\n" +
+	"\x1bDeleteKeywordSynonymRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1e\n" +
+	"\x1cDeleteKeywordSynonymResponse\"\xad\x01\n" +
 	"\n" +
 	"VideoGenre\x12\x19\n" +
 	"\bvideo_id\x18\x01 \x01(\tR\avideoId\x12\x19\n" +
 	"\bgenre_id\x18\x02 \x01(\tR\agenreId\x129\n" +
 	"\n" +
-	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"3\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12.\n" +
+	"\x05match\x18\x04 \x01(\v2\x18.ingestion.v1.GenreMatchR\x05match\"\x9e\x01\n" +
+	"\n" +
+	"GenreMatch\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\tR\x06result\x129\n" +
+	"\amatches\x18\x02 \x03(\v2\x1f.ingestion.v1.GenreKeywordMatchR\amatches\x12=\n" +
+	"\fevaluated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vevaluatedAt\"\xb6\x02\n" +
+	"\x11GenreKeywordMatch\x12(\n" +
+	"\x10keyword_group_id\x18\x01 \x01(\tR\x0ekeywordGroupId\x12#\n" +
+	"\rkeyword_group\x18\x02 \x01(\tR\fkeywordGroup\x12\x1f\n" +
+	"\vfilter_type\x18\x03 \x01(\tR\n" +
+	"filterType\x12\x14\n" +
+	"\x05field\x18\x04 \x01(\tR\x05field\x12\x1f\n" +
+	"\vmatch_start\x18\x05 \x01(\x05R\n" +
+	"matchStart\x12\x1b\n" +
+	"\tmatch_end\x18\x06 \x01(\x05R\bmatchEnd\x12!\n" +
+	"\fmatched_text\x18\a \x01(\tR\vmatchedText\x12\x1a\n" +
+	"\bdecisive\x18\b \x01(\bR\bdecisive\x12\x1e\n" +
+	"\n" +
+	"overridden\x18\t \x01(\bR\n" +
+	"overridden\"o\n" +
 	"\x16ListVideoGenresRequest\x12\x19\n" +
-	"\bvideo_id\x18\x01 \x01(\tR\avideoId\"V\n" +
+	"\bvideo_id\x18\x01 \x01(\tR\avideoId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x9f\x01\n" +
 	"\x17ListVideoGenresResponse\x12;\n" +
-	"\fvideo_genres\x18\x01 \x03(\v2\x18.ingestion.v1.VideoGenreR\vvideoGenres\"Q\n" +
+	"\fvideo_genres\x18\x01 \x03(\v2\x18.ingestion.v1.VideoGenreR\vvideoGenres\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"Q\n" +
 	"\x19AssignVideoToGenreRequest\x12\x19\n" +
 	"\bvideo_id\x18\x01 \x01(\tR\avideoId\x12\x19\n" +
 	"\bgenre_id\x18\x02 \x01(\tR\agenreId\"W\n" +
@@ -5464,7 +9071,7 @@ const file_ingestion_v1_ingestion_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a<\n" +
 	"\x0eNewValuesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb3\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xf4\x02\n" +
 	"\x14ListAuditLogsRequest\x12\x19\n" +
 	"\bactor_id\x18\x01 \x01(\tR\aactorId\x12#\n" +
 	"\rresource_type\x18\x02 \x01(\tR\fresourceType\x12\x1f\n" +
@@ -5472,7 +9079,11 @@ const file_ingestion_v1_ingestion_proto_rawDesc = "" +
 	"resourceId\x12\x1b\n" +
 	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x05 \x01(\tR\tpageToken\"\x97\x01\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06action\x18\x06 \x01(\tR\x06action\x12#\n" +
+	"\rchanged_field\x18\a \x01(\tR\fchangedField\x12?\n" +
+	"\rcreated_after\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\"\x97\x01\n" +
 	"\x15ListAuditLogsResponse\x125\n" +
 	"\n" +
 	"audit_logs\x18\x01 \x03(\v2\x16.ingestion.v1.AuditLogR\tauditLogs\x12&\n" +
@@ -5482,7 +9093,18 @@ const file_ingestion_v1_ingestion_proto_rawDesc = "" +
 	"\x12GetAuditLogRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"J\n" +
 	"\x13GetAuditLogResponse\x123\n" +
-	"\taudit_log\x18\x01 \x01(\v2\x16.ingestion.v1.AuditLogR\bauditLog\"\xb5\x04\n" +
+	"\taudit_log\x18\x01 \x01(\v2\x16.ingestion.v1.AuditLogR\bauditLog\"\xba\x02\n" +
+	"\x16ExportAuditLogsRequest\x12\x19\n" +
+	"\bactor_id\x18\x01 \x01(\tR\aactorId\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12#\n" +
+	"\rresource_type\x18\x03 \x01(\tR\fresourceType\x12\x1f\n" +
+	"\vresource_id\x18\x04 \x01(\tR\n" +
+	"resourceId\x12#\n" +
+	"\rchanged_field\x18\x05 \x01(\tR\fchangedField\x12?\n" +
+	"\rcreated_after\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\"-\n" +
+	"\x17ExportAuditLogsResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"\x9d\x06\n" +
 	"\bBatchJob\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\bjob_type\x18\x02 \x01(\tR\ajobType\x12\x16\n" +
@@ -5498,7 +9120,13 @@ const file_ingestion_v1_ingestion_proto_rawDesc = "" +
 	"statistics\x18\b \x03(\v2&.ingestion.v1.BatchJob.StatisticsEntryR\n" +
 	"statistics\x129\n" +
 	"\n" +
-	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a=\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12J\n" +
+	"\x13cancel_requested_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x11cancelRequestedAt\x12\x19\n" +
+	"\bretry_of\x18\v \x01(\tR\aretryOf\x12-\n" +
+	"\x12progress_processed\x18\f \x01(\x05R\x11progressProcessed\x12%\n" +
+	"\x0eprogress_total\x18\r \x01(\x05R\rprogressTotal\x12)\n" +
+	"\x10progress_current\x18\x0e \x01(\tR\x0fprogressCurrent\x1a=\n" +
 	"\x0fParametersEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a=\n" +
@@ -5520,7 +9148,15 @@ const file_ingestion_v1_ingestion_proto_rawDesc = "" +
 	"\x12GetBatchJobRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"J\n" +
 	"\x13GetBatchJobResponse\x123\n" +
-	"\tbatch_job\x18\x01 \x01(\v2\x16.ingestion.v1.BatchJobR\bbatchJob\"\xe4\x02\n" +
+	"\tbatch_job\x18\x01 \x01(\v2\x16.ingestion.v1.BatchJobR\bbatchJob\"'\n" +
+	"\x15CancelBatchJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"M\n" +
+	"\x16CancelBatchJobResponse\x123\n" +
+	"\tbatch_job\x18\x01 \x01(\v2\x16.ingestion.v1.BatchJobR\bbatchJob\"&\n" +
+	"\x14RetryBatchJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"L\n" +
+	"\x15RetryBatchJobResponse\x123\n" +
+	"\tbatch_job\x18\x01 \x01(\v2\x16.ingestion.v1.BatchJobR\bbatchJob\"\xc8\x04\n" +
 	"\rVideoSnapshot\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\bvideo_id\x18\x02 \x01(\tR\avideoId\x12'\n" +
@@ -5534,7 +9170,15 @@ const file_ingestion_v1_ingestion_proto_rawDesc = "" +
 	"\x12subscription_count\x18\a \x01(\x03R\x11subscriptionCount\x12\x16\n" +
 	"\x06source\x18\b \x01(\tR\x06source\x129\n" +
 	"\n" +
-	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"[\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12#\n" +
+	"\rdrift_seconds\x18\n" +
+	" \x01(\x03R\fdriftSeconds\x12*\n" +
+	"\x0ecomments_count\x18\v \x01(\x03H\x00R\rcommentsCount\x88\x01\x01\x12B\n" +
+	"\ametrics\x18\f \x03(\v2(.ingestion.v1.VideoSnapshot.MetricsEntryR\ametrics\x1a:\n" +
+	"\fMetricsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01B\x11\n" +
+	"\x0f_comments_count\"[\n" +
 	"\x15CreateSnapshotRequest\x12\x19\n" +
 	"\bvideo_id\x18\x01 \x01(\tR\avideoId\x12'\n" +
 	"\x0fcheckpoint_hour\x18\x02 \x01(\x05R\x0echeckpointHour\"Q\n" +
@@ -5544,23 +9188,48 @@ const file_ingestion_v1_ingestion_proto_rawDesc = "" +
 	"\bvideo_id\x18\x01 \x01(\tR\avideoId\x12'\n" +
 	"\x0fcheckpoint_hour\x18\x02 \x01(\x05R\x0echeckpointHour\"N\n" +
 	"\x13GetSnapshotResponse\x127\n" +
-	"\bsnapshot\x18\x01 \x01(\v2\x1b.ingestion.v1.VideoSnapshotR\bsnapshot\"1\n" +
+	"\bsnapshot\x18\x01 \x01(\v2\x1b.ingestion.v1.VideoSnapshotR\bsnapshot\"\xd2\x02\n" +
 	"\x14ListSnapshotsRequest\x12\x19\n" +
-	"\bvideo_id\x18\x01 \x01(\tR\avideoId\"R\n" +
+	"\bvideo_id\x18\x01 \x01(\tR\avideoId\x12\x19\n" +
+	"\bgenre_id\x18\x02 \x01(\tR\agenreId\x12,\n" +
+	"\x0fcheckpoint_hour\x18\x03 \x01(\x05H\x00R\x0echeckpointHour\x88\x01\x01\x12A\n" +
+	"\x0emeasured_after\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rmeasuredAfter\x12C\n" +
+	"\x0fmeasured_before\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0emeasuredBefore\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageTokenB\x12\n" +
+	"\x10_checkpoint_hour\"z\n" +
 	"\x15ListSnapshotsResponse\x129\n" +
-	"\tsnapshots\x18\x01 \x03(\v2\x1b.ingestion.v1.VideoSnapshotR\tsnapshots\"\x1a\n" +
-	"\x18ScheduleSnapshotsRequest\"\x90\x01\n" +
+	"\tsnapshots\x18\x01 \x03(\v2\x1b.ingestion.v1.VideoSnapshotR\tsnapshots\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xda\x02\n" +
+	"\x16StreamSnapshotsRequest\x12\x19\n" +
+	"\bgenre_id\x18\x01 \x01(\tR\agenreId\x12,\n" +
+	"\x0fcheckpoint_hour\x18\x02 \x01(\x05H\x00R\x0echeckpointHour\x88\x01\x01\x12A\n" +
+	"\x0emeasured_after\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\rmeasuredAfter\x12C\n" +
+	"\x0fmeasured_before\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0emeasuredBefore\x12\x19\n" +
+	"\bvideo_id\x18\x05 \x01(\tR\avideoId\x12!\n" +
+	"\fresume_token\x18\x06 \x01(\tR\vresumeToken\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\a \x01(\x05R\tbatchSizeB\x12\n" +
+	"\x10_checkpoint_hour\"w\n" +
+	"\x17StreamSnapshotsResponse\x129\n" +
+	"\tsnapshots\x18\x01 \x03(\v2\x1b.ingestion.v1.VideoSnapshotR\tsnapshots\x12!\n" +
+	"\fresume_token\x18\x02 \x01(\tR\vresumeToken\"0\n" +
+	"\x18ScheduleSnapshotsRequest\x12\x14\n" +
+	"\x05hours\x18\x01 \x01(\x05R\x05hours\"\xd5\x01\n" +
 	"\x19ScheduleSnapshotsResponse\x12)\n" +
 	"\x10videos_processed\x18\x01 \x01(\x05R\x0fvideosProcessed\x12'\n" +
 	"\x0ftasks_scheduled\x18\x02 \x01(\x05R\x0etasksScheduled\x12\x1f\n" +
 	"\vduration_ms\x18\x03 \x01(\x03R\n" +
-	"durationMs\"\x17\n" +
-	"\x15UpdateChannelsRequest\"\x93\x01\n" +
+	"durationMs\x12C\n" +
+	"\x0fpublished_since\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0epublishedSince\"\x17\n" +
+	"\x15UpdateChannelsRequest\"\xbc\x01\n" +
 	"\x16UpdateChannelsResponse\x12-\n" +
 	"\x12channels_processed\x18\x01 \x01(\x05R\x11channelsProcessed\x12)\n" +
 	"\x10channels_updated\x18\x02 \x01(\x05R\x0fchannelsUpdated\x12\x1f\n" +
 	"\vduration_ms\x18\x03 \x01(\x03R\n" +
-	"durationMs\":\n" +
+	"durationMs\x12'\n" +
+	"\x0fsnapshots_taken\x18\x04 \x01(\x05R\x0esnapshotsTaken\":\n" +
 	"\x1dCollectTrendingByGenreRequest\x12\x19\n" +
 	"\bgenre_id\x18\x01 \x01(\tR\agenreId\"\xae\x01\n" +
 	"\x1eCollectTrendingByGenreResponse\x12\x1d\n" +
@@ -5578,13 +9247,14 @@ const file_ingestion_v1_ingestion_proto_rawDesc = "" +
 	"totalAdded\x12Q\n" +
 	"\rgenre_results\x18\x04 \x03(\v2,.ingestion.v1.CollectTrendingByGenreResponseR\fgenreResults\x12\x1f\n" +
 	"\vduration_ms\x18\x05 \x01(\x03R\n" +
-	"durationMs2\xa3\x1d\n" +
+	"durationMs2\xd8/\n" +
 	"\x10IngestionService\x12O\n" +
 	"\n" +
 	"GetChannel\x12\x1f.ingestion.v1.GetChannelRequest\x1a .ingestion.v1.GetChannelResponse\x12U\n" +
 	"\fListChannels\x12!.ingestion.v1.ListChannelsRequest\x1a\".ingestion.v1.ListChannelsResponse\x12a\n" +
 	"\x10SubscribeChannel\x12%.ingestion.v1.SubscribeChannelRequest\x1a&.ingestion.v1.SubscribeChannelResponse\x12g\n" +
-	"\x12UnsubscribeChannel\x12'.ingestion.v1.UnsubscribeChannelRequest\x1a(.ingestion.v1.UnsubscribeChannelResponse\x12I\n" +
+	"\x12UnsubscribeChannel\x12'.ingestion.v1.UnsubscribeChannelRequest\x1a(.ingestion.v1.UnsubscribeChannelResponse\x12a\n" +
+	"\x10GetChannelGrowth\x12%.ingestion.v1.GetChannelGrowthRequest\x1a&.ingestion.v1.GetChannelGrowthResponse\x12I\n" +
 	"\bGetVideo\x12\x1d.ingestion.v1.GetVideoRequest\x1a\x1e.ingestion.v1.GetVideoResponse\x12O\n" +
 	"\n" +
 	"ListVideos\x12\x1f.ingestion.v1.ListVideosRequest\x1a .ingestion.v1.ListVideosResponse\x12^\n" +
@@ -5592,7 +9262,8 @@ const file_ingestion_v1_ingestion_proto_rawDesc = "" +
 	"\x14CollectSubscriptions\x12).ingestion.v1.CollectSubscriptionsRequest\x1a*.ingestion.v1.CollectSubscriptionsResponse\x12[\n" +
 	"\x0eCreateSnapshot\x12#.ingestion.v1.CreateSnapshotRequest\x1a$.ingestion.v1.CreateSnapshotResponse\x12R\n" +
 	"\vGetSnapshot\x12 .ingestion.v1.GetSnapshotRequest\x1a!.ingestion.v1.GetSnapshotResponse\x12X\n" +
-	"\rListSnapshots\x12\".ingestion.v1.ListSnapshotsRequest\x1a#.ingestion.v1.ListSnapshotsResponse\x12O\n" +
+	"\rListSnapshots\x12\".ingestion.v1.ListSnapshotsRequest\x1a#.ingestion.v1.ListSnapshotsResponse\x12`\n" +
+	"\x0fStreamSnapshots\x12$.ingestion.v1.StreamSnapshotsRequest\x1a%.ingestion.v1.StreamSnapshotsResponse0\x01\x12O\n" +
 	"\n" +
 	"ListGenres\x12\x1f.ingestion.v1.ListGenresRequest\x1a .ingestion.v1.ListGenresResponse\x12I\n" +
 	"\bGetGenre\x12\x1d.ingestion.v1.GetGenreRequest\x1a\x1e.ingestion.v1.GetGenreResponse\x12[\n" +
@@ -5603,23 +9274,43 @@ const file_ingestion_v1_ingestion_proto_rawDesc = "" +
 	"\fDisableGenre\x12!.ingestion.v1.DisableGenreRequest\x1a\".ingestion.v1.DisableGenreResponse\x12p\n" +
 	"\x15ListYouTubeCategories\x12*.ingestion.v1.ListYouTubeCategoriesRequest\x1a+.ingestion.v1.ListYouTubeCategoriesResponse\x12g\n" +
 	"\x12GetYouTubeCategory\x12'.ingestion.v1.GetYouTubeCategoryRequest\x1a(.ingestion.v1.GetYouTubeCategoryResponse\x12p\n" +
-	"\x15UpdateYouTubeCategory\x12*.ingestion.v1.UpdateYouTubeCategoryRequest\x1a+.ingestion.v1.UpdateYouTubeCategoryResponse\x12O\n" +
+	"\x15UpdateYouTubeCategory\x12*.ingestion.v1.UpdateYouTubeCategoryRequest\x1a+.ingestion.v1.UpdateYouTubeCategoryResponse\x12T\n" +
 	"\n" +
-	"GetKeyword\x12\x1f.ingestion.v1.GetKeywordRequest\x1a .ingestion.v1.GetKeywordResponse\x12U\n" +
-	"\fListKeywords\x12!.ingestion.v1.ListKeywordsRequest\x1a\".ingestion.v1.ListKeywordsResponse\x12j\n" +
-	"\x13ListKeywordsByGenre\x12(.ingestion.v1.ListKeywordsByGenreRequest\x1a).ingestion.v1.ListKeywordsByGenreResponse\x12X\n" +
-	"\rCreateKeyword\x12\".ingestion.v1.CreateKeywordRequest\x1a#.ingestion.v1.CreateKeywordResponse\x12X\n" +
-	"\rUpdateKeyword\x12\".ingestion.v1.UpdateKeywordRequest\x1a#.ingestion.v1.UpdateKeywordResponse\x12X\n" +
-	"\rEnableKeyword\x12\".ingestion.v1.EnableKeywordRequest\x1a#.ingestion.v1.EnableKeywordResponse\x12[\n" +
-	"\x0eDisableKeyword\x12#.ingestion.v1.DisableKeywordRequest\x1a$.ingestion.v1.DisableKeywordResponse\x12X\n" +
-	"\rDeleteKeyword\x12\".ingestion.v1.DeleteKeywordRequest\x1a#.ingestion.v1.DeleteKeywordResponse\x12^\n" +
+	"GetKeyword\x12\x1f.ingestion.v1.GetKeywordRequest\x1a .ingestion.v1.GetKeywordResponse\"\x03\x88\x02\x01\x12Z\n" +
+	"\fListKeywords\x12!.ingestion.v1.ListKeywordsRequest\x1a\".ingestion.v1.ListKeywordsResponse\"\x03\x88\x02\x01\x12o\n" +
+	"\x13ListKeywordsByGenre\x12(.ingestion.v1.ListKeywordsByGenreRequest\x1a).ingestion.v1.ListKeywordsByGenreResponse\"\x03\x88\x02\x01\x12]\n" +
+	"\rCreateKeyword\x12\".ingestion.v1.CreateKeywordRequest\x1a#.ingestion.v1.CreateKeywordResponse\"\x03\x88\x02\x01\x12]\n" +
+	"\rUpdateKeyword\x12\".ingestion.v1.UpdateKeywordRequest\x1a#.ingestion.v1.UpdateKeywordResponse\"\x03\x88\x02\x01\x12]\n" +
+	"\rEnableKeyword\x12\".ingestion.v1.EnableKeywordRequest\x1a#.ingestion.v1.EnableKeywordResponse\"\x03\x88\x02\x01\x12`\n" +
+	"\x0eDisableKeyword\x12#.ingestion.v1.DisableKeywordRequest\x1a$.ingestion.v1.DisableKeywordResponse\"\x03\x88\x02\x01\x12]\n" +
+	"\rDeleteKeyword\x12\".ingestion.v1.DeleteKeywordRequest\x1a#.ingestion.v1.DeleteKeywordResponse\"\x03\x88\x02\x01\x12^\n" +
+	"\x0fGetKeywordGroup\x12$.ingestion.v1.GetKeywordGroupRequest\x1a%.ingestion.v1.GetKeywordGroupResponse\x12d\n" +
+	"\x11ListKeywordGroups\x12&.ingestion.v1.ListKeywordGroupsRequest\x1a'.ingestion.v1.ListKeywordGroupsResponse\x12g\n" +
+	"\x12CreateKeywordGroup\x12'.ingestion.v1.CreateKeywordGroupRequest\x1a(.ingestion.v1.CreateKeywordGroupResponse\x12g\n" +
+	"\x12UpdateKeywordGroup\x12'.ingestion.v1.UpdateKeywordGroupRequest\x1a(.ingestion.v1.UpdateKeywordGroupResponse\x12\x7f\n" +
+	"\x1aUpdateKeywordGroupKeywords\x12/.ingestion.v1.UpdateKeywordGroupKeywordsRequest\x1a0.ingestion.v1.UpdateKeywordGroupKeywordsResponse\x12j\n" +
+	"\x13AddKeywordGroupItem\x12(.ingestion.v1.AddKeywordGroupItemRequest\x1a).ingestion.v1.AddKeywordGroupItemResponse\x12s\n" +
+	"\x16RemoveKeywordGroupItem\x12+.ingestion.v1.RemoveKeywordGroupItemRequest\x1a,.ingestion.v1.RemoveKeywordGroupItemResponse\x12g\n" +
+	"\x12EnableKeywordGroup\x12'.ingestion.v1.EnableKeywordGroupRequest\x1a(.ingestion.v1.EnableKeywordGroupResponse\x12j\n" +
+	"\x13DisableKeywordGroup\x12(.ingestion.v1.DisableKeywordGroupRequest\x1a).ingestion.v1.DisableKeywordGroupResponse\x12g\n" +
+	"\x12DeleteKeywordGroup\x12'.ingestion.v1.DeleteKeywordGroupRequest\x1a(.ingestion.v1.DeleteKeywordGroupResponse\x12s\n" +
+	"\x16GetKeywordGroupPattern\x12+.ingestion.v1.GetKeywordGroupPatternRequest\x1a,.ingestion.v1.GetKeywordGroupPatternResponse\x12a\n" +
+	"\x10TestKeywordGroup\x12%.ingestion.v1.TestKeywordGroupRequest\x1a&.ingestion.v1.TestKeywordGroupResponse\x12j\n" +
+	"\x13ListKeywordSynonyms\x12(.ingestion.v1.ListKeywordSynonymsRequest\x1a).ingestion.v1.ListKeywordSynonymsResponse\x12d\n" +
+	"\x11GetKeywordSynonym\x12&.ingestion.v1.GetKeywordSynonymRequest\x1a'.ingestion.v1.GetKeywordSynonymResponse\x12m\n" +
+	"\x14CreateKeywordSynonym\x12).ingestion.v1.CreateKeywordSynonymRequest\x1a*.ingestion.v1.CreateKeywordSynonymResponse\x12m\n" +
+	"\x14UpdateKeywordSynonym\x12).ingestion.v1.UpdateKeywordSynonymRequest\x1a*.ingestion.v1.UpdateKeywordSynonymResponse\x12m\n" +
+	"\x14DeleteKeywordSynonym\x12).ingestion.v1.DeleteKeywordSynonymRequest\x1a*.ingestion.v1.DeleteKeywordSynonymResponse\x12^\n" +
 	"\x0fListVideoGenres\x12$.ingestion.v1.ListVideoGenresRequest\x1a%.ingestion.v1.ListVideoGenresResponse\x12g\n" +
 	"\x12AssignVideoToGenre\x12'.ingestion.v1.AssignVideoToGenreRequest\x1a(.ingestion.v1.AssignVideoToGenreResponse\x12m\n" +
 	"\x14RemoveVideoFromGenre\x12).ingestion.v1.RemoveVideoFromGenreRequest\x1a*.ingestion.v1.RemoveVideoFromGenreResponse\x12X\n" +
 	"\rListAuditLogs\x12\".ingestion.v1.ListAuditLogsRequest\x1a#.ingestion.v1.ListAuditLogsResponse\x12R\n" +
-	"\vGetAuditLog\x12 .ingestion.v1.GetAuditLogRequest\x1a!.ingestion.v1.GetAuditLogResponse\x12X\n" +
+	"\vGetAuditLog\x12 .ingestion.v1.GetAuditLogRequest\x1a!.ingestion.v1.GetAuditLogResponse\x12`\n" +
+	"\x0fExportAuditLogs\x12$.ingestion.v1.ExportAuditLogsRequest\x1a%.ingestion.v1.ExportAuditLogsResponse0\x01\x12X\n" +
 	"\rListBatchJobs\x12\".ingestion.v1.ListBatchJobsRequest\x1a#.ingestion.v1.ListBatchJobsResponse\x12R\n" +
-	"\vGetBatchJob\x12 .ingestion.v1.GetBatchJobRequest\x1a!.ingestion.v1.GetBatchJobResponse\x12d\n" +
+	"\vGetBatchJob\x12 .ingestion.v1.GetBatchJobRequest\x1a!.ingestion.v1.GetBatchJobResponse\x12[\n" +
+	"\x0eCancelBatchJob\x12#.ingestion.v1.CancelBatchJobRequest\x1a$.ingestion.v1.CancelBatchJobResponse\x12X\n" +
+	"\rRetryBatchJob\x12\".ingestion.v1.RetryBatchJobRequest\x1a#.ingestion.v1.RetryBatchJobResponse\x12d\n" +
 	"\x11ScheduleSnapshots\x12&.ingestion.v1.ScheduleSnapshotsRequest\x1a'.ingestion.v1.ScheduleSnapshotsResponse\x12[\n" +
 	"\x0eUpdateChannels\x12#.ingestion.v1.UpdateChannelsRequest\x1a$.ingestion.v1.UpdateChannelsResponse\x12s\n" +
 	"\x16CollectTrendingByGenre\x12+.ingestion.v1.CollectTrendingByGenreRequest\x1a,.ingestion.v1.CollectTrendingByGenreResponse\x12g\n" +
@@ -5637,248 +9328,386 @@ func file_ingestion_v1_ingestion_proto_rawDescGZIP() []byte {
 	return file_ingestion_v1_ingestion_proto_rawDescData
 }
 
-var file_ingestion_v1_ingestion_proto_msgTypes = make([]protoimpl.MessageInfo, 93)
+var file_ingestion_v1_ingestion_proto_msgTypes = make([]protoimpl.MessageInfo, 144)
 var file_ingestion_v1_ingestion_proto_goTypes = []any{
-	(*Channel)(nil),                        // 0: ingestion.v1.Channel
-	(*GetChannelRequest)(nil),              // 1: ingestion.v1.GetChannelRequest
-	(*GetChannelResponse)(nil),             // 2: ingestion.v1.GetChannelResponse
-	(*ListChannelsRequest)(nil),            // 3: ingestion.v1.ListChannelsRequest
-	(*ListChannelsResponse)(nil),           // 4: ingestion.v1.ListChannelsResponse
-	(*SubscribeChannelRequest)(nil),        // 5: ingestion.v1.SubscribeChannelRequest
-	(*SubscribeChannelResponse)(nil),       // 6: ingestion.v1.SubscribeChannelResponse
-	(*UnsubscribeChannelRequest)(nil),      // 7: ingestion.v1.UnsubscribeChannelRequest
-	(*UnsubscribeChannelResponse)(nil),     // 8: ingestion.v1.UnsubscribeChannelResponse
-	(*Video)(nil),                          // 9: ingestion.v1.Video
-	(*GetVideoRequest)(nil),                // 10: ingestion.v1.GetVideoRequest
-	(*GetVideoResponse)(nil),               // 11: ingestion.v1.GetVideoResponse
-	(*ListVideosRequest)(nil),              // 12: ingestion.v1.ListVideosRequest
-	(*ListVideosResponse)(nil),             // 13: ingestion.v1.ListVideosResponse
-	(*CollectTrendingRequest)(nil),         // 14: ingestion.v1.CollectTrendingRequest
-	(*CollectTrendingResponse)(nil),        // 15: ingestion.v1.CollectTrendingResponse
-	(*CollectSubscriptionsRequest)(nil),    // 16: ingestion.v1.CollectSubscriptionsRequest
-	(*CollectSubscriptionsResponse)(nil),   // 17: ingestion.v1.CollectSubscriptionsResponse
-	(*Genre)(nil),                          // 18: ingestion.v1.Genre
-	(*ListGenresRequest)(nil),              // 19: ingestion.v1.ListGenresRequest
-	(*ListGenresResponse)(nil),             // 20: ingestion.v1.ListGenresResponse
-	(*GetGenreRequest)(nil),                // 21: ingestion.v1.GetGenreRequest
-	(*GetGenreResponse)(nil),               // 22: ingestion.v1.GetGenreResponse
-	(*GetGenreByCodeRequest)(nil),          // 23: ingestion.v1.GetGenreByCodeRequest
-	(*GetGenreByCodeResponse)(nil),         // 24: ingestion.v1.GetGenreByCodeResponse
-	(*CreateGenreRequest)(nil),             // 25: ingestion.v1.CreateGenreRequest
-	(*CreateGenreResponse)(nil),            // 26: ingestion.v1.CreateGenreResponse
-	(*UpdateGenreRequest)(nil),             // 27: ingestion.v1.UpdateGenreRequest
-	(*UpdateGenreResponse)(nil),            // 28: ingestion.v1.UpdateGenreResponse
-	(*EnableGenreRequest)(nil),             // 29: ingestion.v1.EnableGenreRequest
-	(*EnableGenreResponse)(nil),            // 30: ingestion.v1.EnableGenreResponse
-	(*DisableGenreRequest)(nil),            // 31: ingestion.v1.DisableGenreRequest
-	(*DisableGenreResponse)(nil),           // 32: ingestion.v1.DisableGenreResponse
-	(*YouTubeCategory)(nil),                // 33: ingestion.v1.YouTubeCategory
-	(*ListYouTubeCategoriesRequest)(nil),   // 34: ingestion.v1.ListYouTubeCategoriesRequest
-	(*ListYouTubeCategoriesResponse)(nil),  // 35: ingestion.v1.ListYouTubeCategoriesResponse
-	(*GetYouTubeCategoryRequest)(nil),      // 36: ingestion.v1.GetYouTubeCategoryRequest
-	(*GetYouTubeCategoryResponse)(nil),     // 37: ingestion.v1.GetYouTubeCategoryResponse
-	(*UpdateYouTubeCategoryRequest)(nil),   // 38: ingestion.v1.UpdateYouTubeCategoryRequest
-	(*UpdateYouTubeCategoryResponse)(nil),  // 39: ingestion.v1.UpdateYouTubeCategoryResponse
-	(*Keyword)(nil),                        // 40: ingestion.v1.Keyword
-	(*GetKeywordRequest)(nil),              // 41: ingestion.v1.GetKeywordRequest
-	(*GetKeywordResponse)(nil),             // 42: ingestion.v1.GetKeywordResponse
-	(*ListKeywordsRequest)(nil),            // 43: ingestion.v1.ListKeywordsRequest
-	(*ListKeywordsResponse)(nil),           // 44: ingestion.v1.ListKeywordsResponse
-	(*ListKeywordsByGenreRequest)(nil),     // 45: ingestion.v1.ListKeywordsByGenreRequest
-	(*ListKeywordsByGenreResponse)(nil),    // 46: ingestion.v1.ListKeywordsByGenreResponse
-	(*CreateKeywordRequest)(nil),           // 47: ingestion.v1.CreateKeywordRequest
-	(*CreateKeywordResponse)(nil),          // 48: ingestion.v1.CreateKeywordResponse
-	(*UpdateKeywordRequest)(nil),           // 49: ingestion.v1.UpdateKeywordRequest
-	(*UpdateKeywordResponse)(nil),          // 50: ingestion.v1.UpdateKeywordResponse
-	(*EnableKeywordRequest)(nil),           // 51: ingestion.v1.EnableKeywordRequest
-	(*EnableKeywordResponse)(nil),          // 52: ingestion.v1.EnableKeywordResponse
-	(*DisableKeywordRequest)(nil),          // 53: ingestion.v1.DisableKeywordRequest
-	(*DisableKeywordResponse)(nil),         // 54: ingestion.v1.DisableKeywordResponse
-	(*DeleteKeywordRequest)(nil),           // 55: ingestion.v1.DeleteKeywordRequest
-	(*DeleteKeywordResponse)(nil),          // 56: ingestion.v1.DeleteKeywordResponse
-	(*VideoGenre)(nil),                     // 57: ingestion.v1.VideoGenre
-	(*ListVideoGenresRequest)(nil),         // 58: ingestion.v1.ListVideoGenresRequest
-	(*ListVideoGenresResponse)(nil),        // 59: ingestion.v1.ListVideoGenresResponse
-	(*AssignVideoToGenreRequest)(nil),      // 60: ingestion.v1.AssignVideoToGenreRequest
-	(*AssignVideoToGenreResponse)(nil),     // 61: ingestion.v1.AssignVideoToGenreResponse
-	(*RemoveVideoFromGenreRequest)(nil),    // 62: ingestion.v1.RemoveVideoFromGenreRequest
-	(*RemoveVideoFromGenreResponse)(nil),   // 63: ingestion.v1.RemoveVideoFromGenreResponse
-	(*AuditLog)(nil),                       // 64: ingestion.v1.AuditLog
-	(*ListAuditLogsRequest)(nil),           // 65: ingestion.v1.ListAuditLogsRequest
-	(*ListAuditLogsResponse)(nil),          // 66: ingestion.v1.ListAuditLogsResponse
-	(*GetAuditLogRequest)(nil),             // 67: ingestion.v1.GetAuditLogRequest
-	(*GetAuditLogResponse)(nil),            // 68: ingestion.v1.GetAuditLogResponse
-	(*BatchJob)(nil),                       // 69: ingestion.v1.BatchJob
-	(*ListBatchJobsRequest)(nil),           // 70: ingestion.v1.ListBatchJobsRequest
-	(*ListBatchJobsResponse)(nil),          // 71: ingestion.v1.ListBatchJobsResponse
-	(*GetBatchJobRequest)(nil),             // 72: ingestion.v1.GetBatchJobRequest
-	(*GetBatchJobResponse)(nil),            // 73: ingestion.v1.GetBatchJobResponse
-	(*VideoSnapshot)(nil),                  // 74: ingestion.v1.VideoSnapshot
-	(*CreateSnapshotRequest)(nil),          // 75: ingestion.v1.CreateSnapshotRequest
-	(*CreateSnapshotResponse)(nil),         // 76: ingestion.v1.CreateSnapshotResponse
-	(*GetSnapshotRequest)(nil),             // 77: ingestion.v1.GetSnapshotRequest
-	(*GetSnapshotResponse)(nil),            // 78: ingestion.v1.GetSnapshotResponse
-	(*ListSnapshotsRequest)(nil),           // 79: ingestion.v1.ListSnapshotsRequest
-	(*ListSnapshotsResponse)(nil),          // 80: ingestion.v1.ListSnapshotsResponse
-	(*ScheduleSnapshotsRequest)(nil),       // 81: ingestion.v1.ScheduleSnapshotsRequest
-	(*ScheduleSnapshotsResponse)(nil),      // 82: ingestion.v1.ScheduleSnapshotsResponse
-	(*UpdateChannelsRequest)(nil),          // 83: ingestion.v1.UpdateChannelsRequest
-	(*UpdateChannelsResponse)(nil),         // 84: ingestion.v1.UpdateChannelsResponse
-	(*CollectTrendingByGenreRequest)(nil),  // 85: ingestion.v1.CollectTrendingByGenreRequest
-	(*CollectTrendingByGenreResponse)(nil), // 86: ingestion.v1.CollectTrendingByGenreResponse
-	(*CollectAllTrendingRequest)(nil),      // 87: ingestion.v1.CollectAllTrendingRequest
-	(*CollectAllTrendingResponse)(nil),     // 88: ingestion.v1.CollectAllTrendingResponse
-	nil,                                    // 89: ingestion.v1.AuditLog.OldValuesEntry
-	nil,                                    // 90: ingestion.v1.AuditLog.NewValuesEntry
-	nil,                                    // 91: ingestion.v1.BatchJob.ParametersEntry
-	nil,                                    // 92: ingestion.v1.BatchJob.StatisticsEntry
-	(*timestamppb.Timestamp)(nil),          // 93: google.protobuf.Timestamp
+	(*Channel)(nil),                            // 0: ingestion.v1.Channel
+	(*GetChannelRequest)(nil),                  // 1: ingestion.v1.GetChannelRequest
+	(*GetChannelResponse)(nil),                 // 2: ingestion.v1.GetChannelResponse
+	(*ListChannelsRequest)(nil),                // 3: ingestion.v1.ListChannelsRequest
+	(*ListChannelsResponse)(nil),               // 4: ingestion.v1.ListChannelsResponse
+	(*SubscribeChannelRequest)(nil),            // 5: ingestion.v1.SubscribeChannelRequest
+	(*SubscribeChannelResponse)(nil),           // 6: ingestion.v1.SubscribeChannelResponse
+	(*UnsubscribeChannelRequest)(nil),          // 7: ingestion.v1.UnsubscribeChannelRequest
+	(*UnsubscribeChannelResponse)(nil),         // 8: ingestion.v1.UnsubscribeChannelResponse
+	(*ChannelGrowthPoint)(nil),                 // 9: ingestion.v1.ChannelGrowthPoint
+	(*GetChannelGrowthRequest)(nil),            // 10: ingestion.v1.GetChannelGrowthRequest
+	(*GetChannelGrowthResponse)(nil),           // 11: ingestion.v1.GetChannelGrowthResponse
+	(*Video)(nil),                              // 12: ingestion.v1.Video
+	(*GetVideoRequest)(nil),                    // 13: ingestion.v1.GetVideoRequest
+	(*GetVideoResponse)(nil),                   // 14: ingestion.v1.GetVideoResponse
+	(*ListVideosRequest)(nil),                  // 15: ingestion.v1.ListVideosRequest
+	(*ListVideosResponse)(nil),                 // 16: ingestion.v1.ListVideosResponse
+	(*CollectTrendingRequest)(nil),             // 17: ingestion.v1.CollectTrendingRequest
+	(*CollectTrendingResponse)(nil),            // 18: ingestion.v1.CollectTrendingResponse
+	(*CollectSubscriptionsRequest)(nil),        // 19: ingestion.v1.CollectSubscriptionsRequest
+	(*CollectSubscriptionsResponse)(nil),       // 20: ingestion.v1.CollectSubscriptionsResponse
+	(*Genre)(nil),                              // 21: ingestion.v1.Genre
+	(*ListGenresRequest)(nil),                  // 22: ingestion.v1.ListGenresRequest
+	(*ListGenresResponse)(nil),                 // 23: ingestion.v1.ListGenresResponse
+	(*GetGenreRequest)(nil),                    // 24: ingestion.v1.GetGenreRequest
+	(*GetGenreResponse)(nil),                   // 25: ingestion.v1.GetGenreResponse
+	(*GetGenreByCodeRequest)(nil),              // 26: ingestion.v1.GetGenreByCodeRequest
+	(*GetGenreByCodeResponse)(nil),             // 27: ingestion.v1.GetGenreByCodeResponse
+	(*CreateGenreRequest)(nil),                 // 28: ingestion.v1.CreateGenreRequest
+	(*CreateGenreResponse)(nil),                // 29: ingestion.v1.CreateGenreResponse
+	(*UpdateGenreRequest)(nil),                 // 30: ingestion.v1.UpdateGenreRequest
+	(*UpdateGenreResponse)(nil),                // 31: ingestion.v1.UpdateGenreResponse
+	(*EnableGenreRequest)(nil),                 // 32: ingestion.v1.EnableGenreRequest
+	(*EnableGenreResponse)(nil),                // 33: ingestion.v1.EnableGenreResponse
+	(*DisableGenreRequest)(nil),                // 34: ingestion.v1.DisableGenreRequest
+	(*DisableGenreResponse)(nil),               // 35: ingestion.v1.DisableGenreResponse
+	(*YouTubeCategory)(nil),                    // 36: ingestion.v1.YouTubeCategory
+	(*ListYouTubeCategoriesRequest)(nil),       // 37: ingestion.v1.ListYouTubeCategoriesRequest
+	(*ListYouTubeCategoriesResponse)(nil),      // 38: ingestion.v1.ListYouTubeCategoriesResponse
+	(*GetYouTubeCategoryRequest)(nil),          // 39: ingestion.v1.GetYouTubeCategoryRequest
+	(*GetYouTubeCategoryResponse)(nil),         // 40: ingestion.v1.GetYouTubeCategoryResponse
+	(*UpdateYouTubeCategoryRequest)(nil),       // 41: ingestion.v1.UpdateYouTubeCategoryRequest
+	(*UpdateYouTubeCategoryResponse)(nil),      // 42: ingestion.v1.UpdateYouTubeCategoryResponse
+	(*Keyword)(nil),                            // 43: ingestion.v1.Keyword
+	(*GetKeywordRequest)(nil),                  // 44: ingestion.v1.GetKeywordRequest
+	(*GetKeywordResponse)(nil),                 // 45: ingestion.v1.GetKeywordResponse
+	(*ListKeywordsRequest)(nil),                // 46: ingestion.v1.ListKeywordsRequest
+	(*ListKeywordsResponse)(nil),               // 47: ingestion.v1.ListKeywordsResponse
+	(*ListKeywordsByGenreRequest)(nil),         // 48: ingestion.v1.ListKeywordsByGenreRequest
+	(*ListKeywordsByGenreResponse)(nil),        // 49: ingestion.v1.ListKeywordsByGenreResponse
+	(*CreateKeywordRequest)(nil),               // 50: ingestion.v1.CreateKeywordRequest
+	(*CreateKeywordResponse)(nil),              // 51: ingestion.v1.CreateKeywordResponse
+	(*UpdateKeywordRequest)(nil),               // 52: ingestion.v1.UpdateKeywordRequest
+	(*UpdateKeywordResponse)(nil),              // 53: ingestion.v1.UpdateKeywordResponse
+	(*EnableKeywordRequest)(nil),               // 54: ingestion.v1.EnableKeywordRequest
+	(*EnableKeywordResponse)(nil),              // 55: ingestion.v1.EnableKeywordResponse
+	(*DisableKeywordRequest)(nil),              // 56: ingestion.v1.DisableKeywordRequest
+	(*DisableKeywordResponse)(nil),             // 57: ingestion.v1.DisableKeywordResponse
+	(*DeleteKeywordRequest)(nil),               // 58: ingestion.v1.DeleteKeywordRequest
+	(*DeleteKeywordResponse)(nil),              // 59: ingestion.v1.DeleteKeywordResponse
+	(*KeywordGroup)(nil),                       // 60: ingestion.v1.KeywordGroup
+	(*GetKeywordGroupRequest)(nil),             // 61: ingestion.v1.GetKeywordGroupRequest
+	(*GetKeywordGroupResponse)(nil),            // 62: ingestion.v1.GetKeywordGroupResponse
+	(*ListKeywordGroupsRequest)(nil),           // 63: ingestion.v1.ListKeywordGroupsRequest
+	(*ListKeywordGroupsResponse)(nil),          // 64: ingestion.v1.ListKeywordGroupsResponse
+	(*CreateKeywordGroupRequest)(nil),          // 65: ingestion.v1.CreateKeywordGroupRequest
+	(*CreateKeywordGroupResponse)(nil),         // 66: ingestion.v1.CreateKeywordGroupResponse
+	(*UpdateKeywordGroupRequest)(nil),          // 67: ingestion.v1.UpdateKeywordGroupRequest
+	(*UpdateKeywordGroupResponse)(nil),         // 68: ingestion.v1.UpdateKeywordGroupResponse
+	(*UpdateKeywordGroupKeywordsRequest)(nil),  // 69: ingestion.v1.UpdateKeywordGroupKeywordsRequest
+	(*UpdateKeywordGroupKeywordsResponse)(nil), // 70: ingestion.v1.UpdateKeywordGroupKeywordsResponse
+	(*AddKeywordGroupItemRequest)(nil),         // 71: ingestion.v1.AddKeywordGroupItemRequest
+	(*AddKeywordGroupItemResponse)(nil),        // 72: ingestion.v1.AddKeywordGroupItemResponse
+	(*RemoveKeywordGroupItemRequest)(nil),      // 73: ingestion.v1.RemoveKeywordGroupItemRequest
+	(*RemoveKeywordGroupItemResponse)(nil),     // 74: ingestion.v1.RemoveKeywordGroupItemResponse
+	(*EnableKeywordGroupRequest)(nil),          // 75: ingestion.v1.EnableKeywordGroupRequest
+	(*EnableKeywordGroupResponse)(nil),         // 76: ingestion.v1.EnableKeywordGroupResponse
+	(*DisableKeywordGroupRequest)(nil),         // 77: ingestion.v1.DisableKeywordGroupRequest
+	(*DisableKeywordGroupResponse)(nil),        // 78: ingestion.v1.DisableKeywordGroupResponse
+	(*DeleteKeywordGroupRequest)(nil),          // 79: ingestion.v1.DeleteKeywordGroupRequest
+	(*DeleteKeywordGroupResponse)(nil),         // 80: ingestion.v1.DeleteKeywordGroupResponse
+	(*GetKeywordGroupPatternRequest)(nil),      // 81: ingestion.v1.GetKeywordGroupPatternRequest
+	(*GetKeywordGroupPatternResponse)(nil),     // 82: ingestion.v1.GetKeywordGroupPatternResponse
+	(*TestKeywordGroupRequest)(nil),            // 83: ingestion.v1.TestKeywordGroupRequest
+	(*TestKeywordGroupResponse)(nil),           // 84: ingestion.v1.TestKeywordGroupResponse
+	(*KeywordGroupTestMatch)(nil),              // 85: ingestion.v1.KeywordGroupTestMatch
+	(*KeywordSynonym)(nil),                     // 86: ingestion.v1.KeywordSynonym
+	(*ListKeywordSynonymsRequest)(nil),         // 87: ingestion.v1.ListKeywordSynonymsRequest
+	(*ListKeywordSynonymsResponse)(nil),        // 88: ingestion.v1.ListKeywordSynonymsResponse
+	(*GetKeywordSynonymRequest)(nil),           // 89: ingestion.v1.GetKeywordSynonymRequest
+	(*GetKeywordSynonymResponse)(nil),          // 90: ingestion.v1.GetKeywordSynonymResponse
+	(*CreateKeywordSynonymRequest)(nil),        // 91: ingestion.v1.CreateKeywordSynonymRequest
+	(*CreateKeywordSynonymResponse)(nil),       // 92: ingestion.v1.CreateKeywordSynonymResponse
+	(*UpdateKeywordSynonymRequest)(nil),        // 93: ingestion.v1.UpdateKeywordSynonymRequest
+	(*UpdateKeywordSynonymResponse)(nil),       // 94: ingestion.v1.UpdateKeywordSynonymResponse
+	(*DeleteKeywordSynonymRequest)(nil),        // 95: ingestion.v1.DeleteKeywordSynonymRequest
+	(*DeleteKeywordSynonymResponse)(nil),       // 96: ingestion.v1.DeleteKeywordSynonymResponse
+	(*VideoGenre)(nil),                         // 97: ingestion.v1.VideoGenre
+	(*GenreMatch)(nil),                         // 98: ingestion.v1.GenreMatch
+	(*GenreKeywordMatch)(nil),                  // 99: ingestion.v1.GenreKeywordMatch
+	(*ListVideoGenresRequest)(nil),             // 100: ingestion.v1.ListVideoGenresRequest
+	(*ListVideoGenresResponse)(nil),            // 101: ingestion.v1.ListVideoGenresResponse
+	(*AssignVideoToGenreRequest)(nil),          // 102: ingestion.v1.AssignVideoToGenreRequest
+	(*AssignVideoToGenreResponse)(nil),         // 103: ingestion.v1.AssignVideoToGenreResponse
+	(*RemoveVideoFromGenreRequest)(nil),        // 104: ingestion.v1.RemoveVideoFromGenreRequest
+	(*RemoveVideoFromGenreResponse)(nil),       // 105: ingestion.v1.RemoveVideoFromGenreResponse
+	(*AuditLog)(nil),                           // 106: ingestion.v1.AuditLog
+	(*ListAuditLogsRequest)(nil),               // 107: ingestion.v1.ListAuditLogsRequest
+	(*ListAuditLogsResponse)(nil),              // 108: ingestion.v1.ListAuditLogsResponse
+	(*GetAuditLogRequest)(nil),                 // 109: ingestion.v1.GetAuditLogRequest
+	(*GetAuditLogResponse)(nil),                // 110: ingestion.v1.GetAuditLogResponse
+	(*ExportAuditLogsRequest)(nil),             // 111: ingestion.v1.ExportAuditLogsRequest
+	(*ExportAuditLogsResponse)(nil),            // 112: ingestion.v1.ExportAuditLogsResponse
+	(*BatchJob)(nil),                           // 113: ingestion.v1.BatchJob
+	(*ListBatchJobsRequest)(nil),               // 114: ingestion.v1.ListBatchJobsRequest
+	(*ListBatchJobsResponse)(nil),              // 115: ingestion.v1.ListBatchJobsResponse
+	(*GetBatchJobRequest)(nil),                 // 116: ingestion.v1.GetBatchJobRequest
+	(*GetBatchJobResponse)(nil),                // 117: ingestion.v1.GetBatchJobResponse
+	(*CancelBatchJobRequest)(nil),              // 118: ingestion.v1.CancelBatchJobRequest
+	(*CancelBatchJobResponse)(nil),             // 119: ingestion.v1.CancelBatchJobResponse
+	(*RetryBatchJobRequest)(nil),               // 120: ingestion.v1.RetryBatchJobRequest
+	(*RetryBatchJobResponse)(nil),              // 121: ingestion.v1.RetryBatchJobResponse
+	(*VideoSnapshot)(nil),                      // 122: ingestion.v1.VideoSnapshot
+	(*CreateSnapshotRequest)(nil),              // 123: ingestion.v1.CreateSnapshotRequest
+	(*CreateSnapshotResponse)(nil),             // 124: ingestion.v1.CreateSnapshotResponse
+	(*GetSnapshotRequest)(nil),                 // 125: ingestion.v1.GetSnapshotRequest
+	(*GetSnapshotResponse)(nil),                // 126: ingestion.v1.GetSnapshotResponse
+	(*ListSnapshotsRequest)(nil),               // 127: ingestion.v1.ListSnapshotsRequest
+	(*ListSnapshotsResponse)(nil),              // 128: ingestion.v1.ListSnapshotsResponse
+	(*StreamSnapshotsRequest)(nil),             // 129: ingestion.v1.StreamSnapshotsRequest
+	(*StreamSnapshotsResponse)(nil),            // 130: ingestion.v1.StreamSnapshotsResponse
+	(*ScheduleSnapshotsRequest)(nil),           // 131: ingestion.v1.ScheduleSnapshotsRequest
+	(*ScheduleSnapshotsResponse)(nil),          // 132: ingestion.v1.ScheduleSnapshotsResponse
+	(*UpdateChannelsRequest)(nil),              // 133: ingestion.v1.UpdateChannelsRequest
+	(*UpdateChannelsResponse)(nil),             // 134: ingestion.v1.UpdateChannelsResponse
+	(*CollectTrendingByGenreRequest)(nil),      // 135: ingestion.v1.CollectTrendingByGenreRequest
+	(*CollectTrendingByGenreResponse)(nil),     // 136: ingestion.v1.CollectTrendingByGenreResponse
+	(*CollectAllTrendingRequest)(nil),          // 137: ingestion.v1.CollectAllTrendingRequest
+	(*CollectAllTrendingResponse)(nil),         // 138: ingestion.v1.CollectAllTrendingResponse
+	nil,                                        // 139: ingestion.v1.AuditLog.OldValuesEntry
+	nil,                                        // 140: ingestion.v1.AuditLog.NewValuesEntry
+	nil,                                        // 141: ingestion.v1.BatchJob.ParametersEntry
+	nil,                                        // 142: ingestion.v1.BatchJob.StatisticsEntry
+	nil,                                        // 143: ingestion.v1.VideoSnapshot.MetricsEntry
+	(*timestamppb.Timestamp)(nil),              // 144: google.protobuf.Timestamp
 }
 var file_ingestion_v1_ingestion_proto_depIdxs = []int32{
-	93, // 0: ingestion.v1.Channel.created_at:type_name -> google.protobuf.Timestamp
-	93, // 1: ingestion.v1.Channel.updated_at:type_name -> google.protobuf.Timestamp
-	93, // 2: ingestion.v1.Channel.deleted_at:type_name -> google.protobuf.Timestamp
-	0,  // 3: ingestion.v1.GetChannelResponse.channel:type_name -> ingestion.v1.Channel
-	0,  // 4: ingestion.v1.ListChannelsResponse.channels:type_name -> ingestion.v1.Channel
-	0,  // 5: ingestion.v1.SubscribeChannelResponse.channel:type_name -> ingestion.v1.Channel
-	0,  // 6: ingestion.v1.UnsubscribeChannelResponse.channel:type_name -> ingestion.v1.Channel
-	93, // 7: ingestion.v1.Video.published_at:type_name -> google.protobuf.Timestamp
-	93, // 8: ingestion.v1.Video.created_at:type_name -> google.protobuf.Timestamp
-	93, // 9: ingestion.v1.Video.updated_at:type_name -> google.protobuf.Timestamp
-	93, // 10: ingestion.v1.Video.deleted_at:type_name -> google.protobuf.Timestamp
-	9,  // 11: ingestion.v1.GetVideoResponse.video:type_name -> ingestion.v1.Video
-	93, // 12: ingestion.v1.ListVideosRequest.published_after:type_name -> google.protobuf.Timestamp
-	9,  // 13: ingestion.v1.ListVideosResponse.videos:type_name -> ingestion.v1.Video
-	93, // 14: ingestion.v1.Genre.created_at:type_name -> google.protobuf.Timestamp
-	93, // 15: ingestion.v1.Genre.updated_at:type_name -> google.protobuf.Timestamp
-	18, // 16: ingestion.v1.ListGenresResponse.genres:type_name -> ingestion.v1.Genre
-	18, // 17: ingestion.v1.GetGenreResponse.genre:type_name -> ingestion.v1.Genre
-	18, // 18: ingestion.v1.GetGenreByCodeResponse.genre:type_name -> ingestion.v1.Genre
-	18, // 19: ingestion.v1.CreateGenreResponse.genre:type_name -> ingestion.v1.Genre
-	18, // 20: ingestion.v1.UpdateGenreResponse.genre:type_name -> ingestion.v1.Genre
-	18, // 21: ingestion.v1.EnableGenreResponse.genre:type_name -> ingestion.v1.Genre
-	18, // 22: ingestion.v1.DisableGenreResponse.genre:type_name -> ingestion.v1.Genre
-	93, // 23: ingestion.v1.YouTubeCategory.created_at:type_name -> google.protobuf.Timestamp
-	93, // 24: ingestion.v1.YouTubeCategory.updated_at:type_name -> google.protobuf.Timestamp
-	33, // 25: ingestion.v1.ListYouTubeCategoriesResponse.categories:type_name -> ingestion.v1.YouTubeCategory
-	33, // 26: ingestion.v1.GetYouTubeCategoryResponse.category:type_name -> ingestion.v1.YouTubeCategory
-	33, // 27: ingestion.v1.UpdateYouTubeCategoryResponse.category:type_name -> ingestion.v1.YouTubeCategory
-	93, // 28: ingestion.v1.Keyword.created_at:type_name -> google.protobuf.Timestamp
-	93, // 29: ingestion.v1.Keyword.updated_at:type_name -> google.protobuf.Timestamp
-	93, // 30: ingestion.v1.Keyword.deleted_at:type_name -> google.protobuf.Timestamp
-	40, // 31: ingestion.v1.GetKeywordResponse.keyword:type_name -> ingestion.v1.Keyword
-	40, // 32: ingestion.v1.ListKeywordsResponse.keywords:type_name -> ingestion.v1.Keyword
-	40, // 33: ingestion.v1.ListKeywordsByGenreResponse.keywords:type_name -> ingestion.v1.Keyword
-	40, // 34: ingestion.v1.CreateKeywordResponse.keyword:type_name -> ingestion.v1.Keyword
-	40, // 35: ingestion.v1.UpdateKeywordResponse.keyword:type_name -> ingestion.v1.Keyword
-	40, // 36: ingestion.v1.EnableKeywordResponse.keyword:type_name -> ingestion.v1.Keyword
-	40, // 37: ingestion.v1.DisableKeywordResponse.keyword:type_name -> ingestion.v1.Keyword
-	93, // 38: ingestion.v1.VideoGenre.created_at:type_name -> google.protobuf.Timestamp
-	57, // 39: ingestion.v1.ListVideoGenresResponse.video_genres:type_name -> ingestion.v1.VideoGenre
-	57, // 40: ingestion.v1.AssignVideoToGenreResponse.video_genre:type_name -> ingestion.v1.VideoGenre
-	89, // 41: ingestion.v1.AuditLog.old_values:type_name -> ingestion.v1.AuditLog.OldValuesEntry
-	90, // 42: ingestion.v1.AuditLog.new_values:type_name -> ingestion.v1.AuditLog.NewValuesEntry
-	93, // 43: ingestion.v1.AuditLog.created_at:type_name -> google.protobuf.Timestamp
-	64, // 44: ingestion.v1.ListAuditLogsResponse.audit_logs:type_name -> ingestion.v1.AuditLog
-	64, // 45: ingestion.v1.GetAuditLogResponse.audit_log:type_name -> ingestion.v1.AuditLog
-	91, // 46: ingestion.v1.BatchJob.parameters:type_name -> ingestion.v1.BatchJob.ParametersEntry
-	93, // 47: ingestion.v1.BatchJob.started_at:type_name -> google.protobuf.Timestamp
-	93, // 48: ingestion.v1.BatchJob.completed_at:type_name -> google.protobuf.Timestamp
-	92, // 49: ingestion.v1.BatchJob.statistics:type_name -> ingestion.v1.BatchJob.StatisticsEntry
-	93, // 50: ingestion.v1.BatchJob.created_at:type_name -> google.protobuf.Timestamp
-	69, // 51: ingestion.v1.ListBatchJobsResponse.batch_jobs:type_name -> ingestion.v1.BatchJob
-	69, // 52: ingestion.v1.GetBatchJobResponse.batch_job:type_name -> ingestion.v1.BatchJob
-	93, // 53: ingestion.v1.VideoSnapshot.measured_at:type_name -> google.protobuf.Timestamp
-	93, // 54: ingestion.v1.VideoSnapshot.created_at:type_name -> google.protobuf.Timestamp
-	74, // 55: ingestion.v1.CreateSnapshotResponse.snapshot:type_name -> ingestion.v1.VideoSnapshot
-	74, // 56: ingestion.v1.GetSnapshotResponse.snapshot:type_name -> ingestion.v1.VideoSnapshot
-	74, // 57: ingestion.v1.ListSnapshotsResponse.snapshots:type_name -> ingestion.v1.VideoSnapshot
-	86, // 58: ingestion.v1.CollectAllTrendingResponse.genre_results:type_name -> ingestion.v1.CollectTrendingByGenreResponse
-	1,  // 59: ingestion.v1.IngestionService.GetChannel:input_type -> ingestion.v1.GetChannelRequest
-	3,  // 60: ingestion.v1.IngestionService.ListChannels:input_type -> ingestion.v1.ListChannelsRequest
-	5,  // 61: ingestion.v1.IngestionService.SubscribeChannel:input_type -> ingestion.v1.SubscribeChannelRequest
-	7,  // 62: ingestion.v1.IngestionService.UnsubscribeChannel:input_type -> ingestion.v1.UnsubscribeChannelRequest
-	10, // 63: ingestion.v1.IngestionService.GetVideo:input_type -> ingestion.v1.GetVideoRequest
-	12, // 64: ingestion.v1.IngestionService.ListVideos:input_type -> ingestion.v1.ListVideosRequest
-	14, // 65: ingestion.v1.IngestionService.CollectTrending:input_type -> ingestion.v1.CollectTrendingRequest
-	16, // 66: ingestion.v1.IngestionService.CollectSubscriptions:input_type -> ingestion.v1.CollectSubscriptionsRequest
-	75, // 67: ingestion.v1.IngestionService.CreateSnapshot:input_type -> ingestion.v1.CreateSnapshotRequest
-	77, // 68: ingestion.v1.IngestionService.GetSnapshot:input_type -> ingestion.v1.GetSnapshotRequest
-	79, // 69: ingestion.v1.IngestionService.ListSnapshots:input_type -> ingestion.v1.ListSnapshotsRequest
-	19, // 70: ingestion.v1.IngestionService.ListGenres:input_type -> ingestion.v1.ListGenresRequest
-	21, // 71: ingestion.v1.IngestionService.GetGenre:input_type -> ingestion.v1.GetGenreRequest
-	23, // 72: ingestion.v1.IngestionService.GetGenreByCode:input_type -> ingestion.v1.GetGenreByCodeRequest
-	25, // 73: ingestion.v1.IngestionService.CreateGenre:input_type -> ingestion.v1.CreateGenreRequest
-	27, // 74: ingestion.v1.IngestionService.UpdateGenre:input_type -> ingestion.v1.UpdateGenreRequest
-	29, // 75: ingestion.v1.IngestionService.EnableGenre:input_type -> ingestion.v1.EnableGenreRequest
-	31, // 76: ingestion.v1.IngestionService.DisableGenre:input_type -> ingestion.v1.DisableGenreRequest
-	34, // 77: ingestion.v1.IngestionService.ListYouTubeCategories:input_type -> ingestion.v1.ListYouTubeCategoriesRequest
-	36, // 78: ingestion.v1.IngestionService.GetYouTubeCategory:input_type -> ingestion.v1.GetYouTubeCategoryRequest
-	38, // 79: ingestion.v1.IngestionService.UpdateYouTubeCategory:input_type -> ingestion.v1.UpdateYouTubeCategoryRequest
-	41, // 80: ingestion.v1.IngestionService.GetKeyword:input_type -> ingestion.v1.GetKeywordRequest
-	43, // 81: ingestion.v1.IngestionService.ListKeywords:input_type -> ingestion.v1.ListKeywordsRequest
-	45, // 82: ingestion.v1.IngestionService.ListKeywordsByGenre:input_type -> ingestion.v1.ListKeywordsByGenreRequest
-	47, // 83: ingestion.v1.IngestionService.CreateKeyword:input_type -> ingestion.v1.CreateKeywordRequest
-	49, // 84: ingestion.v1.IngestionService.UpdateKeyword:input_type -> ingestion.v1.UpdateKeywordRequest
-	51, // 85: ingestion.v1.IngestionService.EnableKeyword:input_type -> ingestion.v1.EnableKeywordRequest
-	53, // 86: ingestion.v1.IngestionService.DisableKeyword:input_type -> ingestion.v1.DisableKeywordRequest
-	55, // 87: ingestion.v1.IngestionService.DeleteKeyword:input_type -> ingestion.v1.DeleteKeywordRequest
-	58, // 88: ingestion.v1.IngestionService.ListVideoGenres:input_type -> ingestion.v1.ListVideoGenresRequest
-	60, // 89: ingestion.v1.IngestionService.AssignVideoToGenre:input_type -> ingestion.v1.AssignVideoToGenreRequest
-	62, // 90: ingestion.v1.IngestionService.RemoveVideoFromGenre:input_type -> ingestion.v1.RemoveVideoFromGenreRequest
-	65, // 91: ingestion.v1.IngestionService.ListAuditLogs:input_type -> ingestion.v1.ListAuditLogsRequest
-	67, // 92: ingestion.v1.IngestionService.GetAuditLog:input_type -> ingestion.v1.GetAuditLogRequest
-	70, // 93: ingestion.v1.IngestionService.ListBatchJobs:input_type -> ingestion.v1.ListBatchJobsRequest
-	72, // 94: ingestion.v1.IngestionService.GetBatchJob:input_type -> ingestion.v1.GetBatchJobRequest
-	81, // 95: ingestion.v1.IngestionService.ScheduleSnapshots:input_type -> ingestion.v1.ScheduleSnapshotsRequest
-	83, // 96: ingestion.v1.IngestionService.UpdateChannels:input_type -> ingestion.v1.UpdateChannelsRequest
-	85, // 97: ingestion.v1.IngestionService.CollectTrendingByGenre:input_type -> ingestion.v1.CollectTrendingByGenreRequest
-	87, // 98: ingestion.v1.IngestionService.CollectAllTrending:input_type -> ingestion.v1.CollectAllTrendingRequest
-	2,  // 99: ingestion.v1.IngestionService.GetChannel:output_type -> ingestion.v1.GetChannelResponse
-	4,  // 100: ingestion.v1.IngestionService.ListChannels:output_type -> ingestion.v1.ListChannelsResponse
-	6,  // 101: ingestion.v1.IngestionService.SubscribeChannel:output_type -> ingestion.v1.SubscribeChannelResponse
-	8,  // 102: ingestion.v1.IngestionService.UnsubscribeChannel:output_type -> ingestion.v1.UnsubscribeChannelResponse
-	11, // 103: ingestion.v1.IngestionService.GetVideo:output_type -> ingestion.v1.GetVideoResponse
-	13, // 104: ingestion.v1.IngestionService.ListVideos:output_type -> ingestion.v1.ListVideosResponse
-	15, // 105: ingestion.v1.IngestionService.CollectTrending:output_type -> ingestion.v1.CollectTrendingResponse
-	17, // 106: ingestion.v1.IngestionService.CollectSubscriptions:output_type -> ingestion.v1.CollectSubscriptionsResponse
-	76, // 107: ingestion.v1.IngestionService.CreateSnapshot:output_type -> ingestion.v1.CreateSnapshotResponse
-	78, // 108: ingestion.v1.IngestionService.GetSnapshot:output_type -> ingestion.v1.GetSnapshotResponse
-	80, // 109: ingestion.v1.IngestionService.ListSnapshots:output_type -> ingestion.v1.ListSnapshotsResponse
-	20, // 110: ingestion.v1.IngestionService.ListGenres:output_type -> ingestion.v1.ListGenresResponse
-	22, // 111: ingestion.v1.IngestionService.GetGenre:output_type -> ingestion.v1.GetGenreResponse
-	24, // 112: ingestion.v1.IngestionService.GetGenreByCode:output_type -> ingestion.v1.GetGenreByCodeResponse
-	26, // 113: ingestion.v1.IngestionService.CreateGenre:output_type -> ingestion.v1.CreateGenreResponse
-	28, // 114: ingestion.v1.IngestionService.UpdateGenre:output_type -> ingestion.v1.UpdateGenreResponse
-	30, // 115: ingestion.v1.IngestionService.EnableGenre:output_type -> ingestion.v1.EnableGenreResponse
-	32, // 116: ingestion.v1.IngestionService.DisableGenre:output_type -> ingestion.v1.DisableGenreResponse
-	35, // 117: ingestion.v1.IngestionService.ListYouTubeCategories:output_type -> ingestion.v1.ListYouTubeCategoriesResponse
-	37, // 118: ingestion.v1.IngestionService.GetYouTubeCategory:output_type -> ingestion.v1.GetYouTubeCategoryResponse
-	39, // 119: ingestion.v1.IngestionService.UpdateYouTubeCategory:output_type -> ingestion.v1.UpdateYouTubeCategoryResponse
-	42, // 120: ingestion.v1.IngestionService.GetKeyword:output_type -> ingestion.v1.GetKeywordResponse
-	44, // 121: ingestion.v1.IngestionService.ListKeywords:output_type -> ingestion.v1.ListKeywordsResponse
-	46, // 122: ingestion.v1.IngestionService.ListKeywordsByGenre:output_type -> ingestion.v1.ListKeywordsByGenreResponse
-	48, // 123: ingestion.v1.IngestionService.CreateKeyword:output_type -> ingestion.v1.CreateKeywordResponse
-	50, // 124: ingestion.v1.IngestionService.UpdateKeyword:output_type -> ingestion.v1.UpdateKeywordResponse
-	52, // 125: ingestion.v1.IngestionService.EnableKeyword:output_type -> ingestion.v1.EnableKeywordResponse
-	54, // 126: ingestion.v1.IngestionService.DisableKeyword:output_type -> ingestion.v1.DisableKeywordResponse
-	56, // 127: ingestion.v1.IngestionService.DeleteKeyword:output_type -> ingestion.v1.DeleteKeywordResponse
-	59, // 128: ingestion.v1.IngestionService.ListVideoGenres:output_type -> ingestion.v1.ListVideoGenresResponse
-	61, // 129: ingestion.v1.IngestionService.AssignVideoToGenre:output_type -> ingestion.v1.AssignVideoToGenreResponse
-	63, // 130: ingestion.v1.IngestionService.RemoveVideoFromGenre:output_type -> ingestion.v1.RemoveVideoFromGenreResponse
-	66, // 131: ingestion.v1.IngestionService.ListAuditLogs:output_type -> ingestion.v1.ListAuditLogsResponse
-	68, // 132: ingestion.v1.IngestionService.GetAuditLog:output_type -> ingestion.v1.GetAuditLogResponse
-	71, // 133: ingestion.v1.IngestionService.ListBatchJobs:output_type -> ingestion.v1.ListBatchJobsResponse
-	73, // 134: ingestion.v1.IngestionService.GetBatchJob:output_type -> ingestion.v1.GetBatchJobResponse
-	82, // 135: ingestion.v1.IngestionService.ScheduleSnapshots:output_type -> ingestion.v1.ScheduleSnapshotsResponse
-	84, // 136: ingestion.v1.IngestionService.UpdateChannels:output_type -> ingestion.v1.UpdateChannelsResponse
-	86, // 137: ingestion.v1.IngestionService.CollectTrendingByGenre:output_type -> ingestion.v1.CollectTrendingByGenreResponse
-	88, // 138: ingestion.v1.IngestionService.CollectAllTrending:output_type -> ingestion.v1.CollectAllTrendingResponse
-	99, // [99:139] is the sub-list for method output_type
-	59, // [59:99] is the sub-list for method input_type
-	59, // [59:59] is the sub-list for extension type_name
-	59, // [59:59] is the sub-list for extension extendee
-	0,  // [0:59] is the sub-list for field type_name
+	144, // 0: ingestion.v1.Channel.created_at:type_name -> google.protobuf.Timestamp
+	144, // 1: ingestion.v1.Channel.updated_at:type_name -> google.protobuf.Timestamp
+	144, // 2: ingestion.v1.Channel.deleted_at:type_name -> google.protobuf.Timestamp
+	0,   // 3: ingestion.v1.GetChannelResponse.channel:type_name -> ingestion.v1.Channel
+	0,   // 4: ingestion.v1.ListChannelsResponse.channels:type_name -> ingestion.v1.Channel
+	0,   // 5: ingestion.v1.SubscribeChannelResponse.channel:type_name -> ingestion.v1.Channel
+	0,   // 6: ingestion.v1.UnsubscribeChannelResponse.channel:type_name -> ingestion.v1.Channel
+	144, // 7: ingestion.v1.ChannelGrowthPoint.measured_at:type_name -> google.protobuf.Timestamp
+	9,   // 8: ingestion.v1.GetChannelGrowthResponse.points:type_name -> ingestion.v1.ChannelGrowthPoint
+	144, // 9: ingestion.v1.Video.published_at:type_name -> google.protobuf.Timestamp
+	144, // 10: ingestion.v1.Video.created_at:type_name -> google.protobuf.Timestamp
+	144, // 11: ingestion.v1.Video.updated_at:type_name -> google.protobuf.Timestamp
+	144, // 12: ingestion.v1.Video.deleted_at:type_name -> google.protobuf.Timestamp
+	12,  // 13: ingestion.v1.GetVideoResponse.video:type_name -> ingestion.v1.Video
+	0,   // 14: ingestion.v1.GetVideoResponse.channel:type_name -> ingestion.v1.Channel
+	21,  // 15: ingestion.v1.GetVideoResponse.genres:type_name -> ingestion.v1.Genre
+	122, // 16: ingestion.v1.GetVideoResponse.latest_snapshot:type_name -> ingestion.v1.VideoSnapshot
+	144, // 17: ingestion.v1.ListVideosRequest.published_after:type_name -> google.protobuf.Timestamp
+	144, // 18: ingestion.v1.ListVideosRequest.published_before:type_name -> google.protobuf.Timestamp
+	12,  // 19: ingestion.v1.ListVideosResponse.videos:type_name -> ingestion.v1.Video
+	144, // 20: ingestion.v1.Genre.created_at:type_name -> google.protobuf.Timestamp
+	144, // 21: ingestion.v1.Genre.updated_at:type_name -> google.protobuf.Timestamp
+	21,  // 22: ingestion.v1.ListGenresResponse.genres:type_name -> ingestion.v1.Genre
+	21,  // 23: ingestion.v1.GetGenreResponse.genre:type_name -> ingestion.v1.Genre
+	21,  // 24: ingestion.v1.GetGenreByCodeResponse.genre:type_name -> ingestion.v1.Genre
+	21,  // 25: ingestion.v1.CreateGenreResponse.genre:type_name -> ingestion.v1.Genre
+	21,  // 26: ingestion.v1.UpdateGenreResponse.genre:type_name -> ingestion.v1.Genre
+	21,  // 27: ingestion.v1.EnableGenreResponse.genre:type_name -> ingestion.v1.Genre
+	21,  // 28: ingestion.v1.DisableGenreResponse.genre:type_name -> ingestion.v1.Genre
+	144, // 29: ingestion.v1.YouTubeCategory.created_at:type_name -> google.protobuf.Timestamp
+	144, // 30: ingestion.v1.YouTubeCategory.updated_at:type_name -> google.protobuf.Timestamp
+	36,  // 31: ingestion.v1.ListYouTubeCategoriesResponse.categories:type_name -> ingestion.v1.YouTubeCategory
+	36,  // 32: ingestion.v1.GetYouTubeCategoryResponse.category:type_name -> ingestion.v1.YouTubeCategory
+	36,  // 33: ingestion.v1.UpdateYouTubeCategoryResponse.category:type_name -> ingestion.v1.YouTubeCategory
+	144, // 34: ingestion.v1.Keyword.created_at:type_name -> google.protobuf.Timestamp
+	144, // 35: ingestion.v1.Keyword.updated_at:type_name -> google.protobuf.Timestamp
+	144, // 36: ingestion.v1.Keyword.deleted_at:type_name -> google.protobuf.Timestamp
+	43,  // 37: ingestion.v1.GetKeywordResponse.keyword:type_name -> ingestion.v1.Keyword
+	43,  // 38: ingestion.v1.ListKeywordsResponse.keywords:type_name -> ingestion.v1.Keyword
+	43,  // 39: ingestion.v1.ListKeywordsByGenreResponse.keywords:type_name -> ingestion.v1.Keyword
+	43,  // 40: ingestion.v1.CreateKeywordResponse.keyword:type_name -> ingestion.v1.Keyword
+	43,  // 41: ingestion.v1.UpdateKeywordResponse.keyword:type_name -> ingestion.v1.Keyword
+	43,  // 42: ingestion.v1.EnableKeywordResponse.keyword:type_name -> ingestion.v1.Keyword
+	43,  // 43: ingestion.v1.DisableKeywordResponse.keyword:type_name -> ingestion.v1.Keyword
+	144, // 44: ingestion.v1.KeywordGroup.created_at:type_name -> google.protobuf.Timestamp
+	144, // 45: ingestion.v1.KeywordGroup.updated_at:type_name -> google.protobuf.Timestamp
+	60,  // 46: ingestion.v1.GetKeywordGroupResponse.keyword_group:type_name -> ingestion.v1.KeywordGroup
+	60,  // 47: ingestion.v1.ListKeywordGroupsResponse.keyword_groups:type_name -> ingestion.v1.KeywordGroup
+	60,  // 48: ingestion.v1.CreateKeywordGroupResponse.keyword_group:type_name -> ingestion.v1.KeywordGroup
+	60,  // 49: ingestion.v1.UpdateKeywordGroupResponse.keyword_group:type_name -> ingestion.v1.KeywordGroup
+	60,  // 50: ingestion.v1.UpdateKeywordGroupKeywordsResponse.keyword_group:type_name -> ingestion.v1.KeywordGroup
+	60,  // 51: ingestion.v1.AddKeywordGroupItemResponse.keyword_group:type_name -> ingestion.v1.KeywordGroup
+	60,  // 52: ingestion.v1.RemoveKeywordGroupItemResponse.keyword_group:type_name -> ingestion.v1.KeywordGroup
+	60,  // 53: ingestion.v1.EnableKeywordGroupResponse.keyword_group:type_name -> ingestion.v1.KeywordGroup
+	60,  // 54: ingestion.v1.DisableKeywordGroupResponse.keyword_group:type_name -> ingestion.v1.KeywordGroup
+	85,  // 55: ingestion.v1.TestKeywordGroupResponse.newly_included:type_name -> ingestion.v1.KeywordGroupTestMatch
+	85,  // 56: ingestion.v1.TestKeywordGroupResponse.newly_excluded:type_name -> ingestion.v1.KeywordGroupTestMatch
+	144, // 57: ingestion.v1.KeywordGroupTestMatch.published_at:type_name -> google.protobuf.Timestamp
+	144, // 58: ingestion.v1.KeywordSynonym.created_at:type_name -> google.protobuf.Timestamp
+	144, // 59: ingestion.v1.KeywordSynonym.updated_at:type_name -> google.protobuf.Timestamp
+	86,  // 60: ingestion.v1.ListKeywordSynonymsResponse.keyword_synonyms:type_name -> ingestion.v1.KeywordSynonym
+	86,  // 61: ingestion.v1.GetKeywordSynonymResponse.keyword_synonym:type_name -> ingestion.v1.KeywordSynonym
+	86,  // 62: ingestion.v1.CreateKeywordSynonymResponse.keyword_synonym:type_name -> ingestion.v1.KeywordSynonym
+	86,  // 63: ingestion.v1.UpdateKeywordSynonymResponse.keyword_synonym:type_name -> ingestion.v1.KeywordSynonym
+	144, // 64: ingestion.v1.VideoGenre.created_at:type_name -> google.protobuf.Timestamp
+	98,  // 65: ingestion.v1.VideoGenre.match:type_name -> ingestion.v1.GenreMatch
+	99,  // 66: ingestion.v1.GenreMatch.matches:type_name -> ingestion.v1.GenreKeywordMatch
+	144, // 67: ingestion.v1.GenreMatch.evaluated_at:type_name -> google.protobuf.Timestamp
+	97,  // 68: ingestion.v1.ListVideoGenresResponse.video_genres:type_name -> ingestion.v1.VideoGenre
+	97,  // 69: ingestion.v1.AssignVideoToGenreResponse.video_genre:type_name -> ingestion.v1.VideoGenre
+	139, // 70: ingestion.v1.AuditLog.old_values:type_name -> ingestion.v1.AuditLog.OldValuesEntry
+	140, // 71: ingestion.v1.AuditLog.new_values:type_name -> ingestion.v1.AuditLog.NewValuesEntry
+	144, // 72: ingestion.v1.AuditLog.created_at:type_name -> google.protobuf.Timestamp
+	144, // 73: ingestion.v1.ListAuditLogsRequest.created_after:type_name -> google.protobuf.Timestamp
+	144, // 74: ingestion.v1.ListAuditLogsRequest.created_before:type_name -> google.protobuf.Timestamp
+	106, // 75: ingestion.v1.ListAuditLogsResponse.audit_logs:type_name -> ingestion.v1.AuditLog
+	106, // 76: ingestion.v1.GetAuditLogResponse.audit_log:type_name -> ingestion.v1.AuditLog
+	144, // 77: ingestion.v1.ExportAuditLogsRequest.created_after:type_name -> google.protobuf.Timestamp
+	144, // 78: ingestion.v1.ExportAuditLogsRequest.created_before:type_name -> google.protobuf.Timestamp
+	141, // 79: ingestion.v1.BatchJob.parameters:type_name -> ingestion.v1.BatchJob.ParametersEntry
+	144, // 80: ingestion.v1.BatchJob.started_at:type_name -> google.protobuf.Timestamp
+	144, // 81: ingestion.v1.BatchJob.completed_at:type_name -> google.protobuf.Timestamp
+	142, // 82: ingestion.v1.BatchJob.statistics:type_name -> ingestion.v1.BatchJob.StatisticsEntry
+	144, // 83: ingestion.v1.BatchJob.created_at:type_name -> google.protobuf.Timestamp
+	144, // 84: ingestion.v1.BatchJob.cancel_requested_at:type_name -> google.protobuf.Timestamp
+	113, // 85: ingestion.v1.ListBatchJobsResponse.batch_jobs:type_name -> ingestion.v1.BatchJob
+	113, // 86: ingestion.v1.GetBatchJobResponse.batch_job:type_name -> ingestion.v1.BatchJob
+	113, // 87: ingestion.v1.CancelBatchJobResponse.batch_job:type_name -> ingestion.v1.BatchJob
+	113, // 88: ingestion.v1.RetryBatchJobResponse.batch_job:type_name -> ingestion.v1.BatchJob
+	144, // 89: ingestion.v1.VideoSnapshot.measured_at:type_name -> google.protobuf.Timestamp
+	144, // 90: ingestion.v1.VideoSnapshot.created_at:type_name -> google.protobuf.Timestamp
+	143, // 91: ingestion.v1.VideoSnapshot.metrics:type_name -> ingestion.v1.VideoSnapshot.MetricsEntry
+	122, // 92: ingestion.v1.CreateSnapshotResponse.snapshot:type_name -> ingestion.v1.VideoSnapshot
+	122, // 93: ingestion.v1.GetSnapshotResponse.snapshot:type_name -> ingestion.v1.VideoSnapshot
+	144, // 94: ingestion.v1.ListSnapshotsRequest.measured_after:type_name -> google.protobuf.Timestamp
+	144, // 95: ingestion.v1.ListSnapshotsRequest.measured_before:type_name -> google.protobuf.Timestamp
+	122, // 96: ingestion.v1.ListSnapshotsResponse.snapshots:type_name -> ingestion.v1.VideoSnapshot
+	144, // 97: ingestion.v1.StreamSnapshotsRequest.measured_after:type_name -> google.protobuf.Timestamp
+	144, // 98: ingestion.v1.StreamSnapshotsRequest.measured_before:type_name -> google.protobuf.Timestamp
+	122, // 99: ingestion.v1.StreamSnapshotsResponse.snapshots:type_name -> ingestion.v1.VideoSnapshot
+	144, // 100: ingestion.v1.ScheduleSnapshotsResponse.published_since:type_name -> google.protobuf.Timestamp
+	136, // 101: ingestion.v1.CollectAllTrendingResponse.genre_results:type_name -> ingestion.v1.CollectTrendingByGenreResponse
+	1,   // 102: ingestion.v1.IngestionService.GetChannel:input_type -> ingestion.v1.GetChannelRequest
+	3,   // 103: ingestion.v1.IngestionService.ListChannels:input_type -> ingestion.v1.ListChannelsRequest
+	5,   // 104: ingestion.v1.IngestionService.SubscribeChannel:input_type -> ingestion.v1.SubscribeChannelRequest
+	7,   // 105: ingestion.v1.IngestionService.UnsubscribeChannel:input_type -> ingestion.v1.UnsubscribeChannelRequest
+	10,  // 106: ingestion.v1.IngestionService.GetChannelGrowth:input_type -> ingestion.v1.GetChannelGrowthRequest
+	13,  // 107: ingestion.v1.IngestionService.GetVideo:input_type -> ingestion.v1.GetVideoRequest
+	15,  // 108: ingestion.v1.IngestionService.ListVideos:input_type -> ingestion.v1.ListVideosRequest
+	17,  // 109: ingestion.v1.IngestionService.CollectTrending:input_type -> ingestion.v1.CollectTrendingRequest
+	19,  // 110: ingestion.v1.IngestionService.CollectSubscriptions:input_type -> ingestion.v1.CollectSubscriptionsRequest
+	123, // 111: ingestion.v1.IngestionService.CreateSnapshot:input_type -> ingestion.v1.CreateSnapshotRequest
+	125, // 112: ingestion.v1.IngestionService.GetSnapshot:input_type -> ingestion.v1.GetSnapshotRequest
+	127, // 113: ingestion.v1.IngestionService.ListSnapshots:input_type -> ingestion.v1.ListSnapshotsRequest
+	129, // 114: ingestion.v1.IngestionService.StreamSnapshots:input_type -> ingestion.v1.StreamSnapshotsRequest
+	22,  // 115: ingestion.v1.IngestionService.ListGenres:input_type -> ingestion.v1.ListGenresRequest
+	24,  // 116: ingestion.v1.IngestionService.GetGenre:input_type -> ingestion.v1.GetGenreRequest
+	26,  // 117: ingestion.v1.IngestionService.GetGenreByCode:input_type -> ingestion.v1.GetGenreByCodeRequest
+	28,  // 118: ingestion.v1.IngestionService.CreateGenre:input_type -> ingestion.v1.CreateGenreRequest
+	30,  // 119: ingestion.v1.IngestionService.UpdateGenre:input_type -> ingestion.v1.UpdateGenreRequest
+	32,  // 120: ingestion.v1.IngestionService.EnableGenre:input_type -> ingestion.v1.EnableGenreRequest
+	34,  // 121: ingestion.v1.IngestionService.DisableGenre:input_type -> ingestion.v1.DisableGenreRequest
+	37,  // 122: ingestion.v1.IngestionService.ListYouTubeCategories:input_type -> ingestion.v1.ListYouTubeCategoriesRequest
+	39,  // 123: ingestion.v1.IngestionService.GetYouTubeCategory:input_type -> ingestion.v1.GetYouTubeCategoryRequest
+	41,  // 124: ingestion.v1.IngestionService.UpdateYouTubeCategory:input_type -> ingestion.v1.UpdateYouTubeCategoryRequest
+	44,  // 125: ingestion.v1.IngestionService.GetKeyword:input_type -> ingestion.v1.GetKeywordRequest
+	46,  // 126: ingestion.v1.IngestionService.ListKeywords:input_type -> ingestion.v1.ListKeywordsRequest
+	48,  // 127: ingestion.v1.IngestionService.ListKeywordsByGenre:input_type -> ingestion.v1.ListKeywordsByGenreRequest
+	50,  // 128: ingestion.v1.IngestionService.CreateKeyword:input_type -> ingestion.v1.CreateKeywordRequest
+	52,  // 129: ingestion.v1.IngestionService.UpdateKeyword:input_type -> ingestion.v1.UpdateKeywordRequest
+	54,  // 130: ingestion.v1.IngestionService.EnableKeyword:input_type -> ingestion.v1.EnableKeywordRequest
+	56,  // 131: ingestion.v1.IngestionService.DisableKeyword:input_type -> ingestion.v1.DisableKeywordRequest
+	58,  // 132: ingestion.v1.IngestionService.DeleteKeyword:input_type -> ingestion.v1.DeleteKeywordRequest
+	61,  // 133: ingestion.v1.IngestionService.GetKeywordGroup:input_type -> ingestion.v1.GetKeywordGroupRequest
+	63,  // 134: ingestion.v1.IngestionService.ListKeywordGroups:input_type -> ingestion.v1.ListKeywordGroupsRequest
+	65,  // 135: ingestion.v1.IngestionService.CreateKeywordGroup:input_type -> ingestion.v1.CreateKeywordGroupRequest
+	67,  // 136: ingestion.v1.IngestionService.UpdateKeywordGroup:input_type -> ingestion.v1.UpdateKeywordGroupRequest
+	69,  // 137: ingestion.v1.IngestionService.UpdateKeywordGroupKeywords:input_type -> ingestion.v1.UpdateKeywordGroupKeywordsRequest
+	71,  // 138: ingestion.v1.IngestionService.AddKeywordGroupItem:input_type -> ingestion.v1.AddKeywordGroupItemRequest
+	73,  // 139: ingestion.v1.IngestionService.RemoveKeywordGroupItem:input_type -> ingestion.v1.RemoveKeywordGroupItemRequest
+	75,  // 140: ingestion.v1.IngestionService.EnableKeywordGroup:input_type -> ingestion.v1.EnableKeywordGroupRequest
+	77,  // 141: ingestion.v1.IngestionService.DisableKeywordGroup:input_type -> ingestion.v1.DisableKeywordGroupRequest
+	79,  // 142: ingestion.v1.IngestionService.DeleteKeywordGroup:input_type -> ingestion.v1.DeleteKeywordGroupRequest
+	81,  // 143: ingestion.v1.IngestionService.GetKeywordGroupPattern:input_type -> ingestion.v1.GetKeywordGroupPatternRequest
+	83,  // 144: ingestion.v1.IngestionService.TestKeywordGroup:input_type -> ingestion.v1.TestKeywordGroupRequest
+	87,  // 145: ingestion.v1.IngestionService.ListKeywordSynonyms:input_type -> ingestion.v1.ListKeywordSynonymsRequest
+	89,  // 146: ingestion.v1.IngestionService.GetKeywordSynonym:input_type -> ingestion.v1.GetKeywordSynonymRequest
+	91,  // 147: ingestion.v1.IngestionService.CreateKeywordSynonym:input_type -> ingestion.v1.CreateKeywordSynonymRequest
+	93,  // 148: ingestion.v1.IngestionService.UpdateKeywordSynonym:input_type -> ingestion.v1.UpdateKeywordSynonymRequest
+	95,  // 149: ingestion.v1.IngestionService.DeleteKeywordSynonym:input_type -> ingestion.v1.DeleteKeywordSynonymRequest
+	100, // 150: ingestion.v1.IngestionService.ListVideoGenres:input_type -> ingestion.v1.ListVideoGenresRequest
+	102, // 151: ingestion.v1.IngestionService.AssignVideoToGenre:input_type -> ingestion.v1.AssignVideoToGenreRequest
+	104, // 152: ingestion.v1.IngestionService.RemoveVideoFromGenre:input_type -> ingestion.v1.RemoveVideoFromGenreRequest
+	107, // 153: ingestion.v1.IngestionService.ListAuditLogs:input_type -> ingestion.v1.ListAuditLogsRequest
+	109, // 154: ingestion.v1.IngestionService.GetAuditLog:input_type -> ingestion.v1.GetAuditLogRequest
+	111, // 155: ingestion.v1.IngestionService.ExportAuditLogs:input_type -> ingestion.v1.ExportAuditLogsRequest
+	114, // 156: ingestion.v1.IngestionService.ListBatchJobs:input_type -> ingestion.v1.ListBatchJobsRequest
+	116, // 157: ingestion.v1.IngestionService.GetBatchJob:input_type -> ingestion.v1.GetBatchJobRequest
+	118, // 158: ingestion.v1.IngestionService.CancelBatchJob:input_type -> ingestion.v1.CancelBatchJobRequest
+	120, // 159: ingestion.v1.IngestionService.RetryBatchJob:input_type -> ingestion.v1.RetryBatchJobRequest
+	131, // 160: ingestion.v1.IngestionService.ScheduleSnapshots:input_type -> ingestion.v1.ScheduleSnapshotsRequest
+	133, // 161: ingestion.v1.IngestionService.UpdateChannels:input_type -> ingestion.v1.UpdateChannelsRequest
+	135, // 162: ingestion.v1.IngestionService.CollectTrendingByGenre:input_type -> ingestion.v1.CollectTrendingByGenreRequest
+	137, // 163: ingestion.v1.IngestionService.CollectAllTrending:input_type -> ingestion.v1.CollectAllTrendingRequest
+	2,   // 164: ingestion.v1.IngestionService.GetChannel:output_type -> ingestion.v1.GetChannelResponse
+	4,   // 165: ingestion.v1.IngestionService.ListChannels:output_type -> ingestion.v1.ListChannelsResponse
+	6,   // 166: ingestion.v1.IngestionService.SubscribeChannel:output_type -> ingestion.v1.SubscribeChannelResponse
+	8,   // 167: ingestion.v1.IngestionService.UnsubscribeChannel:output_type -> ingestion.v1.UnsubscribeChannelResponse
+	11,  // 168: ingestion.v1.IngestionService.GetChannelGrowth:output_type -> ingestion.v1.GetChannelGrowthResponse
+	14,  // 169: ingestion.v1.IngestionService.GetVideo:output_type -> ingestion.v1.GetVideoResponse
+	16,  // 170: ingestion.v1.IngestionService.ListVideos:output_type -> ingestion.v1.ListVideosResponse
+	18,  // 171: ingestion.v1.IngestionService.CollectTrending:output_type -> ingestion.v1.CollectTrendingResponse
+	20,  // 172: ingestion.v1.IngestionService.CollectSubscriptions:output_type -> ingestion.v1.CollectSubscriptionsResponse
+	124, // 173: ingestion.v1.IngestionService.CreateSnapshot:output_type -> ingestion.v1.CreateSnapshotResponse
+	126, // 174: ingestion.v1.IngestionService.GetSnapshot:output_type -> ingestion.v1.GetSnapshotResponse
+	128, // 175: ingestion.v1.IngestionService.ListSnapshots:output_type -> ingestion.v1.ListSnapshotsResponse
+	130, // 176: ingestion.v1.IngestionService.StreamSnapshots:output_type -> ingestion.v1.StreamSnapshotsResponse
+	23,  // 177: ingestion.v1.IngestionService.ListGenres:output_type -> ingestion.v1.ListGenresResponse
+	25,  // 178: ingestion.v1.IngestionService.GetGenre:output_type -> ingestion.v1.GetGenreResponse
+	27,  // 179: ingestion.v1.IngestionService.GetGenreByCode:output_type -> ingestion.v1.GetGenreByCodeResponse
+	29,  // 180: ingestion.v1.IngestionService.CreateGenre:output_type -> ingestion.v1.CreateGenreResponse
+	31,  // 181: ingestion.v1.IngestionService.UpdateGenre:output_type -> ingestion.v1.UpdateGenreResponse
+	33,  // 182: ingestion.v1.IngestionService.EnableGenre:output_type -> ingestion.v1.EnableGenreResponse
+	35,  // 183: ingestion.v1.IngestionService.DisableGenre:output_type -> ingestion.v1.DisableGenreResponse
+	38,  // 184: ingestion.v1.IngestionService.ListYouTubeCategories:output_type -> ingestion.v1.ListYouTubeCategoriesResponse
+	40,  // 185: ingestion.v1.IngestionService.GetYouTubeCategory:output_type -> ingestion.v1.GetYouTubeCategoryResponse
+	42,  // 186: ingestion.v1.IngestionService.UpdateYouTubeCategory:output_type -> ingestion.v1.UpdateYouTubeCategoryResponse
+	45,  // 187: ingestion.v1.IngestionService.GetKeyword:output_type -> ingestion.v1.GetKeywordResponse
+	47,  // 188: ingestion.v1.IngestionService.ListKeywords:output_type -> ingestion.v1.ListKeywordsResponse
+	49,  // 189: ingestion.v1.IngestionService.ListKeywordsByGenre:output_type -> ingestion.v1.ListKeywordsByGenreResponse
+	51,  // 190: ingestion.v1.IngestionService.CreateKeyword:output_type -> ingestion.v1.CreateKeywordResponse
+	53,  // 191: ingestion.v1.IngestionService.UpdateKeyword:output_type -> ingestion.v1.UpdateKeywordResponse
+	55,  // 192: ingestion.v1.IngestionService.EnableKeyword:output_type -> ingestion.v1.EnableKeywordResponse
+	57,  // 193: ingestion.v1.IngestionService.DisableKeyword:output_type -> ingestion.v1.DisableKeywordResponse
+	59,  // 194: ingestion.v1.IngestionService.DeleteKeyword:output_type -> ingestion.v1.DeleteKeywordResponse
+	62,  // 195: ingestion.v1.IngestionService.GetKeywordGroup:output_type -> ingestion.v1.GetKeywordGroupResponse
+	64,  // 196: ingestion.v1.IngestionService.ListKeywordGroups:output_type -> ingestion.v1.ListKeywordGroupsResponse
+	66,  // 197: ingestion.v1.IngestionService.CreateKeywordGroup:output_type -> ingestion.v1.CreateKeywordGroupResponse
+	68,  // 198: ingestion.v1.IngestionService.UpdateKeywordGroup:output_type -> ingestion.v1.UpdateKeywordGroupResponse
+	70,  // 199: ingestion.v1.IngestionService.UpdateKeywordGroupKeywords:output_type -> ingestion.v1.UpdateKeywordGroupKeywordsResponse
+	72,  // 200: ingestion.v1.IngestionService.AddKeywordGroupItem:output_type -> ingestion.v1.AddKeywordGroupItemResponse
+	74,  // 201: ingestion.v1.IngestionService.RemoveKeywordGroupItem:output_type -> ingestion.v1.RemoveKeywordGroupItemResponse
+	76,  // 202: ingestion.v1.IngestionService.EnableKeywordGroup:output_type -> ingestion.v1.EnableKeywordGroupResponse
+	78,  // 203: ingestion.v1.IngestionService.DisableKeywordGroup:output_type -> ingestion.v1.DisableKeywordGroupResponse
+	80,  // 204: ingestion.v1.IngestionService.DeleteKeywordGroup:output_type -> ingestion.v1.DeleteKeywordGroupResponse
+	82,  // 205: ingestion.v1.IngestionService.GetKeywordGroupPattern:output_type -> ingestion.v1.GetKeywordGroupPatternResponse
+	84,  // 206: ingestion.v1.IngestionService.TestKeywordGroup:output_type -> ingestion.v1.TestKeywordGroupResponse
+	88,  // 207: ingestion.v1.IngestionService.ListKeywordSynonyms:output_type -> ingestion.v1.ListKeywordSynonymsResponse
+	90,  // 208: ingestion.v1.IngestionService.GetKeywordSynonym:output_type -> ingestion.v1.GetKeywordSynonymResponse
+	92,  // 209: ingestion.v1.IngestionService.CreateKeywordSynonym:output_type -> ingestion.v1.CreateKeywordSynonymResponse
+	94,  // 210: ingestion.v1.IngestionService.UpdateKeywordSynonym:output_type -> ingestion.v1.UpdateKeywordSynonymResponse
+	96,  // 211: ingestion.v1.IngestionService.DeleteKeywordSynonym:output_type -> ingestion.v1.DeleteKeywordSynonymResponse
+	101, // 212: ingestion.v1.IngestionService.ListVideoGenres:output_type -> ingestion.v1.ListVideoGenresResponse
+	103, // 213: ingestion.v1.IngestionService.AssignVideoToGenre:output_type -> ingestion.v1.AssignVideoToGenreResponse
+	105, // 214: ingestion.v1.IngestionService.RemoveVideoFromGenre:output_type -> ingestion.v1.RemoveVideoFromGenreResponse
+	108, // 215: ingestion.v1.IngestionService.ListAuditLogs:output_type -> ingestion.v1.ListAuditLogsResponse
+	110, // 216: ingestion.v1.IngestionService.GetAuditLog:output_type -> ingestion.v1.GetAuditLogResponse
+	112, // 217: ingestion.v1.IngestionService.ExportAuditLogs:output_type -> ingestion.v1.ExportAuditLogsResponse
+	115, // 218: ingestion.v1.IngestionService.ListBatchJobs:output_type -> ingestion.v1.ListBatchJobsResponse
+	117, // 219: ingestion.v1.IngestionService.GetBatchJob:output_type -> ingestion.v1.GetBatchJobResponse
+	119, // 220: ingestion.v1.IngestionService.CancelBatchJob:output_type -> ingestion.v1.CancelBatchJobResponse
+	121, // 221: ingestion.v1.IngestionService.RetryBatchJob:output_type -> ingestion.v1.RetryBatchJobResponse
+	132, // 222: ingestion.v1.IngestionService.ScheduleSnapshots:output_type -> ingestion.v1.ScheduleSnapshotsResponse
+	134, // 223: ingestion.v1.IngestionService.UpdateChannels:output_type -> ingestion.v1.UpdateChannelsResponse
+	136, // 224: ingestion.v1.IngestionService.CollectTrendingByGenre:output_type -> ingestion.v1.CollectTrendingByGenreResponse
+	138, // 225: ingestion.v1.IngestionService.CollectAllTrending:output_type -> ingestion.v1.CollectAllTrendingResponse
+	164, // [164:226] is the sub-list for method output_type
+	102, // [102:164] is the sub-list for method input_type
+	102, // [102:102] is the sub-list for extension type_name
+	102, // [102:102] is the sub-list for extension extendee
+	0,   // [0:102] is the sub-list for field type_name
 }
 
 func init() { file_ingestion_v1_ingestion_proto_init() }
@@ -5886,13 +9715,21 @@ func file_ingestion_v1_ingestion_proto_init() {
 	if File_ingestion_v1_ingestion_proto != nil {
 		return
 	}
+	file_ingestion_v1_ingestion_proto_msgTypes[15].OneofWrappers = []any{}
+	file_ingestion_v1_ingestion_proto_msgTypes[30].OneofWrappers = []any{}
+	file_ingestion_v1_ingestion_proto_msgTypes[67].OneofWrappers = []any{}
+	file_ingestion_v1_ingestion_proto_msgTypes[83].OneofWrappers = []any{}
+	file_ingestion_v1_ingestion_proto_msgTypes[93].OneofWrappers = []any{}
+	file_ingestion_v1_ingestion_proto_msgTypes[122].OneofWrappers = []any{}
+	file_ingestion_v1_ingestion_proto_msgTypes[127].OneofWrappers = []any{}
+	file_ingestion_v1_ingestion_proto_msgTypes[129].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ingestion_v1_ingestion_proto_rawDesc), len(file_ingestion_v1_ingestion_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   93,
+			NumMessages:   144,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
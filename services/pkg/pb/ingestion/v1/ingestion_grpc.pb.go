@@ -19,46 +19,68 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	IngestionService_GetChannel_FullMethodName             = "/ingestion.v1.IngestionService/GetChannel"
-	IngestionService_ListChannels_FullMethodName           = "/ingestion.v1.IngestionService/ListChannels"
-	IngestionService_SubscribeChannel_FullMethodName       = "/ingestion.v1.IngestionService/SubscribeChannel"
-	IngestionService_UnsubscribeChannel_FullMethodName     = "/ingestion.v1.IngestionService/UnsubscribeChannel"
-	IngestionService_GetVideo_FullMethodName               = "/ingestion.v1.IngestionService/GetVideo"
-	IngestionService_ListVideos_FullMethodName             = "/ingestion.v1.IngestionService/ListVideos"
-	IngestionService_CollectTrending_FullMethodName        = "/ingestion.v1.IngestionService/CollectTrending"
-	IngestionService_CollectSubscriptions_FullMethodName   = "/ingestion.v1.IngestionService/CollectSubscriptions"
-	IngestionService_CreateSnapshot_FullMethodName         = "/ingestion.v1.IngestionService/CreateSnapshot"
-	IngestionService_GetSnapshot_FullMethodName            = "/ingestion.v1.IngestionService/GetSnapshot"
-	IngestionService_ListSnapshots_FullMethodName          = "/ingestion.v1.IngestionService/ListSnapshots"
-	IngestionService_ListGenres_FullMethodName             = "/ingestion.v1.IngestionService/ListGenres"
-	IngestionService_GetGenre_FullMethodName               = "/ingestion.v1.IngestionService/GetGenre"
-	IngestionService_GetGenreByCode_FullMethodName         = "/ingestion.v1.IngestionService/GetGenreByCode"
-	IngestionService_CreateGenre_FullMethodName            = "/ingestion.v1.IngestionService/CreateGenre"
-	IngestionService_UpdateGenre_FullMethodName            = "/ingestion.v1.IngestionService/UpdateGenre"
-	IngestionService_EnableGenre_FullMethodName            = "/ingestion.v1.IngestionService/EnableGenre"
-	IngestionService_DisableGenre_FullMethodName           = "/ingestion.v1.IngestionService/DisableGenre"
-	IngestionService_ListYouTubeCategories_FullMethodName  = "/ingestion.v1.IngestionService/ListYouTubeCategories"
-	IngestionService_GetYouTubeCategory_FullMethodName     = "/ingestion.v1.IngestionService/GetYouTubeCategory"
-	IngestionService_UpdateYouTubeCategory_FullMethodName  = "/ingestion.v1.IngestionService/UpdateYouTubeCategory"
-	IngestionService_GetKeyword_FullMethodName             = "/ingestion.v1.IngestionService/GetKeyword"
-	IngestionService_ListKeywords_FullMethodName           = "/ingestion.v1.IngestionService/ListKeywords"
-	IngestionService_ListKeywordsByGenre_FullMethodName    = "/ingestion.v1.IngestionService/ListKeywordsByGenre"
-	IngestionService_CreateKeyword_FullMethodName          = "/ingestion.v1.IngestionService/CreateKeyword"
-	IngestionService_UpdateKeyword_FullMethodName          = "/ingestion.v1.IngestionService/UpdateKeyword"
-	IngestionService_EnableKeyword_FullMethodName          = "/ingestion.v1.IngestionService/EnableKeyword"
-	IngestionService_DisableKeyword_FullMethodName         = "/ingestion.v1.IngestionService/DisableKeyword"
-	IngestionService_DeleteKeyword_FullMethodName          = "/ingestion.v1.IngestionService/DeleteKeyword"
-	IngestionService_ListVideoGenres_FullMethodName        = "/ingestion.v1.IngestionService/ListVideoGenres"
-	IngestionService_AssignVideoToGenre_FullMethodName     = "/ingestion.v1.IngestionService/AssignVideoToGenre"
-	IngestionService_RemoveVideoFromGenre_FullMethodName   = "/ingestion.v1.IngestionService/RemoveVideoFromGenre"
-	IngestionService_ListAuditLogs_FullMethodName          = "/ingestion.v1.IngestionService/ListAuditLogs"
-	IngestionService_GetAuditLog_FullMethodName            = "/ingestion.v1.IngestionService/GetAuditLog"
-	IngestionService_ListBatchJobs_FullMethodName          = "/ingestion.v1.IngestionService/ListBatchJobs"
-	IngestionService_GetBatchJob_FullMethodName            = "/ingestion.v1.IngestionService/GetBatchJob"
-	IngestionService_ScheduleSnapshots_FullMethodName      = "/ingestion.v1.IngestionService/ScheduleSnapshots"
-	IngestionService_UpdateChannels_FullMethodName         = "/ingestion.v1.IngestionService/UpdateChannels"
-	IngestionService_CollectTrendingByGenre_FullMethodName = "/ingestion.v1.IngestionService/CollectTrendingByGenre"
-	IngestionService_CollectAllTrending_FullMethodName     = "/ingestion.v1.IngestionService/CollectAllTrending"
+	IngestionService_GetChannel_FullMethodName                 = "/ingestion.v1.IngestionService/GetChannel"
+	IngestionService_ListChannels_FullMethodName               = "/ingestion.v1.IngestionService/ListChannels"
+	IngestionService_SubscribeChannel_FullMethodName           = "/ingestion.v1.IngestionService/SubscribeChannel"
+	IngestionService_UnsubscribeChannel_FullMethodName         = "/ingestion.v1.IngestionService/UnsubscribeChannel"
+	IngestionService_GetChannelGrowth_FullMethodName           = "/ingestion.v1.IngestionService/GetChannelGrowth"
+	IngestionService_GetVideo_FullMethodName                   = "/ingestion.v1.IngestionService/GetVideo"
+	IngestionService_ListVideos_FullMethodName                 = "/ingestion.v1.IngestionService/ListVideos"
+	IngestionService_CollectTrending_FullMethodName            = "/ingestion.v1.IngestionService/CollectTrending"
+	IngestionService_CollectSubscriptions_FullMethodName       = "/ingestion.v1.IngestionService/CollectSubscriptions"
+	IngestionService_CreateSnapshot_FullMethodName             = "/ingestion.v1.IngestionService/CreateSnapshot"
+	IngestionService_GetSnapshot_FullMethodName                = "/ingestion.v1.IngestionService/GetSnapshot"
+	IngestionService_ListSnapshots_FullMethodName              = "/ingestion.v1.IngestionService/ListSnapshots"
+	IngestionService_StreamSnapshots_FullMethodName            = "/ingestion.v1.IngestionService/StreamSnapshots"
+	IngestionService_ListGenres_FullMethodName                 = "/ingestion.v1.IngestionService/ListGenres"
+	IngestionService_GetGenre_FullMethodName                   = "/ingestion.v1.IngestionService/GetGenre"
+	IngestionService_GetGenreByCode_FullMethodName             = "/ingestion.v1.IngestionService/GetGenreByCode"
+	IngestionService_CreateGenre_FullMethodName                = "/ingestion.v1.IngestionService/CreateGenre"
+	IngestionService_UpdateGenre_FullMethodName                = "/ingestion.v1.IngestionService/UpdateGenre"
+	IngestionService_EnableGenre_FullMethodName                = "/ingestion.v1.IngestionService/EnableGenre"
+	IngestionService_DisableGenre_FullMethodName               = "/ingestion.v1.IngestionService/DisableGenre"
+	IngestionService_ListYouTubeCategories_FullMethodName      = "/ingestion.v1.IngestionService/ListYouTubeCategories"
+	IngestionService_GetYouTubeCategory_FullMethodName         = "/ingestion.v1.IngestionService/GetYouTubeCategory"
+	IngestionService_UpdateYouTubeCategory_FullMethodName      = "/ingestion.v1.IngestionService/UpdateYouTubeCategory"
+	IngestionService_GetKeyword_FullMethodName                 = "/ingestion.v1.IngestionService/GetKeyword"
+	IngestionService_ListKeywords_FullMethodName               = "/ingestion.v1.IngestionService/ListKeywords"
+	IngestionService_ListKeywordsByGenre_FullMethodName        = "/ingestion.v1.IngestionService/ListKeywordsByGenre"
+	IngestionService_CreateKeyword_FullMethodName              = "/ingestion.v1.IngestionService/CreateKeyword"
+	IngestionService_UpdateKeyword_FullMethodName              = "/ingestion.v1.IngestionService/UpdateKeyword"
+	IngestionService_EnableKeyword_FullMethodName              = "/ingestion.v1.IngestionService/EnableKeyword"
+	IngestionService_DisableKeyword_FullMethodName             = "/ingestion.v1.IngestionService/DisableKeyword"
+	IngestionService_DeleteKeyword_FullMethodName              = "/ingestion.v1.IngestionService/DeleteKeyword"
+	IngestionService_GetKeywordGroup_FullMethodName            = "/ingestion.v1.IngestionService/GetKeywordGroup"
+	IngestionService_ListKeywordGroups_FullMethodName          = "/ingestion.v1.IngestionService/ListKeywordGroups"
+	IngestionService_CreateKeywordGroup_FullMethodName         = "/ingestion.v1.IngestionService/CreateKeywordGroup"
+	IngestionService_UpdateKeywordGroup_FullMethodName         = "/ingestion.v1.IngestionService/UpdateKeywordGroup"
+	IngestionService_UpdateKeywordGroupKeywords_FullMethodName = "/ingestion.v1.IngestionService/UpdateKeywordGroupKeywords"
+	IngestionService_AddKeywordGroupItem_FullMethodName        = "/ingestion.v1.IngestionService/AddKeywordGroupItem"
+	IngestionService_RemoveKeywordGroupItem_FullMethodName     = "/ingestion.v1.IngestionService/RemoveKeywordGroupItem"
+	IngestionService_EnableKeywordGroup_FullMethodName         = "/ingestion.v1.IngestionService/EnableKeywordGroup"
+	IngestionService_DisableKeywordGroup_FullMethodName        = "/ingestion.v1.IngestionService/DisableKeywordGroup"
+	IngestionService_DeleteKeywordGroup_FullMethodName         = "/ingestion.v1.IngestionService/DeleteKeywordGroup"
+	IngestionService_GetKeywordGroupPattern_FullMethodName     = "/ingestion.v1.IngestionService/GetKeywordGroupPattern"
+	IngestionService_TestKeywordGroup_FullMethodName           = "/ingestion.v1.IngestionService/TestKeywordGroup"
+	IngestionService_ListKeywordSynonyms_FullMethodName        = "/ingestion.v1.IngestionService/ListKeywordSynonyms"
+	IngestionService_GetKeywordSynonym_FullMethodName          = "/ingestion.v1.IngestionService/GetKeywordSynonym"
+	IngestionService_CreateKeywordSynonym_FullMethodName       = "/ingestion.v1.IngestionService/CreateKeywordSynonym"
+	IngestionService_UpdateKeywordSynonym_FullMethodName       = "/ingestion.v1.IngestionService/UpdateKeywordSynonym"
+	IngestionService_DeleteKeywordSynonym_FullMethodName       = "/ingestion.v1.IngestionService/DeleteKeywordSynonym"
+	IngestionService_ListVideoGenres_FullMethodName            = "/ingestion.v1.IngestionService/ListVideoGenres"
+	IngestionService_AssignVideoToGenre_FullMethodName         = "/ingestion.v1.IngestionService/AssignVideoToGenre"
+	IngestionService_RemoveVideoFromGenre_FullMethodName       = "/ingestion.v1.IngestionService/RemoveVideoFromGenre"
+	IngestionService_ListAuditLogs_FullMethodName              = "/ingestion.v1.IngestionService/ListAuditLogs"
+	IngestionService_GetAuditLog_FullMethodName                = "/ingestion.v1.IngestionService/GetAuditLog"
+	IngestionService_ExportAuditLogs_FullMethodName            = "/ingestion.v1.IngestionService/ExportAuditLogs"
+	IngestionService_ListBatchJobs_FullMethodName              = "/ingestion.v1.IngestionService/ListBatchJobs"
+	IngestionService_GetBatchJob_FullMethodName                = "/ingestion.v1.IngestionService/GetBatchJob"
+	IngestionService_CancelBatchJob_FullMethodName             = "/ingestion.v1.IngestionService/CancelBatchJob"
+	IngestionService_RetryBatchJob_FullMethodName              = "/ingestion.v1.IngestionService/RetryBatchJob"
+	IngestionService_ScheduleSnapshots_FullMethodName          = "/ingestion.v1.IngestionService/ScheduleSnapshots"
+	IngestionService_UpdateChannels_FullMethodName             = "/ingestion.v1.IngestionService/UpdateChannels"
+	IngestionService_CollectTrendingByGenre_FullMethodName     = "/ingestion.v1.IngestionService/CollectTrendingByGenre"
+	IngestionService_CollectAllTrending_FullMethodName         = "/ingestion.v1.IngestionService/CollectAllTrending"
 )
 
 // IngestionServiceClient is the client API for IngestionService service.
@@ -72,6 +94,7 @@ type IngestionServiceClient interface {
 	ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error)
 	SubscribeChannel(ctx context.Context, in *SubscribeChannelRequest, opts ...grpc.CallOption) (*SubscribeChannelResponse, error)
 	UnsubscribeChannel(ctx context.Context, in *UnsubscribeChannelRequest, opts ...grpc.CallOption) (*UnsubscribeChannelResponse, error)
+	GetChannelGrowth(ctx context.Context, in *GetChannelGrowthRequest, opts ...grpc.CallOption) (*GetChannelGrowthResponse, error)
 	// Video operations
 	GetVideo(ctx context.Context, in *GetVideoRequest, opts ...grpc.CallOption) (*GetVideoResponse, error)
 	ListVideos(ctx context.Context, in *ListVideosRequest, opts ...grpc.CallOption) (*ListVideosResponse, error)
@@ -81,6 +104,7 @@ type IngestionServiceClient interface {
 	CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*CreateSnapshotResponse, error)
 	GetSnapshot(ctx context.Context, in *GetSnapshotRequest, opts ...grpc.CallOption) (*GetSnapshotResponse, error)
 	ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error)
+	StreamSnapshots(ctx context.Context, in *StreamSnapshotsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamSnapshotsResponse], error)
 	// Genre operations
 	ListGenres(ctx context.Context, in *ListGenresRequest, opts ...grpc.CallOption) (*ListGenresResponse, error)
 	GetGenre(ctx context.Context, in *GetGenreRequest, opts ...grpc.CallOption) (*GetGenreResponse, error)
@@ -93,15 +117,44 @@ type IngestionServiceClient interface {
 	ListYouTubeCategories(ctx context.Context, in *ListYouTubeCategoriesRequest, opts ...grpc.CallOption) (*ListYouTubeCategoriesResponse, error)
 	GetYouTubeCategory(ctx context.Context, in *GetYouTubeCategoryRequest, opts ...grpc.CallOption) (*GetYouTubeCategoryResponse, error)
 	UpdateYouTubeCategory(ctx context.Context, in *UpdateYouTubeCategoryRequest, opts ...grpc.CallOption) (*UpdateYouTubeCategoryResponse, error)
-	// Keyword operations (updated with genre support)
+	// Deprecated: Do not use.
+	// Keyword operations (legacy single-regex keywords, deprecated in favor of keyword groups)
 	GetKeyword(ctx context.Context, in *GetKeywordRequest, opts ...grpc.CallOption) (*GetKeywordResponse, error)
+	// Deprecated: Do not use.
 	ListKeywords(ctx context.Context, in *ListKeywordsRequest, opts ...grpc.CallOption) (*ListKeywordsResponse, error)
+	// Deprecated: Do not use.
 	ListKeywordsByGenre(ctx context.Context, in *ListKeywordsByGenreRequest, opts ...grpc.CallOption) (*ListKeywordsByGenreResponse, error)
+	// Deprecated: Do not use.
 	CreateKeyword(ctx context.Context, in *CreateKeywordRequest, opts ...grpc.CallOption) (*CreateKeywordResponse, error)
+	// Deprecated: Do not use.
 	UpdateKeyword(ctx context.Context, in *UpdateKeywordRequest, opts ...grpc.CallOption) (*UpdateKeywordResponse, error)
+	// Deprecated: Do not use.
 	EnableKeyword(ctx context.Context, in *EnableKeywordRequest, opts ...grpc.CallOption) (*EnableKeywordResponse, error)
+	// Deprecated: Do not use.
 	DisableKeyword(ctx context.Context, in *DisableKeywordRequest, opts ...grpc.CallOption) (*DisableKeywordResponse, error)
+	// Deprecated: Do not use.
 	DeleteKeyword(ctx context.Context, in *DeleteKeywordRequest, opts ...grpc.CallOption) (*DeleteKeywordResponse, error)
+	// Keyword group operations
+	GetKeywordGroup(ctx context.Context, in *GetKeywordGroupRequest, opts ...grpc.CallOption) (*GetKeywordGroupResponse, error)
+	ListKeywordGroups(ctx context.Context, in *ListKeywordGroupsRequest, opts ...grpc.CallOption) (*ListKeywordGroupsResponse, error)
+	CreateKeywordGroup(ctx context.Context, in *CreateKeywordGroupRequest, opts ...grpc.CallOption) (*CreateKeywordGroupResponse, error)
+	UpdateKeywordGroup(ctx context.Context, in *UpdateKeywordGroupRequest, opts ...grpc.CallOption) (*UpdateKeywordGroupResponse, error)
+	UpdateKeywordGroupKeywords(ctx context.Context, in *UpdateKeywordGroupKeywordsRequest, opts ...grpc.CallOption) (*UpdateKeywordGroupKeywordsResponse, error)
+	AddKeywordGroupItem(ctx context.Context, in *AddKeywordGroupItemRequest, opts ...grpc.CallOption) (*AddKeywordGroupItemResponse, error)
+	RemoveKeywordGroupItem(ctx context.Context, in *RemoveKeywordGroupItemRequest, opts ...grpc.CallOption) (*RemoveKeywordGroupItemResponse, error)
+	EnableKeywordGroup(ctx context.Context, in *EnableKeywordGroupRequest, opts ...grpc.CallOption) (*EnableKeywordGroupResponse, error)
+	DisableKeywordGroup(ctx context.Context, in *DisableKeywordGroupRequest, opts ...grpc.CallOption) (*DisableKeywordGroupResponse, error)
+	DeleteKeywordGroup(ctx context.Context, in *DeleteKeywordGroupRequest, opts ...grpc.CallOption) (*DeleteKeywordGroupResponse, error)
+	GetKeywordGroupPattern(ctx context.Context, in *GetKeywordGroupPatternRequest, opts ...grpc.CallOption) (*GetKeywordGroupPatternResponse, error)
+	// Filters recent videos of the genre with a proposed keyword group, without saving it
+	TestKeywordGroup(ctx context.Context, in *TestKeywordGroupRequest, opts ...grpc.CallOption) (*TestKeywordGroupResponse, error)
+	// Synonym dictionary operations. Keyword group patterns also match the
+	// aliases of their keywords.
+	ListKeywordSynonyms(ctx context.Context, in *ListKeywordSynonymsRequest, opts ...grpc.CallOption) (*ListKeywordSynonymsResponse, error)
+	GetKeywordSynonym(ctx context.Context, in *GetKeywordSynonymRequest, opts ...grpc.CallOption) (*GetKeywordSynonymResponse, error)
+	CreateKeywordSynonym(ctx context.Context, in *CreateKeywordSynonymRequest, opts ...grpc.CallOption) (*CreateKeywordSynonymResponse, error)
+	UpdateKeywordSynonym(ctx context.Context, in *UpdateKeywordSynonymRequest, opts ...grpc.CallOption) (*UpdateKeywordSynonymResponse, error)
+	DeleteKeywordSynonym(ctx context.Context, in *DeleteKeywordSynonymRequest, opts ...grpc.CallOption) (*DeleteKeywordSynonymResponse, error)
 	// Video-Genre operations
 	ListVideoGenres(ctx context.Context, in *ListVideoGenresRequest, opts ...grpc.CallOption) (*ListVideoGenresResponse, error)
 	AssignVideoToGenre(ctx context.Context, in *AssignVideoToGenreRequest, opts ...grpc.CallOption) (*AssignVideoToGenreResponse, error)
@@ -109,9 +162,12 @@ type IngestionServiceClient interface {
 	// Audit operations
 	ListAuditLogs(ctx context.Context, in *ListAuditLogsRequest, opts ...grpc.CallOption) (*ListAuditLogsResponse, error)
 	GetAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*GetAuditLogResponse, error)
+	ExportAuditLogs(ctx context.Context, in *ExportAuditLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportAuditLogsResponse], error)
 	// Batch job operations
 	ListBatchJobs(ctx context.Context, in *ListBatchJobsRequest, opts ...grpc.CallOption) (*ListBatchJobsResponse, error)
 	GetBatchJob(ctx context.Context, in *GetBatchJobRequest, opts ...grpc.CallOption) (*GetBatchJobResponse, error)
+	CancelBatchJob(ctx context.Context, in *CancelBatchJobRequest, opts ...grpc.CallOption) (*CancelBatchJobResponse, error)
+	RetryBatchJob(ctx context.Context, in *RetryBatchJobRequest, opts ...grpc.CallOption) (*RetryBatchJobResponse, error)
 	// System operations
 	ScheduleSnapshots(ctx context.Context, in *ScheduleSnapshotsRequest, opts ...grpc.CallOption) (*ScheduleSnapshotsResponse, error)
 	UpdateChannels(ctx context.Context, in *UpdateChannelsRequest, opts ...grpc.CallOption) (*UpdateChannelsResponse, error)
@@ -167,6 +223,16 @@ func (c *ingestionServiceClient) UnsubscribeChannel(ctx context.Context, in *Uns
 	return out, nil
 }
 
+func (c *ingestionServiceClient) GetChannelGrowth(ctx context.Context, in *GetChannelGrowthRequest, opts ...grpc.CallOption) (*GetChannelGrowthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChannelGrowthResponse)
+	err := c.cc.Invoke(ctx, IngestionService_GetChannelGrowth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ingestionServiceClient) GetVideo(ctx context.Context, in *GetVideoRequest, opts ...grpc.CallOption) (*GetVideoResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetVideoResponse)
@@ -237,6 +303,25 @@ func (c *ingestionServiceClient) ListSnapshots(ctx context.Context, in *ListSnap
 	return out, nil
 }
 
+func (c *ingestionServiceClient) StreamSnapshots(ctx context.Context, in *StreamSnapshotsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamSnapshotsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &IngestionService_ServiceDesc.Streams[0], IngestionService_StreamSnapshots_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamSnapshotsRequest, StreamSnapshotsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IngestionService_StreamSnapshotsClient = grpc.ServerStreamingClient[StreamSnapshotsResponse]
+
 func (c *ingestionServiceClient) ListGenres(ctx context.Context, in *ListGenresRequest, opts ...grpc.CallOption) (*ListGenresResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListGenresResponse)
@@ -337,6 +422,7 @@ func (c *ingestionServiceClient) UpdateYouTubeCategory(ctx context.Context, in *
 	return out, nil
 }
 
+// Deprecated: Do not use.
 func (c *ingestionServiceClient) GetKeyword(ctx context.Context, in *GetKeywordRequest, opts ...grpc.CallOption) (*GetKeywordResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetKeywordResponse)
@@ -347,6 +433,7 @@ func (c *ingestionServiceClient) GetKeyword(ctx context.Context, in *GetKeywordR
 	return out, nil
 }
 
+// Deprecated: Do not use.
 func (c *ingestionServiceClient) ListKeywords(ctx context.Context, in *ListKeywordsRequest, opts ...grpc.CallOption) (*ListKeywordsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListKeywordsResponse)
@@ -357,6 +444,7 @@ func (c *ingestionServiceClient) ListKeywords(ctx context.Context, in *ListKeywo
 	return out, nil
 }
 
+// Deprecated: Do not use.
 func (c *ingestionServiceClient) ListKeywordsByGenre(ctx context.Context, in *ListKeywordsByGenreRequest, opts ...grpc.CallOption) (*ListKeywordsByGenreResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListKeywordsByGenreResponse)
@@ -367,6 +455,7 @@ func (c *ingestionServiceClient) ListKeywordsByGenre(ctx context.Context, in *Li
 	return out, nil
 }
 
+// Deprecated: Do not use.
 func (c *ingestionServiceClient) CreateKeyword(ctx context.Context, in *CreateKeywordRequest, opts ...grpc.CallOption) (*CreateKeywordResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateKeywordResponse)
@@ -377,6 +466,7 @@ func (c *ingestionServiceClient) CreateKeyword(ctx context.Context, in *CreateKe
 	return out, nil
 }
 
+// Deprecated: Do not use.
 func (c *ingestionServiceClient) UpdateKeyword(ctx context.Context, in *UpdateKeywordRequest, opts ...grpc.CallOption) (*UpdateKeywordResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateKeywordResponse)
@@ -387,6 +477,7 @@ func (c *ingestionServiceClient) UpdateKeyword(ctx context.Context, in *UpdateKe
 	return out, nil
 }
 
+// Deprecated: Do not use.
 func (c *ingestionServiceClient) EnableKeyword(ctx context.Context, in *EnableKeywordRequest, opts ...grpc.CallOption) (*EnableKeywordResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(EnableKeywordResponse)
@@ -397,6 +488,7 @@ func (c *ingestionServiceClient) EnableKeyword(ctx context.Context, in *EnableKe
 	return out, nil
 }
 
+// Deprecated: Do not use.
 func (c *ingestionServiceClient) DisableKeyword(ctx context.Context, in *DisableKeywordRequest, opts ...grpc.CallOption) (*DisableKeywordResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DisableKeywordResponse)
@@ -407,6 +499,7 @@ func (c *ingestionServiceClient) DisableKeyword(ctx context.Context, in *Disable
 	return out, nil
 }
 
+// Deprecated: Do not use.
 func (c *ingestionServiceClient) DeleteKeyword(ctx context.Context, in *DeleteKeywordRequest, opts ...grpc.CallOption) (*DeleteKeywordResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteKeywordResponse)
@@ -417,6 +510,176 @@ func (c *ingestionServiceClient) DeleteKeyword(ctx context.Context, in *DeleteKe
 	return out, nil
 }
 
+func (c *ingestionServiceClient) GetKeywordGroup(ctx context.Context, in *GetKeywordGroupRequest, opts ...grpc.CallOption) (*GetKeywordGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetKeywordGroupResponse)
+	err := c.cc.Invoke(ctx, IngestionService_GetKeywordGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionServiceClient) ListKeywordGroups(ctx context.Context, in *ListKeywordGroupsRequest, opts ...grpc.CallOption) (*ListKeywordGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListKeywordGroupsResponse)
+	err := c.cc.Invoke(ctx, IngestionService_ListKeywordGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionServiceClient) CreateKeywordGroup(ctx context.Context, in *CreateKeywordGroupRequest, opts ...grpc.CallOption) (*CreateKeywordGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateKeywordGroupResponse)
+	err := c.cc.Invoke(ctx, IngestionService_CreateKeywordGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionServiceClient) UpdateKeywordGroup(ctx context.Context, in *UpdateKeywordGroupRequest, opts ...grpc.CallOption) (*UpdateKeywordGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateKeywordGroupResponse)
+	err := c.cc.Invoke(ctx, IngestionService_UpdateKeywordGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionServiceClient) UpdateKeywordGroupKeywords(ctx context.Context, in *UpdateKeywordGroupKeywordsRequest, opts ...grpc.CallOption) (*UpdateKeywordGroupKeywordsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateKeywordGroupKeywordsResponse)
+	err := c.cc.Invoke(ctx, IngestionService_UpdateKeywordGroupKeywords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionServiceClient) AddKeywordGroupItem(ctx context.Context, in *AddKeywordGroupItemRequest, opts ...grpc.CallOption) (*AddKeywordGroupItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddKeywordGroupItemResponse)
+	err := c.cc.Invoke(ctx, IngestionService_AddKeywordGroupItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionServiceClient) RemoveKeywordGroupItem(ctx context.Context, in *RemoveKeywordGroupItemRequest, opts ...grpc.CallOption) (*RemoveKeywordGroupItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveKeywordGroupItemResponse)
+	err := c.cc.Invoke(ctx, IngestionService_RemoveKeywordGroupItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionServiceClient) EnableKeywordGroup(ctx context.Context, in *EnableKeywordGroupRequest, opts ...grpc.CallOption) (*EnableKeywordGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnableKeywordGroupResponse)
+	err := c.cc.Invoke(ctx, IngestionService_EnableKeywordGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionServiceClient) DisableKeywordGroup(ctx context.Context, in *DisableKeywordGroupRequest, opts ...grpc.CallOption) (*DisableKeywordGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisableKeywordGroupResponse)
+	err := c.cc.Invoke(ctx, IngestionService_DisableKeywordGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionServiceClient) DeleteKeywordGroup(ctx context.Context, in *DeleteKeywordGroupRequest, opts ...grpc.CallOption) (*DeleteKeywordGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteKeywordGroupResponse)
+	err := c.cc.Invoke(ctx, IngestionService_DeleteKeywordGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionServiceClient) GetKeywordGroupPattern(ctx context.Context, in *GetKeywordGroupPatternRequest, opts ...grpc.CallOption) (*GetKeywordGroupPatternResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetKeywordGroupPatternResponse)
+	err := c.cc.Invoke(ctx, IngestionService_GetKeywordGroupPattern_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionServiceClient) TestKeywordGroup(ctx context.Context, in *TestKeywordGroupRequest, opts ...grpc.CallOption) (*TestKeywordGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TestKeywordGroupResponse)
+	err := c.cc.Invoke(ctx, IngestionService_TestKeywordGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionServiceClient) ListKeywordSynonyms(ctx context.Context, in *ListKeywordSynonymsRequest, opts ...grpc.CallOption) (*ListKeywordSynonymsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListKeywordSynonymsResponse)
+	err := c.cc.Invoke(ctx, IngestionService_ListKeywordSynonyms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionServiceClient) GetKeywordSynonym(ctx context.Context, in *GetKeywordSynonymRequest, opts ...grpc.CallOption) (*GetKeywordSynonymResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetKeywordSynonymResponse)
+	err := c.cc.Invoke(ctx, IngestionService_GetKeywordSynonym_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionServiceClient) CreateKeywordSynonym(ctx context.Context, in *CreateKeywordSynonymRequest, opts ...grpc.CallOption) (*CreateKeywordSynonymResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateKeywordSynonymResponse)
+	err := c.cc.Invoke(ctx, IngestionService_CreateKeywordSynonym_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionServiceClient) UpdateKeywordSynonym(ctx context.Context, in *UpdateKeywordSynonymRequest, opts ...grpc.CallOption) (*UpdateKeywordSynonymResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateKeywordSynonymResponse)
+	err := c.cc.Invoke(ctx, IngestionService_UpdateKeywordSynonym_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionServiceClient) DeleteKeywordSynonym(ctx context.Context, in *DeleteKeywordSynonymRequest, opts ...grpc.CallOption) (*DeleteKeywordSynonymResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteKeywordSynonymResponse)
+	err := c.cc.Invoke(ctx, IngestionService_DeleteKeywordSynonym_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ingestionServiceClient) ListVideoGenres(ctx context.Context, in *ListVideoGenresRequest, opts ...grpc.CallOption) (*ListVideoGenresResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListVideoGenresResponse)
@@ -467,6 +730,25 @@ func (c *ingestionServiceClient) GetAuditLog(ctx context.Context, in *GetAuditLo
 	return out, nil
 }
 
+func (c *ingestionServiceClient) ExportAuditLogs(ctx context.Context, in *ExportAuditLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportAuditLogsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &IngestionService_ServiceDesc.Streams[1], IngestionService_ExportAuditLogs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportAuditLogsRequest, ExportAuditLogsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IngestionService_ExportAuditLogsClient = grpc.ServerStreamingClient[ExportAuditLogsResponse]
+
 func (c *ingestionServiceClient) ListBatchJobs(ctx context.Context, in *ListBatchJobsRequest, opts ...grpc.CallOption) (*ListBatchJobsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListBatchJobsResponse)
@@ -487,6 +769,26 @@ func (c *ingestionServiceClient) GetBatchJob(ctx context.Context, in *GetBatchJo
 	return out, nil
 }
 
+func (c *ingestionServiceClient) CancelBatchJob(ctx context.Context, in *CancelBatchJobRequest, opts ...grpc.CallOption) (*CancelBatchJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelBatchJobResponse)
+	err := c.cc.Invoke(ctx, IngestionService_CancelBatchJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionServiceClient) RetryBatchJob(ctx context.Context, in *RetryBatchJobRequest, opts ...grpc.CallOption) (*RetryBatchJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetryBatchJobResponse)
+	err := c.cc.Invoke(ctx, IngestionService_RetryBatchJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ingestionServiceClient) ScheduleSnapshots(ctx context.Context, in *ScheduleSnapshotsRequest, opts ...grpc.CallOption) (*ScheduleSnapshotsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ScheduleSnapshotsResponse)
@@ -538,6 +840,7 @@ type IngestionServiceServer interface {
 	ListChannels(context.Context, *ListChannelsRequest) (*ListChannelsResponse, error)
 	SubscribeChannel(context.Context, *SubscribeChannelRequest) (*SubscribeChannelResponse, error)
 	UnsubscribeChannel(context.Context, *UnsubscribeChannelRequest) (*UnsubscribeChannelResponse, error)
+	GetChannelGrowth(context.Context, *GetChannelGrowthRequest) (*GetChannelGrowthResponse, error)
 	// Video operations
 	GetVideo(context.Context, *GetVideoRequest) (*GetVideoResponse, error)
 	ListVideos(context.Context, *ListVideosRequest) (*ListVideosResponse, error)
@@ -547,6 +850,7 @@ type IngestionServiceServer interface {
 	CreateSnapshot(context.Context, *CreateSnapshotRequest) (*CreateSnapshotResponse, error)
 	GetSnapshot(context.Context, *GetSnapshotRequest) (*GetSnapshotResponse, error)
 	ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error)
+	StreamSnapshots(*StreamSnapshotsRequest, grpc.ServerStreamingServer[StreamSnapshotsResponse]) error
 	// Genre operations
 	ListGenres(context.Context, *ListGenresRequest) (*ListGenresResponse, error)
 	GetGenre(context.Context, *GetGenreRequest) (*GetGenreResponse, error)
@@ -559,15 +863,44 @@ type IngestionServiceServer interface {
 	ListYouTubeCategories(context.Context, *ListYouTubeCategoriesRequest) (*ListYouTubeCategoriesResponse, error)
 	GetYouTubeCategory(context.Context, *GetYouTubeCategoryRequest) (*GetYouTubeCategoryResponse, error)
 	UpdateYouTubeCategory(context.Context, *UpdateYouTubeCategoryRequest) (*UpdateYouTubeCategoryResponse, error)
-	// Keyword operations (updated with genre support)
+	// Deprecated: Do not use.
+	// Keyword operations (legacy single-regex keywords, deprecated in favor of keyword groups)
 	GetKeyword(context.Context, *GetKeywordRequest) (*GetKeywordResponse, error)
+	// Deprecated: Do not use.
 	ListKeywords(context.Context, *ListKeywordsRequest) (*ListKeywordsResponse, error)
+	// Deprecated: Do not use.
 	ListKeywordsByGenre(context.Context, *ListKeywordsByGenreRequest) (*ListKeywordsByGenreResponse, error)
+	// Deprecated: Do not use.
 	CreateKeyword(context.Context, *CreateKeywordRequest) (*CreateKeywordResponse, error)
+	// Deprecated: Do not use.
 	UpdateKeyword(context.Context, *UpdateKeywordRequest) (*UpdateKeywordResponse, error)
+	// Deprecated: Do not use.
 	EnableKeyword(context.Context, *EnableKeywordRequest) (*EnableKeywordResponse, error)
+	// Deprecated: Do not use.
 	DisableKeyword(context.Context, *DisableKeywordRequest) (*DisableKeywordResponse, error)
+	// Deprecated: Do not use.
 	DeleteKeyword(context.Context, *DeleteKeywordRequest) (*DeleteKeywordResponse, error)
+	// Keyword group operations
+	GetKeywordGroup(context.Context, *GetKeywordGroupRequest) (*GetKeywordGroupResponse, error)
+	ListKeywordGroups(context.Context, *ListKeywordGroupsRequest) (*ListKeywordGroupsResponse, error)
+	CreateKeywordGroup(context.Context, *CreateKeywordGroupRequest) (*CreateKeywordGroupResponse, error)
+	UpdateKeywordGroup(context.Context, *UpdateKeywordGroupRequest) (*UpdateKeywordGroupResponse, error)
+	UpdateKeywordGroupKeywords(context.Context, *UpdateKeywordGroupKeywordsRequest) (*UpdateKeywordGroupKeywordsResponse, error)
+	AddKeywordGroupItem(context.Context, *AddKeywordGroupItemRequest) (*AddKeywordGroupItemResponse, error)
+	RemoveKeywordGroupItem(context.Context, *RemoveKeywordGroupItemRequest) (*RemoveKeywordGroupItemResponse, error)
+	EnableKeywordGroup(context.Context, *EnableKeywordGroupRequest) (*EnableKeywordGroupResponse, error)
+	DisableKeywordGroup(context.Context, *DisableKeywordGroupRequest) (*DisableKeywordGroupResponse, error)
+	DeleteKeywordGroup(context.Context, *DeleteKeywordGroupRequest) (*DeleteKeywordGroupResponse, error)
+	GetKeywordGroupPattern(context.Context, *GetKeywordGroupPatternRequest) (*GetKeywordGroupPatternResponse, error)
+	// Filters recent videos of the genre with a proposed keyword group, without saving it
+	TestKeywordGroup(context.Context, *TestKeywordGroupRequest) (*TestKeywordGroupResponse, error)
+	// Synonym dictionary operations. Keyword group patterns also match the
+	// aliases of their keywords.
+	ListKeywordSynonyms(context.Context, *ListKeywordSynonymsRequest) (*ListKeywordSynonymsResponse, error)
+	GetKeywordSynonym(context.Context, *GetKeywordSynonymRequest) (*GetKeywordSynonymResponse, error)
+	CreateKeywordSynonym(context.Context, *CreateKeywordSynonymRequest) (*CreateKeywordSynonymResponse, error)
+	UpdateKeywordSynonym(context.Context, *UpdateKeywordSynonymRequest) (*UpdateKeywordSynonymResponse, error)
+	DeleteKeywordSynonym(context.Context, *DeleteKeywordSynonymRequest) (*DeleteKeywordSynonymResponse, error)
 	// Video-Genre operations
 	ListVideoGenres(context.Context, *ListVideoGenresRequest) (*ListVideoGenresResponse, error)
 	AssignVideoToGenre(context.Context, *AssignVideoToGenreRequest) (*AssignVideoToGenreResponse, error)
@@ -575,9 +908,12 @@ type IngestionServiceServer interface {
 	// Audit operations
 	ListAuditLogs(context.Context, *ListAuditLogsRequest) (*ListAuditLogsResponse, error)
 	GetAuditLog(context.Context, *GetAuditLogRequest) (*GetAuditLogResponse, error)
+	ExportAuditLogs(*ExportAuditLogsRequest, grpc.ServerStreamingServer[ExportAuditLogsResponse]) error
 	// Batch job operations
 	ListBatchJobs(context.Context, *ListBatchJobsRequest) (*ListBatchJobsResponse, error)
 	GetBatchJob(context.Context, *GetBatchJobRequest) (*GetBatchJobResponse, error)
+	CancelBatchJob(context.Context, *CancelBatchJobRequest) (*CancelBatchJobResponse, error)
+	RetryBatchJob(context.Context, *RetryBatchJobRequest) (*RetryBatchJobResponse, error)
 	// System operations
 	ScheduleSnapshots(context.Context, *ScheduleSnapshotsRequest) (*ScheduleSnapshotsResponse, error)
 	UpdateChannels(context.Context, *UpdateChannelsRequest) (*UpdateChannelsResponse, error)
@@ -605,6 +941,9 @@ func (UnimplementedIngestionServiceServer) SubscribeChannel(context.Context, *Su
 func (UnimplementedIngestionServiceServer) UnsubscribeChannel(context.Context, *UnsubscribeChannelRequest) (*UnsubscribeChannelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeChannel not implemented")
 }
+func (UnimplementedIngestionServiceServer) GetChannelGrowth(context.Context, *GetChannelGrowthRequest) (*GetChannelGrowthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelGrowth not implemented")
+}
 func (UnimplementedIngestionServiceServer) GetVideo(context.Context, *GetVideoRequest) (*GetVideoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVideo not implemented")
 }
@@ -626,6 +965,9 @@ func (UnimplementedIngestionServiceServer) GetSnapshot(context.Context, *GetSnap
 func (UnimplementedIngestionServiceServer) ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
 }
+func (UnimplementedIngestionServiceServer) StreamSnapshots(*StreamSnapshotsRequest, grpc.ServerStreamingServer[StreamSnapshotsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamSnapshots not implemented")
+}
 func (UnimplementedIngestionServiceServer) ListGenres(context.Context, *ListGenresRequest) (*ListGenresResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListGenres not implemented")
 }
@@ -680,6 +1022,57 @@ func (UnimplementedIngestionServiceServer) DisableKeyword(context.Context, *Disa
 func (UnimplementedIngestionServiceServer) DeleteKeyword(context.Context, *DeleteKeywordRequest) (*DeleteKeywordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteKeyword not implemented")
 }
+func (UnimplementedIngestionServiceServer) GetKeywordGroup(context.Context, *GetKeywordGroupRequest) (*GetKeywordGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKeywordGroup not implemented")
+}
+func (UnimplementedIngestionServiceServer) ListKeywordGroups(context.Context, *ListKeywordGroupsRequest) (*ListKeywordGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKeywordGroups not implemented")
+}
+func (UnimplementedIngestionServiceServer) CreateKeywordGroup(context.Context, *CreateKeywordGroupRequest) (*CreateKeywordGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateKeywordGroup not implemented")
+}
+func (UnimplementedIngestionServiceServer) UpdateKeywordGroup(context.Context, *UpdateKeywordGroupRequest) (*UpdateKeywordGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateKeywordGroup not implemented")
+}
+func (UnimplementedIngestionServiceServer) UpdateKeywordGroupKeywords(context.Context, *UpdateKeywordGroupKeywordsRequest) (*UpdateKeywordGroupKeywordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateKeywordGroupKeywords not implemented")
+}
+func (UnimplementedIngestionServiceServer) AddKeywordGroupItem(context.Context, *AddKeywordGroupItemRequest) (*AddKeywordGroupItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddKeywordGroupItem not implemented")
+}
+func (UnimplementedIngestionServiceServer) RemoveKeywordGroupItem(context.Context, *RemoveKeywordGroupItemRequest) (*RemoveKeywordGroupItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveKeywordGroupItem not implemented")
+}
+func (UnimplementedIngestionServiceServer) EnableKeywordGroup(context.Context, *EnableKeywordGroupRequest) (*EnableKeywordGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableKeywordGroup not implemented")
+}
+func (UnimplementedIngestionServiceServer) DisableKeywordGroup(context.Context, *DisableKeywordGroupRequest) (*DisableKeywordGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableKeywordGroup not implemented")
+}
+func (UnimplementedIngestionServiceServer) DeleteKeywordGroup(context.Context, *DeleteKeywordGroupRequest) (*DeleteKeywordGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteKeywordGroup not implemented")
+}
+func (UnimplementedIngestionServiceServer) GetKeywordGroupPattern(context.Context, *GetKeywordGroupPatternRequest) (*GetKeywordGroupPatternResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKeywordGroupPattern not implemented")
+}
+func (UnimplementedIngestionServiceServer) TestKeywordGroup(context.Context, *TestKeywordGroupRequest) (*TestKeywordGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestKeywordGroup not implemented")
+}
+func (UnimplementedIngestionServiceServer) ListKeywordSynonyms(context.Context, *ListKeywordSynonymsRequest) (*ListKeywordSynonymsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKeywordSynonyms not implemented")
+}
+func (UnimplementedIngestionServiceServer) GetKeywordSynonym(context.Context, *GetKeywordSynonymRequest) (*GetKeywordSynonymResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKeywordSynonym not implemented")
+}
+func (UnimplementedIngestionServiceServer) CreateKeywordSynonym(context.Context, *CreateKeywordSynonymRequest) (*CreateKeywordSynonymResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateKeywordSynonym not implemented")
+}
+func (UnimplementedIngestionServiceServer) UpdateKeywordSynonym(context.Context, *UpdateKeywordSynonymRequest) (*UpdateKeywordSynonymResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateKeywordSynonym not implemented")
+}
+func (UnimplementedIngestionServiceServer) DeleteKeywordSynonym(context.Context, *DeleteKeywordSynonymRequest) (*DeleteKeywordSynonymResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteKeywordSynonym not implemented")
+}
 func (UnimplementedIngestionServiceServer) ListVideoGenres(context.Context, *ListVideoGenresRequest) (*ListVideoGenresResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListVideoGenres not implemented")
 }
@@ -695,12 +1088,21 @@ func (UnimplementedIngestionServiceServer) ListAuditLogs(context.Context, *ListA
 func (UnimplementedIngestionServiceServer) GetAuditLog(context.Context, *GetAuditLogRequest) (*GetAuditLogResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLog not implemented")
 }
+func (UnimplementedIngestionServiceServer) ExportAuditLogs(*ExportAuditLogsRequest, grpc.ServerStreamingServer[ExportAuditLogsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportAuditLogs not implemented")
+}
 func (UnimplementedIngestionServiceServer) ListBatchJobs(context.Context, *ListBatchJobsRequest) (*ListBatchJobsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBatchJobs not implemented")
 }
 func (UnimplementedIngestionServiceServer) GetBatchJob(context.Context, *GetBatchJobRequest) (*GetBatchJobResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBatchJob not implemented")
 }
+func (UnimplementedIngestionServiceServer) CancelBatchJob(context.Context, *CancelBatchJobRequest) (*CancelBatchJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBatchJob not implemented")
+}
+func (UnimplementedIngestionServiceServer) RetryBatchJob(context.Context, *RetryBatchJobRequest) (*RetryBatchJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryBatchJob not implemented")
+}
 func (UnimplementedIngestionServiceServer) ScheduleSnapshots(context.Context, *ScheduleSnapshotsRequest) (*ScheduleSnapshotsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ScheduleSnapshots not implemented")
 }
@@ -806,6 +1208,24 @@ func _IngestionService_UnsubscribeChannel_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IngestionService_GetChannelGrowth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelGrowthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionServiceServer).GetChannelGrowth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionService_GetChannelGrowth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionServiceServer).GetChannelGrowth(ctx, req.(*GetChannelGrowthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IngestionService_GetVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetVideoRequest)
 	if err := dec(in); err != nil {
@@ -932,6 +1352,17 @@ func _IngestionService_ListSnapshots_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IngestionService_StreamSnapshots_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamSnapshotsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IngestionServiceServer).StreamSnapshots(m, &grpc.GenericServerStream[StreamSnapshotsRequest, StreamSnapshotsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IngestionService_StreamSnapshotsServer = grpc.ServerStreamingServer[StreamSnapshotsResponse]
+
 func _IngestionService_ListGenres_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListGenresRequest)
 	if err := dec(in); err != nil {
@@ -1256,6 +1687,312 @@ func _IngestionService_DeleteKeyword_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IngestionService_GetKeywordGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKeywordGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionServiceServer).GetKeywordGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionService_GetKeywordGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionServiceServer).GetKeywordGroup(ctx, req.(*GetKeywordGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionService_ListKeywordGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKeywordGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionServiceServer).ListKeywordGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionService_ListKeywordGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionServiceServer).ListKeywordGroups(ctx, req.(*ListKeywordGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionService_CreateKeywordGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateKeywordGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionServiceServer).CreateKeywordGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionService_CreateKeywordGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionServiceServer).CreateKeywordGroup(ctx, req.(*CreateKeywordGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionService_UpdateKeywordGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateKeywordGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionServiceServer).UpdateKeywordGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionService_UpdateKeywordGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionServiceServer).UpdateKeywordGroup(ctx, req.(*UpdateKeywordGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionService_UpdateKeywordGroupKeywords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateKeywordGroupKeywordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionServiceServer).UpdateKeywordGroupKeywords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionService_UpdateKeywordGroupKeywords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionServiceServer).UpdateKeywordGroupKeywords(ctx, req.(*UpdateKeywordGroupKeywordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionService_AddKeywordGroupItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddKeywordGroupItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionServiceServer).AddKeywordGroupItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionService_AddKeywordGroupItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionServiceServer).AddKeywordGroupItem(ctx, req.(*AddKeywordGroupItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionService_RemoveKeywordGroupItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveKeywordGroupItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionServiceServer).RemoveKeywordGroupItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionService_RemoveKeywordGroupItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionServiceServer).RemoveKeywordGroupItem(ctx, req.(*RemoveKeywordGroupItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionService_EnableKeywordGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableKeywordGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionServiceServer).EnableKeywordGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionService_EnableKeywordGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionServiceServer).EnableKeywordGroup(ctx, req.(*EnableKeywordGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionService_DisableKeywordGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableKeywordGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionServiceServer).DisableKeywordGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionService_DisableKeywordGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionServiceServer).DisableKeywordGroup(ctx, req.(*DisableKeywordGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionService_DeleteKeywordGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteKeywordGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionServiceServer).DeleteKeywordGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionService_DeleteKeywordGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionServiceServer).DeleteKeywordGroup(ctx, req.(*DeleteKeywordGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionService_GetKeywordGroupPattern_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKeywordGroupPatternRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionServiceServer).GetKeywordGroupPattern(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionService_GetKeywordGroupPattern_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionServiceServer).GetKeywordGroupPattern(ctx, req.(*GetKeywordGroupPatternRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionService_TestKeywordGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestKeywordGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionServiceServer).TestKeywordGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionService_TestKeywordGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionServiceServer).TestKeywordGroup(ctx, req.(*TestKeywordGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionService_ListKeywordSynonyms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKeywordSynonymsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionServiceServer).ListKeywordSynonyms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionService_ListKeywordSynonyms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionServiceServer).ListKeywordSynonyms(ctx, req.(*ListKeywordSynonymsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionService_GetKeywordSynonym_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKeywordSynonymRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionServiceServer).GetKeywordSynonym(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionService_GetKeywordSynonym_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionServiceServer).GetKeywordSynonym(ctx, req.(*GetKeywordSynonymRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionService_CreateKeywordSynonym_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateKeywordSynonymRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionServiceServer).CreateKeywordSynonym(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionService_CreateKeywordSynonym_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionServiceServer).CreateKeywordSynonym(ctx, req.(*CreateKeywordSynonymRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionService_UpdateKeywordSynonym_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateKeywordSynonymRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionServiceServer).UpdateKeywordSynonym(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionService_UpdateKeywordSynonym_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionServiceServer).UpdateKeywordSynonym(ctx, req.(*UpdateKeywordSynonymRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionService_DeleteKeywordSynonym_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteKeywordSynonymRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionServiceServer).DeleteKeywordSynonym(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionService_DeleteKeywordSynonym_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionServiceServer).DeleteKeywordSynonym(ctx, req.(*DeleteKeywordSynonymRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IngestionService_ListVideoGenres_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListVideoGenresRequest)
 	if err := dec(in); err != nil {
@@ -1346,6 +2083,17 @@ func _IngestionService_GetAuditLog_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IngestionService_ExportAuditLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportAuditLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IngestionServiceServer).ExportAuditLogs(m, &grpc.GenericServerStream[ExportAuditLogsRequest, ExportAuditLogsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IngestionService_ExportAuditLogsServer = grpc.ServerStreamingServer[ExportAuditLogsResponse]
+
 func _IngestionService_ListBatchJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListBatchJobsRequest)
 	if err := dec(in); err != nil {
@@ -1382,6 +2130,42 @@ func _IngestionService_GetBatchJob_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IngestionService_CancelBatchJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBatchJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionServiceServer).CancelBatchJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionService_CancelBatchJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionServiceServer).CancelBatchJob(ctx, req.(*CancelBatchJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionService_RetryBatchJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryBatchJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionServiceServer).RetryBatchJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionService_RetryBatchJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionServiceServer).RetryBatchJob(ctx, req.(*RetryBatchJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IngestionService_ScheduleSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ScheduleSnapshotsRequest)
 	if err := dec(in); err != nil {
@@ -1477,6 +2261,10 @@ var IngestionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnsubscribeChannel",
 			Handler:    _IngestionService_UnsubscribeChannel_Handler,
 		},
+		{
+			MethodName: "GetChannelGrowth",
+			Handler:    _IngestionService_GetChannelGrowth_Handler,
+		},
 		{
 			MethodName: "GetVideo",
 			Handler:    _IngestionService_GetVideo_Handler,
@@ -1577,6 +2365,74 @@ var IngestionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteKeyword",
 			Handler:    _IngestionService_DeleteKeyword_Handler,
 		},
+		{
+			MethodName: "GetKeywordGroup",
+			Handler:    _IngestionService_GetKeywordGroup_Handler,
+		},
+		{
+			MethodName: "ListKeywordGroups",
+			Handler:    _IngestionService_ListKeywordGroups_Handler,
+		},
+		{
+			MethodName: "CreateKeywordGroup",
+			Handler:    _IngestionService_CreateKeywordGroup_Handler,
+		},
+		{
+			MethodName: "UpdateKeywordGroup",
+			Handler:    _IngestionService_UpdateKeywordGroup_Handler,
+		},
+		{
+			MethodName: "UpdateKeywordGroupKeywords",
+			Handler:    _IngestionService_UpdateKeywordGroupKeywords_Handler,
+		},
+		{
+			MethodName: "AddKeywordGroupItem",
+			Handler:    _IngestionService_AddKeywordGroupItem_Handler,
+		},
+		{
+			MethodName: "RemoveKeywordGroupItem",
+			Handler:    _IngestionService_RemoveKeywordGroupItem_Handler,
+		},
+		{
+			MethodName: "EnableKeywordGroup",
+			Handler:    _IngestionService_EnableKeywordGroup_Handler,
+		},
+		{
+			MethodName: "DisableKeywordGroup",
+			Handler:    _IngestionService_DisableKeywordGroup_Handler,
+		},
+		{
+			MethodName: "DeleteKeywordGroup",
+			Handler:    _IngestionService_DeleteKeywordGroup_Handler,
+		},
+		{
+			MethodName: "GetKeywordGroupPattern",
+			Handler:    _IngestionService_GetKeywordGroupPattern_Handler,
+		},
+		{
+			MethodName: "TestKeywordGroup",
+			Handler:    _IngestionService_TestKeywordGroup_Handler,
+		},
+		{
+			MethodName: "ListKeywordSynonyms",
+			Handler:    _IngestionService_ListKeywordSynonyms_Handler,
+		},
+		{
+			MethodName: "GetKeywordSynonym",
+			Handler:    _IngestionService_GetKeywordSynonym_Handler,
+		},
+		{
+			MethodName: "CreateKeywordSynonym",
+			Handler:    _IngestionService_CreateKeywordSynonym_Handler,
+		},
+		{
+			MethodName: "UpdateKeywordSynonym",
+			Handler:    _IngestionService_UpdateKeywordSynonym_Handler,
+		},
+		{
+			MethodName: "DeleteKeywordSynonym",
+			Handler:    _IngestionService_DeleteKeywordSynonym_Handler,
+		},
 		{
 			MethodName: "ListVideoGenres",
 			Handler:    _IngestionService_ListVideoGenres_Handler,
@@ -1605,6 +2461,14 @@ var IngestionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBatchJob",
 			Handler:    _IngestionService_GetBatchJob_Handler,
 		},
+		{
+			MethodName: "CancelBatchJob",
+			Handler:    _IngestionService_CancelBatchJob_Handler,
+		},
+		{
+			MethodName: "RetryBatchJob",
+			Handler:    _IngestionService_RetryBatchJob_Handler,
+		},
 		{
 			MethodName: "ScheduleSnapshots",
 			Handler:    _IngestionService_ScheduleSnapshots_Handler,
@@ -1622,6 +2486,17 @@ var IngestionService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _IngestionService_CollectAllTrending_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamSnapshots",
+			Handler:       _IngestionService_StreamSnapshots_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportAuditLogs",
+			Handler:       _IngestionService_ExportAuditLogs_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "ingestion/v1/ingestion.proto",
 }